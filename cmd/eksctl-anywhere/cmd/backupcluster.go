@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/version"
+)
+
+type backupClusterOptions struct {
+	// kubeConfig is the kubeconfig of the management cluster.
+	kubeConfig string
+	output     string
+}
+
+var bco = &backupClusterOptions{}
+
+func init() {
+	backupCmd.AddCommand(backupClusterCmd)
+
+	backupClusterCmd.Flags().StringVar(&bco.kubeConfig, "kubeconfig", "",
+		"Management cluster kubeconfig file. Defaults to the kubeconfig generated for the cluster")
+	backupClusterCmd.Flags().StringVarP(&bco.output, "output", "o", "",
+		"Directory to save the backup to, or a file ending in .tar.gz to save it as a gzipped tarball. Defaults to <cluster name>-backup-<timestamp>")
+}
+
+var backupClusterCmd = &cobra.Command{
+	Use:          "cluster <management cluster name> [flags]",
+	Short:        "Backup a management cluster and its workload clusters",
+	Long:         "This command saves the EKS-A, CAPI and provider objects, together with the secrets in the eksa-system namespace, of a management cluster and all the workload clusters it manages",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return bco.backupCluster(cmd.Context(), args[0])
+	},
+}
+
+func (o *backupClusterOptions) backupCluster(ctx context.Context, clusterName string) error {
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, clusterName)
+	if err != nil {
+		return err
+	}
+
+	output := o.output
+	if output == "" {
+		output = fmt.Sprintf("%s-backup-%s", clusterName, time.Now().Format("2006-01-02T15_04_05"))
+	}
+	output, err = filepath.Abs(output)
+	if err != nil {
+		return fmt.Errorf("resolving backup path: %v", err)
+	}
+	if _, err := os.Stat(output); err == nil {
+		return fmt.Errorf("backup destination %s already exists", output)
+	}
+
+	dir := output
+	if clusterbackup.IsArchive(output) {
+		// The temporary directory is created next to the archive so it's mounted when running executables in a container.
+		dir, err = os.MkdirTemp(filepath.Dir(output), "eksa-backup-")
+		if err != nil {
+			return fmt.Errorf("creating temporary backup directory: %v", err)
+		}
+		defer os.RemoveAll(dir)
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("unable to initialize k8s client: %v", err)
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(filepath.Dir(output), filepath.Dir(kubeConfig)).
+		WithClusterctl().
+		Build(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	defer close(ctx, deps)
+
+	managementCluster := &types.Cluster{
+		Name:           clusterName,
+		KubeconfigFile: kubeConfig,
+	}
+	backuper := clusterbackup.NewBackuper(clientutil.NewKubeClient(client), deps.Clusterctl, version.Get().GitVersion)
+	manifest, err := backuper.Backup(ctx, managementCluster, dir)
+	if err != nil {
+		return fmt.Errorf("backing up cluster %s: %v", clusterName, err)
+	}
+
+	if clusterbackup.IsArchive(output) {
+		if err := clusterbackup.Archive(dir, output); err != nil {
+			return err
+		}
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Backup of %d cluster(s) saved to %s", len(manifest.Clusters), output))
+	return nil
+}
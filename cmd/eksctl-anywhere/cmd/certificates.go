@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+var certificatesCmd = &cobra.Command{
+	Use:   "certificates",
+	Short: "Manage cluster certificates",
+	Long:  "Use eksctl anywhere certificates to check and renew the certificates of the control plane and etcd nodes",
+}
+
+func init() {
+	rootCmd.AddCommand(certificatesCmd)
+}
+
+// certificatesOptions are the options shared by all certificates subcommands.
+type certificatesOptions struct {
+	fileName string
+	// kubeConfig is the kubeconfig of the cluster managing the target cluster.
+	kubeConfig     string
+	privateKeyPath string
+	sshUser        string
+}
+
+func (o *certificatesOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.fileName, "filename", "f", "", "Filename that contains EKS-A cluster configuration")
+	cmd.Flags().StringVar(&o.kubeConfig, "kubeconfig", "", "Management cluster kubeconfig file. Defaults to the kubeconfig generated for the cluster")
+	cmd.Flags().StringVar(&o.privateKeyPath, "ssh-key", "", "Path to the private SSH key used to access the nodes")
+	cmd.Flags().StringVar(&o.sshUser, "ssh-user", "", "SSH user for all nodes. Defaults to the first user in each machine config")
+	for _, flag := range []string{"filename", "ssh-key"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			log.Fatalf("Error marking flag as required: %v", err)
+		}
+	}
+}
+
+// nodes returns the control plane and etcd nodes of the cluster.
+func (o *certificatesOptions) nodes(ctx context.Context) ([]certificates.Node, error) {
+	config, err := cluster.ParseConfigFromFile(o.fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfig := o.kubeConfig
+	if kubeConfig == "" {
+		kubeConfig = kubeconfig.FromClusterName(config.Cluster.Name)
+	}
+	kubeConfig, err = kubeconfig.ResolveAndValidateFilename(kubeConfig, "")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize k8s client: %v", err)
+	}
+
+	return certificates.NewNodeReader(clientutil.NewKubeClient(client)).Read(ctx, config, o.sshUser)
+}
+
+// dependencies builds the ssh executable, mounting the folder with the private key
+// when running executables in a container.
+func (o *certificatesOptions) dependencies(ctx context.Context) (*dependencies.Dependencies, error) {
+	keyPath, err := filepath.Abs(o.privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("resolving ssh key path: %v", err)
+	}
+	o.privateKeyPath = keyPath
+
+	return dependencies.NewFactory().
+		WithExecutableMountDirs(filepath.Dir(keyPath)).
+		WithSSH().
+		Build(ctx)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+type checkCertificatesOptions struct {
+	certificatesOptions
+	expirationThreshold time.Duration
+}
+
+var cco = &checkCertificatesOptions{}
+
+var checkCertificatesCmd = &cobra.Command{
+	Use:          "check",
+	Short:        "Check the expiration of the control plane and etcd certificates",
+	Long:         "Connects to the control plane and external etcd nodes of a cluster over SSH and reports the expiration date of their certificates",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cco.checkCertificates(cmd.Context())
+	},
+}
+
+func init() {
+	certificatesCmd.AddCommand(checkCertificatesCmd)
+	cco.addFlags(checkCertificatesCmd)
+	checkCertificatesCmd.Flags().DurationVar(&cco.expirationThreshold, "expiration-threshold", 30*24*time.Hour, "Certificates expiring within this duration are reported as expiring")
+}
+
+func (o *checkCertificatesOptions) checkCertificates(ctx context.Context) error {
+	nodes, err := o.nodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	deps, err := o.dependencies(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	defer close(ctx, deps)
+
+	nodeCerts, err := certificates.NewChecker(deps.SSH, o.privateKeyPath).Check(ctx, nodes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := certificates.PrintExpirations(os.Stdout, nodeCerts, now, o.expirationThreshold); err != nil {
+		return err
+	}
+
+	if expiring := certificates.ExpiringCertificates(nodeCerts, now, o.expirationThreshold); expiring > 0 {
+		logger.MarkWarning(fmt.Sprintf("%d certificate(s) expire in less than %s, run `eksctl anywhere certificates renew` to renew them", expiring, o.expirationThreshold))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+var cro = &certificatesOptions{}
+
+var renewCertificatesCmd = &cobra.Command{
+	Use:          "renew",
+	Short:        "Renew the control plane and etcd certificates",
+	Long:         "Connects to the control plane and external etcd nodes of a cluster over SSH and renews their certificates one node at a time, backing up the existing certificates in each node",
+	PreRunE:      bindFlagsToViper,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return renewCertificates(cmd.Context(), cro)
+	},
+}
+
+func init() {
+	certificatesCmd.AddCommand(renewCertificatesCmd)
+	cro.addFlags(renewCertificatesCmd)
+}
+
+func renewCertificates(ctx context.Context, o *certificatesOptions) error {
+	nodes, err := o.nodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	deps, err := o.dependencies(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	defer close(ctx, deps)
+
+	results, err := certificates.NewRenewer(deps.SSH, o.privateKeyPath).Renew(ctx, nodes)
+	for _, r := range results {
+		logger.Info("Certificates renewed", "node", r.Node.Name, "backup", r.BackupDir)
+	}
+	if err != nil {
+		return fmt.Errorf("renewing certificates: %v", err)
+	}
+
+	logger.MarkSuccess("Certificates renewed successfully")
+	return nil
+}
@@ -7,6 +7,10 @@ const (
 	externalEtcdWaitTimeoutFlag = "external-etcd-wait-timeout"
 	perMachineWaitTimeoutFlag   = "per-machine-wait-timeout"
 	noTimeoutsFlag              = "no-timeouts"
+	eventsFileFlag              = "events-file"
+	resumeFlag                  = "resume"
+	dryRunFlag                  = "dry-run"
+	multiHopFlag                = "multi-hop"
 )
 
 type Operation int
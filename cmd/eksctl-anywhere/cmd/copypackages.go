@@ -53,6 +53,7 @@ func init() {
 		logger.Fatal(err, "Cannot mark flag as required")
 	}
 	copyPackagesCmd.Flags().StringVar(&cpc.srcChartRegistry, "src-chart-registry", "", "The source registry that stores helm charts (default src-image-registry)")
+	copyPackagesCmd.Flags().StringSliceVar(&cpc.srcFallbackEndpoints, "src-fallback-endpoint", nil, "Fallback registry mirror endpoints (host:port) hosting the same artifacts as the source registries, tried in order when a source registry fails")
 	copyPackagesCmd.Flags().BoolVar(&cpc.dstPlainHTTP, "dst-plain-http", false, "Whether or not to use plain http for destination registry")
 	copyPackagesCmd.Flags().BoolVar(&cpc.dstInsecure, "dst-insecure", false, "Skip TLS verification against the destination registry")
 	copyPackagesCmd.Flags().BoolVar(&cpc.dryRun, "dry-run", false, "Dry run will show what artifacts would be copied, but not actually copy them")
@@ -72,13 +73,14 @@ var publicPackages = []string{"ecr-token-refresher", "eks-anywhere-packages", "c
 
 // copyPackagesConfig copies packages specified in a bundle to a destination.
 type copyPackagesConfig struct {
-	destRegistry     string
-	srcImageRegistry string
-	srcChartRegistry string
-	kubeVersion      string
-	dstPlainHTTP     bool
-	dstInsecure      bool
-	dryRun           bool
+	destRegistry         string
+	srcImageRegistry     string
+	srcChartRegistry     string
+	srcFallbackEndpoints []string
+	kubeVersion          string
+	dstPlainHTTP         bool
+	dstInsecure          bool
+	dryRun               bool
 }
 
 func runCopyPackages(_ *cobra.Command, args []string) error {
@@ -87,7 +89,12 @@ func runCopyPackages(_ *cobra.Command, args []string) error {
 		cpc.srcChartRegistry = cpc.srcImageRegistry
 	}
 	ctx := context.Background()
-	bundle, err := getPackageBundle(ctx, cpc.srcChartRegistry, cpc.kubeVersion)
+	var bundle *packagesv1.PackageBundle
+	err := registry.WithFailover(registry.FailoverRegistries(cpc.srcChartRegistry, cpc.srcFallbackEndpoints), func(srcRegistry string) error {
+		var err error
+		bundle, err = getPackageBundle(ctx, srcRegistry, cpc.kubeVersion)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("cannot fetch package bundle: %w", err)
 	}
@@ -97,8 +104,7 @@ func runCopyPackages(_ *cobra.Command, args []string) error {
 
 	// copy package bundle yaml after charts and images
 	tag := getPackageBundleTag(cpc.kubeVersion)
-	_, err = orasCopy(ctx, curatedpackages.ImageRepositoryName, cpc.srcChartRegistry, tag, cpc.destRegistry, tag)
-	return err
+	return orasCopyWithFailover(ctx, curatedpackages.ImageRepositoryName, cpc.srcChartRegistry, tag, cpc.destRegistry, tag)
 }
 
 func getTagsFromChartValues(chartValues map[string]any, res map[string]string) error {
@@ -167,7 +173,12 @@ func copyArtifacts(ctx context.Context, bundle *packagesv1.PackageBundle) error
 		for _, v := range p.Source.Versions {
 			chartTag := v.Name
 			url := cpc.srcChartRegistry + "/" + p.Source.Repository
-			values, err := getChartValues(url + ":" + chartTag)
+			var values map[string]interface{}
+			err := registry.WithFailover(registry.FailoverRegistries(cpc.srcChartRegistry, cpc.srcFallbackEndpoints), func(srcRegistry string) error {
+				var err error
+				values, err = getChartValues(srcRegistry + "/" + p.Source.Repository + ":" + chartTag)
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("cannot get chart values %s: %w", url+":"+chartTag, err)
 			}
@@ -176,7 +187,7 @@ func copyArtifacts(ctx context.Context, bundle *packagesv1.PackageBundle) error
 			if err = getTagsFromChartValues(values, tags); err != nil {
 				return fmt.Errorf("cannot get tags from chart values: %w", err)
 			}
-			_, err = orasCopy(ctx, p.Source.Repository, cpc.srcChartRegistry, chartTag, cpc.destRegistry, chartTag)
+			err = orasCopyWithFailover(ctx, p.Source.Repository, cpc.srcChartRegistry, chartTag, cpc.destRegistry, chartTag)
 			if err != nil {
 				return fmt.Errorf("cannot copy chart to repo: %w", err)
 			}
@@ -195,7 +206,7 @@ func copyImages(ctx context.Context, images []packagesv1.VersionImages, tags map
 			logger.V(0).Info("Using tag as the reference for digest", "tag", t, "digest", i.Digest)
 			dstRef = t
 		}
-		_, err := orasCopy(ctx, i.Repository, cpc.srcImageRegistry, i.Digest, cpc.destRegistry, dstRef)
+		err := orasCopyWithFailover(ctx, i.Repository, cpc.srcImageRegistry, i.Digest, cpc.destRegistry, dstRef)
 		if err != nil {
 			return fmt.Errorf("cannot copy image to repo: %w", err)
 		}
@@ -216,6 +227,15 @@ func getChartValues(chartURL string) (map[string]interface{}, error) {
 	return chart.Values, nil
 }
 
+// orasCopyWithFailover copies an artifact from srcRegistry, falling back to the same registry on each of the
+// source fallback endpoints if it fails.
+func orasCopyWithFailover(ctx context.Context, repo, srcRegistry, srcRef, dstRegistry, dstRef string) error {
+	return registry.WithFailover(registry.FailoverRegistries(srcRegistry, cpc.srcFallbackEndpoints), func(srcRegistry string) error {
+		_, err := orasCopy(ctx, repo, srcRegistry, srcRef, dstRegistry, dstRef)
+		return err
+	})
+}
+
 func orasCopy(ctx context.Context, repo, srcRegistry, srcRef, dstRegistry, dstRef string) (ocispec.Descriptor, error) {
 	logger.V(0).Info("Copying artifact", "from", srcRegistry+"/"+repo, "to", dstRegistry+"/"+repo, "dstRef", dstRef)
 
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,9 +14,10 @@ import (
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/aflag"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/awsiamauth"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clustermanager"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
-	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/dryrun"
 	"github.com/aws/eks-anywhere/pkg/features"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
@@ -29,12 +33,15 @@ import (
 type createClusterOptions struct {
 	clusterOptions
 	timeoutOptions
+	eventsOptions
 	forceClean            bool
 	skipIpCheck           bool
 	hardwareCSVPath       string
 	tinkerbellBootstrapIP string
+	bootstrapKubeconfig   string
 	installPackages       string
 	skipValidations       []string
+	dryRun                bool
 	providerOptions       *dependencies.ProviderOptions
 }
 
@@ -61,13 +68,16 @@ func init() {
 	createCmd.AddCommand(createClusterCmd)
 	applyClusterOptionFlags(createClusterCmd.Flags(), &cc.clusterOptions)
 	applyTimeoutFlags(createClusterCmd.Flags(), &cc.timeoutOptions)
+	applyEventsFlags(createClusterCmd.Flags(), &cc.eventsOptions)
 	applyTinkerbellHardwareFlag(createClusterCmd.Flags(), &cc.hardwareCSVPath)
 	aflag.String(aflag.TinkerbellBootstrapIP, &cc.tinkerbellBootstrapIP, createClusterCmd.Flags())
+	applyBootstrapKubeconfigFlag(createClusterCmd.Flags(), &cc.bootstrapKubeconfig)
 	createClusterCmd.Flags().BoolVar(&cc.forceClean, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(createClusterCmd.Flags())
 	createClusterCmd.Flags().BoolVar(&cc.skipIpCheck, "skip-ip-check", false, "Skip check for whether cluster control plane ip is in use")
 	createClusterCmd.Flags().StringVar(&cc.installPackages, "install-packages", "", "Location of curated packages configuration files to install to the cluster")
 	createClusterCmd.Flags().StringArrayVar(&cc.skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass create validations by name. Valid arguments you can pass are --skip-validations=%s", strings.Join(createvalidations.SkippableValidations[:], ",")))
+	createClusterCmd.Flags().BoolVar(&cc.dryRun, dryRunFlag, false, "Run the provider setup and preflight validations and render the manifests for the cluster into the cluster folder, without creating any infrastructure")
 	tinkerbellFlags(createClusterCmd.Flags(), cc.providerOptions.Tinkerbell.BMCOptions.RPC)
 
 	aflag.MarkRequired(createClusterCmd.Flags(), aflag.ClusterConfig.Name)
@@ -108,6 +118,12 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 
 	ctx := cmd.Context()
 
+	closeEvents, err := cc.initEvents()
+	if err != nil {
+		return err
+	}
+	defer closeEvents()
+
 	clusterConfigFileExist := validations.FileExists(cc.fileName)
 	if !clusterConfigFileExist {
 		return fmt.Errorf("the cluster config file %s does not exist", cc.fileName)
@@ -128,15 +144,20 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 		return errors.New("etcdEncryption is not supported during cluster creation")
 	}
 
-	docker := executables.BuildDockerExecutable()
+	kubeconfigPath := kubeconfig.FromClusterName(clusterConfig.Name)
 
-	if err := validations.CheckMinimumDockerVersion(ctx, docker); err != nil {
-		return fmt.Errorf("failed to validate docker: %v", err)
+	if cc.bootstrapKubeconfig != "" {
+		if err := validateBootstrapKubeconfig(clusterConfig, cc.bootstrapKubeconfig, kubeconfigPath); err != nil {
+			return err
+		}
 	}
 
-	validations.CheckDockerAllocatedMemory(ctx, docker)
+	if dockerRequired(cc.bootstrapKubeconfig) {
+		if err := containerRuntimeValidation(ctx, clusterConfig, cc.bootstrapKubeconfig); err != nil {
+			return err
+		}
+	}
 
-	kubeconfigPath := kubeconfig.FromClusterName(clusterConfig.Name)
 	if validations.FileExistsAndIsNotEmpty(kubeconfigPath) {
 		return fmt.Errorf(
 			"old cluster config file exists under %s, please use a different clusterName to proceed",
@@ -158,6 +179,9 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 	if err != nil {
 		return err
 	}
+	if cc.bootstrapKubeconfig != "" {
+		dirs = append(dirs, filepath.Dir(cc.bootstrapKubeconfig))
+	}
 
 	createCLIConfig, err := buildCreateCliConfig(cc)
 	if err != nil {
@@ -182,7 +206,7 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 		WithCliConfig(cliConfig).
 		WithClusterManager(clusterSpec.Cluster, clusterManagerTimeoutOpts).
 		WithProvider(cc.fileName, clusterSpec.Cluster, cc.skipIpCheck, cc.hardwareCSVPath, cc.forceClean, cc.tinkerbellBootstrapIP, skippedValidations, cc.providerOptions).
-		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		WithGitOps(clusterSpec, cliConfig).
 		WithWriter().
 		WithEksdInstaller().
 		WithPackageManager(clusterSpec, cc.installPackages, cc.managementKubeconfig).
@@ -198,6 +222,14 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 		factory.WithNoTimeouts()
 	}
 
+	if cc.bootstrapKubeconfig != "" {
+		factory.WithExistingBootstrapCluster(cc.bootstrapKubeconfig)
+	}
+
+	if cc.dryRun {
+		factory.WithCiliumTemplater()
+	}
+
 	deps, err := factory.Build(ctx)
 	if err != nil {
 		return err
@@ -228,6 +260,10 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 	}
 	createValidations := createvalidations.New(validationOpts)
 
+	if cc.dryRun {
+		return cc.renderDryRun(ctx, deps, mgmt, clusterSpec, createValidations)
+	}
+
 	if features.UseNewWorkflows().IsActive() {
 		deps, err = factory.
 			Build(ctx)
@@ -255,7 +291,7 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 		createWorkloadCluster := workload.NewCreate(
 			deps.Provider,
 			deps.ClusterManager,
-			deps.GitOpsFlux,
+			deps.GitOps,
 			deps.Writer,
 			deps.EksdInstaller,
 			deps.PackageManager,
@@ -272,7 +308,7 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 			deps.UnAuthKubeClient,
 			deps.Provider,
 			deps.ClusterManager,
-			deps.GitOpsFlux,
+			deps.GitOps,
 			deps.Writer,
 			deps.EksdInstaller,
 			deps.PackageManager,
@@ -288,3 +324,26 @@ func (cc *createClusterOptions) createCluster(cmd *cobra.Command, _ []string) er
 	cleanup(deps, &err)
 	return err
 }
+
+// renderDryRun runs the provider setup and the preflight validations and renders the manifests
+// for the cluster, stopping before any infrastructure is created.
+func (cc *createClusterOptions) renderDryRun(ctx context.Context, deps *dependencies.Dependencies, managementCluster *types.Cluster, clusterSpec *cluster.Spec, createValidations *createvalidations.CreateValidations) error {
+	runner := validations.NewRunner()
+	runner.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name: fmt.Sprintf("%s Provider setup is valid", deps.Provider.Name()),
+			Err:  deps.Provider.SetupAndValidateCreateCluster(ctx, clusterSpec),
+		}
+	})
+	runner.Register(createValidations.PreflightValidations(ctx)...)
+	if err := runner.Run(); err != nil {
+		return err
+	}
+
+	result, err := dryrun.NewRenderer(deps.Provider, deps.CiliumTemplater, deps.Writer, nil).RenderCreate(ctx, managementCluster, clusterSpec)
+	if err != nil {
+		return fmt.Errorf("rendering dry run manifests: %v", err)
+	}
+
+	return printDryRunResult(os.Stdout, result)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -19,10 +20,13 @@ import (
 
 type deleteClusterOptions struct {
 	clusterOptions
+	eventsOptions
 	wConfig               string
 	forceCleanup          bool
+	resume                bool
 	hardwareFileName      string
 	tinkerbellBootstrapIP string
+	bootstrapKubeconfig   string
 	providerOptions       *dependencies.ProviderOptions
 }
 
@@ -46,6 +50,11 @@ var deleteClusterCmd = &cobra.Command{
 		if err := dc.validate(cmd.Context(), args); err != nil {
 			return err
 		}
+		closeEvents, err := dc.initEvents()
+		if err != nil {
+			return err
+		}
+		defer closeEvents()
 		if err := dc.deleteCluster(cmd.Context()); err != nil {
 			return fmt.Errorf("failed to delete cluster: %v", err)
 		}
@@ -59,8 +68,11 @@ func init() {
 	deleteClusterCmd.Flags().StringVarP(&dc.wConfig, "w-config", "w", "", "Kubeconfig file to use when deleting a workload cluster")
 	deleteClusterCmd.Flags().BoolVar(&dc.forceCleanup, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(deleteClusterCmd.Flags())
+	deleteClusterCmd.Flags().BoolVar(&dc.resume, resumeFlag, false, "Resume a failed delete from its checkpoint, skipping the steps that already completed. Fails if the cluster has changed since the checkpoint was saved")
 	deleteClusterCmd.Flags().StringVar(&dc.managementKubeconfig, "kubeconfig", "", "kubeconfig file pointing to a management cluster")
 	deleteClusterCmd.Flags().StringVar(&dc.bundlesOverride, "bundles-override", "", "Override default Bundles manifest (not recommended)")
+	applyBootstrapKubeconfigFlag(deleteClusterCmd.Flags(), &dc.bootstrapKubeconfig)
+	applyEventsFlags(deleteClusterCmd.Flags(), &dc.eventsOptions)
 	tinkerbellFlags(deleteClusterCmd.Flags(), dc.providerOptions.Tinkerbell.BMCOptions.RPC)
 }
 
@@ -80,7 +92,7 @@ func (dc *deleteClusterOptions) validate(ctx context.Context, args []string) err
 		}
 		dc.fileName = filename
 	}
-	clusterConfig, err := commonValidation(ctx, dc.fileName)
+	clusterConfig, err := readClusterConfig(dc.fileName)
 	if err != nil {
 		return err
 	}
@@ -90,6 +102,18 @@ func (dc *deleteClusterOptions) validate(ctx context.Context, args []string) err
 		return err
 	}
 
+	if dc.bootstrapKubeconfig != "" {
+		if err := validateBootstrapKubeconfig(clusterConfig, dc.bootstrapKubeconfig, kubeconfigPath); err != nil {
+			return err
+		}
+	}
+
+	if dockerRequired(dc.bootstrapKubeconfig) {
+		if err := containerRuntimeValidation(ctx, clusterConfig, dc.bootstrapKubeconfig); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -108,26 +132,34 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if dc.bootstrapKubeconfig != "" {
+		dirs = append(dirs, filepath.Dir(dc.bootstrapKubeconfig))
+	}
 
 	deleteCLIConfig := buildDeleteCliConfig()
 	if err != nil {
 		return err
 	}
 
-	deps, err := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
+	factory := dependencies.ForSpec(clusterSpec).WithExecutableMountDirs(dirs...).
 		WithBootstrapper().
 		WithCliConfig(cliConfig).
 		WithClusterManager(clusterSpec.Cluster, nil).
 		WithProvider(dc.fileName, clusterSpec.Cluster, cc.skipIpCheck, dc.hardwareFileName, false, dc.tinkerbellBootstrapIP, map[string]bool{}, dc.providerOptions).
-		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		WithGitOps(clusterSpec, cliConfig).
 		WithWriter().
 		WithDeleteClusterDefaulter(deleteCLIConfig).
 		WithClusterDeleter().
 		WithEksdInstaller().
 		WithEKSAInstaller().
 		WithUnAuthKubeClient().
-		WithClusterMover().
-		Build(ctx)
+		WithClusterMover()
+
+	if dc.bootstrapKubeconfig != "" {
+		factory.WithExistingBootstrapCluster(dc.bootstrapKubeconfig)
+	}
+
+	deps, err := factory.Build(ctx)
 	if err != nil {
 		return err
 	}
@@ -152,10 +184,18 @@ func (dc *deleteClusterOptions) deleteCluster(ctx context.Context) error {
 	}
 
 	if clusterSpec.Cluster.IsManaged() {
-		deleteWorkload := workload.NewDelete(deps.Provider, deps.Writer, deps.ClusterManager, deps.ClusterDeleter, deps.GitOpsFlux)
+		var deleteOpts []workload.DeleteOpt
+		if dc.resume {
+			deleteOpts = append(deleteOpts, workload.WithDeleteResume())
+		}
+		deleteWorkload := workload.NewDelete(deps.Provider, deps.Writer, deps.ClusterManager, deps.ClusterDeleter, deps.GitOps, deleteOpts...)
 		err = deleteWorkload.Run(ctx, cluster, clusterSpec)
 	} else {
-		deleteManagement := management.NewDelete(deps.Bootstrapper, deps.Provider, deps.Writer, deps.ClusterManager, deps.GitOpsFlux, deps.ClusterDeleter, deps.EksdInstaller, deps.EksaInstaller, deps.UnAuthKubeClient, deps.ClusterMover)
+		var deleteOpts []management.DeleteOpt
+		if dc.resume {
+			deleteOpts = append(deleteOpts, management.WithDeleteResume())
+		}
+		deleteManagement := management.NewDelete(deps.Bootstrapper, deps.Provider, deps.Writer, deps.ClusterManager, deps.GitOps, deps.ClusterDeleter, deps.EksdInstaller, deps.EksaInstaller, deps.UnAuthKubeClient, deps.ClusterMover, deleteOpts...)
 		err = deleteManagement.Run(ctx, cluster, clusterSpec)
 	}
 	cleanup(deps, &err)
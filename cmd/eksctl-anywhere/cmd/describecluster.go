@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clusterstatus"
+)
+
+type describeClusterOptions struct {
+	output string
+	// kubeConfig is an optional kubeconfig file for the management cluster.
+	kubeConfig string
+	namespace  string
+}
+
+var dco = &describeClusterOptions{}
+
+func init() {
+	describeCmd.AddCommand(describeClusterCommand)
+
+	describeClusterCommand.Flags().StringVarP(&dco.output, "output", "o", clusterstatus.OutputTable,
+		"Specifies the output format (valid option: table, json, yaml)")
+	describeClusterCommand.Flags().StringVar(&dco.kubeConfig, "kubeconfig", "",
+		"Path to the management cluster kubeconfig file.")
+	describeClusterCommand.Flags().StringVarP(&dco.namespace, "namespace", "n", "",
+		"Namespace of the EKS-A cluster. Defaults to default.")
+}
+
+var describeClusterCommand = &cobra.Command{
+	Use:          "cluster <name> [flags]",
+	Short:        "Describe the status of a cluster",
+	Long:         "This command displays the status, conditions and CAPI control plane and worker node group readiness of an EKS-A cluster",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := clusterstatus.ValidateOutputFormat(dco.output); err != nil {
+			return err
+		}
+
+		reader, err := newClusterStatusReader(dco.kubeConfig)
+		if err != nil {
+			return err
+		}
+
+		report, err := reader.Read(cmd.Context(), args[0], dco.namespace)
+		if err != nil {
+			return err
+		}
+
+		return clusterstatus.PrintDetails(os.Stdout, report, dco.output)
+	},
+}
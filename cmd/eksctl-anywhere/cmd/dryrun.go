@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/eks-anywhere/pkg/dryrun"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// printDryRunResult writes the paths of the rendered manifests and, for upgrades, the diff with the live objects to w.
+func printDryRunResult(w io.Writer, result *dryrun.Result) error {
+	logger.Info("Dry run complete, no changes were made", "dir", result.Dir)
+	for _, f := range result.Files {
+		if _, err := fmt.Fprintln(w, f); err != nil {
+			return err
+		}
+	}
+
+	if result.Diff != "" {
+		if _, err := fmt.Fprintf(w, "\n%s", result.Diff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -10,14 +11,17 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/aflag"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
 const (
 	TinkerbellHardwareCSVFlagName        = "hardware-csv"
 	TinkerbellHardwareCSVFlagAlias       = "z"
-	TinkerbellHardwareCSVFlagDescription = "Path to a CSV file containing hardware data."
+	TinkerbellHardwareCSVFlagDescription = "Path to a CSV, or a JSON or YAML inventory, file containing hardware data."
 	KubeconfigFile                       = "kubeconfig"
+	BootstrapKubeconfigFlagName          = "bootstrap-kubeconfig"
 
 	forceCleanupDeprecationMessageForUpgrade = `The flag --force-cleanup has been removed. For more information on how to troubleshoot existing bootstrap clusters, please refer to the documentation:
 https://anywhere.eks.amazonaws.com/docs/troubleshooting/troubleshooting/#cluster-upgrade-fails-with-management-components-on-bootstrap-cluster`
@@ -90,3 +94,43 @@ func hideForceCleanup(flags *pflag.FlagSet) {
 		log.Fatalf("Failed hiding flag: %v", err)
 	}
 }
+
+func applyBootstrapKubeconfigFlag(flagSet *pflag.FlagSet, pathOut *string) {
+	flagSet.StringVar(
+		pathOut,
+		BootstrapKubeconfigFlagName,
+		"",
+		"Kubeconfig file of an existing cluster to use as bootstrap cluster instead of creating a local kind cluster",
+	)
+}
+
+// validateBootstrapKubeconfig checks that an existing cluster can be used as bootstrap cluster
+// for the cluster defined in clusterConfig, which is accessible with clusterKubeconfig.
+func validateBootstrapKubeconfig(clusterConfig *v1alpha1.Cluster, bootstrapKubeconfig, clusterKubeconfig string) error {
+	if err := kubeconfig.ValidateFilename(bootstrapKubeconfig); err != nil {
+		return fmt.Errorf("invalid --%s: %v", BootstrapKubeconfigFlagName, err)
+	}
+
+	if clusterConfig.IsManaged() {
+		return fmt.Errorf("--%s is only supported for management clusters", BootstrapKubeconfigFlagName)
+	}
+
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case v1alpha1.DockerDatacenterKind, v1alpha1.TinkerbellDatacenterKind:
+		return fmt.Errorf("--%s is not supported for %s", BootstrapKubeconfigFlagName, clusterConfig.Spec.DatacenterRef.Kind)
+	}
+
+	bootstrapPath, err := filepath.Abs(bootstrapKubeconfig)
+	if err != nil {
+		return err
+	}
+	clusterPath, err := filepath.Abs(clusterKubeconfig)
+	if err != nil {
+		return err
+	}
+	if bootstrapPath == clusterPath {
+		return fmt.Errorf("--%s can't point to the cluster %s", BootstrapKubeconfigFlagName, clusterConfig.Name)
+	}
+
+	return nil
+}
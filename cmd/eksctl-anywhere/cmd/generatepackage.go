@@ -107,6 +107,7 @@ func generatePackages(ctx context.Context, args []string) error {
 	if err != nil {
 		return err
 	}
+	curatedpackages.SetClusterAutoscalerConfig(packages, cluster)
 	if err = packageClient.WritePackagesToStdOut(packages); err != nil {
 		return err
 	}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterstatus"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+)
+
+type getClusterOptions struct {
+	output string
+	// kubeConfig is an optional kubeconfig file for the management cluster.
+	kubeConfig string
+	namespace  string
+}
+
+var gco = &getClusterOptions{}
+
+func init() {
+	getCmd.AddCommand(getClusterCommand)
+
+	getClusterCommand.Flags().StringVarP(&gco.output, "output", "o", clusterstatus.OutputTable,
+		"Specifies the output format (valid option: table, json, yaml)")
+	getClusterCommand.Flags().StringVar(&gco.kubeConfig, "kubeconfig", "",
+		"Path to the management cluster kubeconfig file.")
+	getClusterCommand.Flags().StringVarP(&gco.namespace, "namespace", "n", "",
+		"Namespace of the EKS-A cluster(s). Defaults to all namespaces when listing and to default when a name is provided.")
+}
+
+var getClusterCommand = &cobra.Command{
+	Use:          "cluster [name] [flags]",
+	Aliases:      []string{"clusters"},
+	Short:        "Get cluster(s) status",
+	Long:         "This command displays a summary of the status of the EKS-A clusters managed by a management cluster",
+	Args:         cobra.MaximumNArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := clusterstatus.ValidateOutputFormat(gco.output); err != nil {
+			return err
+		}
+
+		reader, err := newClusterStatusReader(gco.kubeConfig)
+		if err != nil {
+			return err
+		}
+
+		return getClusters(cmd.Context(), reader, args)
+	},
+}
+
+func getClusters(ctx context.Context, reader *clusterstatus.Reader, args []string) error {
+	var reports []*clusterstatus.Report
+	if len(args) == 1 {
+		report, err := reader.Read(ctx, args[0], gco.namespace)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, report)
+	} else {
+		var err error
+		reports, err = reader.ReadAll(ctx, gco.namespace)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(reports) == 0 && gco.output == clusterstatus.OutputTable {
+		fmt.Println("No clusters found")
+		return nil
+	}
+
+	return clusterstatus.PrintSummary(os.Stdout, reports, gco.output)
+}
+
+func newClusterStatusReader(kubeConfig string) (*clusterstatus.Reader, error) {
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(kubeConfig, "")
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize k8s client: %v", err)
+	}
+
+	return clusterstatus.NewReader(clientutil.NewKubeClient(client)), nil
+}
@@ -44,6 +44,7 @@ func init() {
 	if err := importImagesCmd.MarkFlagRequired("registry"); err != nil {
 		log.Fatalf("Cannot mark 'registry' as required: %s", err)
 	}
+	importImagesCmd.Flags().StringSliceVar(&importImagesCommand.FallbackRegistries, "fallback-registry", nil, "Fallback registry mirror endpoints to also import images and charts into. Credentials are read from REGISTRY_USERNAME_<n> and REGISTRY_PASSWORD_<n>, n being the 1-based position of the endpoint")
 	importImagesCmd.Flags().StringVarP(&importImagesCommand.BundlesFile, "bundles", "b", "", "Bundles file to read artifact dependencies from")
 	if err := importImagesCmd.MarkFlagRequired("bundles"); err != nil {
 		log.Fatalf("Cannot mark 'bundles' as required: %s", err)
@@ -56,11 +57,17 @@ func init() {
 var importImagesCommand = ImportImagesCommand{}
 
 type ImportImagesCommand struct {
-	InputFile        string
-	RegistryEndpoint string
-	BundlesFile      string
-	includePackages  bool
-	insecure         bool
+	InputFile          string
+	RegistryEndpoint   string
+	FallbackRegistries []string
+	BundlesFile        string
+	includePackages    bool
+	insecure           bool
+}
+
+// importRegistry is a registry images and charts are imported into.
+type importRegistry struct {
+	endpoint, username, password string
 }
 
 func (c ImportImagesCommand) Call(ctx context.Context) error {
@@ -69,6 +76,15 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 		return err
 	}
 
+	registries := []importRegistry{{endpoint: c.RegistryEndpoint, username: username, password: password}}
+	for i, endpoint := range c.FallbackRegistries {
+		username, password, err := config.ReadFallbackCredentials(i)
+		if err != nil {
+			return err
+		}
+		registries = append(registries, importRegistry{endpoint: endpoint, username: username, password: password})
+	}
+
 	factory := dependencies.NewFactory()
 	deps, err := factory.
 		WithManifestReader().
@@ -88,18 +104,20 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 
 	// Import the eksa tools image into the registry first, so it can be used immediately
 	// after to build the helm executable
-	importToolsImage := artifacts.ImportToolsImage{
-		Bundles:            bundle,
-		InputFile:          c.InputFile,
-		TmpArtifactsFolder: artifactsFolder,
-		UnPackager:         packagerForFile(c.InputFile),
-		ImageMover: docker.NewImageMover(
-			docker.NewDiskSource(dockerClient, toolsImageFile),
-			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
-		),
+	importToolsImage := func(endpoint string) error {
+		return artifacts.ImportToolsImage{
+			Bundles:            bundle,
+			InputFile:          c.InputFile,
+			TmpArtifactsFolder: artifactsFolder,
+			UnPackager:         packagerForFile(c.InputFile),
+			ImageMover: docker.NewImageMover(
+				docker.NewDiskSource(dockerClient, toolsImageFile),
+				docker.NewRegistryDestination(dockerClient, endpoint),
+			),
+		}.Run(ctx)
 	}
 
-	if err = importToolsImage.Run(ctx); err != nil {
+	if err = importToolsImage(c.RegistryEndpoint); err != nil {
 		return err
 	}
 
@@ -132,22 +150,36 @@ func (c ImportImagesCommand) Call(ctx context.Context) error {
 	defer deps.Close(ctx)
 
 	imagesFile := filepath.Join(artifactsFolder, "images.tar")
-	importArtifacts := artifacts.Import{
-		Reader:  deps.ManifestReader,
-		Bundles: bundle,
-		ImageMover: docker.NewImageMover(
-			docker.NewDiskSource(dockerClient, imagesFile),
-			docker.NewRegistryDestination(dockerClient, c.RegistryEndpoint),
-		),
-		ChartImporter: helm.NewChartRegistryImporter(
-			deps.Helm, artifactsFolder,
-			c.RegistryEndpoint,
-			username,
-			password,
-		),
-		TmpArtifactsFolder: artifactsFolder,
-		FileImporter:       oras.NewFileRegistryImporter(c.RegistryEndpoint, username, password, artifactsFolder),
+	for i, r := range registries {
+		// Importing the artifacts removes the unpackaged tarball, so it has to be unpackaged again
+		// for every fallback registry.
+		if i > 0 {
+			if err = importToolsImage(r.endpoint); err != nil {
+				return err
+			}
+		}
+
+		importArtifacts := artifacts.Import{
+			Reader:  deps.ManifestReader,
+			Bundles: bundle,
+			ImageMover: docker.NewImageMover(
+				docker.NewDiskSource(dockerClient, imagesFile),
+				docker.NewRegistryDestination(dockerClient, r.endpoint),
+			),
+			ChartImporter: helm.NewChartRegistryImporter(
+				deps.Helm, artifactsFolder,
+				r.endpoint,
+				r.username,
+				r.password,
+			),
+			TmpArtifactsFolder: artifactsFolder,
+			FileImporter:       oras.NewFileRegistryImporter(r.endpoint, r.username, r.password, artifactsFolder),
+		}
+
+		if err = importArtifacts.Run(context.WithValue(ctx, types.InsecureRegistry, c.insecure)); err != nil {
+			return err
+		}
 	}
 
-	return importArtifacts.Run(context.WithValue(ctx, types.InsecureRegistry, c.insecure))
+	return nil
 }
@@ -19,8 +19,10 @@ import (
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
@@ -48,6 +50,32 @@ func applyTimeoutFlags(flagSet *pflag.FlagSet, t *timeoutOptions) {
 	flagSet.BoolVar(&t.noTimeouts, noTimeoutsFlag, false, "Disable timeout for all wait operations")
 }
 
+type eventsOptions struct {
+	eventsFile string
+}
+
+func applyEventsFlags(flagSet *pflag.FlagSet, e *eventsOptions) {
+	flagSet.StringVar(&e.eventsFile, eventsFileFlag, "", "Write machine-readable progress events as JSON lines to this file. Use - to stream them to stdout, logs are then written to stderr")
+}
+
+// initEvents starts recording progress events if an events file was provided.
+// The returned function stops recording and closes the file.
+func (e eventsOptions) initEvents() (func(), error) {
+	if e.eventsFile == "" {
+		return func() {}, nil
+	}
+
+	if err := events.Init(events.Options{OutputFilePath: e.eventsFile}); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := events.Close(); err != nil {
+			logger.Error(err, "Closing events file")
+		}
+	}, nil
+}
+
 // buildClusterManagerOpts builds options for constructing a ClusterManager from CLI flags.
 // datacenterKind is an API kind such as v1alpha1.TinkerbellDatacenterKind.
 func buildClusterManagerOpts(t timeoutOptions, datacenterKind string) (*dependencies.ClusterManagerTimeoutOptions, error) {
@@ -257,7 +285,7 @@ func newBasicClusterSpec(options clusterOptions) (*cluster.Spec, error) {
 
 func buildCliConfig(clusterSpec *cluster.Spec) *config.CliConfig {
 	cliConfig := &config.CliConfig{}
-	if clusterSpec.FluxConfig != nil && clusterSpec.FluxConfig.Spec.Git != nil {
+	if gitOpsConfig := clusterSpec.GitOpsRepositoryConfig(); gitOpsConfig != nil && gitOpsConfig.Spec.Git != nil {
 		cliConfig.GitSshKeyPassphrase = os.Getenv(config.EksaGitPassphraseTokenEnv)
 		cliConfig.GitPrivateKeyFile = os.Getenv(config.EksaGitPrivateKeyTokenEnv)
 		cliConfig.GitKnownHostsFile = os.Getenv(config.EksaGitKnownHostsFileEnv)
@@ -349,10 +377,12 @@ func getManagementCluster(clusterSpec *cluster.Spec) *types.Cluster {
 
 func (c *clusterOptions) directoriesToMount(clusterSpec *cluster.Spec, cliConfig *config.CliConfig, addDirs ...string) ([]string, error) {
 	dirs := c.mountDirs()
-	fluxConfig := clusterSpec.FluxConfig
-	if fluxConfig != nil && fluxConfig.Spec.Git != nil {
+	gitOpsConfig := clusterSpec.GitOpsRepositoryConfig()
+	if gitOpsConfig != nil && gitOpsConfig.Spec.Git != nil {
 		dirs = append(dirs, filepath.Dir(cliConfig.GitPrivateKeyFile))
-		dirs = append(dirs, filepath.Dir(cliConfig.GitKnownHostsFile))
+		if cliConfig.GitKnownHostsFile != "" {
+			dirs = append(dirs, filepath.Dir(cliConfig.GitKnownHostsFile))
+		}
 	}
 
 	if clusterSpec.Config.Cluster.Spec.DatacenterRef.Kind == v1alpha1.CloudStackDatacenterKind {
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/controller/clientutil"
+	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type restoreClusterOptions struct {
+	// kubeConfig is the kubeconfig of the management cluster to restore the backup into.
+	kubeConfig string
+	from       string
+}
+
+var rco = &restoreClusterOptions{}
+
+func init() {
+	restoreCmd.AddCommand(restoreClusterCmd)
+
+	restoreClusterCmd.Flags().StringVar(&rco.kubeConfig, "kubeconfig", "",
+		"Kubeconfig file of the management cluster to restore the backup into")
+	restoreClusterCmd.Flags().StringVar(&rco.from, "from", "",
+		"Backup directory or .tar.gz file created with eksctl anywhere backup cluster")
+	for _, flag := range []string{"kubeconfig", "from"} {
+		if err := restoreClusterCmd.MarkFlagRequired(flag); err != nil {
+			log.Fatalf("Error marking flag as required: %v", err)
+		}
+	}
+}
+
+var restoreClusterCmd = &cobra.Command{
+	Use:          "cluster [flags]",
+	Short:        "Restore a management cluster backup",
+	Long:         "This command recreates the EKS-A, CAPI and provider objects and secrets saved in a backup in a cluster that has the EKS-A components installed and doesn't contain any of the backed up clusters. If the target is an EKS-A management cluster, only the backed up workload clusters are restored and they are adopted by it",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return rco.restoreCluster(cmd.Context())
+	},
+}
+
+func (o *restoreClusterOptions) restoreCluster(ctx context.Context) error {
+	kubeConfig, err := kubeconfig.ResolveAndValidateFilename(o.kubeConfig, "")
+	if err != nil {
+		return err
+	}
+
+	from, err := filepath.Abs(o.from)
+	if err != nil {
+		return fmt.Errorf("resolving backup path: %v", err)
+	}
+
+	dir := from
+	if clusterbackup.IsArchive(from) {
+		// The temporary directory is created next to the archive so it's mounted when running executables in a container.
+		dir, err = os.MkdirTemp(filepath.Dir(from), "eksa-restore-")
+		if err != nil {
+			return fmt.Errorf("creating temporary directory to extract backup: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		if err := clusterbackup.Extract(from, dir); err != nil {
+			return err
+		}
+	}
+
+	client, err := kubernetes.NewRuntimeClientFromFileName(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("unable to initialize k8s client: %v", err)
+	}
+
+	deps, err := dependencies.NewFactory().
+		WithExecutableMountDirs(filepath.Dir(dir), filepath.Dir(kubeConfig)).
+		WithClusterctl().
+		Build(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to initialize executables: %v", err)
+	}
+	defer close(ctx, deps)
+
+	target := &types.Cluster{
+		KubeconfigFile: kubeConfig,
+	}
+	manifest, err := clusterbackup.NewRestorer(clientutil.NewKubeClient(client), deps.Clusterctl).Restore(ctx, target, dir)
+	if err != nil {
+		return fmt.Errorf("restoring backup: %v", err)
+	}
+
+	logger.MarkSuccess(fmt.Sprintf("Restored %d cluster(s) from the backup of management cluster %s", len(manifest.Clusters), manifest.ManagementCluster))
+	return nil
+}
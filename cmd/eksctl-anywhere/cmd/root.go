@@ -3,17 +3,23 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/aws/eks-anywhere/pkg/events"
+	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
+const containerRuntimeFlagName = "container-runtime"
+
 var rootCmd = &cobra.Command{
 	Use:              "anywhere",
 	Short:            "Amazon EKS Anywhere",
@@ -32,18 +38,35 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.PersistentFlags().IntP("verbosity", "v", 0, "Set the log level verbosity")
+	rootCmd.PersistentFlags().String(containerRuntimeFlagName, "", fmt.Sprintf("Container runtime used to run the tools container and the bootstrap cluster (%s). Defaults to $%s or docker", strings.Join(executables.ContainerRuntimes, ", "), executables.ContainerRuntimeEnv))
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		log.Fatalf("failed to bind flags for root: %v", err)
 	}
 }
 
 func rootPersistentPreRun(cmd *cobra.Command, args []string) {
-	if err := initLogger(); err != nil {
+	if err := initLogger(cmd); err != nil {
+		log.Fatal(err)
+	}
+	if err := initContainerRuntime(); err != nil {
 		log.Fatal(err)
 	}
 }
 
-func initLogger() error {
+// initContainerRuntime selects the container runtime set with the flag, so it's used by all the
+// components, and validates it's supported. The runtime reaches kind through its own env, not the
+// process env.
+func initContainerRuntime() error {
+	executables.SetContainerRuntimeName(viper.GetString(containerRuntimeFlagName))
+
+	if err := executables.ValidateContainerRuntimeName(executables.ContainerRuntimeName()); err != nil {
+		return fmt.Errorf("invalid --%s or %s: %v", containerRuntimeFlagName, executables.ContainerRuntimeEnv, err)
+	}
+
+	return nil
+}
+
+func initLogger(cmd *cobra.Command) error {
 	logsFolder := filepath.Join(".", "eksa-cli-logs")
 	err := os.MkdirAll(logsFolder, 0o750)
 	if err != nil {
@@ -51,9 +74,16 @@ func initLogger() error {
 	}
 
 	outputFilePath := filepath.Join(".", "eksa-cli-logs", fmt.Sprintf("%s.log", time.Now().Format("2006-01-02T15_04_05")))
+	// Progress events written to stdout can't be mixed with the logs.
+	var console io.Writer = os.Stdout
+	if f := cmd.Flags().Lookup(eventsFileFlag); f != nil && f.Value.String() == events.StdoutPath {
+		console = os.Stderr
+	}
+
 	if err = logger.Init(logger.Options{
 		Level:          viper.GetInt("verbosity"),
 		OutputFilePath: outputFilePath,
+		ConsoleOutput:  console,
 	}); err != nil {
 		return fmt.Errorf("root cmd: %v", err)
 	}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/aws/eks-anywhere/cmd/eksctl-anywhere/cmd/aflag"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/dryrun"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
@@ -24,11 +27,15 @@ import (
 type upgradeClusterOptions struct {
 	clusterOptions
 	timeoutOptions
+	eventsOptions
 	wConfig               string
 	forceClean            bool
+	resume                bool
 	hardwareCSVPath       string
 	tinkerbellBootstrapIP string
 	skipValidations       []string
+	dryRun                bool
+	multiHop              bool
 	providerOptions       *dependencies.ProviderOptions
 }
 
@@ -55,7 +62,12 @@ var upgradeClusterCmd = &cobra.Command{
 			return errors.New("please remove the --force-cleanup flag")
 		}
 
-		if err := uc.upgradeCluster(cmd, args); err != nil {
+		upgrade := uc.upgradeCluster
+		if uc.multiHop {
+			upgrade = uc.upgradeClusterMultiHop
+		}
+
+		if err := upgrade(cmd, args); err != nil {
 			return fmt.Errorf("failed to upgrade cluster: %v", err)
 		}
 		return nil
@@ -66,11 +78,17 @@ func init() {
 	upgradeCmd.AddCommand(upgradeClusterCmd)
 	applyClusterOptionFlags(upgradeClusterCmd.Flags(), &uc.clusterOptions)
 	applyTimeoutFlags(upgradeClusterCmd.Flags(), &uc.timeoutOptions)
+	applyEventsFlags(upgradeClusterCmd.Flags(), &uc.eventsOptions)
 	applyTinkerbellHardwareFlag(upgradeClusterCmd.Flags(), &uc.hardwareCSVPath)
 	upgradeClusterCmd.Flags().StringVarP(&uc.wConfig, "w-config", "w", "", "Kubeconfig file to use when upgrading a workload cluster")
 	upgradeClusterCmd.Flags().BoolVar(&uc.forceClean, "force-cleanup", false, "Force deletion of previously created bootstrap cluster")
 	hideForceCleanup(upgradeClusterCmd.Flags())
+	upgradeClusterCmd.Flags().BoolVar(&uc.resume, resumeFlag, false, "Resume a failed upgrade from its checkpoint, skipping the steps that already completed. Fails if the cluster has changed since the checkpoint was saved")
 	upgradeClusterCmd.Flags().StringArrayVar(&uc.skipValidations, "skip-validations", []string{}, fmt.Sprintf("Bypass upgrade validations by name. Valid arguments you can pass are --skip-validations=%s", strings.Join(upgradevalidations.SkippableValidations[:], ",")))
+	upgradeClusterCmd.Flags().BoolVar(&uc.dryRun, dryRunFlag, false, "Run the provider setup and preflight validations, render the manifests for the upgrade into the cluster folder and show the differences with the live objects, without upgrading the cluster")
+	upgradeClusterCmd.Flags().BoolVar(&uc.multiHop, multiHopFlag, false, "Upgrade to a Kubernetes version several minor versions ahead of the current one, upgrading the control plane one minor version at a time and letting worker node groups lag within the supported skew")
+	upgradeClusterCmd.MarkFlagsMutuallyExclusive(dryRunFlag, resumeFlag)
+	upgradeClusterCmd.MarkFlagsMutuallyExclusive(dryRunFlag, multiHopFlag)
 	aflag.MarkRequired(createClusterCmd.Flags(), aflag.ClusterConfig.Name)
 	tinkerbellFlags(upgradeClusterCmd.Flags(), uc.providerOptions.Tinkerbell.BMCOptions.RPC)
 }
@@ -79,6 +97,12 @@ func init() {
 func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	closeEvents, err := uc.initEvents()
+	if err != nil {
+		return err
+	}
+	defer closeEvents()
+
 	clusterConfigFileExist := validations.FileExists(uc.fileName)
 	if !clusterConfigFileExist {
 		return fmt.Errorf("the cluster config file %s does not exist", uc.fileName)
@@ -152,7 +176,7 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		WithClusterManager(clusterSpec.Cluster, clusterManagerTimeoutOpts).
 		WithClusterApplier().
 		WithProvider(uc.fileName, clusterSpec.Cluster, cc.skipIpCheck, uc.hardwareCSVPath, uc.forceClean, uc.tinkerbellBootstrapIP, skippedValidations, uc.providerOptions).
-		WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+		WithGitOps(clusterSpec, cliConfig).
 		WithWriter().
 		WithCAPIManager().
 		WithEksdUpgrader().
@@ -167,6 +191,10 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 		factory.WithNoTimeouts()
 	}
 
+	if uc.dryRun {
+		factory.WithCiliumTemplater()
+	}
+
 	deps, err := factory.Build(ctx)
 	if err != nil {
 		return err
@@ -205,34 +233,48 @@ func (uc *upgradeClusterOptions) upgradeCluster(cmd *cobra.Command, args []strin
 
 	upgradeValidations := upgradevalidations.New(validationOpts)
 
+	if uc.dryRun {
+		return uc.renderDryRun(ctx, deps, managementCluster, workloadCluster, clusterSpec, upgradeValidations)
+	}
+
 	if clusterConfig.IsSelfManaged() {
+		var upgradeOpts []management.UpgradeOpt
+		if uc.resume {
+			upgradeOpts = append(upgradeOpts, management.WithUpgradeResume())
+		}
 		upgrade := management.NewUpgrade(
 			deps.UnAuthKubeClient,
 			deps.Provider,
 			deps.CAPIManager,
 			deps.ClusterManager,
-			deps.GitOpsFlux,
+			deps.GitOps,
 			deps.Writer,
 			deps.EksdUpgrader,
 			deps.EksdInstaller,
 			deps.ClusterApplier,
 			deps.PackageManager,
 			deps.AwsIamAuth,
+			upgradeOpts...,
 		)
 
 		err = upgrade.Run(ctx, clusterSpec, managementCluster, upgradeValidations)
 
 	} else {
+		var upgradeOpts []workload.UpgradeOpt
+		if uc.resume {
+			upgradeOpts = append(upgradeOpts, workload.WithUpgradeResume())
+		}
 		upgradeWorkloadCluster := workload.NewUpgrade(
 			deps.UnAuthKubeClient,
 			deps.Provider,
 			deps.ClusterManager,
-			deps.GitOpsFlux,
+			deps.GitOps,
 			deps.Writer,
 			deps.ClusterApplier,
 			deps.EksdInstaller,
 			deps.PackageManager,
 			deps.AwsIamAuth,
+			upgradeOpts...,
 		)
 		err = upgradeWorkloadCluster.Run(ctx, workloadCluster, clusterSpec, upgradeValidations)
 	}
@@ -259,3 +301,31 @@ func (uc *upgradeClusterOptions) commonValidations(ctx context.Context) (cluster
 
 	return clusterConfig, nil
 }
+
+// renderDryRun runs the provider setup and the preflight validations and renders the manifests
+// for the upgrade, diffing them against the live objects without modifying the cluster.
+func (uc *upgradeClusterOptions) renderDryRun(ctx context.Context, deps *dependencies.Dependencies, managementCluster, workloadCluster *types.Cluster, newSpec *cluster.Spec, upgradeValidations *upgradevalidations.UpgradeValidations) error {
+	currentSpec, err := deps.ClusterManager.GetCurrentClusterSpec(ctx, managementCluster, newSpec.Cluster.Name)
+	if err != nil {
+		return fmt.Errorf("getting current cluster spec: %v", err)
+	}
+
+	runner := validations.NewRunner()
+	runner.Register(func() *validations.ValidationResult {
+		return &validations.ValidationResult{
+			Name: fmt.Sprintf("%s provider validation", deps.Provider.Name()),
+			Err:  deps.Provider.SetupAndValidateUpgradeCluster(ctx, managementCluster, newSpec, currentSpec),
+		}
+	})
+	runner.Register(upgradeValidations.PreflightValidations(ctx)...)
+	if err := runner.Run(); err != nil {
+		return err
+	}
+
+	result, err := dryrun.NewRenderer(deps.Provider, deps.CiliumTemplater, deps.Writer, deps.Kubectl).RenderUpgrade(ctx, managementCluster, workloadCluster, currentSpec, newSpec)
+	if err != nil {
+		return fmt.Errorf("rendering dry run manifests: %v", err)
+	}
+
+	return printDryRunResult(os.Stdout, result)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/multihop"
+)
+
+// upgradeClusterMultiHop upgrades the cluster to the Kubernetes version in the cluster config
+// one minor version at a time, running a regular upgrade for each hop.
+func (uc *upgradeClusterOptions) upgradeClusterMultiHop(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if _, err := uc.commonValidations(ctx); err != nil {
+		return fmt.Errorf("common validations failed due to: %v", err)
+	}
+
+	target, err := cluster.ParseConfigFromFile(uc.fileName)
+	if err != nil {
+		return fmt.Errorf("the cluster config file provided is invalid: %v", err)
+	}
+
+	current, err := uc.currentCluster(ctx, target.Cluster)
+	if err != nil {
+		return err
+	}
+
+	writer, err := filewriter.NewWriter(target.Cluster.Name)
+	if err != nil {
+		return err
+	}
+
+	upgrader, err := multihop.NewUpgrader(writer, func(_ context.Context, configFile string, resume bool) error {
+		hopOptions := *uc
+		hopOptions.fileName = configFile
+		hopOptions.resume = resume
+		return hopOptions.upgradeCluster(cmd, args)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := upgrader.Run(ctx, current, target, uc.resume); err != nil {
+		return fmt.Errorf("%v. Run the command again with --%s to continue the upgrade from the hop that failed", err, resumeFlag)
+	}
+
+	return nil
+}
+
+// currentCluster reads the EKS-A Cluster object from the cluster that manages it.
+func (uc *upgradeClusterOptions) currentCluster(ctx context.Context, target *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
+	kubeConfig := getKubeconfigPath(target.Name, uc.wConfig)
+	if target.IsManaged() {
+		kubeConfig = uc.managementKubeconfig
+		if kubeConfig == "" {
+			var err error
+			if kubeConfig, err = getManagementClusterKubeconfig(target.ManagedBy()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := kubeconfig.ValidateFilename(kubeConfig); err != nil {
+		return nil, err
+	}
+
+	k8sClient, err := kubernetes.NewRuntimeClientFromFileName(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize k8s client: %v", err)
+	}
+
+	namespace := target.Namespace
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+
+	current := &v1alpha1.Cluster{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: namespace}, current); err != nil {
+		return nil, fmt.Errorf("fetching current cluster: %v", err)
+	}
+
+	return current, nil
+}
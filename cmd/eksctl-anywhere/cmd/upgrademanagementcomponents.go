@@ -56,7 +56,7 @@ var upgradeManagementComponentsCmd = &cobra.Command{
 			WithClusterManager(clusterSpec.Cluster, nil).
 			WithClusterApplier().
 			WithProvider(umco.fileName, clusterSpec.Cluster, false, "", false, "", nil, nil).
-			WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig).
+			WithGitOps(clusterSpec, cliConfig).
 			WithWriter().
 			WithCAPIManager().
 			WithEksdUpgrader().
@@ -75,7 +75,7 @@ var upgradeManagementComponentsCmd = &cobra.Command{
 			deps.Provider,
 			deps.CAPIManager,
 			deps.ClusterManager,
-			deps.GitOpsFlux,
+			deps.GitOps,
 			deps.Writer,
 			deps.EksdUpgrader,
 			deps.EksdInstaller,
@@ -6,14 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
 	"github.com/aws/eks-anywhere/pkg/eksd"
 	"github.com/aws/eks-anywhere/pkg/logger"
-	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	"github.com/aws/eks-anywhere/pkg/types"
 )
 
@@ -67,6 +68,7 @@ func (uc *upgradeClusterOptions) upgradePlanCluster(ctx context.Context) error {
 		WithProvider(uc.fileName, newClusterSpec.Cluster, false, uc.hardwareCSVPath, uc.forceClean, uc.tinkerbellBootstrapIP, map[string]bool{}, uc.providerOptions).
 		WithGitOpsFlux(newClusterSpec.Cluster, newClusterSpec.FluxConfig, nil).
 		WithCAPIManager().
+		WithNetworkingProviders().
 		Build(ctx)
 	if err != nil {
 		return err
@@ -96,8 +98,14 @@ func (uc *upgradeClusterOptions) upgradePlanCluster(ctx context.Context) error {
 		}
 	}
 
-	componentChangeDiffs.Append(cilium.ChangeDiff(currentSpec, newClusterSpec))
+	cniProvider, err := deps.NetworkingProviders.Get(newClusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig)
+	if err != nil {
+		return err
+	}
+
+	componentChangeDiffs.Append(cniProvider.UpgradePlan(currentSpec, newClusterSpec))
 	componentChangeDiffs.Append(eksd.ChangeDiff(currentSpec, newClusterSpec))
+	componentChangeDiffs.Append(clusterapi.NodeRolloutChangeDiff(currentSpec, newClusterSpec))
 
 	serializedDiff, err := serialize(componentChangeDiffs, output)
 	if err != nil {
@@ -121,21 +129,50 @@ func serialize(componentChangeDiffs *types.ChangeDiff, outputFormat string) (str
 }
 
 func serializeToText(componentChangeDiffs *types.ChangeDiff) (string, error) {
-	if componentChangeDiffs == nil || (componentChangeDiffs != nil && len(componentChangeDiffs.ComponentReports) == 0) {
+	if componentChangeDiffs == nil {
 		return "All the components are up to date with the latest versions", nil
 	}
 
 	buffer := bytes.Buffer{}
-	w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
-	fmt.Fprintln(w, "NAME\tCURRENT VERSION\tNEXT VERSION")
-	for i := range componentChangeDiffs.ComponentReports {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", componentChangeDiffs.ComponentReports[i].ComponentName, componentChangeDiffs.ComponentReports[i].OldVersion, componentChangeDiffs.ComponentReports[i].NewVersion)
+	if len(componentChangeDiffs.ComponentReports) == 0 {
+		buffer.WriteString("All the components are up to date with the latest versions\n")
+	} else {
+		w := tabwriter.NewWriter(&buffer, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(w, "NAME\tCURRENT VERSION\tNEXT VERSION")
+		for i := range componentChangeDiffs.ComponentReports {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", componentChangeDiffs.ComponentReports[i].ComponentName, componentChangeDiffs.ComponentReports[i].OldVersion, componentChangeDiffs.ComponentReports[i].NewVersion)
+		}
+		if err := w.Flush(); err != nil {
+			return "", fmt.Errorf("failed flushing table writer: %v", err)
+		}
+	}
+
+	if len(componentChangeDiffs.NodeGroups) > 0 {
+		if err := serializeNodeGroupsToText(&buffer, componentChangeDiffs.NodeGroups); err != nil {
+			return "", err
+		}
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+func serializeNodeGroupsToText(buffer *bytes.Buffer, nodeGroups []types.NodeGroupRollout) error {
+	rolledNodes := 0
+	buffer.WriteString("\n")
+	w := tabwriter.NewWriter(buffer, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE GROUP\tROLE\tACTION\tNODES\tMAX SURGE\tMAX UNAVAILABLE\tBATCHES\tREASONS")
+	for _, n := range nodeGroups {
+		if n.Rolls() {
+			rolledNodes += n.Nodes
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n", n.Name, n.Role, n.Action, n.Nodes, n.MaxSurge, n.MaxUnavailable, n.Batches, strings.Join(n.Reasons, "; "))
 	}
 	if err := w.Flush(); err != nil {
-		return "", fmt.Errorf("failed flushing table writer: %v", err)
+		return fmt.Errorf("failed flushing table writer: %v", err)
 	}
 
-	return buffer.String(), nil
+	fmt.Fprintf(buffer, "\n%d node(s) will be replaced or upgraded in place\n", rolledNodes)
+	return nil
 }
 
 func serializeToJson(componentChangeDiffs *types.ChangeDiff) (string, error) {
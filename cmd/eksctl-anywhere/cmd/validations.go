@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -10,12 +11,83 @@ import (
 )
 
 func commonValidation(ctx context.Context, clusterConfigFile string) (*v1alpha1.Cluster, error) {
+	if err := dockerValidation(ctx); err != nil {
+		return nil, err
+	}
+
+	return readClusterConfig(clusterConfigFile)
+}
+
+// dockerRequired returns true if the CLI needs Docker or the selected container runtime, either to
+// create a kind bootstrap cluster or to run the executables in the tools container.
+func dockerRequired(bootstrapKubeconfig string) bool {
+	return bootstrapKubeconfig == "" || executables.ExecutablesInDocker()
+}
+
+func dockerValidation(ctx context.Context) error {
+	if executables.ContainerRuntimeName() != executables.DockerRuntime {
+		_, err := detectContainerRuntime(ctx)
+		return err
+	}
+
 	docker := executables.BuildDockerExecutable()
 	err := validations.CheckMinimumDockerVersion(ctx, docker)
 	if err != nil {
-		return nil, fmt.Errorf("failed to validate docker: %v", err)
+		return fmt.Errorf("failed to validate docker: %v", err)
 	}
 	validations.CheckDockerAllocatedMemory(ctx, docker)
+
+	return nil
+}
+
+func detectContainerRuntime(ctx context.Context) (executables.ContainerRuntime, error) {
+	runtime, err := executables.ContainerRuntimeFromEnv(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate container runtime: %v", err)
+	}
+
+	return runtime, nil
+}
+
+// containerRuntimeValidation validates the selected container runtime can be used to run the
+// tools container and the bootstrap cluster for the cluster.
+func containerRuntimeValidation(ctx context.Context, clusterConfig *v1alpha1.Cluster, bootstrapKubeconfig string) error {
+	if executables.ContainerRuntimeName() == executables.DockerRuntime {
+		return dockerValidation(ctx)
+	}
+
+	runtime, err := detectContainerRuntime(ctx)
+	if err != nil {
+		return err
+	}
+
+	return validateContainerRuntimeForCluster(runtime, clusterConfig, bootstrapKubeconfig, executables.ExecutablesInDocker())
+}
+
+func validateContainerRuntimeForCluster(runtime executables.ContainerRuntime, clusterConfig *v1alpha1.Cluster, bootstrapKubeconfig string, toolsContainer bool) error {
+	switch clusterConfig.Spec.DatacenterRef.Kind {
+	case v1alpha1.DockerDatacenterKind:
+		return fmt.Errorf("the %s provider requires the docker container runtime, %s is not supported", clusterConfig.Spec.DatacenterRef.Kind, runtime.Name())
+	case v1alpha1.TinkerbellDatacenterKind:
+		if runtime.Rootless() {
+			return fmt.Errorf("the %s provider requires a rootful container runtime to serve boots on the host network, %s is running rootless", clusterConfig.Spec.DatacenterRef.Kind, runtime.Name())
+		}
+	}
+
+	// kind runs in the tools container and manages the bootstrap cluster nodes through the docker API socket.
+	if toolsContainer && bootstrapKubeconfig == "" && runtime.APISocket() == "" {
+		switch runtime.Name() {
+		case executables.PodmanRuntime:
+			return errors.New("the podman API socket is not available, enable it with 'systemctl enable --now podman.socket' (add --user for rootless podman)")
+		default:
+			return fmt.Errorf("%s doesn't provide a docker compatible API to create the bootstrap cluster from the tools container, set MR_TOOLS_DISABLE=true to use the binaries in the host or use --%s", runtime.Name(), BootstrapKubeconfigFlagName)
+		}
+	}
+
+	return nil
+}
+
+func readClusterConfig(clusterConfigFile string) (*v1alpha1.Cluster, error) {
 	clusterConfigFileExist := validations.FileExists(clusterConfigFile)
 	if !clusterConfigFileExist {
 		return nil, fmt.Errorf("the cluster config file %s does not exist", clusterConfigFile)
@@ -3,6 +3,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -21,6 +22,7 @@ import (
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	c "github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/controller"
@@ -53,6 +55,8 @@ type ClusterReconciler struct {
 	packagesClient             PackagesClient
 	machineHealthCheck         MachineHealthCheckReconciler
 	vSpherefailureDomainMover  FailureDomainApplier
+	etcdEncryption             EtcdEncryptionReconciler
+	now                        func() time.Time
 }
 
 // PackagesClient handles curated packages operations from within the cluster
@@ -79,6 +83,12 @@ type MachineHealthCheckReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) error
 }
 
+// EtcdEncryptionReconciler re-encrypts the etcd encrypted resources of an eks-a cluster
+// after its encryption configuration changes.
+type EtcdEncryptionReconciler interface {
+	Reconcile(ctx context.Context, logger logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error)
+}
+
 // ClusterValidator runs cluster level preflight validations before it goes to provider reconciler.
 type ClusterValidator interface {
 	ValidateManagementClusterName(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) error
@@ -87,6 +97,22 @@ type ClusterValidator interface {
 // ClusterReconcilerOption allows to configure the ClusterReconciler.
 type ClusterReconcilerOption func(*ClusterReconciler)
 
+// WithNow sets the function the ClusterReconciler uses to get the current time,
+// used to evaluate maintenance windows.
+func WithNow(now func() time.Time) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.now = now
+	}
+}
+
+// WithEtcdEncryptionReconciler sets the reconciler that re-encrypts resources, to complete
+// key rotations, when the etcd encryption configuration changes.
+func WithEtcdEncryptionReconciler(etcdEncryption EtcdEncryptionReconciler) ClusterReconcilerOption {
+	return func(r *ClusterReconciler) {
+		r.etcdEncryption = etcdEncryption
+	}
+}
+
 // SpecBuilder builds a cluster specification from an EKS Anywhere Cluster object.
 type SpecBuilder interface {
 	BuildSpec(ctx context.Context, eksaCluster *anywherev1.Cluster) (*c.Spec, error)
@@ -174,6 +200,7 @@ func NewClusterReconciler(client client.Client, registry ProviderClusterReconcil
 		packagesClient:             pkgs,
 		machineHealthCheck:         machineHealthCheck,
 		vSpherefailureDomainMover:  failuredomainmover,
+		now:                        time.Now,
 	}
 
 	for _, opt := range opts {
@@ -258,10 +285,10 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager, log logr.Logger)
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;create;delete
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=list
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters;gitopsconfigs;snowmachineconfigs;snowdatacenterconfigs;snowippools;vspheredatacenterconfigs;vspheremachineconfigs;dockerdatacenterconfigs;tinkerbellmachineconfigs;tinkerbelltemplateconfigs;tinkerbelldatacenterconfigs;cloudstackdatacenterconfigs;cloudstackmachineconfigs;nutanixdatacenterconfigs;nutanixmachineconfigs;awsiamconfigs;oidcconfigs;awsiamconfigs;fluxconfigs,verbs=get;list;watch;update;patch
-// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/status;snowmachineconfigs/status;snowippools/status;vspheredatacenterconfigs/status;vspheremachineconfigs/status;dockerdatacenterconfigs/status;tinkerbelldatacenterconfigs/status;tinkerbellmachineconfigs/status;tinkerbelltemplateconfigs/status;cloudstackdatacenterconfigs/status;cloudstackmachineconfigs/status;awsiamconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters;gitopsconfigs;snowmachineconfigs;snowdatacenterconfigs;snowippools;vspheredatacenterconfigs;vspheremachineconfigs;vsphereippools;dockerdatacenterconfigs;tinkerbellmachineconfigs;tinkerbelltemplateconfigs;tinkerbelldatacenterconfigs;cloudstackdatacenterconfigs;cloudstackmachineconfigs;nutanixdatacenterconfigs;nutanixmachineconfigs;awsiamconfigs;oidcconfigs;awsiamconfigs;fluxconfigs;argocdconfigs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/status;snowmachineconfigs/status;snowippools/status;vspheredatacenterconfigs/status;vspheremachineconfigs/status;vsphereippools/status;dockerdatacenterconfigs/status;tinkerbelldatacenterconfigs/status;tinkerbellmachineconfigs/status;tinkerbelltemplateconfigs/status;cloudstackdatacenterconfigs/status;cloudstackmachineconfigs/status;awsiamconfigs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=bundles,verbs=get;list;watch
-// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/finalizers;snowmachineconfigs/finalizers;snowippools/finalizers;vspheredatacenterconfigs/finalizers;vspheremachineconfigs/finalizers;cloudstackdatacenterconfigs/finalizers;cloudstackmachineconfigs/finalizers;dockerdatacenterconfigs/finalizers;bundles/finalizers;awsiamconfigs/finalizers;tinkerbelldatacenterconfigs/finalizers;tinkerbellmachineconfigs/finalizers;tinkerbelltemplateconfigs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=anywhere.eks.amazonaws.com,resources=clusters/finalizers;snowmachineconfigs/finalizers;snowippools/finalizers;vspheredatacenterconfigs/finalizers;vspheremachineconfigs/finalizers;vsphereippools/finalizers;cloudstackdatacenterconfigs/finalizers;cloudstackmachineconfigs/finalizers;dockerdatacenterconfigs/finalizers;bundles/finalizers;awsiamconfigs/finalizers;tinkerbelldatacenterconfigs/finalizers;tinkerbellmachineconfigs/finalizers;tinkerbelltemplateconfigs/finalizers,verbs=update
 // +kubebuilder:rbac:groups=bootstrap.cluster.x-k8s.io,resources=kubeadmconfigtemplates,verbs=create;get;list;patch;update;watch
 // +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=machinedeployments,verbs=list;watch;get;patch;update;create;delete
 // +kubebuilder:rbac:groups="cluster.x-k8s.io",resources=clusters,verbs=list;watch;get;patch;update;create;delete
@@ -361,11 +388,14 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	}
 
 	aggregatedGeneration := aggregatedGeneration(config)
+	rolloutHashes := clusterapi.RolloutHashes(config)
 
 	// If there is no difference between the aggregated generation and childrenReconciledGeneration,
 	// and there is no difference in the reconciled generation and .metadata.generation of the cluster,
-	// then return without any further processing.
-	if aggregatedGeneration == cluster.Status.ChildrenReconciledGeneration && cluster.Status.ReconciledGeneration == cluster.Generation {
+	// then return without any further processing, unless there are rolling changes deferred
+	// from a previous reconciliation.
+	if aggregatedGeneration == cluster.Status.ChildrenReconciledGeneration && cluster.Status.ReconciledGeneration == cluster.Generation &&
+		len(clusterapi.PendingRollouts(cluster.Status.AppliedRolloutHashes, rolloutHashes)) == 0 {
 		log.Info("Generation and aggregated generation match reconciled generations for cluster and child objects, skipping reconciliation.")
 
 		// Failure messages are cleared in the reconciler loop after running validations. But sometimes,
@@ -380,13 +410,43 @@ func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 			cluster.ClearFailure()
 		}
 
+		// Clusters reconciled before rollout hashes were introduced don't have them yet. Since the
+		// cluster is fully reconciled, the current hashes are the ones applied to the nodes.
+		if cluster.Status.AppliedRolloutHashes == nil {
+			cluster.Status.AppliedRolloutHashes = rolloutHashes
+		}
+
 		return ctrl.Result{}, nil
 	}
 
-	return r.reconcile(ctx, log, cluster, aggregatedGeneration)
+	deferResult, deferred, err := r.deferRollingChanges(ctx, log, cluster, rolloutHashes)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	result, err = r.reconcile(ctx, log, cluster, aggregatedGeneration, appliedRolloutHashes(cluster.Status.AppliedRolloutHashes, rolloutHashes, deferred))
+	if err != nil || result.Requeue || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	return deferResult.ToCtrlResult(), nil
+}
+
+// appliedRolloutHashes returns the rollout hashes applied to the nodes after a reconciliation,
+// keeping the previously applied hashes for the node groups whose rolling changes were deferred.
+func appliedRolloutHashes(applied, desired map[string]string, deferred []string) map[string]string {
+	hashes := make(map[string]string, len(desired))
+	for name, hash := range desired {
+		hashes[name] = hash
+	}
+	for _, name := range deferred {
+		hashes[name] = applied[name]
+	}
+
+	return hashes
 }
 
-func (r *ClusterReconciler) reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster, aggregatedGeneration int64) (ctrl.Result, error) {
+func (r *ClusterReconciler) reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster, aggregatedGeneration int64, rolloutHashes map[string]string) (ctrl.Result, error) {
 	clusterProviderReconciler := r.providerReconcilerRegistry.Get(cluster.Spec.DatacenterRef.Kind)
 
 	var reconcileResult controller.Result
@@ -425,6 +485,10 @@ func (r *ClusterReconciler) reconcile(ctx context.Context, log logr.Logger, clus
 	// be placed above this line.
 	cluster.Status.ReconciledGeneration = cluster.Generation
 	cluster.Status.ChildrenReconciledGeneration = aggregatedGeneration
+	cluster.Status.AppliedRolloutHashes = rolloutHashes
+	if conditions.GetReason(cluster, anywherev1.RollingChangesAppliedCondition) == anywherev1.RollingChangesInProgressReason {
+		conditions.MarkTrue(cluster, anywherev1.RollingChangesAppliedCondition)
+	}
 
 	// TODO(eksa-controller-SME): properly handle packages reconcile error and not triggering machine upgrade when
 	// packages reconcile is still in progress.
@@ -485,11 +549,81 @@ func (r *ClusterReconciler) preClusterProviderReconcile(ctx context.Context, log
 		if err := config.SetCredentialsEnv(rUsername, rPassword); err != nil {
 			return controller.Result{}, err
 		}
+
+		for i, fallback := range cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints {
+			if !fallback.Authenticate {
+				continue
+			}
+			rUsername, rPassword, err := config.ReadFallbackCredentialsFromSecret(ctx, r.client, i)
+			if err != nil {
+				return controller.Result{}, err
+			}
+			if err := config.SetFallbackCredentialsEnv(i, rUsername, rPassword); err != nil {
+				return controller.Result{}, err
+			}
+		}
 	}
 
 	return controller.Result{}, nil
 }
 
+// deferRollingChanges decides if the changes that replace or upgrade nodes can be rolled out. When the
+// cluster is outside its maintenance windows, it marks the rolling changes as deferred, which makes the
+// provider reconcilers keep the current machine specs, and returns the deferred node groups and a result
+// that requeues the cluster for when the next window opens. The rest of the changes are still reconciled.
+func (r *ClusterReconciler) deferRollingChanges(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster, rolloutHashes map[string]string) (controller.Result, []string, error) {
+	windows, err := r.maintenanceWindows(ctx, cluster)
+	if err != nil {
+		return controller.Result{}, nil, err
+	}
+
+	if len(windows) == 0 {
+		conditions.Delete(cluster, anywherev1.RollingChangesAppliedCondition)
+		return controller.Result{}, nil, nil
+	}
+
+	pending := clusterapi.PendingRollouts(cluster.Status.AppliedRolloutHashes, rolloutHashes)
+	if len(pending) == 0 {
+		conditions.MarkTrue(cluster, anywherev1.RollingChangesAppliedCondition)
+		return controller.Result{}, nil, nil
+	}
+
+	now := r.now()
+	if anywherev1.InMaintenanceWindow(windows, now) {
+		log.Info("Inside maintenance window, rolling out changes", "nodeGroups", pending)
+		conditions.MarkFalse(cluster, anywherev1.RollingChangesAppliedCondition, anywherev1.RollingChangesInProgressReason, clusterv1.ConditionSeverityInfo,
+			"Rolling out changes to nodes in %s", strings.Join(pending, ", "))
+		return controller.Result{}, nil, nil
+	}
+
+	next := anywherev1.NextMaintenanceWindow(windows, now)
+	requeueAfter := defaultRequeueTime
+	if !next.IsZero() {
+		requeueAfter = next.Sub(now)
+	}
+
+	log.Info("Outside maintenance window, deferring rolling changes", "nodeGroups", pending, "nextWindow", next)
+	conditions.MarkFalse(cluster, anywherev1.RollingChangesAppliedCondition, anywherev1.OutsideMaintenanceWindowReason, clusterv1.ConditionSeverityInfo,
+		"Changes that roll nodes in %s are deferred until the next maintenance window at %s", strings.Join(pending, ", "), next.UTC().Format(time.RFC3339))
+
+	return controller.ResultWithRequeue(requeueAfter), pending, nil
+}
+
+// maintenanceWindows returns the maintenance windows of the cluster or, if it doesn't have any
+// and it's a workload cluster, the ones of its management cluster.
+func (r *ClusterReconciler) maintenanceWindows(ctx context.Context, cluster *anywherev1.Cluster) ([]anywherev1.MaintenanceWindow, error) {
+	if len(cluster.Spec.MaintenanceWindows) > 0 || cluster.IsSelfManaged() {
+		return cluster.Spec.MaintenanceWindows, nil
+	}
+
+	mgmt, err := getManagementCluster(ctx, cluster, r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	return mgmt.Spec.MaintenanceWindows, nil
+}
+
 func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
 	if cluster.HasAWSIamConfig() {
 		if result, err := r.awsIamAuth.Reconcile(ctx, log, cluster); err != nil {
@@ -503,6 +637,14 @@ func (r *ClusterReconciler) postClusterProviderReconcile(ctx context.Context, lo
 		return controller.Result{}, err
 	}
 
+	if r.etcdEncryption != nil {
+		if result, err := r.etcdEncryption.Reconcile(ctx, log, cluster); err != nil {
+			return controller.Result{}, err
+		} else if result.Return() {
+			return result, nil
+		}
+	}
+
 	return controller.Result{}, nil
 }
 
@@ -548,10 +690,15 @@ func (r *ClusterReconciler) updateStatus(ctx context.Context, log logr.Logger, c
 	if defaultCNIConfiguredCondition == nil ||
 		(defaultCNIConfiguredCondition != nil &&
 			defaultCNIConfiguredCondition.Status == "False" &&
-			defaultCNIConfiguredCondition.Reason != anywherev1.SkipUpgradesForDefaultCNIConfiguredReason) {
+			defaultCNIConfiguredCondition.Reason != anywherev1.SkipUpgradesForDefaultCNIConfiguredReason &&
+			defaultCNIConfiguredCondition.Reason != anywherev1.CustomCNIConfiguredReason) {
 		summarizedConditionTypes = append(summarizedConditionTypes, anywherev1.DefaultCNIConfiguredCondition)
 	}
 
+	if conditions.Has(cluster, anywherev1.CNIReadyCondition) {
+		summarizedConditionTypes = append(summarizedConditionTypes, anywherev1.CNIReadyCondition)
+	}
+
 	// Always update the readyCondition by summarizing the state of other conditions.
 	conditions.SetSummary(cluster,
 		conditions.WithConditions(summarizedConditionTypes...),
@@ -684,6 +831,9 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *anywh
 			anywherev1.ControlPlaneReadyCondition,
 			anywherev1.WorkersReadyCondition,
 			anywherev1.DefaultCNIConfiguredCondition,
+			anywherev1.CNIReadyCondition,
+			anywherev1.RollingChangesAppliedCondition,
+			anywherev1.CanaryUpgradeHealthyCondition,
 		}},
 	}, patchOpts...)
 
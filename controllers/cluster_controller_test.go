@@ -265,6 +265,217 @@ func TestClusterReconcilerReconcileSelfManagedCluster(t *testing.T) {
 	g.Expect(result).To(Equal(ctrl.Result{}))
 }
 
+func TestClusterReconcilerReconcileEtcdReencryptionInProgress(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	cluster := maintenanceWindowTestCluster()
+	cluster.Spec.MaintenanceWindows = nil
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	mockCtrl := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(mockCtrl)
+	iam := mocks.NewMockAWSIamConfigReconciler(mockCtrl)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(mockCtrl)
+	etcdEncryption := mocks.NewMockEtcdEncryptionReconciler(mockCtrl)
+	clusterValidator := mocks.NewMockClusterValidator(mockCtrl)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(cluster, kcp, test.EKSARelease(), createBundle()).
+		WithStatusSubresource(cluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(mockCtrl)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster)).Return(nil)
+	etcdEncryption.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster)).Return(controller.ResultWithRequeue(30*time.Second), nil)
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil, controllers.WithEtcdEncryptionReconciler(etcdEncryption))
+	result, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: 30 * time.Second}))
+
+	api := envtest.NewAPIExpecter(t, c)
+	api.ShouldEventuallyMatch(ctx, cluster, func(g Gomega) {
+		g.Expect(cluster.Status.ReconciledGeneration).To(Equal(int64(1)))
+	})
+}
+
+func maintenanceWindowTestCluster() *anywherev1.Cluster {
+	version := test.DevEksaVersion()
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-management-cluster",
+			Generation: 2,
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube132,
+			EksaVersion:       &version,
+			ClusterNetwork: anywherev1.ClusterNetwork{
+				CNIConfig: &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				},
+			},
+			MachineHealthCheck: &anywherev1.MachineHealthCheck{
+				UnhealthyMachineTimeout: &metav1.Duration{
+					Duration: constants.DefaultUnhealthyMachineTimeout,
+				},
+				NodeStartupTimeout: &metav1.Duration{
+					Duration: constants.DefaultNodeStartupTimeout,
+				},
+			},
+			MaintenanceWindows: []anywherev1.MaintenanceWindow{
+				{
+					Days:     []string{"Saturday"},
+					Start:    "22:00",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ReconciledGeneration: 1,
+			AppliedRolloutHashes: map[string]string{
+				"my-management-cluster": "previous-hash",
+			},
+		},
+	}
+}
+
+func TestClusterReconcilerReconcileOutsideMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := maintenanceWindowTestCluster()
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	controller := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(controller)
+	iam := mocks.NewMockAWSIamConfigReconciler(controller)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(controller)
+	clusterValidator := mocks.NewMockClusterValidator(controller)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(cluster, kcp, test.EKSARelease(), createBundle()).
+		WithStatusSubresource(cluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(controller)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster)).Return(nil)
+
+	// Monday, the next window opens on Saturday at 22:00.
+	now := time.Date(2024, time.June, 3, 12, 0, 0, 0, time.UTC)
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil, controllers.WithNow(func() time.Time { return now }))
+	result, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{RequeueAfter: 5*24*time.Hour + 10*time.Hour}))
+
+	api := envtest.NewAPIExpecter(t, c)
+	api.ShouldEventuallyMatch(ctx, cluster, func(g Gomega) {
+		condition := conditions.Get(cluster, anywherev1.RollingChangesAppliedCondition)
+		g.Expect(condition).NotTo(BeNil())
+		g.Expect(condition.Status).To(Equal(apiv1.ConditionFalse))
+		g.Expect(condition.Reason).To(Equal(anywherev1.OutsideMaintenanceWindowReason))
+		g.Expect(condition.Message).To(ContainSubstring("my-management-cluster"))
+		g.Expect(cluster.Status.ReconciledGeneration).To(Equal(int64(2)))
+		g.Expect(cluster.Status.AppliedRolloutHashes).To(HaveKeyWithValue("my-management-cluster", "previous-hash"))
+	})
+}
+
+func TestClusterReconcilerReconcileDeferredChangesInsideMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := maintenanceWindowTestCluster()
+	cluster.Status.ReconciledGeneration = cluster.Generation
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	controller := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(controller)
+	iam := mocks.NewMockAWSIamConfigReconciler(controller)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(controller)
+	clusterValidator := mocks.NewMockClusterValidator(controller)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(cluster, kcp, test.EKSARelease(), createBundle()).
+		WithStatusSubresource(cluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(controller)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster)).Return(nil)
+
+	// The generations match, but the changes deferred in a previous reconciliation are still pending.
+	now := time.Date(2024, time.June, 9, 1, 0, 0, 0, time.UTC)
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil, controllers.WithNow(func() time.Time { return now }))
+	result, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{}))
+
+	api := envtest.NewAPIExpecter(t, c)
+	api.ShouldEventuallyMatch(ctx, cluster, func(g Gomega) {
+		g.Expect(cluster.Status.AppliedRolloutHashes["my-management-cluster"]).NotTo(Equal("previous-hash"))
+		g.Expect(conditions.IsTrue(cluster, anywherev1.RollingChangesAppliedCondition)).To(BeTrue())
+	})
+}
+
+func TestClusterReconcilerReconcileSeedsAppliedRolloutHashes(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := maintenanceWindowTestCluster()
+	cluster.Status.ReconciledGeneration = cluster.Generation
+	cluster.Status.AppliedRolloutHashes = nil
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	controller := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(controller)
+	iam := mocks.NewMockAWSIamConfigReconciler(controller)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(controller)
+	clusterValidator := mocks.NewMockClusterValidator(controller)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(cluster, kcp, test.EKSARelease(), createBundle()).
+		WithStatusSubresource(cluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(controller)
+
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil)
+	result, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{}))
+
+	api := envtest.NewAPIExpecter(t, c)
+	api.ShouldEventuallyMatch(ctx, cluster, func(g Gomega) {
+		g.Expect(cluster.Status.AppliedRolloutHashes).To(HaveKey("my-management-cluster"))
+	})
+}
+
+func TestClusterReconcilerReconcileInsideMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := maintenanceWindowTestCluster()
+	kcp := testKubeadmControlPlaneFromCluster(cluster)
+
+	controller := gomock.NewController(t)
+	providerReconciler := mocks.NewMockProviderClusterReconciler(controller)
+	iam := mocks.NewMockAWSIamConfigReconciler(controller)
+	mhcReconciler := mocks.NewMockMachineHealthCheckReconciler(controller)
+	clusterValidator := mocks.NewMockClusterValidator(controller)
+	registry := newRegistryMock(providerReconciler)
+	c := fake.NewClientBuilder().WithRuntimeObjects(cluster, kcp, test.EKSARelease(), createBundle()).
+		WithStatusSubresource(cluster).
+		Build()
+	mockPkgs := mocks.NewMockPackagesClient(controller)
+	providerReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster))
+	mhcReconciler.EXPECT().Reconcile(ctx, gomock.AssignableToTypeOf(logr.Logger{}), sameName(cluster)).Return(nil)
+
+	// Sunday at 01:00, inside the window that opened on Saturday at 22:00.
+	now := time.Date(2024, time.June, 9, 1, 0, 0, 0, time.UTC)
+	r := controllers.NewClusterReconciler(c, registry, iam, clusterValidator, mockPkgs, mhcReconciler, nil, controllers.WithNow(func() time.Time { return now }))
+	result, err := r.Reconcile(ctx, clusterRequest(cluster))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(Equal(ctrl.Result{}))
+
+	api := envtest.NewAPIExpecter(t, c)
+	api.ShouldEventuallyMatch(ctx, cluster, func(g Gomega) {
+		g.Expect(cluster.Status.ReconciledGeneration).To(Equal(int64(2)))
+		g.Expect(cluster.Status.AppliedRolloutHashes).To(HaveKey("my-management-cluster"))
+		g.Expect(cluster.Status.AppliedRolloutHashes["my-management-cluster"]).NotTo(Equal("previous-hash"))
+		g.Expect(conditions.IsTrue(cluster, anywherev1.RollingChangesAppliedCondition)).To(BeTrue())
+	})
+}
+
 func TestClusterReconcilerReconcileUnclearedClusterFailure(t *testing.T) {
 	config, bundles := baseTestVsphereCluster()
 	version := test.DevEksaVersion()
@@ -322,6 +533,8 @@ func TestClusterReconcilerReconcileConditions(t *testing.T) {
 	testCases := []struct {
 		testName                string
 		skipCNIUpgrade          bool
+		customCNI               bool
+		cniNotReady             bool
 		cniUpgradeInProgress    bool
 		kcpStatus               controlplanev1.KubeadmControlPlaneStatus
 		machineDeploymentStatus clusterv1.MachineDeploymentStatus
@@ -483,6 +696,79 @@ func TestClusterReconcilerReconcileConditions(t *testing.T) {
 			},
 			result: ctrl.Result{},
 		},
+		{
+			testName:             "cluster ready, custom cni",
+			customCNI:            true,
+			cniUpgradeInProgress: false,
+			kcpStatus: controlplanev1.KubeadmControlPlaneStatus{
+				ReadyReplicas:   1,
+				Replicas:        1,
+				UpdatedReplicas: 1,
+				Conditions: clusterv1.Conditions{
+					{
+						Type:   controlplanev1.ControlPlaneComponentsHealthyCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+					{
+						Type:   controlplanev1.AvailableCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+					{
+						Type:   clusterv1.ReadyCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+				},
+			},
+			machineDeploymentStatus: clusterv1.MachineDeploymentStatus{
+				ReadyReplicas:   1,
+				Replicas:        1,
+				UpdatedReplicas: 1,
+			},
+			wantConditions: []anywherev1.Condition{
+				*conditions.TrueCondition(anywherev1.ReadyCondition),
+				*conditions.TrueCondition(anywherev1.ControlPlaneReadyCondition),
+				*conditions.FalseCondition(anywherev1.DefaultCNIConfiguredCondition, anywherev1.CustomCNIConfiguredReason, clusterv1.ConditionSeverityInfo, "Configured to use a custom CNI"),
+				*conditions.TrueCondition(anywherev1.WorkersReadyCondition),
+				*conditions.TrueCondition(anywherev1.ControlPlaneInitializedCondition),
+			},
+			result: ctrl.Result{},
+		},
+		{
+			testName:             "cluster not ready, custom cni not ready",
+			customCNI:            true,
+			cniNotReady:          true,
+			cniUpgradeInProgress: false,
+			kcpStatus: controlplanev1.KubeadmControlPlaneStatus{
+				ReadyReplicas:   1,
+				Replicas:        1,
+				UpdatedReplicas: 1,
+				Conditions: clusterv1.Conditions{
+					{
+						Type:   controlplanev1.ControlPlaneComponentsHealthyCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+					{
+						Type:   controlplanev1.AvailableCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+					{
+						Type:   clusterv1.ReadyCondition,
+						Status: apiv1.ConditionStatus("True"),
+					},
+				},
+			},
+			machineDeploymentStatus: clusterv1.MachineDeploymentStatus{
+				ReadyReplicas:   1,
+				Replicas:        1,
+				UpdatedReplicas: 1,
+			},
+			wantConditions: []anywherev1.Condition{
+				*conditions.FalseCondition(anywherev1.ReadyCondition, anywherev1.CNINotReadyReason, clusterv1.ConditionSeverityInfo, "node my-node is not ready"),
+				*conditions.FalseCondition(anywherev1.CNIReadyCondition, anywherev1.CNINotReadyReason, clusterv1.ConditionSeverityInfo, "node my-node is not ready"),
+				*conditions.TrueCondition(anywherev1.WorkersReadyCondition),
+			},
+			result: ctrl.Result{Requeue: false, RequeueAfter: 10 * time.Second},
+		},
 		{
 			testName:             "cluster not ready, default cni upgrade in progress",
 			skipCNIUpgrade:       false,
@@ -530,6 +816,9 @@ func TestClusterReconcilerReconcileConditions(t *testing.T) {
 			config.Cluster.Spec.ManagementCluster = anywherev1.ManagementCluster{Name: "management-cluster"}
 
 			config.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.SkipUpgrade = ptr.Bool(tt.skipCNIUpgrade)
+			if tt.customCNI {
+				config.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{Custom: &anywherev1.CustomCNIConfig{}}
+			}
 
 			mgmt := config.DeepCopy()
 			mgmt.Cluster.Name = "management-cluster"
@@ -597,6 +886,14 @@ func TestClusterReconcilerReconcileConditions(t *testing.T) {
 						return
 					}
 
+					if tt.customCNI {
+						conditions.MarkFalse(cluster, anywherev1.DefaultCNIConfiguredCondition, anywherev1.CustomCNIConfiguredReason, clusterv1.ConditionSeverityInfo, "Configured to use a custom CNI")
+						if tt.cniNotReady {
+							conditions.MarkFalse(cluster, anywherev1.CNIReadyCondition, anywherev1.CNINotReadyReason, clusterv1.ConditionSeverityInfo, "node my-node is not ready")
+						}
+						return
+					}
+
 					if tt.cniUpgradeInProgress {
 						conditions.MarkFalse(cluster, anywherev1.DefaultCNIConfiguredCondition, anywherev1.DefaultCNIUpgradeInProgressReason, clusterv1.ConditionSeverityInfo, "Cilium version upgrade needed")
 						return
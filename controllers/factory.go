@@ -19,11 +19,14 @@ import (
 	"github.com/aws/eks-anywhere/pkg/crypto"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	etcdencryptionreconciler "github.com/aws/eks-anywhere/pkg/etcdencryption/reconciler"
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/executables/cmk"
 	"github.com/aws/eks-anywhere/pkg/helm"
+	"github.com/aws/eks-anywhere/pkg/networking"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
 	ciliumreconciler "github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
+	"github.com/aws/eks-anywhere/pkg/networking/custom"
 	cnireconciler "github.com/aws/eks-anywhere/pkg/networking/reconciler"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack"
 	cloudstackreconciler "github.com/aws/eks-anywhere/pkg/providers/cloudstack/reconciler"
@@ -55,6 +58,7 @@ type Factory struct {
 	ipValidator                  *clusters.IPValidator
 	awsIamConfigReconciler       *awsiamconfigreconciler.Reconciler
 	machineHealthCheckReconciler *mhcreconciler.Reconciler
+	etcdEncryptionReconciler     *etcdencryptionreconciler.Reconciler
 	logger                       logr.Logger
 	deps                         *dependencies.Dependencies
 	packageControllerClient      *curatedpackages.PackageControllerClient
@@ -76,6 +80,7 @@ type Reconcilers struct {
 	ControlPlaneUpgradeReconciler      *ControlPlaneUpgradeReconciler
 	MachineDeploymentUpgradeReconciler *MachineDeploymentUpgradeReconciler
 	NodeUpgradeReconciler              *NodeUpgradeReconciler
+	IPAddressClaimReconciler           *IPAddressClaimReconciler
 }
 
 type buildStep func(ctx context.Context) error
@@ -120,13 +125,16 @@ func (f *Factory) WithClusterReconciler(capiProviders []clusterctlv1.Provider, o
 		WithProviderClusterReconcilerRegistry(capiProviders).
 		withAWSIamConfigReconciler().
 		withPackageControllerClient().
-		withMachineHealthCheckReconciler()
+		withMachineHealthCheckReconciler().
+		withEtcdEncryptionReconciler()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.reconcilers.ClusterReconciler != nil {
 			return nil
 		}
 
+		opts = append([]ClusterReconcilerOption{WithEtcdEncryptionReconciler(f.etcdEncryptionReconciler)}, opts...)
+
 		f.reconcilers.ClusterReconciler = NewClusterReconciler(
 			f.manager.GetClient(),
 			f.registry,
@@ -513,7 +521,10 @@ func (f *Factory) withCNIReconciler(providerNamespace string) *Factory {
 			return nil
 		}
 
-		f.cniReconciler = cnireconciler.New(ciliumreconciler.New(f.ciliumTemplater, []string{providerNamespace}))
+		f.cniReconciler = cnireconciler.New(networking.NewRegistry(
+			ciliumreconciler.NewProvider(ciliumreconciler.New(f.ciliumTemplater, []string{providerNamespace})),
+			custom.NewProvider(),
+		))
 
 		return nil
 	})
@@ -594,6 +605,25 @@ func (f *Factory) withMachineHealthCheckReconciler() *Factory {
 	return f
 }
 
+func (f *Factory) withEtcdEncryptionReconciler() *Factory {
+	f.withTracker()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.etcdEncryptionReconciler != nil {
+			return nil
+		}
+
+		f.etcdEncryptionReconciler = etcdencryptionreconciler.New(
+			f.manager.GetClient(),
+			f.tracker,
+		)
+
+		return nil
+	})
+
+	return f
+}
+
 // WithKubeadmControlPlaneReconciler builds the KubeadmControlPlane reconciler.
 func (f *Factory) WithKubeadmControlPlaneReconciler() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
@@ -685,6 +715,23 @@ func (f *Factory) WithNodeUpgradeReconciler() *Factory {
 	return f
 }
 
+// WithIPAddressClaimReconciler builds the IPAddressClaim reconciler.
+func (f *Factory) WithIPAddressClaimReconciler() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.reconcilers.IPAddressClaimReconciler != nil {
+			return nil
+		}
+
+		f.reconcilers.IPAddressClaimReconciler = NewIPAddressClaimReconciler(
+			f.manager.GetClient(),
+		)
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) getProviderNamespace(providerName string) string {
 	var providerNamespace string
 	switch providerName {
@@ -331,3 +331,23 @@ func TestFactoryWithMachineDeploymentUpgradeReconciler(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(reconcilers.MachineDeploymentUpgradeReconciler).NotTo(BeNil())
 }
+
+func TestFactoryWithIPAddressClaimReconciler(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	logger := nullLog()
+	ctrl := gomock.NewController(t)
+	manager := mocks.NewMockManager(ctrl)
+	manager.EXPECT().GetClient().AnyTimes()
+	manager.EXPECT().GetScheme().AnyTimes()
+
+	f := controllers.NewFactory(logger, manager).
+		WithIPAddressClaimReconciler()
+
+	// testing idempotence
+	f.WithIPAddressClaimReconciler()
+
+	reconcilers, err := f.Build(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reconcilers.IPAddressClaimReconciler).NotTo(BeNil())
+}
@@ -0,0 +1,225 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+)
+
+// IPAddressClaimReconciler reconciles the IPAddressClaims that reference a VSphereIPPool,
+// allocating an IPAddress from the pool for each of them following the CAPI IPAM contract.
+type IPAddressClaimReconciler struct {
+	client client.Client
+	log    logr.Logger
+}
+
+// NewIPAddressClaimReconciler returns a new instance of IPAddressClaimReconciler.
+func NewIPAddressClaimReconciler(client client.Client) *IPAddressClaimReconciler {
+	return &IPAddressClaimReconciler{
+		client: client,
+		log:    ctrl.Log.WithName("IPAddressClaimController"),
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IPAddressClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ipamv1.IPAddressClaim{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool {
+			claim, ok := o.(*ipamv1.IPAddressClaim)
+			return ok && isVSphereIPPoolRef(claim.Spec.PoolRef)
+		}))).
+		Complete(r)
+}
+
+//+kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile allocates an ip from a VSphereIPPool for an IPAddressClaim.
+func (r *IPAddressClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := r.log.WithValues("IPAddressClaim", req.NamespacedName)
+
+	claim := &ipamv1.IPAddressClaim{}
+	if err := r.client.Get(ctx, req.NamespacedName, claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !isVSphereIPPoolRef(claim.Spec.PoolRef) || !claim.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(claim, r.client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, claim); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("patching ipaddressclaim: %v", err)})
+		}
+	}()
+
+	log.Info("Reconciling ip address claim")
+	if err := r.reconcile(ctx, log, claim); err != nil {
+		conditions.MarkFalse(claim, clusterv1.ReadyCondition, "AllocationFailed", clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	conditions.MarkTrue(claim, clusterv1.ReadyCondition)
+	return ctrl.Result{}, nil
+}
+
+func (r *IPAddressClaimReconciler) reconcile(ctx context.Context, log logr.Logger, claim *ipamv1.IPAddressClaim) error {
+	addresses := &ipamv1.IPAddressList{}
+	if err := r.client.List(ctx, addresses, client.InNamespace(claim.Namespace)); err != nil {
+		return fmt.Errorf("listing ip addresses: %v", err)
+	}
+
+	used := map[string]struct{}{}
+	for _, address := range addresses.Items {
+		if address.Spec.ClaimRef.Name == claim.Name && isVSphereIPPoolRef(address.Spec.PoolRef) {
+			claim.Status.AddressRef = corev1.LocalObjectReference{Name: address.Name}
+			return nil
+		}
+		if address.Spec.PoolRef.Name == claim.Spec.PoolRef.Name && isVSphereIPPoolRef(address.Spec.PoolRef) {
+			used[normalizeIP(address.Spec.Address)] = struct{}{}
+		}
+	}
+
+	pool, err := r.getPool(ctx, claim)
+	if err != nil {
+		return err
+	}
+
+	for _, ipRange := range pool.Spec.Pools {
+		_, subnet, err := net.ParseCIDR(ipRange.Subnet)
+		if err != nil {
+			return fmt.Errorf("parsing subnet %s of VSphereIPPool %s: %v", ipRange.Subnet, pool.Name, err)
+		}
+		prefix, _ := subnet.Mask.Size()
+
+		start, end := net.ParseIP(ipRange.IPStart), net.ParseIP(ipRange.IPEnd)
+		for ip := start; ip != nil && bytes.Compare(ip.To16(), end.To16()) <= 0; ip = nextIP(ip) {
+			if _, ok := used[normalizeIP(ip.String())]; ok {
+				continue
+			}
+
+			address := newIPAddress(claim, ip.String(), prefix, ipRange.Gateway)
+			if err := r.client.Create(ctx, address); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					// Another claim got this ip first, try with the next one.
+					continue
+				}
+				return fmt.Errorf("creating ip address %s: %v", address.Name, err)
+			}
+
+			log.Info("Allocated ip address from pool", "address", address.Spec.Address, "pool", pool.Name)
+			claim.Status.AddressRef = corev1.LocalObjectReference{Name: address.Name}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("VSphereIPPool %s has no free ips left", pool.Name)
+}
+
+// getPool returns the VSphereIPPool referenced by the claim. Claims are created in the namespace of the
+// CAPI objects, so the pool is looked up in the namespace of the EKS-A cluster, which the CAPI cluster
+// of the claim records in its labels.
+func (r *IPAddressClaimReconciler) getPool(ctx context.Context, claim *ipamv1.IPAddressClaim) (*anywherev1.VSphereIPPool, error) {
+	clusterName, ok := claim.Labels[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil, fmt.Errorf("ipaddressclaim %s is missing the %s label", claim.Name, clusterv1.ClusterNameLabel)
+	}
+
+	capiCluster := &clusterv1.Cluster{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: claim.Namespace}, capiCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("cluster %s for ipaddressclaim %s not found", clusterName, claim.Name)
+		}
+		return nil, fmt.Errorf("getting cluster %s: %v", clusterName, err)
+	}
+
+	namespace := capiCluster.Labels[clusterapi.EKSAClusterLabelNamespace]
+	if namespace == "" {
+		namespace = claim.Namespace
+	}
+
+	pool := &anywherev1.VSphereIPPool{}
+	key := types.NamespacedName{Name: claim.Spec.PoolRef.Name, Namespace: namespace}
+	if err := r.client.Get(ctx, key, pool); err != nil {
+		return nil, fmt.Errorf("getting VSphereIPPool %s: %v", claim.Spec.PoolRef.Name, err)
+	}
+
+	return pool, nil
+}
+
+func newIPAddress(claim *ipamv1.IPAddressClaim, ip string, prefix int, gateway string) *ipamv1.IPAddress {
+	return &ipamv1.IPAddress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: ipamv1.GroupVersion.String(),
+			Kind:       "IPAddress",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			// The name is derived from the ip so two claims can't be allocated the same one.
+			Name:      fmt.Sprintf("%s-%s", claim.Spec.PoolRef.Name, strings.NewReplacer(".", "-", ":", "-").Replace(ip)),
+			Namespace: claim.Namespace,
+			Labels:    claim.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(claim, ipamv1.GroupVersion.WithKind("IPAddressClaim")),
+			},
+		},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: corev1.LocalObjectReference{Name: claim.Name},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  ip,
+			Prefix:   prefix,
+			Gateway:  gateway,
+		},
+	}
+}
+
+func isVSphereIPPoolRef(ref corev1.TypedLocalObjectReference) bool {
+	return ref.APIGroup != nil && *ref.APIGroup == anywherev1.GroupVersion.Group && ref.Kind == anywherev1.VSphereIPPoolKind
+}
+
+func normalizeIP(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil {
+		return parsed.String()
+	}
+	return ip
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := new(big.Int).Add(new(big.Int).SetBytes(ip.To16()), big.NewInt(1)).Bytes()
+	if len(next) > net.IPv6len {
+		return nil
+	}
+
+	result := make(net.IP, net.IPv6len)
+	copy(result[net.IPv6len-len(next):], next)
+	return result
+}
@@ -0,0 +1,197 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/aws/eks-anywhere/controllers"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func TestIPAddressClaimReconcilerReconcileAllocatesFirstFreeIP(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster, pool, claim := getObjectsForIPAddressClaimTest()
+	used := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-10-0-0-10", Namespace: constants.EksaSystemNamespace},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: corev1.LocalObjectReference{Name: "other-claim"},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  "10.0.0.10",
+			Prefix:   24,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(cluster, pool, claim, used).
+		WithStatusSubresource(claim).
+		Build()
+
+	r := controllers.NewIPAddressClaimReconciler(client)
+	_, err := r.Reconcile(ctx, ipAddressClaimRequest(claim))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(client.Get(ctx, types.NamespacedName{Name: claim.Name, Namespace: claim.Namespace}, claim)).To(Succeed())
+	g.Expect(claim.Status.AddressRef.Name).To(Equal("pool-10-0-0-11"))
+	g.Expect(conditions.IsTrue(claim, clusterv1.ReadyCondition)).To(BeTrue())
+
+	address := &ipamv1.IPAddress{}
+	g.Expect(client.Get(ctx, types.NamespacedName{Name: "pool-10-0-0-11", Namespace: claim.Namespace}, address)).To(Succeed())
+	g.Expect(address.Spec.Address).To(Equal("10.0.0.11"))
+	g.Expect(address.Spec.Prefix).To(Equal(24))
+	g.Expect(address.Spec.Gateway).To(Equal("10.0.0.1"))
+	g.Expect(address.Spec.ClaimRef.Name).To(Equal(claim.Name))
+	g.Expect(address.OwnerReferences).To(HaveLen(1))
+}
+
+func TestIPAddressClaimReconcilerReconcileReusesAllocatedIP(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster, pool, claim := getObjectsForIPAddressClaimTest()
+	allocated := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-10-0-0-12", Namespace: constants.EksaSystemNamespace},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: corev1.LocalObjectReference{Name: claim.Name},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  "10.0.0.12",
+			Prefix:   24,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(cluster, pool, claim, allocated).
+		WithStatusSubresource(claim).
+		Build()
+
+	r := controllers.NewIPAddressClaimReconciler(client)
+	_, err := r.Reconcile(ctx, ipAddressClaimRequest(claim))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(client.Get(ctx, types.NamespacedName{Name: claim.Name, Namespace: claim.Namespace}, claim)).To(Succeed())
+	g.Expect(claim.Status.AddressRef.Name).To(Equal("pool-10-0-0-12"))
+
+	addresses := &ipamv1.IPAddressList{}
+	g.Expect(client.List(ctx, addresses)).To(Succeed())
+	g.Expect(addresses.Items).To(HaveLen(1))
+}
+
+func TestIPAddressClaimReconcilerReconcilePoolExhausted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster, pool, claim := getObjectsForIPAddressClaimTest()
+	pool.Spec.Pools[0].IPEnd = "10.0.0.10"
+	used := &ipamv1.IPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool-10-0-0-10", Namespace: constants.EksaSystemNamespace},
+		Spec: ipamv1.IPAddressSpec{
+			ClaimRef: corev1.LocalObjectReference{Name: "other-claim"},
+			PoolRef:  claim.Spec.PoolRef,
+			Address:  "10.0.0.10",
+			Prefix:   24,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(cluster, pool, claim, used).
+		WithStatusSubresource(claim).
+		Build()
+
+	r := controllers.NewIPAddressClaimReconciler(client)
+	_, err := r.Reconcile(ctx, ipAddressClaimRequest(claim))
+	g.Expect(err).To(MatchError(ContainSubstring("VSphereIPPool pool has no free ips left")))
+
+	g.Expect(client.Get(ctx, types.NamespacedName{Name: claim.Name, Namespace: claim.Namespace}, claim)).To(Succeed())
+	g.Expect(conditions.IsFalse(claim, clusterv1.ReadyCondition)).To(BeTrue())
+}
+
+func TestIPAddressClaimReconcilerReconcileIgnoresOtherPools(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster, pool, claim := getObjectsForIPAddressClaimTest()
+	claim.Spec.PoolRef.Kind = "InClusterIPPool"
+	client := fake.NewClientBuilder().WithObjects(cluster, pool, claim).
+		WithStatusSubresource(claim).
+		Build()
+
+	r := controllers.NewIPAddressClaimReconciler(client)
+	_, err := r.Reconcile(ctx, ipAddressClaimRequest(claim))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	addresses := &ipamv1.IPAddressList{}
+	g.Expect(client.List(ctx, addresses)).To(Succeed())
+	g.Expect(addresses.Items).To(BeEmpty())
+}
+
+func TestIPAddressClaimReconcilerReconcileClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	_, pool, claim := getObjectsForIPAddressClaimTest()
+	client := fake.NewClientBuilder().WithObjects(pool, claim).
+		WithStatusSubresource(claim).
+		Build()
+
+	r := controllers.NewIPAddressClaimReconciler(client)
+	_, err := r.Reconcile(ctx, ipAddressClaimRequest(claim))
+	g.Expect(err).To(MatchError(ContainSubstring("cluster my-cluster for ipaddressclaim my-cluster-cp-0-0 not found")))
+}
+
+func getObjectsForIPAddressClaimTest() (*clusterv1.Cluster, *anywherev1.VSphereIPPool, *ipamv1.IPAddressClaim) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+			Labels: map[string]string{
+				clusterapi.EKSAClusterLabelName:      "my-cluster",
+				clusterapi.EKSAClusterLabelNamespace: "default",
+			},
+		},
+	}
+	pool := &anywherev1.VSphereIPPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool",
+			Namespace: "default",
+		},
+		Spec: anywherev1.VSphereIPPoolSpec{
+			Pools: []anywherev1.IPPool{
+				{
+					IPStart: "10.0.0.10",
+					IPEnd:   "10.0.0.20",
+					Subnet:  "10.0.0.0/24",
+					Gateway: "10.0.0.1",
+				},
+			},
+		},
+	}
+	claim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-cp-0-0",
+			Namespace: constants.EksaSystemNamespace,
+			UID:       "claim-uid",
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: cluster.Name,
+			},
+		},
+		Spec: ipamv1.IPAddressClaimSpec{
+			PoolRef: corev1.TypedLocalObjectReference{
+				APIGroup: ptr.String(anywherev1.GroupVersion.Group),
+				Kind:     anywherev1.VSphereIPPoolKind,
+				Name:     pool.Name,
+			},
+		},
+	}
+
+	return cluster, pool, claim
+}
+
+func ipAddressClaimRequest(claim *ipamv1.IPAddressClaim) reconcile.Request {
+	return reconcile.Request{
+		NamespacedName: client.ObjectKeyFromObject(claim),
+	}
+}
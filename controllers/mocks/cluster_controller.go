@@ -232,6 +232,44 @@ func (mr *MockMachineHealthCheckReconcilerMockRecorder) Reconcile(ctx, logger, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockMachineHealthCheckReconciler)(nil).Reconcile), ctx, logger, cluster)
 }
 
+// MockEtcdEncryptionReconciler is a mock of EtcdEncryptionReconciler interface.
+type MockEtcdEncryptionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEtcdEncryptionReconcilerMockRecorder
+}
+
+// MockEtcdEncryptionReconcilerMockRecorder is the mock recorder for MockEtcdEncryptionReconciler.
+type MockEtcdEncryptionReconcilerMockRecorder struct {
+	mock *MockEtcdEncryptionReconciler
+}
+
+// NewMockEtcdEncryptionReconciler creates a new mock instance.
+func NewMockEtcdEncryptionReconciler(ctrl *gomock.Controller) *MockEtcdEncryptionReconciler {
+	mock := &MockEtcdEncryptionReconciler{ctrl: ctrl}
+	mock.recorder = &MockEtcdEncryptionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEtcdEncryptionReconciler) EXPECT() *MockEtcdEncryptionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockEtcdEncryptionReconciler) Reconcile(ctx context.Context, logger logr.Logger, cluster *v1alpha1.Cluster) (controller.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, logger, cluster)
+	ret0, _ := ret[0].(controller.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockEtcdEncryptionReconcilerMockRecorder) Reconcile(ctx, logger, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockEtcdEncryptionReconciler)(nil).Reconcile), ctx, logger, cluster)
+}
+
 // MockClusterValidator is a mock of ClusterValidator interface.
 type MockClusterValidator struct {
 	ctrl     *gomock.Controller
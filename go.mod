@@ -53,6 +53,7 @@ require (
 	gopkg.in/yaml.v3 v3.0.1
 	helm.sh/helm/v3 v3.14.4
 	k8s.io/api v0.29.5
+	k8s.io/apiextensions-apiserver v0.29.1
 	k8s.io/apimachinery v0.29.5
 	k8s.io/apiserver v0.29.5
 	k8s.io/client-go v0.29.5
@@ -200,7 +201,6 @@ require (
 	google.golang.org/protobuf v1.35.2 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/warnings.v0 v0.1.2 // indirect
-	k8s.io/apiextensions-apiserver v0.29.1 // indirect
 	k8s.io/cluster-bootstrap v0.28.5 // indirect
 	k8s.io/kube-openapi v0.0.0-20231010175941-2dd684a91f00 // indirect
 	k8s.io/kubelet v0.29.5
@@ -29,6 +29,7 @@ import (
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 	dockerv1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -72,6 +73,7 @@ func init() {
 	utilruntime.Must(eksdv1alpha1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(snowv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(addonsv1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(ipamv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(tinkerbellv1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(tinkv1alpha1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(rufiov1alpha1.AddToScheme(scheme.Scheme))
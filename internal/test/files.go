@@ -118,6 +118,28 @@ func NewWriter(t *testing.T) (dir string, writer filewriter.FileWriter) {
 	return dir, writer
 }
 
+// ChdirTemp changes the working directory to a new temporary folder until the test finishes, so
+// the files the code under test writes relative to it don't end up in the source tree. It returns
+// the original working directory, to build the paths to testdata files.
+func ChdirTemp(t *testing.T) (wd string) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("error getting working directory for test: %v", err)
+	}
+
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("error changing to temporary working directory for test: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Errorf("error restoring working directory after test: %v", err)
+		}
+	})
+
+	return wd
+}
+
 func cleanupDir(t *testing.T, dir string) func() {
 	return func() {
 		if !t.Failed() {
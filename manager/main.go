@@ -25,6 +25,7 @@ import (
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	ipamv1 "sigs.k8s.io/cluster-api/exp/ipam/api/v1beta1"
 	dockerv1 "sigs.k8s.io/cluster-api/test/infrastructure/docker/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -61,6 +62,7 @@ func init() {
 	utilruntime.Must(eksdv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(snowv1.AddToScheme(scheme))
 	utilruntime.Must(addonsv1.AddToScheme(scheme))
+	utilruntime.Must(ipamv1.AddToScheme(scheme))
 	utilruntime.Must(tinkerbellv1.AddToScheme(scheme))
 	utilruntime.Must(tinkv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(rufiov1alpha1.AddToScheme(scheme))
@@ -186,7 +188,8 @@ func setupReconcilers(ctx context.Context, setupLog logr.Logger, mgr ctrl.Manage
 		WithMachineDeploymentReconciler().
 		WithControlPlaneUpgradeReconciler().
 		WithMachineDeploymentUpgradeReconciler().
-		WithNodeUpgradeReconciler()
+		WithNodeUpgradeReconciler().
+		WithIPAddressClaimReconciler()
 
 	reconcilers, err := factory.Build(ctx)
 	if err != nil {
@@ -254,6 +257,12 @@ func setupReconcilers(ctx context.Context, setupLog logr.Logger, mgr ctrl.Manage
 		failed = true
 	}
 
+	setupLog.Info("Setting up ipaddressclaim controller")
+	if err := (reconcilers.IPAddressClaimReconciler).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IPAddressClaim")
+		failed = true
+	}
+
 	if failed {
 		if err := factory.Close(ctx); err != nil {
 			setupLog.Error(err, "Failed closing controller factory")
@@ -305,6 +314,10 @@ func setupVSphereWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
 		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.VSphereMachineConfigKind)
 		os.Exit(1)
 	}
+	if err := (&anywherev1.VSphereIPPool{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", WEBHOOK, anywherev1.VSphereIPPoolKind)
+		os.Exit(1)
+	}
 }
 
 func setupCloudstackWebhooks(setupLog logr.Logger, mgr ctrl.Manager) {
@@ -0,0 +1,28 @@
+package v1alpha1
+
+const (
+	ArgoCDConfigKind       = "ArgoCDConfig"
+	ArgoCDDefaultNamespace = "argocd"
+	ArgoCDDefaultBranch    = "main"
+)
+
+// validateArgoCDConfig validates the repository configuration of the ArgoCDConfig, which follows the
+// same rules as the FluxConfig one.
+func validateArgoCDConfig(config *ArgoCDConfig) error {
+	return validateFluxConfig(config.ConvertToFluxConfig())
+}
+
+func setArgoCDConfigDefaults(argo *ArgoCDConfig) {
+	if argo == nil {
+		return
+	}
+
+	c := &argo.Spec
+	if len(c.SystemNamespace) == 0 {
+		c.SystemNamespace = ArgoCDDefaultNamespace
+	}
+
+	if len(c.Branch) == 0 {
+		c.Branch = ArgoCDDefaultBranch
+	}
+}
@@ -0,0 +1,148 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestArgoCDConfigValidate(t *testing.T) {
+	tests := []struct {
+		testName string
+		config   *ArgoCDConfig
+		wantErr  string
+	}{
+		{
+			testName: "valid github",
+			config: &ArgoCDConfig{
+				Spec: ArgoCDConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "janedoe",
+						Repository: "argo-fleet",
+					},
+				},
+			},
+		},
+		{
+			testName: "valid gitlab",
+			config: &ArgoCDConfig{
+				Spec: ArgoCDConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Owner:      "platform/clusters",
+						Repository: "argo-fleet",
+					},
+				},
+			},
+		},
+		{
+			testName: "valid git",
+			config: &ArgoCDConfig{
+				Spec: ArgoCDConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@github.com/username/repo.git",
+					},
+				},
+			},
+		},
+		{
+			testName: "no provider",
+			config:   &ArgoCDConfig{},
+			wantErr:  "must specify a provider",
+		},
+		{
+			testName: "multiple providers",
+			config: &ArgoCDConfig{
+				Spec: ArgoCDConfigSpec{
+					Github: &GithubProviderConfig{
+						Owner:      "janedoe",
+						Repository: "argo-fleet",
+					},
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@github.com/username/repo.git",
+					},
+				},
+			},
+			wantErr: "must specify only one provider",
+		},
+		{
+			testName: "invalid branch",
+			config: &ArgoCDConfig{
+				Spec: ArgoCDConfigSpec{
+					Branch: "main..",
+					Github: &GithubProviderConfig{
+						Owner:      "janedoe",
+						Repository: "argo-fleet",
+					},
+				},
+			},
+			wantErr: "is not a valid git branch name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			g := NewWithT(t)
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestArgoCDConfigSetDefaults(t *testing.T) {
+	g := NewWithT(t)
+	config := &ArgoCDConfig{}
+	config.SetDefaults()
+	g.Expect(config.Spec.SystemNamespace).To(Equal(ArgoCDDefaultNamespace))
+	g.Expect(config.Spec.Branch).To(Equal(ArgoCDDefaultBranch))
+}
+
+func TestArgoCDConfigConvertToFluxConfig(t *testing.T) {
+	g := NewWithT(t)
+	config := &ArgoCDConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       ArgoCDConfigKind,
+			APIVersion: SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argo",
+			Namespace: "default",
+		},
+		Spec: ArgoCDConfigSpec{
+			SystemNamespace:   "argocd",
+			ClusterConfigPath: "clusters/mgmt",
+			Branch:            "main",
+			Github: &GithubProviderConfig{
+				Owner:      "janedoe",
+				Repository: "argo-fleet",
+			},
+		},
+	}
+
+	got := config.ConvertToFluxConfig()
+	g.Expect(got.Kind()).To(Equal(FluxConfigKind))
+	g.Expect(got.Name).To(Equal("argo"))
+	g.Expect(got.Spec).To(Equal(FluxConfigSpec{
+		SystemNamespace:   "argocd",
+		ClusterConfigPath: "clusters/mgmt",
+		Branch:            "main",
+		Github: &GithubProviderConfig{
+			Owner:      "janedoe",
+			Repository: "argo-fleet",
+		},
+	}))
+}
+
+func TestArgoCDConfigSpecEqual(t *testing.T) {
+	g := NewWithT(t)
+	a := &ArgoCDConfigSpec{Branch: "main", Github: &GithubProviderConfig{Owner: "janedoe", Repository: "repo"}}
+	b := a.DeepCopy()
+	g.Expect(a.Equal(b)).To(BeTrue())
+
+	b.Github.Repository = "other"
+	g.Expect(a.Equal(b)).To(BeFalse())
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/kubelet/config/v1beta1"
@@ -196,6 +198,7 @@ var clusterConfigValidations = []func(*Cluster) error{
 	validateControlPlaneAPIServerOIDCExtraArgs,
 	validateControlPlaneKubeletConfiguration,
 	validateWorkerNodeKubeletConfiguration,
+	validateMaintenanceWindows,
 }
 
 // GetClusterConfig parses a Cluster object from a multiobject yaml file in disk
@@ -362,7 +365,15 @@ func (c *Cluster) RegistryAuth() bool {
 	if c.Spec.RegistryMirrorConfiguration == nil {
 		return false
 	}
-	return c.Spec.RegistryMirrorConfiguration.Authenticate
+	if c.Spec.RegistryMirrorConfiguration.Authenticate {
+		return true
+	}
+	for _, e := range c.Spec.RegistryMirrorConfiguration.FallbackEndpoints {
+		if e.Authenticate {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *Cluster) ProxyConfiguration() map[string]string {
@@ -690,6 +701,18 @@ func validateAutoscalingConfig(w *WorkerNodeGroupConfiguration) error {
 	if w.AutoScalingConfiguration.MaxCount < *w.Count {
 		return errors.New("max count must be greater than or equal to count")
 	}
+	if threshold := w.AutoScalingConfiguration.ScaleDownUtilizationThreshold; threshold != "" {
+		value, err := strconv.ParseFloat(threshold, 64)
+		if err != nil || value < 0 || value > 1 {
+			return fmt.Errorf("scale down utilization threshold %s is invalid, must be a number between 0 and 1", threshold)
+		}
+	}
+	if w.AutoScalingConfiguration.ScaleDownUnneededTime != nil && w.AutoScalingConfiguration.ScaleDownUnneededTime.Duration <= 0 {
+		return errors.New("scale down unneeded time must be greater than 0")
+	}
+	if w.AutoScalingConfiguration.ExpanderPriority != nil && *w.AutoScalingConfiguration.ExpanderPriority < 0 {
+		return errors.New("expander priority must be non negative")
+	}
 
 	return nil
 }
@@ -737,20 +760,33 @@ func validateNetworking(clusterConfig *Cluster) error {
 	if len(clusterNetwork.Services.CidrBlocks) <= 0 {
 		return errors.New("services CIDR block not specified or empty")
 	}
-	if len(clusterNetwork.Pods.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Pods are not yet supported")
+	if len(clusterNetwork.Pods.CidrBlocks) > 2 {
+		return errors.New("at most 2 CIDR blocks, one IPv4 and one IPv6, are supported for Pods")
 	}
-	if len(clusterNetwork.Services.CidrBlocks) > 1 {
-		return fmt.Errorf("multiple CIDR blocks for Services are not yet supported")
+	if len(clusterNetwork.Services.CidrBlocks) > 2 {
+		return errors.New("at most 2 CIDR blocks, one IPv4 and one IPv6, are supported for Services")
 	}
-	_, podCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Pods.CidrBlocks[0])
+	podFamilies, err := cidrBlocksIPFamilies(clusterNetwork.Pods.CidrBlocks)
 	if err != nil {
 		return fmt.Errorf("invalid CIDR block format for Pods: %s. Please specify a valid CIDR block for pod subnet", clusterNetwork.Pods)
 	}
-	_, serviceCIDRIPNet, err := net.ParseCIDR(clusterNetwork.Services.CidrBlocks[0])
+	serviceFamilies, err := cidrBlocksIPFamilies(clusterNetwork.Services.CidrBlocks)
 	if err != nil {
 		return fmt.Errorf("invalid CIDR block for Services: %s. Please specify a valid CIDR block for service subnet", clusterNetwork.Services)
 	}
+	if len(podFamilies) == 2 && podFamilies[0] == podFamilies[1] {
+		return fmt.Errorf("dual-stack CIDR blocks for Pods must be one IPv4 and one IPv6: %v", clusterNetwork.Pods.CidrBlocks)
+	}
+	if !ipFamiliesEqual(podFamilies, serviceFamilies) {
+		return fmt.Errorf("CIDR blocks for Pods %v and Services %v must have the same IP families in the same order", clusterNetwork.Pods.CidrBlocks, clusterNetwork.Services.CidrBlocks)
+	}
+	if podFamilies[0] != IPv4Family || len(podFamilies) > 1 {
+		if !supportsIPv6Networking[clusterConfig.Spec.DatacenterRef.Kind] {
+			return fmt.Errorf("dual-stack and IPv6 cluster networking are not supported for %s", clusterConfig.Spec.DatacenterRef.Kind)
+		}
+	}
+	_, podCIDRIPNet, _ := net.ParseCIDR(clusterNetwork.Pods.CidrBlocks[0])
+	_, serviceCIDRIPNet, _ := net.ParseCIDR(clusterNetwork.Services.CidrBlocks[0])
 
 	if clusterConfig.Spec.DatacenterRef.Kind == SnowDatacenterKind {
 		controlPlaneEndpoint := net.ParseIP(clusterConfig.Spec.ControlPlaneConfiguration.Endpoint.Host)
@@ -765,12 +801,63 @@ func validateNetworking(clusterConfig *Cluster) error {
 		}
 	}
 
+	for i, cidr := range clusterNetwork.Pods.CidrBlocks {
+		if err := validateNodeCIDRMaskSize(cidr, podFamilies[i], clusterNetwork.Nodes); err != nil {
+			return err
+		}
+	}
+
+	if err := validateCiliumRoutingCIDRs(clusterNetwork, podFamilies); err != nil {
+		return err
+	}
+
+	return validateCNIPlugin(clusterNetwork)
+}
+
+// supportsIPv6Networking are the providers that support dual-stack and IPv6 only clusters.
+var supportsIPv6Networking = map[string]bool{
+	DockerDatacenterKind:  true,
+	VSphereDatacenterKind: true,
+}
+
+func cidrBlocksIPFamilies(cidrs []string) ([]IPFamily, error) {
+	families := make([]IPFamily, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		family, err := CIDRIPFamily(cidr)
+		if err != nil {
+			return nil, err
+		}
+		families = append(families, family)
+	}
+	return families, nil
+}
+
+func ipFamiliesEqual(a, b []IPFamily) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func validateNodeCIDRMaskSize(podCIDR string, family IPFamily, nodes *Nodes) error {
+	_, podCIDRIPNet, _ := net.ParseCIDR(podCIDR)
 	podMaskSize, _ := podCIDRIPNet.Mask.Size()
-	nodeCidrMaskSize := constants.DefaultNodeCidrMaskSize
 
-	if clusterNetwork.Nodes != nil && clusterNetwork.Nodes.CIDRMaskSize != nil {
-		nodeCidrMaskSize = *clusterNetwork.Nodes.CIDRMaskSize
+	nodeCidrMaskSize := constants.DefaultNodeCidrMaskSize
+	if family == IPv6Family {
+		nodeCidrMaskSize = constants.DefaultNodeCidrMaskSizeIPv6
+		if nodes != nil && nodes.CIDRMaskSizeIPv6 != nil {
+			nodeCidrMaskSize = *nodes.CIDRMaskSizeIPv6
+		}
+	} else if nodes != nil && nodes.CIDRMaskSize != nil {
+		nodeCidrMaskSize = *nodes.CIDRMaskSize
 	}
+
 	// the pod subnet mask needs to allow one or multiple node-masks
 	// i.e. if it has a /24 the node mask must be between 24 and 32 for ipv4
 	// the below validations are run by kubeadm and we are bubbling those up here for better customer experience
@@ -782,7 +869,24 @@ func validateNetworking(clusterConfig *Cluster) error {
 		return fmt.Errorf("pod subnet mask (%d) and node-mask (%d) difference is greater than %d", podMaskSize, nodeCidrMaskSize, podSubnetNodeMaskMaxDiff)
 	}
 
-	return validateCNIPlugin(clusterNetwork)
+	return nil
+}
+
+// validateCiliumRoutingCIDRs checks Cilium direct routing has a native routing CIDR for each IP family of the cluster.
+func validateCiliumRoutingCIDRs(network ClusterNetwork, families []IPFamily) error {
+	if network.CNIConfig == nil || network.CNIConfig.Cilium == nil || network.CNIConfig.Cilium.RoutingMode != CiliumRoutingModeDirect {
+		return nil
+	}
+	cilium := network.CNIConfig.Cilium
+	for _, family := range families {
+		if family == IPv4Family && cilium.IPv4NativeRoutingCIDR == "" {
+			return errors.New("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set for IPv4 and dual-stack clusters")
+		}
+		if family == IPv6Family && cilium.IPv6NativeRoutingCIDR == "" {
+			return errors.New("validating cniConfig: direct routing mode requires IPv6NativeRoutingCIDR to be set for dual-stack and IPv6 clusters")
+		}
+	}
+	return nil
 }
 
 func validateCNIPlugin(network ClusterNetwork) error {
@@ -818,6 +922,10 @@ func validateCNIConfig(cniConfig *CNIConfig) error {
 		cniPluginSpecified++
 	}
 
+	if cniConfig.Custom != nil {
+		cniPluginSpecified++
+	}
+
 	if cniPluginSpecified == 0 {
 		allErrs = append(allErrs, fmt.Errorf("no cni plugin specified"))
 	} else if cniPluginSpecified > 1 {
@@ -838,13 +946,21 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 	}
 
 	if !cilium.IsManaged() {
-		if cilium.PolicyEnforcementMode != "" {
+		if cilium.PolicyEnforcementMode != "" || cilium.HasExtraConfig() {
 			return errors.New("when using skipUpgrades for cilium all other fields must be empty")
 		}
 	}
 
-	if cilium.RoutingMode == "direct" && cilium.IPv4NativeRoutingCIDR == "" {
-		return errors.New("direct routing mode requires IPv4NativeRoutingCIDR to be set")
+	if cilium.Hubble != nil && cilium.Hubble.UI && !cilium.Hubble.Relay {
+		return errors.New("cilium hubble ui requires hubble relay to be enabled")
+	}
+
+	if err := validateCiliumHelmValues(cilium.HelmValues); err != nil {
+		return err
+	}
+
+	if cilium.RoutingMode == "direct" && cilium.IPv4NativeRoutingCIDR == "" && cilium.IPv6NativeRoutingCIDR == "" {
+		return errors.New("direct routing mode requires IPv4NativeRoutingCIDR or IPv6NativeRoutingCIDR to be set")
 	}
 
 	if cilium.PolicyEnforcementMode == "" {
@@ -858,6 +974,94 @@ func validateCiliumConfig(cilium *CiliumConfig) error {
 	return nil
 }
 
+// ciliumManagedHelmValues are the Cilium Helm chart values set by EKS Anywhere. They can't be
+// overridden, nor can any value nested under them, with the extra Helm values.
+var ciliumManagedHelmValues = [][]string{
+	{"agent"},
+	{"autoDirectNodeRoutes"},
+	{"bgpControlPlane", "enabled"},
+	{"cni", "chainingMode"},
+	{"egressMasqueradeInterfaces"},
+	{"hubble", "enabled"},
+	{"hubble", "relay", "enabled"},
+	{"hubble", "relay", "image"},
+	{"hubble", "ui", "backend", "image"},
+	{"hubble", "ui", "enabled"},
+	{"hubble", "ui", "frontend", "image"},
+	{"identityAllocationMode"},
+	{"image", "repository"},
+	{"image", "tag"},
+	{"ipam", "mode"},
+	{"ipv4", "enabled"},
+	{"ipv4NativeRoutingCIDR"},
+	{"ipv6", "enabled"},
+	{"ipv6NativeRoutingCIDR"},
+	{"k8sServiceHost"},
+	{"k8sServicePort"},
+	{"kubeProxyReplacement"},
+	{"operator", "enabled"},
+	{"operator", "image", "repository"},
+	{"operator", "image", "tag"},
+	{"operator", "prometheus", "enabled"},
+	{"operator", "replicas"},
+	{"podAnnotations", CiliumExtraConfigHashAnnotation},
+	{"policyEnforcementMode"},
+	{"preflight"},
+	{"prometheus", "enabled"},
+	{"rollOutCiliumPods"},
+	{"routingMode"},
+	{"tunnelProtocol"},
+	{"underlayProtocol"},
+	{"upgradeCompatibility"},
+}
+
+func validateCiliumHelmValues(helmValues map[string]string) error {
+	keys := make([]string, 0, len(helmValues))
+	for k := range helmValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	paths := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		path := strings.Split(k, ".")
+		for _, p := range path {
+			if p == "" {
+				return fmt.Errorf("cilium helm value key \"%s\" is invalid, it must be a dot separated path", k)
+			}
+		}
+
+		for _, managed := range ciliumManagedHelmValues {
+			if pathsOverlap(path, managed) {
+				return fmt.Errorf("cilium helm value \"%s\" can't be set, it overlaps with \"%s\" which is managed by EKS Anywhere", k, strings.Join(managed, "."))
+			}
+		}
+
+		for i, other := range paths {
+			if pathsOverlap(path, other) {
+				return fmt.Errorf("cilium helm values \"%s\" and \"%s\" overlap", keys[i], k)
+			}
+		}
+
+		paths = append(paths, path)
+	}
+
+	return nil
+}
+
+// pathsOverlap returns true if one of the paths is equal to or a prefix of the other.
+func pathsOverlap(a, b []string) bool {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func validateProxyConfig(clusterConfig *Cluster) error {
 	if clusterConfig.Spec.ProxyConfiguration == nil {
 		return nil
@@ -914,6 +1118,23 @@ func validateMirrorConfig(clusterConfig *Cluster) error {
 		return fmt.Errorf("registry mirror port %s is invalid, please provide a valid port", clusterConfig.Spec.RegistryMirrorConfiguration.Port)
 	}
 
+	endpoints := map[string]struct{}{
+		net.JoinHostPort(clusterConfig.Spec.RegistryMirrorConfiguration.Endpoint, clusterConfig.Spec.RegistryMirrorConfiguration.Port): {},
+	}
+	for i, fallback := range clusterConfig.Spec.RegistryMirrorConfiguration.FallbackEndpoints {
+		if fallback.Endpoint == "" {
+			return fmt.Errorf("no value set for RegistryMirrorConfiguration.FallbackEndpoints[%d].Endpoint", i)
+		}
+		if !networkutils.IsPortValid(fallback.Port) {
+			return fmt.Errorf("registry mirror fallback endpoint port %s is invalid, please provide a valid port", fallback.Port)
+		}
+		base := net.JoinHostPort(fallback.Endpoint, fallback.Port)
+		if _, ok := endpoints[base]; ok {
+			return fmt.Errorf("registry mirror endpoint %s is duplicated", base)
+		}
+		endpoints[base] = struct{}{}
+	}
+
 	mirrorCount := 0
 	ociNamespaces := clusterConfig.Spec.RegistryMirrorConfiguration.OCINamespaces
 	for _, ociNamespace := range ociNamespaces {
@@ -956,8 +1177,8 @@ func validateGitOps(clusterConfig *Cluster) error {
 
 	gitOpsRefKind := gitOpsRef.Kind
 
-	if gitOpsRefKind != GitOpsConfigKind && gitOpsRefKind != FluxConfigKind {
-		return errors.New("only GitOpsConfig or FluxConfig Kind are supported at this time")
+	if gitOpsRefKind != GitOpsConfigKind && gitOpsRefKind != FluxConfigKind && gitOpsRefKind != ArgoCDConfigKind {
+		return errors.New("only GitOpsConfig, FluxConfig or ArgoCDConfig Kind are supported at this time")
 	}
 
 	if gitOpsRef.Name == "" {
@@ -1020,10 +1241,14 @@ func validateMDUpgradeRolloutStrategy(w *WorkerNodeGroupConfiguration, datacente
 		return nil
 	}
 
+	if w.UpgradeRolloutStrategy.Canary != nil && w.UpgradeRolloutStrategy.Type != CanaryStrategyType {
+		return fmt.Errorf("WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary field must be empty for upgradeRolloutStrategy.type %s", w.UpgradeRolloutStrategy.Type)
+	}
+
 	switch w.UpgradeRolloutStrategy.Type {
-	case RollingUpdateStrategyType:
+	case RollingUpdateStrategyType, CanaryStrategyType:
 		if w.UpgradeRolloutStrategy.RollingUpdate == nil {
-			return fmt.Errorf("WorkerNodeGroupConfiguration: upgradeRolloutStrategy.rollingUpdate field is required for upgradeRolloutStrategy.type RollingUpdate")
+			return fmt.Errorf("WorkerNodeGroupConfiguration: upgradeRolloutStrategy.rollingUpdate field is required for upgradeRolloutStrategy.type %s", w.UpgradeRolloutStrategy.Type)
 		}
 		if w.UpgradeRolloutStrategy.RollingUpdate.MaxSurge < 0 || w.UpgradeRolloutStrategy.RollingUpdate.MaxUnavailable < 0 {
 			return fmt.Errorf("WorkerNodeGroupConfiguration: maxSurge and maxUnavailable values cannot be negative")
@@ -1033,6 +1258,12 @@ func validateMDUpgradeRolloutStrategy(w *WorkerNodeGroupConfiguration, datacente
 			return fmt.Errorf("WorkerNodeGroupConfiguration: maxSurge and maxUnavailable not specified or are 0. maxSurge and maxUnavailable cannot both be 0")
 		}
 
+		if w.UpgradeRolloutStrategy.Type == CanaryStrategyType {
+			if err := validateCanaryParams(w.UpgradeRolloutStrategy.Canary); err != nil {
+				return fmt.Errorf("WorkerNodeGroupConfiguration: %v", err)
+			}
+		}
+
 	case InPlaceStrategyType:
 		if w.UpgradeRolloutStrategy.RollingUpdate != nil {
 			return fmt.Errorf("WorkerNodeGroupConfiguration: RollingUpdate field must be empty for 'InPlace' upgrade rollout strategy type")
@@ -1047,7 +1278,41 @@ func validateMDUpgradeRolloutStrategy(w *WorkerNodeGroupConfiguration, datacente
 			return fmt.Errorf("WorkerNodeGroupConfiguration: 'InPlace' upgrade rollout strategy type is only supported on Bare Metal")
 		}
 	default:
-		return fmt.Errorf("WorkerNodeGroupConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type")
+		return fmt.Errorf("WorkerNodeGroupConfiguration: only 'RollingUpdate', 'InPlace' and 'Canary' are supported for upgrade rollout strategy type")
+	}
+
+	return nil
+}
+
+func validateCanaryParams(canary *WorkerNodesCanaryParams) error {
+	if canary == nil {
+		return nil
+	}
+
+	if canary.Nodes != nil {
+		if canary.Nodes.Type == intstr.Int && canary.Nodes.IntVal < 1 {
+			return fmt.Errorf("upgradeRolloutStrategy.canary.nodes must be at least 1")
+		}
+		if canary.Nodes.Type == intstr.String {
+			percentage, err := strconv.Atoi(strings.TrimSuffix(canary.Nodes.StrVal, "%"))
+			if err != nil || !strings.HasSuffix(canary.Nodes.StrVal, "%") || percentage < 1 || percentage > 100 {
+				return fmt.Errorf("upgradeRolloutStrategy.canary.nodes %s must be a number or a percentage between 1%% and 100%%", canary.Nodes.StrVal)
+			}
+		}
+	}
+
+	if canary.SoakTime != nil && canary.SoakTime.Duration < 0 {
+		return fmt.Errorf("upgradeRolloutStrategy.canary.soakTime cannot be negative")
+	}
+
+	if canary.Timeout != nil && canary.Timeout.Duration <= 0 {
+		return fmt.Errorf("upgradeRolloutStrategy.canary.timeout must be positive")
+	}
+
+	for _, check := range canary.PodsReady {
+		if check.Namespace == "" {
+			return fmt.Errorf("upgradeRolloutStrategy.canary.podsReady namespace is required")
+		}
 	}
 
 	return nil
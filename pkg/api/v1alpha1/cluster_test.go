@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/features"
@@ -3038,6 +3040,313 @@ func TestValidateNetworking(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "dual-stack",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 only",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: DockerDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "too many pods CIDR blocks",
+			wantErr: fmt.Errorf("at most 2 CIDR blocks, one IPv4 and one IPv6, are supported for Pods"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/56",
+								"10.2.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "dual-stack pods CIDR blocks with the same family",
+			wantErr: fmt.Errorf("dual-stack CIDR blocks for Pods must be one IPv4 and one IPv6: [10.1.0.0/16 10.2.0.0/16]"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"10.2.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"10.97.0.0/16",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "pods and services CIDR blocks families don't match",
+			wantErr: fmt.Errorf("CIDR blocks for Pods [fd00:10:1::/56 10.1.0.0/16] and Services [10.96.0.0/12 fd00:10:96::/112] must have the same IP families in the same order"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:10:1::/56",
+								"10.1.0.0/16",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 not supported by provider",
+			wantErr: fmt.Errorf("dual-stack and IPv6 cluster networking are not supported for TinkerbellDatacenterConfig"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: TinkerbellDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 node cidr mask size invalid",
+			wantErr: fmt.Errorf("the size of pod subnet with mask 120 is smaller than or equal to the size of node subnet with mask 64"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/120",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 node cidr mask size invalid diff",
+			wantErr: fmt.Errorf("pod subnet mask (24) and node-mask (64) difference is greater than 16"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:10:1::/24",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "ipv6 node cidr mask size from nodes config",
+			wantErr: fmt.Errorf("the size of pod subnet with mask 56 is smaller than or equal to the size of node subnet with mask 28"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: DockerDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"fd00:10:96::/112",
+							},
+						},
+						Nodes: &Nodes{
+							CIDRMaskSizeIPv6: nodeCidrMaskSize,
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{}},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium direct routing without ipv6 native routing cidr",
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv6NativeRoutingCIDR to be set for dual-stack and IPv6 clusters"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv4NativeRoutingCIDR: "10.1.0.0/16"}},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium direct routing without ipv4 native routing cidr",
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set for IPv4 and dual-stack clusters"),
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv6NativeRoutingCIDR: "fd00:10:1::/56"}},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium direct routing dual-stack",
+			wantErr: nil,
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					DatacenterRef: Ref{
+						Kind: VSphereDatacenterKind,
+					},
+					ClusterNetwork: ClusterNetwork{
+						Pods: Pods{
+							CidrBlocks: []string{
+								"10.1.0.0/16",
+								"fd00:10:1::/56",
+							},
+						},
+						Services: Services{
+							CidrBlocks: []string{
+								"10.96.0.0/12",
+								"fd00:10:96::/112",
+							},
+						},
+						CNIConfig: &CNIConfig{Cilium: &CiliumConfig{RoutingMode: CiliumRoutingModeDirect, IPv4NativeRoutingCIDR: "10.1.0.0/16", IPv6NativeRoutingCIDR: "fd00:10:1::/56"}},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3074,7 +3383,7 @@ func TestValidateCNIConfig(t *testing.T) {
 		},
 		{
 			name:    "directmode needs native routing CIDR",
-			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR to be set"),
+			wantErr: fmt.Errorf("validating cniConfig: direct routing mode requires IPv4NativeRoutingCIDR or IPv6NativeRoutingCIDR to be set"),
 			clusterNetwork: &ClusterNetwork{
 				CNIConfig: &CNIConfig{
 					Cilium: &CiliumConfig{
@@ -3163,6 +3472,110 @@ func TestValidateCNIConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "cilium extra config",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble:               &CiliumHubbleConfig{Relay: true, UI: true},
+						BGPControlPlane:      &CiliumBGPControlPlaneConfig{},
+						KubeProxyReplacement: true,
+						HelmValues: map[string]string{
+							"image.pullPolicy":                 "Always",
+							"hubble.metrics.enableOpenMetrics": "true",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium hubble ui without relay",
+			wantErr: fmt.Errorf("validating cniConfig: cilium hubble ui requires hubble relay to be enabled"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						Hubble: &CiliumHubbleConfig{UI: true},
+					},
+				},
+			},
+		},
+		{
+			name: "CiliumSkipUpgradeWithExtraConfig",
+			wantErr: fmt.Errorf("validating cniConfig: when using skipUpgrades for cilium all " +
+				"other fields must be empty"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						SkipUpgrade: ptr.Bool(true),
+						HelmValues:  map[string]string{"envoy.enabled": "true"},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium helm value managed by EKS Anywhere",
+			wantErr: fmt.Errorf("validating cniConfig: cilium helm value \"image\" can't be set, it overlaps with \"image.repository\" which is managed by EKS Anywhere"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						HelmValues: map[string]string{"image": "my-cilium"},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium helm value nested under value managed by EKS Anywhere",
+			wantErr: fmt.Errorf("validating cniConfig: cilium helm value \"routingMode.foo\" can't be set, it overlaps with \"routingMode\" which is managed by EKS Anywhere"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						HelmValues: map[string]string{"routingMode.foo": "bar"},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium helm values overlap",
+			wantErr: fmt.Errorf("validating cniConfig: cilium helm values \"bpf\" and \"bpf.masquerade\" overlap"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						HelmValues: map[string]string{"bpf.masquerade": "true", "bpf": "x"},
+					},
+				},
+			},
+		},
+		{
+			name:    "cilium helm value invalid key",
+			wantErr: fmt.Errorf("validating cniConfig: cilium helm value key \"bpf..masquerade\" is invalid, it must be a dot separated path"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{
+						HelmValues: map[string]string{"bpf..masquerade": "true"},
+					},
+				},
+			},
+		},
+		{
+			name:    "custom CNI",
+			wantErr: nil,
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Custom: &CustomCNIConfig{},
+				},
+			},
+		},
+		{
+			name:    "custom CNI with cilium",
+			wantErr: fmt.Errorf("validating cniConfig: cannot specify more than one cni plugins"),
+			clusterNetwork: &ClusterNetwork{
+				CNIConfig: &CNIConfig{
+					Cilium: &CiliumConfig{},
+					Custom: &CustomCNIConfig{},
+				},
+			},
+		},
 		{
 			name: "CiliumSkipUpgradeExplicitFalseWithOtherFields",
 			clusterNetwork: &ClusterNetwork{
@@ -3295,6 +3708,83 @@ func TestValidateMirrorConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "fallback endpoint not specified",
+			wantErr: "no value set for RegistryMirrorConfiguration.FallbackEndpoints[0].Endpoint",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FallbackEndpoints: []RegistryMirrorEndpoint{
+							{
+								Port: "443",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid fallback endpoint port",
+			wantErr: "registry mirror fallback endpoint port 65536 is invalid",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FallbackEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint: "1.2.3.5",
+								Port:     "65536",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "duplicated fallback endpoint",
+			wantErr: "registry mirror endpoint 1.2.3.4:443 is duplicated",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FallbackEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint: "1.2.3.4",
+								Port:     "443",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "valid fallback endpoints",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FallbackEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint:      "1.2.3.5",
+								Port:          "443",
+								CACertContent: "ca",
+								Authenticate:  true,
+							},
+							{
+								Endpoint: "1.2.3.6",
+								Port:     "5000",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3374,6 +3864,56 @@ func TestValidateAutoscalingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "scale from zero with autoscaler options",
+			wantErr: "",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(0),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount:                      0,
+					MaxCount:                      3,
+					ScaleDownUtilizationThreshold: "0.4",
+					ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+					ExpanderPriority:              ptr.Int(10),
+				},
+			},
+		},
+		{
+			name:    "invalid scale down utilization threshold",
+			wantErr: "scale down utilization threshold 1.5 is invalid, must be a number between 0 and 1",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount:                      1,
+					MaxCount:                      3,
+					ScaleDownUtilizationThreshold: "1.5",
+				},
+			},
+		},
+		{
+			name:    "invalid scale down unneeded time",
+			wantErr: "scale down unneeded time must be greater than 0",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount:              1,
+					MaxCount:              3,
+					ScaleDownUnneededTime: &metav1.Duration{},
+				},
+			},
+		},
+		{
+			name:    "negative expander priority",
+			wantErr: "expander priority must be non negative",
+			workerNodeGroupConfiguration: &WorkerNodeGroupConfiguration{
+				Count: ptr.Int(1),
+				AutoScalingConfiguration: &AutoScalingConfiguration{
+					MinCount:         1,
+					MaxCount:         3,
+					ExpanderPriority: ptr.Int(-1),
+				},
+			},
+		},
 		{
 			name:    "count < 0 with nil autoscaling",
 			wantErr: "worker node count must be zero or greater if autoscaling is not enabled",
@@ -3433,6 +3973,25 @@ func TestClusterRegistryAuth(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "with fallback endpoint auth",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					RegistryMirrorConfiguration: &RegistryMirrorConfiguration{
+						Endpoint: "1.2.3.4",
+						Port:     "443",
+						FallbackEndpoints: []RegistryMirrorEndpoint{
+							{
+								Endpoint:     "1.2.3.5",
+								Port:         "443",
+								Authenticate: true,
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
 		{
 			name:    "without registry mirror",
 			cluster: &Cluster{},
@@ -3770,7 +4329,7 @@ func TestValidateMDUpgradeRolloutStrategy(t *testing.T) {
 	}{
 		{
 			name:    "rolling upgrade strategy invalid",
-			wantErr: "WorkerNodeGroupConfiguration: only 'RollingUpdate' and 'InPlace' are supported for upgrade rollout strategy type",
+			wantErr: "WorkerNodeGroupConfiguration: only 'RollingUpdate', 'InPlace' and 'Canary' are supported for upgrade rollout strategy type",
 			cluster: &Cluster{
 				Spec: ClusterSpec{
 					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
@@ -3878,6 +4437,127 @@ func TestValidateMDUpgradeRolloutStrategy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "canary upgrade valid",
+			wantErr: "",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1, MaxUnavailable: 0},
+							Canary: &WorkerNodesCanaryParams{
+								Nodes:     &intstr.IntOrString{Type: intstr.String, StrVal: "10%"},
+								SoakTime:  &metav1.Duration{Duration: time.Minute},
+								Timeout:   &metav1.Duration{Duration: 10 * time.Minute},
+								PodsReady: []CanaryPodsReadyCheck{{Namespace: "monitoring", Selector: map[string]string{"app": "agent"}}},
+							},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary upgrade knobs not specified",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.rollingUpdate field is required for upgradeRolloutStrategy.type Canary",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{Type: "Canary"},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary params with rolling update type",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary field must be empty for upgradeRolloutStrategy.type RollingUpdate",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "RollingUpdate",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary nodes zero",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary.nodes must be at least 1",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{Nodes: &intstr.IntOrString{Type: intstr.Int, IntVal: 0}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary nodes invalid percentage",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary.nodes 150% must be a number or a percentage between 1% and 100%",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{Nodes: &intstr.IntOrString{Type: intstr.String, StrVal: "150%"}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary negative soak time",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary.soakTime cannot be negative",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{SoakTime: &metav1.Duration{Duration: -time.Minute}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary zero timeout",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary.timeout must be positive",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{Timeout: &metav1.Duration{}},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "canary pods ready check without namespace",
+			wantErr: "WorkerNodeGroupConfiguration: upgradeRolloutStrategy.canary.podsReady namespace is required",
+			cluster: &Cluster{
+				Spec: ClusterSpec{
+					WorkerNodeGroupConfigurations: []WorkerNodeGroupConfiguration{{
+						UpgradeRolloutStrategy: &WorkerNodesUpgradeRolloutStrategy{
+							Type:          "Canary",
+							RollingUpdate: &WorkerNodesRollingUpdateParams{MaxSurge: 1},
+							Canary:        &WorkerNodesCanaryParams{PodsReady: []CanaryPodsReadyCheck{{}}},
+						},
+					}},
+				},
+			},
+		},
 		{
 			name:    "in place upgrade - tinkerbell",
 			wantErr: "",
@@ -65,6 +65,10 @@ const (
 	// AllowDeleteWhenPausedAnnotation is an annotation applied to an EKS-A cluster that allows the deletion of the cluster
 	// when paused.
 	AllowDeleteWhenPausedAnnotation = "anywhere.eks.amazonaws.com/allow-delete-when-paused"
+
+	// CiliumExtraConfigHashAnnotation is the Cilium agent pod annotation holding a hash of the Cilium
+	// extra config, used to detect changes to it in an existing installation.
+	CiliumExtraConfigHashAnnotation = "anywhere.eks.amazonaws.com/cilium-extra-config-hash"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -92,6 +96,11 @@ type ClusterSpec struct {
 	MachineHealthCheck *MachineHealthCheck `json:"machineHealthCheck,omitempty"`
 	EtcdEncryption     *[]EtcdEncryption   `json:"etcdEncryption,omitempty"`
 	LicenseToken       string              `json:"licenseToken,omitempty"`
+	// MaintenanceWindows restrict when the controller rolls out changes that replace or upgrade nodes,
+	// like Kubernetes version, machine config or EKS Anywhere version changes. Outside the windows,
+	// those changes are deferred until the next window opens. If empty, workload clusters use the
+	// windows of their management cluster and changes are otherwise rolled out immediately.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
 }
 
 // EksaVersion is the semver identifying the release of eks-a used to populate the cluster components.
@@ -193,6 +202,9 @@ func (n *Cluster) Equal(o *Cluster) bool {
 	if n.Spec.LicenseToken != o.Spec.LicenseToken {
 		return false
 	}
+	if !reflect.DeepEqual(n.Spec.MaintenanceWindows, o.Spec.MaintenanceWindows) {
+		return false
+	}
 
 	return true
 }
@@ -246,6 +258,29 @@ type RegistryMirrorConfiguration struct {
 	// InsecureSkipVerify skips the registry certificate verification.
 	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
 	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+
+	// FallbackEndpoints defines an ordered list of registry mirror endpoints to pull images from
+	// when the endpoint above is not reachable. They must host the same OCINamespaces.
+	FallbackEndpoints []RegistryMirrorEndpoint `json:"fallbackEndpoints,omitempty"`
+}
+
+// RegistryMirrorEndpoint defines a fallback registry mirror endpoint.
+type RegistryMirrorEndpoint struct {
+	// Endpoint defines the registry mirror endpoint to use for pulling images
+	Endpoint string `json:"endpoint"`
+
+	// Port defines the port exposed for registry mirror endpoint
+	Port string `json:"port,omitempty"`
+
+	// CACertContent defines the contents registry mirror CA certificate
+	CACertContent string `json:"caCertContent,omitempty"`
+
+	// Authenticate defines if registry requires authentication
+	Authenticate bool `json:"authenticate,omitempty"`
+
+	// InsecureSkipVerify skips the registry certificate verification.
+	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // OCINamespace represents an entity in a local reigstry to group related images.
@@ -265,7 +300,21 @@ func (n *RegistryMirrorConfiguration) Equal(o *RegistryMirrorConfiguration) bool
 	}
 	return n.Endpoint == o.Endpoint && n.Port == o.Port && n.CACertContent == o.CACertContent &&
 		n.InsecureSkipVerify == o.InsecureSkipVerify && n.Authenticate == o.Authenticate &&
-		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces)
+		OCINamespacesSliceEqual(n.OCINamespaces, o.OCINamespaces) &&
+		RegistryMirrorEndpointsSliceEqual(n.FallbackEndpoints, o.FallbackEndpoints)
+}
+
+// RegistryMirrorEndpointsSliceEqual checks if two lists of registry mirror endpoints are the same, in the same order.
+func RegistryMirrorEndpointsSliceEqual(a, b []RegistryMirrorEndpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // OCINamespacesSliceEqual is used to check equality of the OCINamespaces fields of two RegistryMirrorConfiguration.
@@ -553,6 +602,8 @@ func WorkerNodeGroupConfigurationKubeVersionUnchanged(o, n *WorkerNodeGroupConfi
 type ClusterNetwork struct {
 	// Comma-separated list of CIDR blocks to use for pod and service subnets.
 	// Defaults to 192.168.0.0/16 for pod subnet.
+	// Dual-stack clusters use one IPv4 and one IPv6 CIDR block, the first one determines
+	// the primary IP family. IPv6 only clusters use a single IPv6 CIDR block.
 	Pods     Pods     `json:"pods,omitempty"`
 	Services Services `json:"services,omitempty"`
 	// Deprecated. Use CNIConfig
@@ -630,6 +681,9 @@ func (n *CNIConfig) Equal(o *CNIConfig) bool {
 	if !n.Kindnetd.Equal(o.Kindnetd) {
 		return false
 	}
+	if !n.Custom.Equal(o.Custom) {
+		return false
+	}
 	return true
 }
 
@@ -653,6 +707,14 @@ func (n *CiliumConfig) Equal(o *CiliumConfig) bool {
 		return false
 	}
 
+	if !n.Hubble.Equal(o.Hubble) || !n.BGPControlPlane.Equal(o.BGPControlPlane) {
+		return false
+	}
+
+	if n.KubeProxyReplacement != o.KubeProxyReplacement || !MapEqual(n.HelmValues, o.HelmValues) {
+		return false
+	}
+
 	oSkipUpgradeIsFalse := o.SkipUpgrade == nil || !*o.SkipUpgrade
 	nSkipUpgradeIsFalse := n.SkipUpgrade == nil || !*n.SkipUpgrade
 
@@ -676,6 +738,17 @@ func (n *KindnetdConfig) Equal(o *KindnetdConfig) bool {
 	return true
 }
 
+// Equal compares two CustomCNIConfigs and returns true if they are both set or unset.
+func (n *CustomCNIConfig) Equal(o *CustomCNIConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return true
+}
+
 func UsersSliceEqual(a, b []UserConfiguration) bool {
 	if len(a) != len(b) {
 		return false
@@ -726,6 +799,9 @@ func CNIPluginSame(n ClusterNetwork, o ClusterNetwork) bool {
 			if (n.CNIConfig.Kindnetd != nil && o.CNIConfig.Kindnetd == nil) || (n.CNIConfig.Kindnetd == nil && o.CNIConfig.Kindnetd != nil) {
 				return false
 			}
+			if (n.CNIConfig.Custom != nil && o.CNIConfig.Custom == nil) || (n.CNIConfig.Custom == nil && o.CNIConfig.Custom != nil) {
+				return false
+			}
 		}
 	}
 
@@ -795,6 +871,9 @@ type ResolvConf struct {
 type Nodes struct {
 	// CIDRMaskSize defines the mask size for node cidr in the cluster, default for ipv4 is 24. This is an optional field
 	CIDRMaskSize *int `json:"cidrMaskSize,omitempty"`
+	// CIDRMaskSizeIPv6 defines the mask size for IPv6 node cidr in dual-stack and IPv6 clusters, default is 64.
+	// This is an optional field
+	CIDRMaskSizeIPv6 *int `json:"cidrMaskSizeIPv6,omitempty"`
 }
 
 // Equal compares two Nodes definitions and return true if the are equivalent.
@@ -806,14 +885,7 @@ func (n *Nodes) Equal(o *Nodes) bool {
 		return false
 	}
 
-	if n.CIDRMaskSize == o.CIDRMaskSize {
-		return true
-	}
-	if n.CIDRMaskSize == nil || o.CIDRMaskSize == nil {
-		return false
-	}
-
-	return *n.CIDRMaskSize == *o.CIDRMaskSize
+	return intPtrEqual(n.CIDRMaskSize, o.CIDRMaskSize) && intPtrEqual(n.CIDRMaskSizeIPv6, o.CIDRMaskSizeIPv6)
 }
 
 func (n *ResolvConf) Equal(o *ResolvConf) bool {
@@ -862,6 +934,10 @@ type CiliumRoutingMode string
 type CNIConfig struct {
 	Cilium   *CiliumConfig   `json:"cilium,omitempty"`
 	Kindnetd *KindnetdConfig `json:"kindnetd,omitempty"`
+	// Custom indicates the CNI is installed and managed by the user. EKS Anywhere doesn't
+	// install nor upgrade it and only waits for the nodes to be ready.
+	// +optional
+	Custom *CustomCNIConfig `json:"custom,omitempty"`
 }
 
 // IsManaged indicates if EKS-A is responsible for the CNI installation.
@@ -904,6 +980,70 @@ type CiliumConfig struct {
 	// If this is not set autoDirectNodeRoutes will be set to true
 	// +optional
 	IPv6NativeRoutingCIDR string `json:"ipv6NativeRoutingCIDR,omitempty"`
+
+	// Hubble enables Hubble, the Cilium observability layer, and optionally its relay and UI.
+	// +optional
+	Hubble *CiliumHubbleConfig `json:"hubble,omitempty"`
+
+	// BGPControlPlane enables the Cilium BGP control plane. BGP peers are configured with
+	// CiliumBGPPeeringPolicy objects.
+	// +optional
+	BGPControlPlane *CiliumBGPControlPlaneConfig `json:"bgpControlPlane,omitempty"`
+
+	// KubeProxyReplacement enables the Cilium eBPF replacement for kube-proxy. kube-proxy is
+	// removed from the cluster once Cilium is ready and this can't be disabled afterwards.
+	// +optional
+	KubeProxyReplacement bool `json:"kubeProxyReplacement,omitempty"`
+
+	// HelmValues are extra values for the Cilium Helm chart. Keys are dot separated paths,
+	// as used with helm --set, and values are parsed as booleans or integers when possible.
+	// Values wrapped in single or double quotes are kept as strings, without the quotes.
+	// They are merged on top of the values generated by EKS Anywhere, in lexical key order,
+	// and can't override any of them.
+	// +optional
+	HelmValues map[string]string `json:"helmValues,omitempty"`
+}
+
+// CiliumHubbleConfig contains configuration for Hubble.
+type CiliumHubbleConfig struct {
+	// Relay enables Hubble Relay, which aggregates the flows from all the nodes.
+	// +optional
+	Relay bool `json:"relay,omitempty"`
+
+	// UI enables the Hubble UI. It requires Relay to be enabled.
+	// +optional
+	UI bool `json:"ui,omitempty"`
+}
+
+// Equal compares two CiliumHubbleConfigs.
+func (n *CiliumHubbleConfig) Equal(o *CiliumHubbleConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return n.Relay == o.Relay && n.UI == o.UI
+}
+
+// CiliumBGPControlPlaneConfig contains configuration for the Cilium BGP control plane.
+type CiliumBGPControlPlaneConfig struct{}
+
+// Equal compares two CiliumBGPControlPlaneConfigs and returns true if they are both set or unset.
+func (n *CiliumBGPControlPlaneConfig) Equal(o *CiliumBGPControlPlaneConfig) bool {
+	if n == o {
+		return true
+	}
+	if n == nil || o == nil {
+		return false
+	}
+	return true
+}
+
+// HasExtraConfig returns true if any of the Cilium features not configured by default,
+// including extra Helm values, is set.
+func (n *CiliumConfig) HasExtraConfig() bool {
+	return n.Hubble != nil || n.BGPControlPlane != nil || n.KubeProxyReplacement || len(n.HelmValues) > 0
 }
 
 // IsManaged returns true if SkipUpgrade is nil or false indicating EKS-A is responsible for
@@ -915,6 +1055,9 @@ func (n *CiliumConfig) IsManaged() bool {
 // KindnetdConfig contains configuration specific to the Kindnetd CNI.
 type KindnetdConfig struct{}
 
+// CustomCNIConfig contains configuration specific to a CNI installed and managed by the user.
+type CustomCNIConfig struct{}
+
 const (
 	// Cilium is the EKS-A Cilium.
 	Cilium CNI = "cilium"
@@ -1030,6 +1173,22 @@ type ClusterStatus struct {
 
 	// ObservedGeneration is the latest generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// AppliedRolloutHashes are the hashes, per node group, of the configuration that determines
+	// the machines of the group, observed the last time the cluster was successfully reconciled.
+	// They are used to detect changes that replace or upgrade nodes.
+	// NOTE: This field was added for internal use and we do not provide guarantees
+	// to its behavior if changed externally. Its meaning and implementation are
+	// subject to change in the future.
+	AppliedRolloutHashes map[string]string `json:"appliedRolloutHashes,omitempty"`
+
+	// ReencryptedEtcdEncryptionHash is the hash of the etcd encryption configuration all the
+	// encrypted resources were last rewritten with, after the control plane rolled out with it.
+	// It is used to detect when resources need to be re-encrypted, for example after a key rotation.
+	// NOTE: This field was added for internal use and we do not provide guarantees
+	// to its behavior if changed externally. Its meaning and implementation are
+	// subject to change in the future.
+	ReencryptedEtcdEncryptionHash string `json:"reencryptedEtcdEncryptionHash,omitempty"`
 }
 
 type EksdReleaseRef struct {
@@ -1265,6 +1424,20 @@ type AutoScalingConfiguration struct {
 	// MaxCount defines the maximum number of nodes for the associated resource group.
 	// +optional
 	MaxCount int `json:"maxCount,omitempty"`
+
+	// ScaleDownUtilizationThreshold is the node utilization level, defined as the sum of requested resources
+	// divided by capacity, below which a node of the group can be considered for scale down. For example "0.5".
+	// +optional
+	ScaleDownUtilizationThreshold string `json:"scaleDownUtilizationThreshold,omitempty"`
+
+	// ScaleDownUnneededTime is how long a node of the group should be unneeded before it is eligible for scale down.
+	// +optional
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+
+	// ExpanderPriority is the priority of the group when the cluster autoscaler uses the priority expander.
+	// Groups with a higher priority are preferred when scaling up.
+	// +optional
+	ExpanderPriority *int `json:"expanderPriority,omitempty"`
 }
 
 // Equal compares two AutoScalingConfigurations.
@@ -1277,7 +1450,10 @@ func (a *AutoScalingConfiguration) Equal(other *AutoScalingConfiguration) bool {
 		return false
 	}
 
-	return a.MaxCount == other.MaxCount && a.MinCount == other.MinCount
+	return a.MaxCount == other.MaxCount && a.MinCount == other.MinCount &&
+		a.ScaleDownUtilizationThreshold == other.ScaleDownUtilizationThreshold &&
+		reflect.DeepEqual(a.ScaleDownUnneededTime, other.ScaleDownUnneededTime) &&
+		intPtrEqual(a.ExpanderPriority, other.ExpanderPriority)
 }
 
 // UpgradeRolloutStrategyType defines the types of upgrade rollout strategies.
@@ -1289,6 +1465,10 @@ const (
 
 	// InPlaceStrategyType upgrades the machines in-place without rolling out any new nodes.
 	InPlaceStrategyType UpgradeRolloutStrategyType = "InPlace"
+
+	// CanaryStrategyType replaces a few machines of a worker node group first and only rolls out
+	// the rest, using rolling update, if the new nodes pass the health checks.
+	CanaryStrategyType UpgradeRolloutStrategyType = "Canary"
 )
 
 // ControlPlaneUpgradeRolloutStrategy indicates rollout strategy for cluster.
@@ -1306,6 +1486,8 @@ type ControlPlaneRollingUpdateParams struct {
 type WorkerNodesUpgradeRolloutStrategy struct {
 	Type          UpgradeRolloutStrategyType      `json:"type,omitempty"`
 	RollingUpdate *WorkerNodesRollingUpdateParams `json:"rollingUpdate,omitempty"`
+	// Canary configures the canary nodes and health checks for the Canary upgrade rollout strategy type.
+	Canary *WorkerNodesCanaryParams `json:"canary,omitempty"`
 }
 
 // Equal compares two WorkerNodesUpgradeRolloutStrategies.
@@ -1322,6 +1504,10 @@ func (w *WorkerNodesUpgradeRolloutStrategy) Equal(other *WorkerNodesUpgradeRollo
 		return false
 	}
 
+	if !reflect.DeepEqual(w.Canary, other.Canary) {
+		return false
+	}
+
 	if w.RollingUpdate == other.RollingUpdate {
 		return true
 	}
@@ -1339,6 +1525,53 @@ type WorkerNodesRollingUpdateParams struct {
 	MaxUnavailable int `json:"maxUnavailable"`
 }
 
+// WorkerNodesCanaryParams is API for canary strategy knobs.
+// When the machines of a worker node group need to be replaced, the controller first creates
+// the canary nodes with the new configuration, removes the same number of old nodes and runs
+// the health checks. If they pass, the rest of the nodes are replaced using the rollingUpdate
+// params. If they don't pass before the timeout, the rollout is paused.
+type WorkerNodesCanaryParams struct {
+	// Nodes is the number of canary nodes, or the percentage of the node group count, rounded up.
+	// Defaults to 1.
+	// +optional
+	Nodes *intstr.IntOrString `json:"nodes,omitempty"`
+	// SoakTime is how long the canary nodes need to be Ready before the health checks are evaluated.
+	// Defaults to 5m.
+	// +optional
+	SoakTime *metav1.Duration `json:"soakTime,omitempty"`
+	// Timeout is how long the canary nodes can take to become Ready and pass the health checks,
+	// not counting the soak time, before the rollout is paused. Defaults to 30m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// PodsReady are additional health checks that wait for the pods matching a selector to be Ready.
+	// +optional
+	PodsReady []CanaryPodsReadyCheck `json:"podsReady,omitempty"`
+}
+
+// NodeCount returns the number of canary nodes for a worker node group with count nodes.
+// It's at least 1, even for empty or nil params.
+func (c *WorkerNodesCanaryParams) NodeCount(count int) int {
+	if c == nil || c.Nodes == nil {
+		return 1
+	}
+
+	nodes, err := intstr.GetScaledValueFromIntOrPercent(c.Nodes, count, true)
+	if err != nil || nodes < 1 {
+		return 1
+	}
+
+	return nodes
+}
+
+// CanaryPodsReadyCheck is a canary health check that passes when there is at least one pod
+// matching the selector in the namespace and all of them are Ready.
+type CanaryPodsReadyCheck struct {
+	Namespace string `json:"namespace"`
+	// Selector are the labels of the pods to check. If empty, all the pods in the namespace are checked.
+	// +optional
+	Selector map[string]string `json:"selector,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // Cluster is the Schema for the clusters API.
@@ -2905,6 +2905,58 @@ func TestCiliumConfigEquality(t *testing.T) {
 			},
 			Equal: false,
 		},
+		{
+			Name: "EqualExtraConfig",
+			A: &v1alpha1.CiliumConfig{
+				Hubble:               &v1alpha1.CiliumHubbleConfig{Relay: true},
+				BGPControlPlane:      &v1alpha1.CiliumBGPControlPlaneConfig{},
+				KubeProxyReplacement: true,
+				HelmValues:           map[string]string{"envoy.enabled": "true"},
+			},
+			B: &v1alpha1.CiliumConfig{
+				Hubble:               &v1alpha1.CiliumHubbleConfig{Relay: true},
+				BGPControlPlane:      &v1alpha1.CiliumBGPControlPlaneConfig{},
+				KubeProxyReplacement: true,
+				HelmValues:           map[string]string{"envoy.enabled": "true"},
+			},
+			Equal: true,
+		},
+		{
+			Name: "DiffHubble",
+			A: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true},
+			},
+			B: &v1alpha1.CiliumConfig{
+				Hubble: &v1alpha1.CiliumHubbleConfig{},
+			},
+			Equal: false,
+		},
+		{
+			Name: "DiffBGPControlPlane",
+			A: &v1alpha1.CiliumConfig{
+				BGPControlPlane: &v1alpha1.CiliumBGPControlPlaneConfig{},
+			},
+			B:     &v1alpha1.CiliumConfig{},
+			Equal: false,
+		},
+		{
+			Name: "DiffKubeProxyReplacement",
+			A: &v1alpha1.CiliumConfig{
+				KubeProxyReplacement: true,
+			},
+			B:     &v1alpha1.CiliumConfig{},
+			Equal: false,
+		},
+		{
+			Name: "DiffHelmValues",
+			A: &v1alpha1.CiliumConfig{
+				HelmValues: map[string]string{"envoy.enabled": "true"},
+			},
+			B: &v1alpha1.CiliumConfig{
+				HelmValues: map[string]string{"envoy.enabled": "false"},
+			},
+			Equal: false,
+		},
 	}
 
 	for _, tc := range tests {
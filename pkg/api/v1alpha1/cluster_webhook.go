@@ -309,7 +309,7 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 
 	oCNI := old.Spec.ClusterNetwork.CNIConfig
 	nCNI := new.Spec.ClusterNetwork.CNIConfig
-	if oCNI != nil && oCNI.Cilium != nil && !oCNI.Cilium.IsManaged() && nCNI.Cilium.IsManaged() {
+	if oCNI != nil && oCNI.Cilium != nil && !oCNI.Cilium.IsManaged() && nCNI != nil && nCNI.Cilium != nil && nCNI.Cilium.IsManaged() {
 		allErrs = append(
 			allErrs,
 			field.Forbidden(
@@ -319,6 +319,28 @@ func validateImmutableFieldsCluster(new, old *Cluster) field.ErrorList {
 		)
 	}
 
+	// kube-proxy is removed when Cilium replaces it and EKS Anywhere doesn't reinstall it.
+	if oCNI != nil && oCNI.Cilium != nil && oCNI.Cilium.KubeProxyReplacement && (nCNI == nil || nCNI.Cilium == nil || !nCNI.Cilium.KubeProxyReplacement) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("clusterNetwork", "cniConfig", "cilium", "kubeProxyReplacement"),
+				"cannot toggle off kubeProxyReplacement once enabled",
+			),
+		)
+	}
+
+	// EKS Anywhere can't take over the CNI once the user manages it, it would conflict with the running one.
+	if oCNI != nil && oCNI.Custom != nil && (nCNI == nil || nCNI.Custom == nil) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				specPath.Child("clusterNetwork", "cniConfig", "custom"),
+				"cannot switch from a custom CNI to a CNI managed by EKS Anywhere",
+			),
+		)
+	}
+
 	if !new.Spec.ClusterNetwork.Nodes.Equal(old.Spec.ClusterNetwork.Nodes) {
 		allErrs = append(
 			allErrs,
@@ -570,7 +592,7 @@ func validateRemoveWorkerKubernetesVersion(newCPVersion, oldCPVersion Kubernetes
 	return allErrs
 }
 
-func validKubeMinorVersionDiff(old, new KubernetesVersion) (bool, error) {
+func validKubeMinorVersionDiff(old, new KubernetesVersion, maxDiff int) (bool, error) {
 	parsedOldVersion, err := version.ParseGeneric(string(old))
 	if err != nil {
 		return false, fmt.Errorf("could not parse version: %v, %v", old, err)
@@ -589,19 +611,40 @@ func validKubeMinorVersionDiff(old, new KubernetesVersion) (bool, error) {
 	newMinor := int(parsedNewVersion.Minor())
 	minorDiff := newMinor - oldMinor
 
-	if minorDiff < 0 || minorDiff > 2 {
+	if minorDiff < 0 || minorDiff > maxDiff {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// MaxWorkerKubernetesVersionSkew returns how many minor versions worker node groups can be behind
+// a control plane running cpVersion. As of Kubernetes v1.28 it's 3 and 2 in earlier versions.
+func MaxWorkerKubernetesVersionSkew(cpVersion KubernetesVersion) (int, error) {
+	parsed, err := version.ParseGeneric(string(cpVersion))
+	if err != nil {
+		return 0, fmt.Errorf("could not parse version: %v, %v", cpVersion, err)
+	}
+	if parsed.AtLeast(version.MustParseGeneric(string(Kube128))) {
+		return 3, nil
+	}
+	return 2, nil
+}
+
 func validateCPWorkerKubeSkew(cpVersion, workerVersion KubernetesVersion) field.ErrorList {
 	var allErrs field.ErrorList
 	workerPath := field.NewPath("spec").Child("WorkerNodeConfiguration.kubernetesVersion")
 	cpPath := field.NewPath("spec").Child("kubernetesVersion")
 
-	validSkew, err := validKubeMinorVersionDiff(workerVersion, cpVersion)
+	maxSkew, err := MaxWorkerKubernetesVersionSkew(cpVersion)
+	if err != nil {
+		allErrs = append(
+			allErrs,
+			field.Invalid(cpPath, cpVersion, fmt.Sprintf("could not determine minor version difference: %v", err.Error())))
+		return allErrs
+	}
+
+	validSkew, err := validKubeMinorVersionDiff(workerVersion, cpVersion, maxSkew)
 	if err != nil {
 		allErrs = append(
 			allErrs,
@@ -612,7 +655,7 @@ func validateCPWorkerKubeSkew(cpVersion, workerVersion KubernetesVersion) field.
 	if !validSkew {
 		allErrs = append(
 			allErrs,
-			field.Invalid(cpPath, cpVersion, fmt.Sprintf("cluster level minor version must be within 2 versions greater than worker node group version: %v", workerVersion)))
+			field.Invalid(cpPath, cpVersion, fmt.Sprintf("cluster level minor version must be within %d versions greater than worker node group version: %v", maxSkew, workerVersion)))
 	}
 
 	return allErrs
@@ -1297,8 +1297,37 @@ func TestClusterUpdateEtcdEncryption(t *testing.T) {
 			},
 		},
 		{
-			testName:    "two_encryption_providers",
-			expectedErr: errors.New("etcdEncryption[0].providers in invalid, only 1 encryption provider is currently supported"),
+			testName:    "three_encryption_providers",
+			expectedErr: errors.New("etcdEncryption[0].providers in invalid, only up to 2 encryption providers are supported"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config1",
+								SocketListenAddress: "unix:///abc1",
+							},
+						},
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config2",
+								SocketListenAddress: "unix:///abc2",
+							},
+						},
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config3",
+								SocketListenAddress: "unix:///abc3",
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "two_encryption_providers_same_socket",
+			expectedErr: errors.New("etcdEncryption[0].providers[1] is invalid: kms.socketListenAddress unix:///abc is duplicated"),
 			encryptionConfig: &[]v1alpha1.EtcdEncryption{
 				{
 					Providers: []v1alpha1.EtcdEncryptionProvider{
@@ -1319,6 +1348,118 @@ func TestClusterUpdateEtcdEncryption(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName:    "two_encryption_providers_same_name",
+			expectedErr: errors.New("etcdEncryption[0].providers[1] is invalid: kms.name test_config is duplicated"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config",
+								SocketListenAddress: "unix:///abc1",
+							},
+						},
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config",
+								SocketListenAddress: "unix:///abc2",
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "kms_plugin_empty_image",
+			expectedErr: errors.New("etcdEncryption[0].providers[0] is invalid: kms.plugin.image cannot be empty"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test-config",
+								SocketListenAddress: "unix:///var/run/kmsplugin/socket.sock",
+								Plugin:              &v1alpha1.KMSPlugin{},
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "kms_plugin_invalid_name",
+			expectedErr: errors.New("etcdEncryption[0].providers[0] is invalid: kms.name must be a valid DNS-1123 label when kms.plugin is set"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test_config",
+								SocketListenAddress: "unix:///var/run/kmsplugin/socket.sock",
+								Plugin: &v1alpha1.KMSPlugin{
+									Image: "public.ecr.aws/kms-plugin:v1",
+								},
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "kms_plugin_socket_outside_plugin_dir",
+			expectedErr: errors.New("etcdEncryption[0].providers[0] is invalid: kms.socketListenAddress must be a socket in /var/run/kmsplugin/ when kms.plugin is set"),
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "test-config",
+								SocketListenAddress: "unix:///abc",
+								Plugin: &v1alpha1.KMSPlugin{
+									Image: "public.ecr.aws/kms-plugin:v1",
+								},
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
+		{
+			testName:    "valid_key_rotation_with_kms_plugins",
+			expectedErr: nil,
+			encryptionConfig: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "new-key",
+								SocketListenAddress: "unix:///var/run/kmsplugin/new-key.sock",
+								Plugin: &v1alpha1.KMSPlugin{
+									Image: "public.ecr.aws/kms-plugin:v1",
+									Args:  []string{"--key=new"},
+								},
+							},
+						},
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "old-key",
+								SocketListenAddress: "unix:///var/run/kmsplugin/old-key.sock",
+								Plugin: &v1alpha1.KMSPlugin{
+									Image: "public.ecr.aws/kms-plugin:v1",
+									Args:  []string{"--key=old"},
+								},
+							},
+						},
+					},
+					Resources: resources,
+				},
+			},
+		},
 		{
 			testName:    "valid_config",
 			expectedErr: nil,
@@ -2000,6 +2141,64 @@ func TestClusterValidateUpdateSkipUpgradeImmutability(t *testing.T) {
 	}
 }
 
+func TestClusterValidateUpdateKubeProxyReplacementImmutability(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Old   bool
+		New   bool
+		Error bool
+	}{
+		{Name: "FalseToTrue", Old: false, New: true},
+		{Name: "TrueToTrue", Old: true, New: true},
+		{Name: "TrueToFalse", Old: true, New: false, Error: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldCluster := baseCluster(func(c *v1alpha1.Cluster) {
+				c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = tc.Old
+			})
+			newCluster := baseCluster(func(c *v1alpha1.Cluster) {
+				c.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = tc.New
+			})
+
+			_, err := newCluster.ValidateUpdate(oldCluster)
+			if !tc.Error {
+				g.Expect(err).To(Succeed())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(
+					"spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement: Forbidden: cannot toggle off kubeProxyReplacement once enabled",
+				)))
+			}
+		})
+	}
+}
+
+func TestClusterValidateUpdateCustomCNIToCilium(t *testing.T) {
+	cOld := baseCluster(func(c *v1alpha1.Cluster) {
+		c.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Custom: &v1alpha1.CustomCNIConfig{}}
+	})
+	cNew := baseCluster()
+
+	g := NewWithT(t)
+	_, err := cNew.ValidateUpdate(cOld)
+	g.Expect(err).To(MatchError(ContainSubstring(
+		"spec.clusterNetwork.cniConfig.custom: Forbidden: cannot switch from a custom CNI to a CNI managed by EKS Anywhere",
+	)))
+}
+
+func TestClusterValidateUpdateCiliumToCustomCNI(t *testing.T) {
+	cOld := baseCluster()
+	cNew := baseCluster(func(c *v1alpha1.Cluster) {
+		c.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Custom: &v1alpha1.CustomCNIConfig{}}
+	})
+
+	g := NewWithT(t)
+	_, err := cNew.ValidateUpdate(cOld)
+	g.Expect(err).To(Succeed())
+}
+
 func TestClusterValidateUpdateVersionSkew(t *testing.T) {
 	features.ClearCache()
 	cOld := baseCluster()
@@ -2272,6 +2471,62 @@ func TestValidateWorkerVersionSkew(t *testing.T) {
 	}
 }
 
+func TestValidateWorkerKubernetesVersionSkewControlPlaneSkew(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldCPVersion  v1alpha1.KubernetesVersion
+		newCPVersion  v1alpha1.KubernetesVersion
+		workerVersion v1alpha1.KubernetesVersion
+		wantErr       string
+	}{
+		{
+			name:          "2 versions before 1.28",
+			oldCPVersion:  v1alpha1.Kube126,
+			newCPVersion:  v1alpha1.Kube127,
+			workerVersion: v1alpha1.Kube125,
+		},
+		{
+			name:          "3 versions before 1.28",
+			oldCPVersion:  v1alpha1.Kube126,
+			newCPVersion:  v1alpha1.Kube127,
+			workerVersion: v1alpha1.Kube124,
+			wantErr:       "cluster level minor version must be within 2 versions greater than worker node group version: 1.24",
+		},
+		{
+			name:          "3 versions as of 1.28",
+			oldCPVersion:  v1alpha1.Kube127,
+			newCPVersion:  v1alpha1.Kube128,
+			workerVersion: v1alpha1.Kube125,
+		},
+		{
+			name:          "4 versions as of 1.28",
+			oldCPVersion:  v1alpha1.Kube128,
+			newCPVersion:  v1alpha1.Kube129,
+			workerVersion: v1alpha1.Kube125,
+			wantErr:       "cluster level minor version must be within 3 versions greater than worker node group version: 1.25",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			newCluster := baseCluster()
+			newCluster.Spec.KubernetesVersion = tt.newCPVersion
+			newCluster.Spec.WorkerNodeGroupConfigurations[0].KubernetesVersion = &tt.workerVersion
+
+			oldCluster := baseCluster()
+			oldCluster.Spec.KubernetesVersion = tt.oldCPVersion
+			oldCluster.Spec.WorkerNodeGroupConfigurations[0].KubernetesVersion = &tt.workerVersion
+
+			allErrs := v1alpha1.ValidateWorkerKubernetesVersionSkew(newCluster, oldCluster)
+			if tt.wantErr == "" {
+				g.Expect(allErrs).To(BeEmpty())
+			} else {
+				g.Expect(allErrs.ToAggregate()).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
 func TestValidateWorkerVersionSkewAddNodeGroup(t *testing.T) {
 	kube119 := v1alpha1.KubernetesVersion("1.19")
 
@@ -77,4 +77,41 @@ const (
 	// upgrades for the default cni. The default cni may still be installed, for example to successfully
 	// create a cluster.
 	SkipUpgradesForDefaultCNIConfiguredReason = "SkipUpgradesForDefaultCNIConfigured"
+
+	// CustomCNIConfiguredReason used to indicate the cluster has been configured to use a CNI
+	// installed and managed by the user, so no default cni is installed nor upgraded.
+	CustomCNIConfiguredReason = "CustomCNIConfigured"
+
+	// CNIReadyCondition reports whether the CNI of the cluster is ready, as reported by its networking provider.
+	CNIReadyCondition ConditionType = "CNIReady"
+
+	// CNINotReadyReason reports the CNI of the cluster is not ready yet.
+	CNINotReadyReason = "CNINotReady"
+)
+
+const (
+	// RollingChangesAppliedCondition reports whether the changes that replace or upgrade the nodes of
+	// the cluster have been applied or are deferred until the next maintenance window.
+	RollingChangesAppliedCondition ConditionType = "RollingChangesApplied"
+
+	// OutsideMaintenanceWindowReason used when changes that replace or upgrade nodes are pending
+	// and the cluster is outside its maintenance windows.
+	OutsideMaintenanceWindowReason = "OutsideMaintenanceWindow"
+
+	// RollingChangesInProgressReason used when changes that replace or upgrade nodes are being
+	// rolled out inside a maintenance window.
+	RollingChangesInProgressReason = "RollingChangesInProgress"
+)
+
+const (
+	// CanaryUpgradeHealthyCondition reports whether the canary nodes of the worker node groups with a Canary
+	// upgrade rollout strategy passed their health checks.
+	CanaryUpgradeHealthyCondition ConditionType = "CanaryUpgradeHealthy"
+
+	// CanaryUpgradeInProgressReason used when canary nodes are being created or their health checks evaluated.
+	CanaryUpgradeInProgressReason = "CanaryUpgradeInProgress"
+
+	// CanaryHealthChecksFailedReason used when the canary nodes didn't pass the health checks before the
+	// canary timeout and the upgrade of the worker node group is paused.
+	CanaryHealthChecksFailedReason = "CanaryHealthChecksFailed"
 )
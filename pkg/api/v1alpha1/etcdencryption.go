@@ -2,10 +2,12 @@ package v1alpha1
 
 import (
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
@@ -17,6 +19,15 @@ var (
 	DefaultKMSTimeout = metav1.Duration{Duration: time.Second * 3}
 )
 
+// KMSPluginSocketDir is the directory in the control plane nodes, shared between the
+// kube-apiserver and the KMS plugins managed by EKS Anywhere, where the plugin sockets live.
+const KMSPluginSocketDir = "/var/run/kmsplugin/"
+
+// maxEtcdEncryptionProviders is the maximum number of providers in an encryption config.
+// A second provider is only allowed to rotate keys: the first one encrypts new data and
+// the second one is still able to decrypt data written with the old key.
+const maxEtcdEncryptionProviders = 2
+
 // ValidateEtcdEncryptionConfig validates the etcd encryption configuration.
 func ValidateEtcdEncryptionConfig(config *[]EtcdEncryption) error {
 	if config == nil {
@@ -35,13 +46,23 @@ func ValidateEtcdEncryptionConfig(config *[]EtcdEncryption) error {
 		if len(c.Providers) == 0 {
 			return errors.Errorf("etcdEncryption[%d].providers cannot be empty", i)
 		}
-		if len(c.Providers) != 1 {
-			return errors.Errorf("etcdEncryption[%d].providers in invalid, only 1 encryption provider is currently supported", i)
+		if len(c.Providers) > maxEtcdEncryptionProviders {
+			return errors.Errorf("etcdEncryption[%d].providers in invalid, only up to %d encryption providers are supported, the second one only to rotate keys", i, maxEtcdEncryptionProviders)
 		}
+		names := map[string]struct{}{}
+		sockets := map[string]struct{}{}
 		for j, p := range c.Providers {
 			if err := validateKMSConfig(p.KMS); err != nil {
 				return errors.Errorf("etcdEncryption[%d].providers[%d] is invalid: %v", i, j, err)
 			}
+			if _, ok := names[p.KMS.Name]; ok {
+				return errors.Errorf("etcdEncryption[%d].providers[%d] is invalid: kms.name %s is duplicated", i, j, p.KMS.Name)
+			}
+			names[p.KMS.Name] = struct{}{}
+			if _, ok := sockets[p.KMS.SocketListenAddress]; ok {
+				return errors.Errorf("etcdEncryption[%d].providers[%d] is invalid: kms.socketListenAddress %s is duplicated", i, j, p.KMS.SocketListenAddress)
+			}
+			sockets[p.KMS.SocketListenAddress] = struct{}{}
 		}
 		if len(c.Resources) == 0 {
 			return errors.Errorf("etcdEncryption[%d].resources cannot be empty", i)
@@ -68,6 +89,24 @@ func validateKMSConfig(kms *KMS) error {
 	if u.Scheme != "unix" {
 		return errors.Errorf("kms.socketListenAddress has unsupported scheme: %v", u.Scheme)
 	}
+	if kms.Plugin != nil {
+		if err := validateKMSPlugin(kms, u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKMSPlugin(kms *KMS, socket *url.URL) error {
+	if len(kms.Plugin.Image) == 0 {
+		return errors.New("kms.plugin.image cannot be empty")
+	}
+	if errs := validation.IsDNS1123Label(kms.Name); len(errs) != 0 {
+		return errors.Errorf("kms.name must be a valid DNS-1123 label when kms.plugin is set: %s", strings.Join(errs, ", "))
+	}
+	if !strings.HasPrefix(socket.Path, KMSPluginSocketDir) || len(socket.Path) == len(KMSPluginSocketDir) {
+		return errors.Errorf("kms.socketListenAddress must be a socket in %s when kms.plugin is set", KMSPluginSocketDir)
+	}
 	return nil
 }
 
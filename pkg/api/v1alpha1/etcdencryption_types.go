@@ -27,4 +27,26 @@ type KMS struct {
 	SocketListenAddress string `json:"socketListenAddress"`
 	// Timeout for kube-apiserver to wait for KMS plugin. Default is 3s.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Plugin, when set, makes EKS Anywhere run the KMS plugin as a static pod in every control plane
+	// node, listening on SocketListenAddress. Changing it upgrades the plugin by rolling the control plane.
+	// If not set, the KMS plugin needs to be deployed and operated separately.
+	// +optional
+	Plugin *KMSPlugin `json:"plugin,omitempty"`
+}
+
+// KMSPlugin defines the KMS plugin EKS Anywhere deploys in the control plane nodes.
+type KMSPlugin struct {
+	// Image is the container image of the KMS plugin.
+	Image string `json:"image"`
+	// Args are the arguments passed to the KMS plugin container.
+	// +optional
+	Args []string `json:"args,omitempty"`
+	// Env are the environment variables set in the KMS plugin container.
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
+	// Config is the content of a configuration file for the KMS plugin, mounted in the
+	// container at /etc/kms-plugin/config. It's stored in plain text in the cluster objects
+	// and the control plane nodes, so it shouldn't contain credentials.
+	// +optional
+	Config string `json:"config,omitempty"`
 }
@@ -3,7 +3,11 @@ package v1alpha1
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
 )
@@ -16,11 +20,17 @@ const (
 )
 
 func validateFluxConfig(config *FluxConfig) error {
-	if config.Spec.Git != nil && config.Spec.Github != nil {
+	providers := 0
+	for _, set := range []bool{config.Spec.Git != nil, config.Spec.Github != nil, config.Spec.Gitlab != nil} {
+		if set {
+			providers++
+		}
+	}
+	if providers > 1 {
 		return errors.New("must specify only one provider")
 	}
-	if config.Spec.Git == nil && config.Spec.Github == nil {
-		return errors.New("must specify a provider. Valid options are git and github")
+	if providers == 0 {
+		return errors.New("must specify a provider. Valid options are git, github and gitlab")
 	}
 	if config.Spec.Github != nil {
 		err := validateGithubProviderConfig(*config.Spec.Github)
@@ -34,6 +44,12 @@ func validateFluxConfig(config *FluxConfig) error {
 			return err
 		}
 	}
+	if config.Spec.Gitlab != nil {
+		err := validateGitlabProviderConfig(*config.Spec.Gitlab)
+		if err != nil {
+			return err
+		}
+	}
 
 	if len(config.Spec.Branch) > 0 {
 		err := validateGitBranchName(config.Spec.Branch)
@@ -57,9 +73,50 @@ func validateGitProviderConfig(gitProviderConfig GitProviderConfig) error {
 		logger.Info("Warning: 'sshKeyAlgorithm' is not set, defaulting to 'ecdsa'")
 	}
 
+	if len(gitProviderConfig.KnownHosts) > 0 {
+		if err := validateKnownHosts(gitProviderConfig.KnownHosts); err != nil {
+			return err
+		}
+	}
+
 	return validateRepositoryUrl(gitProviderConfig.RepositoryUrl)
 }
 
+func validateGitlabProviderConfig(config GitlabProviderConfig) error {
+	if len(config.Owner) <= 0 {
+		return errors.New("'owner' is not set or empty in gitlabProviderConfig; owner is a required field")
+	}
+	if len(config.Repository) <= 0 {
+		return errors.New("'repository' is not set or empty in gitlabProviderConfig; repository is a required field")
+	}
+	if strings.Contains(config.Hostname, "/") {
+		return fmt.Errorf("'hostname' %s in gitlabProviderConfig is invalid; it must be a hostname, without scheme or path", config.Hostname)
+	}
+	return validateGitRepoName(config.Repository)
+}
+
+// validateKnownHosts validates that knownHosts has at least one host key and all of its entries
+// are in the known_hosts file format.
+func validateKnownHosts(knownHosts string) error {
+	rest := []byte(knownHosts)
+	keys := 0
+	for len(rest) > 0 {
+		var err error
+		_, _, _, _, rest, err = ssh.ParseKnownHosts(rest)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("'knownHosts' in gitProviderConfig is invalid: %v", err)
+		}
+		keys++
+	}
+	if keys == 0 {
+		return errors.New("'knownHosts' in gitProviderConfig is invalid: no host keys found")
+	}
+	return nil
+}
+
 func validateGithubProviderConfig(config GithubProviderConfig) error {
 	if len(config.Owner) <= 0 {
 		return errors.New("'owner' is not set or empty in githubProviderConfig; owner is a required field")
@@ -12,6 +12,8 @@ import (
 const (
 	EksaGitPrivateKeyTokenEnv = "EKSA_GIT_PRIVATE_KEY"
 	EksaGitKnownHostsFileEnv  = "EKSA_GIT_KNOWN_HOSTS"
+
+	testKnownHosts = "git.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n"
 )
 
 func TestValidateFluxConfig(t *testing.T) {
@@ -188,6 +190,196 @@ func TestValidateFluxConfig(t *testing.T) {
 			gitProvider: true,
 			error:       nil,
 		},
+		{
+			testName: "valid fluxconfig gitlab",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Hostname:   "gitlab.example.com",
+						Owner:      "platform/clusters",
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: false,
+			error:   nil,
+		},
+		{
+			testName: "empty gitlab owner",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'owner' is not set or empty in gitlabProviderConfig; owner is a required field"),
+		},
+		{
+			testName: "empty gitlab repo",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Owner: "janedoe",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'repository' is not set or empty in gitlabProviderConfig; repository is a required field"),
+		},
+		{
+			testName: "gitlab hostname with scheme",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Gitlab: &GitlabProviderConfig{
+						Hostname:   "https://gitlab.example.com",
+						Owner:      "janedoe",
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("'hostname' https://gitlab.example.com in gitlabProviderConfig is invalid; it must be a hostname, without scheme or path"),
+		},
+		{
+			testName: "multiple providers",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@github.com/username/repo.git",
+					},
+					Gitlab: &GitlabProviderConfig{
+						Owner:      "janedoe",
+						Repository: "flux-fleet",
+					},
+				},
+			},
+			wantErr: true,
+			error:   errors.New("must specify only one provider"),
+		},
+		{
+			testName: "no provider",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{},
+			},
+			wantErr: true,
+			error:   errors.New("must specify a provider. Valid options are git, github and gitlab"),
+		},
+		{
+			testName: "valid known hosts",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@git.example.com/username/repo.git",
+						KnownHosts:    testKnownHosts,
+					},
+				},
+			},
+			wantErr:     false,
+			gitProvider: true,
+			error:       nil,
+		},
+		{
+			testName: "invalid known hosts",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@git.example.com/username/repo.git",
+						KnownHosts:    "git.example.com ssh-ed25519 invalid",
+					},
+				},
+			},
+			wantErr:     true,
+			gitProvider: true,
+			error:       nil,
+		},
+		{
+			testName: "known hosts without keys",
+			fluxConfig: &FluxConfig{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       FluxConfigKind,
+					APIVersion: SchemeBuilder.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-flux",
+					Namespace: "default",
+				},
+				Spec: FluxConfigSpec{
+					Git: &GitProviderConfig{
+						RepositoryUrl: "ssh://git@git.example.com/username/repo.git",
+						KnownHosts:    "# no keys\n",
+					},
+				},
+			},
+			wantErr:     true,
+			gitProvider: true,
+			error:       errors.New("'knownHosts' in gitProviderConfig is invalid: no host keys found"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -35,6 +35,9 @@ type FluxConfigSpec struct {
 
 	// Used to specify Git provider that will be used to host the git files
 	Git *GitProviderConfig `json:"git,omitempty"`
+
+	// Used to specify GitLab provider to host the Git repo and host the git files
+	Gitlab *GitlabProviderConfig `json:"gitlab,omitempty"`
 }
 
 type GithubProviderConfig struct {
@@ -54,6 +57,24 @@ type GitProviderConfig struct {
 
 	// SSH public key algorithm for the private key specified (rsa, ecdsa, ed25519) (default ecdsa)
 	SshKeyAlgorithm string `json:"sshKeyAlgorithm,omitempty"`
+
+	// KnownHosts pins the host keys of the repository server, in the known_hosts file format.
+	// When set, it's used instead of the file in the EKSA_GIT_KNOWN_HOSTS environment variable.
+	KnownHosts string `json:"knownHosts,omitempty"`
+}
+
+type GitlabProviderConfig struct {
+	// Hostname of the GitLab instance. Defaults to gitlab.com.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Owner is the user or group path of the repository, including subgroups.
+	Owner string `json:"owner"`
+
+	// Repository name.
+	Repository string `json:"repository"`
+
+	// if true, the owner is assumed to be a GitLab user; otherwise a group.
+	Personal bool `json:"personal,omitempty"`
 }
 
 // FluxConfigStatus defines the observed state of FluxConfig.
@@ -97,7 +118,7 @@ func (e *FluxConfigSpec) Equal(n *FluxConfigSpec) bool {
 	if e.ClusterConfigPath != n.ClusterConfigPath {
 		return false
 	}
-	return e.Git.Equal(n.Git) && e.Github.Equal(n.Github)
+	return e.Git.Equal(n.Git) && e.Github.Equal(n.Github) && e.Gitlab.Equal(n.Gitlab)
 }
 
 func (e *GithubProviderConfig) Equal(n *GithubProviderConfig) bool {
@@ -110,6 +131,16 @@ func (e *GithubProviderConfig) Equal(n *GithubProviderConfig) bool {
 	return *e == *n
 }
 
+func (e *GitlabProviderConfig) Equal(n *GitlabProviderConfig) bool {
+	if e == n {
+		return true
+	}
+	if e == nil || n == nil {
+		return false
+	}
+	return *e == *n
+}
+
 func (e *GitProviderConfig) Equal(n *GitProviderConfig) bool {
 	if e == n {
 		return true
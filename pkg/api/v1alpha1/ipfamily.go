@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+)
+
+// IPFamily is an IP address family.
+type IPFamily string
+
+const (
+	// IPv4Family is the IPv4 address family.
+	IPv4Family IPFamily = "IPv4"
+	// IPv6Family is the IPv6 address family.
+	IPv6Family IPFamily = "IPv6"
+)
+
+// CIDRIPFamily returns the IP family of a CIDR block.
+func CIDRIPFamily(cidr string) (IPFamily, error) {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", err
+	}
+	return ipFamily(ip), nil
+}
+
+// IPAddressFamily returns the IP family of an IP address.
+func IPAddressFamily(address string) (IPFamily, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", fmt.Errorf("invalid IP address %s", address)
+	}
+	return ipFamily(ip), nil
+}
+
+func ipFamily(ip net.IP) IPFamily {
+	if ip.To4() != nil {
+		return IPv4Family
+	}
+	return IPv6Family
+}
+
+// IPFamilies returns the IP families of the cluster network, in the same order as the
+// pod CIDR blocks. The first one is the primary family of the cluster.
+// Invalid CIDR blocks are ignored, they are reported by the cluster validation.
+func (n *ClusterNetwork) IPFamilies() []IPFamily {
+	families := make([]IPFamily, 0, len(n.Pods.CidrBlocks))
+	for _, cidr := range n.Pods.CidrBlocks {
+		if family, err := CIDRIPFamily(cidr); err == nil {
+			families = append(families, family)
+		}
+	}
+	return families
+}
+
+// HasIPFamily returns true if the cluster network uses the IP family.
+func (n *ClusterNetwork) HasIPFamily(family IPFamily) bool {
+	for _, f := range n.IPFamilies() {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDualStack returns true if the cluster network uses both IPv4 and IPv6.
+func (n *ClusterNetwork) IsDualStack() bool {
+	return n.HasIPFamily(IPv4Family) && n.HasIPFamily(IPv6Family)
+}
+
+// IsIPv6Primary returns true if the primary IP family of the cluster network is IPv6,
+// either because it's IPv6 only or because it's dual-stack with an IPv6 first.
+func (n *ClusterNetwork) IsIPv6Primary() bool {
+	families := n.IPFamilies()
+	return len(families) > 0 && families[0] == IPv6Family
+}
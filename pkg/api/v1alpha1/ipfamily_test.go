@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCIDRIPFamily(t *testing.T) {
+	g := NewWithT(t)
+
+	family, err := CIDRIPFamily("10.1.0.0/16")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(family).To(Equal(IPv4Family))
+
+	family, err = CIDRIPFamily("fd00:10:1::/56")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(family).To(Equal(IPv6Family))
+
+	_, err = CIDRIPFamily("10.1.0.0")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestIPAddressFamily(t *testing.T) {
+	g := NewWithT(t)
+
+	family, err := IPAddressFamily("192.168.1.10")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(family).To(Equal(IPv4Family))
+
+	family, err = IPAddressFamily("fd00::10")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(family).To(Equal(IPv6Family))
+
+	_, err = IPAddressFamily("my-endpoint")
+	g.Expect(err).To(MatchError(ContainSubstring("invalid IP address my-endpoint")))
+}
+
+func TestClusterNetworkIPFamilies(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []string
+		wantFamilies []IPFamily
+		wantDual     bool
+		wantIPv6     bool
+	}{
+		{
+			name:         "ipv4",
+			pods:         []string{"10.1.0.0/16"},
+			wantFamilies: []IPFamily{IPv4Family},
+		},
+		{
+			name:         "ipv6",
+			pods:         []string{"fd00:10:1::/56"},
+			wantFamilies: []IPFamily{IPv6Family},
+			wantIPv6:     true,
+		},
+		{
+			name:         "dual-stack ipv4 primary",
+			pods:         []string{"10.1.0.0/16", "fd00:10:1::/56"},
+			wantFamilies: []IPFamily{IPv4Family, IPv6Family},
+			wantDual:     true,
+		},
+		{
+			name:         "dual-stack ipv6 primary",
+			pods:         []string{"fd00:10:1::/56", "10.1.0.0/16"},
+			wantFamilies: []IPFamily{IPv6Family, IPv4Family},
+			wantDual:     true,
+			wantIPv6:     true,
+		},
+		{
+			name:         "invalid cidr",
+			pods:         []string{"1.2.3"},
+			wantFamilies: []IPFamily{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			n := &ClusterNetwork{Pods: Pods{CidrBlocks: tt.pods}}
+			g.Expect(n.IPFamilies()).To(Equal(tt.wantFamilies))
+			g.Expect(n.IsDualStack()).To(Equal(tt.wantDual))
+			g.Expect(n.IsIPv6Primary()).To(Equal(tt.wantIPv6))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Embed the time zone database so maintenance windows can be evaluated in any time zone,
+	// even when the host doesn't provide one.
+	_ "time/tzdata"
+)
+
+const maxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Validate checks the maintenance window is well defined.
+func (w *MaintenanceWindow) Validate() error {
+	if _, _, err := w.startTime(); err != nil {
+		return err
+	}
+
+	if w.Duration.Duration <= 0 || w.Duration.Duration > maxMaintenanceWindowDuration {
+		return fmt.Errorf("maintenance window duration %s is invalid, must be greater than 0 and at most %s", w.Duration.Duration, maxMaintenanceWindowDuration)
+	}
+
+	for _, day := range w.Days {
+		if _, ok := weekdays[strings.ToLower(day)]; !ok {
+			return fmt.Errorf("maintenance window day %s is invalid, must be a day of the week", day)
+		}
+	}
+
+	if _, err := w.location(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IsOpen returns true if the maintenance window is open at the given time.
+func (w *MaintenanceWindow) IsOpen(now time.Time) bool {
+	loc, err := w.location()
+	if err != nil {
+		return false
+	}
+	local := now.In(loc)
+
+	// Windows longer than a day can be open even if they started a few days ago.
+	daysBack := int(w.Duration.Duration/(24*time.Hour)) + 1
+	for i := 0; i <= daysBack; i++ {
+		start, ok := w.startOn(local.AddDate(0, 0, -i))
+		if ok && !now.Before(start) && now.Before(start.Add(w.Duration.Duration)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextStart returns the first time after now the maintenance window opens.
+// It returns the zero time if the window is invalid.
+func (w *MaintenanceWindow) NextStart(now time.Time) time.Time {
+	loc, err := w.location()
+	if err != nil {
+		return time.Time{}
+	}
+	local := now.In(loc)
+
+	for i := 0; i <= 7; i++ {
+		start, ok := w.startOn(local.AddDate(0, 0, i))
+		if ok && start.After(now) {
+			return start
+		}
+	}
+
+	return time.Time{}
+}
+
+// startOn returns the time the window opens on the day of t, if the window opens that day.
+func (w *MaintenanceWindow) startOn(t time.Time) (time.Time, bool) {
+	hour, minute, err := w.startTime()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	year, month, day := t.Date()
+	start := time.Date(year, month, day, hour, minute, 0, 0, t.Location())
+	if len(w.Days) == 0 {
+		return start, true
+	}
+
+	for _, d := range w.Days {
+		if weekdays[strings.ToLower(d)] == start.Weekday() {
+			return start, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (w *MaintenanceWindow) startTime() (hour, minute int, err error) {
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("maintenance window start %s is invalid, must be in HH:MM format", w.Start)
+	}
+
+	return start.Hour(), start.Minute(), nil
+}
+
+func (w *MaintenanceWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance window time zone %s is invalid: %v", w.TimeZone, err)
+	}
+
+	return loc, nil
+}
+
+// InMaintenanceWindow returns true if any of the maintenance windows is open at the given time.
+func InMaintenanceWindow(windows []MaintenanceWindow, now time.Time) bool {
+	for i := range windows {
+		if windows[i].IsOpen(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextMaintenanceWindow returns the first time after now any of the maintenance windows opens.
+// It returns the zero time if there are no valid windows.
+func NextMaintenanceWindow(windows []MaintenanceWindow, now time.Time) time.Time {
+	var next time.Time
+	for i := range windows {
+		start := windows[i].NextStart(now)
+		if start.IsZero() {
+			continue
+		}
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+
+	return next
+}
+
+func validateMaintenanceWindows(clusterConfig *Cluster) error {
+	for i := range clusterConfig.Spec.MaintenanceWindows {
+		if err := clusterConfig.Spec.MaintenanceWindows[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			window: MaintenanceWindow{Days: []string{"Saturday", "sunday"}, Start: "22:30", Duration: metav1.Duration{Duration: 4 * time.Hour}, TimeZone: "Europe/Madrid"},
+		},
+		{
+			name:    "invalid start",
+			window:  MaintenanceWindow{Start: "25:00", Duration: metav1.Duration{Duration: time.Hour}},
+			wantErr: "maintenance window start 25:00 is invalid",
+		},
+		{
+			name:    "zero duration",
+			window:  MaintenanceWindow{Start: "02:00"},
+			wantErr: "maintenance window duration 0s is invalid",
+		},
+		{
+			name:    "duration too long",
+			window:  MaintenanceWindow{Start: "02:00", Duration: metav1.Duration{Duration: 8 * 24 * time.Hour}},
+			wantErr: "maintenance window duration 192h0m0s is invalid",
+		},
+		{
+			name:    "invalid day",
+			window:  MaintenanceWindow{Days: []string{"Caturday"}, Start: "02:00", Duration: metav1.Duration{Duration: time.Hour}},
+			wantErr: "maintenance window day Caturday is invalid",
+		},
+		{
+			name:    "invalid time zone",
+			window:  MaintenanceWindow{Start: "02:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+			wantErr: "maintenance window time zone Mars/Olympus is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := tt.window.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowIsOpen(t *testing.T) {
+	// Saturday 22:00 to Sunday 02:00 UTC.
+	weekend := MaintenanceWindow{Days: []string{"Saturday"}, Start: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}}
+	daily := MaintenanceWindow{Start: "09:00", Duration: metav1.Duration{Duration: time.Hour}, TimeZone: "America/New_York"}
+
+	tests := []struct {
+		name   string
+		window MaintenanceWindow
+		now    time.Time
+		want   bool
+	}{
+		{
+			name:   "before start",
+			window: weekend,
+			now:    time.Date(2024, time.June, 1, 21, 59, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "at start",
+			window: weekend,
+			now:    time.Date(2024, time.June, 1, 22, 0, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "open past midnight",
+			window: weekend,
+			now:    time.Date(2024, time.June, 2, 1, 30, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "at end",
+			window: weekend,
+			now:    time.Date(2024, time.June, 2, 2, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "other day",
+			window: weekend,
+			now:    time.Date(2024, time.June, 5, 23, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "time zone",
+			window: daily,
+			now:    time.Date(2024, time.June, 5, 13, 30, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "time zone closed",
+			window: daily,
+			now:    time.Date(2024, time.June, 5, 9, 30, 0, 0, time.UTC),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.window.IsOpen(tt.now)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNextMaintenanceWindow(t *testing.T) {
+	g := NewWithT(t)
+	windows := []MaintenanceWindow{
+		{Days: []string{"Saturday"}, Start: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		{Days: []string{"Wednesday"}, Start: "03:00", Duration: metav1.Duration{Duration: time.Hour}},
+	}
+
+	// Monday.
+	now := time.Date(2024, time.June, 3, 12, 0, 0, 0, time.UTC)
+	g.Expect(InMaintenanceWindow(windows, now)).To(BeFalse())
+	g.Expect(NextMaintenanceWindow(windows, now)).To(Equal(time.Date(2024, time.June, 5, 3, 0, 0, 0, time.UTC)))
+
+	// Wednesday, after the window closed.
+	now = time.Date(2024, time.June, 5, 4, 0, 0, 0, time.UTC)
+	g.Expect(NextMaintenanceWindow(windows, now)).To(Equal(time.Date(2024, time.June, 8, 22, 0, 0, 0, time.UTC)))
+
+	g.Expect(NextMaintenanceWindow(nil, now).IsZero()).To(BeTrue())
+}
+
+func TestValidateMaintenanceWindows(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			MaintenanceWindows: []MaintenanceWindow{{Start: "2:00am", Duration: metav1.Duration{Duration: time.Hour}}},
+		},
+	}
+
+	g.Expect(validateMaintenanceWindows(cluster)).To(MatchError(ContainSubstring("maintenance window start 2:00am is invalid")))
+}
@@ -0,0 +1,17 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// MaintenanceWindow defines a recurring time window when the controller can roll out changes that
+// replace or upgrade the nodes of a cluster.
+type MaintenanceWindow struct {
+	// Days are the days of the week the window opens on, for example Saturday.
+	// If empty, the window opens every day.
+	Days []string `json:"days,omitempty"`
+	// Start is the time of the day the window opens, in 24-hour HH:MM format.
+	Start string `json:"start"`
+	// Duration is how long the window stays open.
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA time zone Days and Start refer to, for example America/Los_Angeles. Defaults to UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
@@ -38,54 +38,59 @@ func generateKeyForIPPool(pool IPPool) string {
 	return fmt.Sprintf("%s%s%s%s", pool.IPStart, pool.IPEnd, pool.Subnet, pool.Gateway)
 }
 
-func validateSnowIPPool(pool *SnowIPPool) error { //nolint:gocyclo
-	for index, ipPool := range pool.Spec.Pools {
+func validateSnowIPPool(pool *SnowIPPool) error {
+	return validateIPPools(SnowIPPoolKind, pool.Spec.Pools)
+}
+
+// validateIPPools validates a list of ip ranges. kind is used to prefix the error messages.
+func validateIPPools(kind string, pools []IPPool) error { //nolint:gocyclo
+	for index, ipPool := range pools {
 		if len(ipPool.IPStart) == 0 {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPStart can not be empty", index)
+			return fmt.Errorf("%s Pools[%d].IPStart can not be empty", kind, index)
 		}
 
 		ipStart := net.ParseIP(ipPool.IPStart)
 		if ipStart == nil {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPStart is invalid", index)
+			return fmt.Errorf("%s Pools[%d].IPStart is invalid", kind, index)
 		}
 
 		if len(ipPool.IPEnd) == 0 {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPEnd can not be empty", index)
+			return fmt.Errorf("%s Pools[%d].IPEnd can not be empty", kind, index)
 		}
 
 		ipEnd := net.ParseIP(ipPool.IPEnd)
 		if ipEnd == nil {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPEnd is invalid", index)
+			return fmt.Errorf("%s Pools[%d].IPEnd is invalid", kind, index)
 		}
 
 		if len(ipPool.Gateway) == 0 {
-			return fmt.Errorf("SnowIPPool Pools[%d].Gateway can not be empty", index)
+			return fmt.Errorf("%s Pools[%d].Gateway can not be empty", kind, index)
 		}
 
 		gateway := net.ParseIP(ipPool.Gateway)
 		if gateway == nil {
-			return fmt.Errorf("SnowIPPool Pools[%d].Gateway is invalid", index)
+			return fmt.Errorf("%s Pools[%d].Gateway is invalid", kind, index)
 		}
 
 		if bytes.Compare(ipStart, ipEnd) >= 0 {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPStart should be smaller than IPEnd", index)
+			return fmt.Errorf("%s Pools[%d].IPStart should be smaller than IPEnd", kind, index)
 		}
 
 		if len(ipPool.Subnet) == 0 {
-			return fmt.Errorf("SnowIPPool Pools[%d].Subnet can not be empty", index)
+			return fmt.Errorf("%s Pools[%d].Subnet can not be empty", kind, index)
 		}
 
 		_, ipNet, err := net.ParseCIDR(ipPool.Subnet)
 		if err != nil {
-			return fmt.Errorf("SnowIPPool Pools[%d].Subnet is invalid: %v", index, err)
+			return fmt.Errorf("%s Pools[%d].Subnet is invalid: %v", kind, index, err)
 		}
 
 		if !ipNet.Contains(ipStart) {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPStart should be within the subnet range %s", index, ipPool.Subnet)
+			return fmt.Errorf("%s Pools[%d].IPStart should be within the subnet range %s", kind, index, ipPool.Subnet)
 		}
 
 		if !ipNet.Contains(ipEnd) {
-			return fmt.Errorf("SnowIPPool Pools[%d].IPEnd should be within the subnet range %s", index, ipPool.Subnet)
+			return fmt.Errorf("%s Pools[%d].IPEnd should be within the subnet range %s", kind, index, ipPool.Subnet)
 		}
 	}
 
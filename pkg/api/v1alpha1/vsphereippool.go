@@ -0,0 +1,63 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+)
+
+const (
+	// VSphereIPPoolKind is the object kind name for VSphereIPPool.
+	VSphereIPPoolKind = "VSphereIPPool"
+)
+
+func validateVSphereIPPool(pool *VSphereIPPool) error {
+	if len(pool.Spec.Pools) == 0 {
+		return fmt.Errorf("VSphereIPPool %s must have at least one pool", pool.Name)
+	}
+
+	if err := validateIPPools(VSphereIPPoolKind, pool.Spec.Pools); err != nil {
+		return err
+	}
+
+	for index, nameserver := range pool.Spec.Nameservers {
+		if net.ParseIP(nameserver) == nil {
+			return fmt.Errorf("VSphereIPPool Nameservers[%d] %s is invalid", index, nameserver)
+		}
+	}
+
+	return nil
+}
+
+// Contains returns true if the ip belongs to any of the ranges of the pool.
+func (p *VSphereIPPool) Contains(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, pool := range p.Spec.Pools {
+		if bytes.Compare(addr, net.ParseIP(pool.IPStart)) >= 0 && bytes.Compare(addr, net.ParseIP(pool.IPEnd)) <= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Size returns the number of ips in the pool. Overlapping ranges are counted more than once.
+func (p *VSphereIPPool) Size() int64 {
+	size := new(big.Int)
+	for _, pool := range p.Spec.Pools {
+		start := new(big.Int).SetBytes(net.ParseIP(pool.IPStart).To16())
+		end := new(big.Int).SetBytes(net.ParseIP(pool.IPEnd).To16())
+		size.Add(size, end.Sub(end, start).Add(end, big.NewInt(1)))
+	}
+
+	if !size.IsInt64() {
+		return int64(^uint64(0) >> 1)
+	}
+
+	return size.Int64()
+}
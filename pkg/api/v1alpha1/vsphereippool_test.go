@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func vsphereIPPool() *VSphereIPPool {
+	return &VSphereIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: VSphereIPPoolSpec{
+			Pools: []IPPool{
+				{
+					IPStart: "10.0.0.10",
+					IPEnd:   "10.0.0.19",
+					Subnet:  "10.0.0.0/24",
+					Gateway: "10.0.0.1",
+				},
+				{
+					IPStart: "10.0.0.30",
+					IPEnd:   "10.0.0.31",
+					Subnet:  "10.0.0.0/24",
+					Gateway: "10.0.0.1",
+				},
+			},
+			Nameservers: []string{"10.0.0.2"},
+		},
+	}
+}
+
+func TestVSphereIPPoolValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(p *VSphereIPPool)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			mutate: func(p *VSphereIPPool) {},
+		},
+		{
+			name: "no pools",
+			mutate: func(p *VSphereIPPool) {
+				p.Spec.Pools = nil
+			},
+			wantErr: "VSphereIPPool pool must have at least one pool",
+		},
+		{
+			name: "invalid range",
+			mutate: func(p *VSphereIPPool) {
+				p.Spec.Pools[1].IPEnd = "10.0.0.1"
+			},
+			wantErr: "VSphereIPPool Pools[1].IPStart should be smaller than IPEnd",
+		},
+		{
+			name: "invalid nameserver",
+			mutate: func(p *VSphereIPPool) {
+				p.Spec.Nameservers = append(p.Spec.Nameservers, "dns")
+			},
+			wantErr: "VSphereIPPool Nameservers[1] dns is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := vsphereIPPool()
+			tt.mutate(p)
+
+			err := p.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestVSphereIPPoolContains(t *testing.T) {
+	g := NewWithT(t)
+	p := vsphereIPPool()
+
+	g.Expect(p.Contains("10.0.0.10")).To(BeTrue())
+	g.Expect(p.Contains("10.0.0.19")).To(BeTrue())
+	g.Expect(p.Contains("10.0.0.31")).To(BeTrue())
+	g.Expect(p.Contains("10.0.0.20")).To(BeFalse())
+	g.Expect(p.Contains("10.0.0.9")).To(BeFalse())
+	g.Expect(p.Contains("invalid")).To(BeFalse())
+}
+
+func TestVSphereIPPoolSize(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(vsphereIPPool().Size()).To(Equal(int64(12)))
+}
@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VSphereIPPoolSpec defines the desired state of VSphereIPPool.
+type VSphereIPPoolSpec struct {
+	// Pools defines a list of ip ranges to allocate static ips for the vSphere machines from.
+	Pools []IPPool `json:"pools,omitempty"`
+
+	// Nameservers is a list of DNS servers configured in the machines using this pool.
+	Nameservers []string `json:"nameservers,omitempty"`
+}
+
+// VSphereIPPoolStatus defines the observed state of VSphereIPPool.
+type VSphereIPPoolStatus struct{}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// VSphereIPPool is the Schema for the VSphereIPPools API.
+// It implements the CAPI IPAM contract, so VSphereMachineTemplates can reference it
+// to get static ips assigned to their machines instead of relying on DHCP.
+type VSphereIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VSphereIPPoolSpec   `json:"spec,omitempty"`
+	Status VSphereIPPoolStatus `json:"status,omitempty"`
+}
+
+// Validate validates the fields in a VSphereIPPool object.
+func (p *VSphereIPPool) Validate() error {
+	return validateVSphereIPPool(p)
+}
+
+// ConvertConfigToConfigGenerateStruct converts a VSphereIPPool to VSphereIPPoolGenerate object.
+func (p *VSphereIPPool) ConvertConfigToConfigGenerateStruct() *VSphereIPPoolGenerate {
+	namespace := defaultEksaNamespace
+	if p.Namespace != "" {
+		namespace = p.Namespace
+	}
+	config := &VSphereIPPoolGenerate{
+		TypeMeta: p.TypeMeta,
+		ObjectMeta: ObjectMeta{
+			Name:        p.Name,
+			Annotations: p.Annotations,
+			Namespace:   namespace,
+		},
+		Spec: p.Spec,
+	}
+
+	return config
+}
+
+// +kubebuilder:object:generate=false
+
+// VSphereIPPoolGenerate is same as VSphereIPPool except stripped down for generation of yaml file during generate clusterconfig.
+type VSphereIPPoolGenerate struct {
+	metav1.TypeMeta `json:",inline"`
+	ObjectMeta      `json:"metadata,omitempty"`
+
+	Spec VSphereIPPoolSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// VSphereIPPoolList contains a list of VSphereIPPool.
+type VSphereIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VSphereIPPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VSphereIPPool{}, &VSphereIPPoolList{})
+}
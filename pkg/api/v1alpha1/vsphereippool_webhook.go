@@ -0,0 +1,71 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var vsphereippoollog = logf.Log.WithName("vsphereippool-resource")
+
+// SetupWebhookWithManager sets up the webhook manager for VSphereIPPool.
+func (r *VSphereIPPool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-anywhere-eks-amazonaws-com-v1alpha1-vsphereippool,mutating=false,failurePolicy=fail,sideEffects=None,groups=anywhere.eks.amazonaws.com,resources=vsphereippools,verbs=create;update,versions=v1alpha1,name=validation.vsphereippool.anywhere.amazonaws.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &VSphereIPPool{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *VSphereIPPool) ValidateCreate() (admission.Warnings, error) {
+	vsphereippoollog.Info("validate create", "name", r.Name)
+
+	return nil, r.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *VSphereIPPool) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	vsphereippoollog.Info("validate update", "name", r.Name)
+
+	oldPool, ok := old.(*VSphereIPPool)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a VSphereIPPool but got a %T", old))
+	}
+
+	if allErrs := validateImmutableFieldsVSphereIPPool(r, oldPool); len(allErrs) != 0 {
+		return nil, apierrors.NewInvalid(GroupVersion.WithKind(VSphereIPPoolKind).GroupKind(), r.Name, allErrs)
+	}
+
+	return nil, r.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *VSphereIPPool) ValidateDelete() (admission.Warnings, error) {
+	vsphereippoollog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
+
+// validateImmutableFieldsVSphereIPPool only allows adding new ranges to a pool, since
+// removing or changing existing ones could leave allocated ips outside of the pool.
+func validateImmutableFieldsVSphereIPPool(new, old *VSphereIPPool) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(new.Spec.Pools) < len(old.Spec.Pools) || !SnowIPPoolsSliceEqual(new.Spec.Pools[:len(old.Spec.Pools)], old.Spec.Pools) {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(field.NewPath("spec").Child("pools"), "existing pools are immutable, only new pools can be added"),
+		)
+	}
+	return allErrs
+}
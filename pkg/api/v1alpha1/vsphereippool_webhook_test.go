@@ -0,0 +1,74 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func vsphereIPPool() *v1alpha1.VSphereIPPool {
+	return &v1alpha1.VSphereIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: v1alpha1.VSphereIPPoolSpec{
+			Pools: []v1alpha1.IPPool{
+				{
+					IPStart: "10.0.0.10",
+					IPEnd:   "10.0.0.19",
+					Subnet:  "10.0.0.0/24",
+					Gateway: "10.0.0.1",
+				},
+			},
+		},
+	}
+}
+
+func TestVSphereIPPoolValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(vsphereIPPool().ValidateCreate()).Error().To(Succeed())
+}
+
+func TestVSphereIPPoolValidateCreateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	p := vsphereIPPool()
+	p.Spec.Pools[0].Gateway = ""
+	g.Expect(p.ValidateCreate()).Error().To(MatchError("VSphereIPPool Pools[0].Gateway can not be empty"))
+}
+
+func TestVSphereIPPoolValidateUpdateAddPool(t *testing.T) {
+	g := NewWithT(t)
+	p := vsphereIPPool()
+	p.Spec.Pools = append(p.Spec.Pools, v1alpha1.IPPool{
+		IPStart: "10.0.0.30",
+		IPEnd:   "10.0.0.39",
+		Subnet:  "10.0.0.0/24",
+		Gateway: "10.0.0.1",
+	})
+	g.Expect(p.ValidateUpdate(vsphereIPPool())).Error().To(Succeed())
+}
+
+func TestVSphereIPPoolValidateUpdateChangePool(t *testing.T) {
+	g := NewWithT(t)
+	p := vsphereIPPool()
+	p.Spec.Pools[0].IPEnd = "10.0.0.15"
+	g.Expect(p.ValidateUpdate(vsphereIPPool())).Error().To(MatchError(ContainSubstring("existing pools are immutable")))
+}
+
+func TestVSphereIPPoolValidateUpdateRemovePool(t *testing.T) {
+	g := NewWithT(t)
+	p := vsphereIPPool()
+	p.Spec.Pools = nil
+	g.Expect(p.ValidateUpdate(vsphereIPPool())).Error().To(MatchError(ContainSubstring("existing pools are immutable")))
+}
+
+func TestVSphereIPPoolValidateUpdateInvalidObject(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(vsphereIPPool().ValidateUpdate(&v1alpha1.Cluster{})).Error().To(MatchError(ContainSubstring("expected a VSphereIPPool")))
+}
+
+func TestVSphereIPPoolValidateDelete(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(vsphereIPPool().ValidateDelete()).Error().To(Succeed())
+}
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -77,6 +78,13 @@ func setVSphereMachineConfigDefaults(machineConfig *VSphereMachineConfig) {
 	}
 }
 
+// SetAdditionalNetworksFullPath converts the relative paths of the additional networks to full inventory paths in the datacenter.
+func (c *VSphereMachineConfig) SetAdditionalNetworksFullPath(datacenter string) {
+	for i := range c.Spec.AdditionalNetworks {
+		c.Spec.AdditionalNetworks[i].Network = generateFullVCenterPath(networkFolderType, c.Spec.AdditionalNetworks[i].Network, datacenter)
+	}
+}
+
 func validateVSphereMachineConfig(config *VSphereMachineConfig) error {
 	if len(config.Spec.Datastore) <= 0 {
 		return fmt.Errorf("VSphereMachineConfig %s datastore is not set or is empty", config.Name)
@@ -93,6 +101,92 @@ func validateVSphereMachineConfig(config *VSphereMachineConfig) error {
 	if err := validateHostOSConfig(config.Spec.HostOSConfiguration, config.Spec.OSFamily); err != nil {
 		return fmt.Errorf("HostOSConfiguration is invalid for VSphereMachineConfig %s: %v", config.Name, err)
 	}
+	if err := validateVSphereMachineConfigIPPoolRef(config, config.Spec.IPPoolRef, "ipPoolRef"); err != nil {
+		return err
+	}
+	if err := validateVSphereMachineConfigAdditionalNetworks(config); err != nil {
+		return err
+	}
+	if err := validateVSphereMachineConfigDataDisks(config); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateVSphereMachineConfigIPPoolRef(config *VSphereMachineConfig, ref *Ref, fieldName string) error {
+	if ref == nil {
+		return nil
+	}
+	if ref.Kind != VSphereIPPoolKind {
+		return fmt.Errorf("VSphereMachineConfig %s %s kind %s is not supported, please use %s", config.Name, fieldName, ref.Kind, VSphereIPPoolKind)
+	}
+	if ref.Name == "" {
+		return fmt.Errorf("VSphereMachineConfig %s %s name is not set or is empty", config.Name, fieldName)
+	}
+	// Static ips are configured through the cloud-init network metadata, which Bottlerocket doesn't read.
+	if config.Spec.OSFamily == Bottlerocket {
+		return fmt.Errorf("VSphereMachineConfig %s %s is not supported for osFamily %s", config.Name, fieldName, Bottlerocket)
+	}
+
+	return nil
+}
+
+func validateVSphereMachineConfigAdditionalNetworks(config *VSphereMachineConfig) error {
+	if len(config.Spec.AdditionalNetworks) > 0 && config.Spec.OSFamily == Bottlerocket {
+		return fmt.Errorf("VSphereMachineConfig %s additionalNetworks is not supported for osFamily %s", config.Name, Bottlerocket)
+	}
+
+	for i, n := range config.Spec.AdditionalNetworks {
+		if n.Network == "" {
+			return fmt.Errorf("VSphereMachineConfig %s additionalNetworks[%d].network is not set or is empty", config.Name, i)
+		}
+		if err := validateVSphereMachineConfigIPPoolRef(config, n.IPPoolRef, fmt.Sprintf("additionalNetworks[%d].ipPoolRef", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateVSphereMachineConfigDataDisks(config *VSphereMachineConfig) error {
+	if len(config.Spec.DataDisks) > 0 && config.Spec.OSFamily == Bottlerocket {
+		return fmt.Errorf("VSphereMachineConfig %s dataDisks is not supported for osFamily %s", config.Name, Bottlerocket)
+	}
+
+	devices := map[string]struct{}{}
+	mountPaths := map[string]struct{}{}
+	labels := map[string]struct{}{}
+	for i, d := range config.Spec.DataDisks {
+		if d.SizeGiB <= 0 {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].sizeGiB must be greater than 0", config.Name, i)
+		}
+		if !strings.HasPrefix(d.Device, "/dev/") {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].device %s is invalid, it must start with /dev/", config.Name, i, d.Device)
+		}
+		if len(d.MountPath) < 2 || !strings.HasPrefix(d.MountPath, "/") {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].mountPath %s is invalid, it must be non-empty and start with /", config.Name, i, d.MountPath)
+		}
+		if d.Filesystem == "" {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].filesystem is not set or is empty", config.Name, i)
+		}
+		if d.Label == "" {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].label is not set or is empty", config.Name, i)
+		}
+
+		if _, ok := devices[d.Device]; ok {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].device %s is duplicated", config.Name, i, d.Device)
+		}
+		if _, ok := mountPaths[d.MountPath]; ok {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].mountPath %s is duplicated", config.Name, i, d.MountPath)
+		}
+		if _, ok := labels[d.Label]; ok {
+			return fmt.Errorf("VSphereMachineConfig %s dataDisks[%d].label %s is duplicated", config.Name, i, d.Label)
+		}
+		devices[d.Device] = struct{}{}
+		mountPaths[d.MountPath] = struct{}{}
+		labels[d.Label] = struct{}{}
+	}
 
 	return nil
 }
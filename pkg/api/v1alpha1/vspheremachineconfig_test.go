@@ -359,3 +359,201 @@ func TestVSphereMachineConfigSetDefaultUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestVSphereMachineConfigValidateIPPoolRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		osFamily OSFamily
+		ref      *Ref
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			osFamily: Ubuntu,
+			ref:      &Ref{Kind: VSphereIPPoolKind, Name: "pool"},
+		},
+		{
+			name:     "invalid kind",
+			osFamily: Ubuntu,
+			ref:      &Ref{Kind: SnowIPPoolKind, Name: "pool"},
+			wantErr:  "VSphereMachineConfig test ipPoolRef kind SnowIPPool is not supported, please use VSphereIPPool",
+		},
+		{
+			name:     "missing name",
+			osFamily: RedHat,
+			ref:      &Ref{Kind: VSphereIPPoolKind},
+			wantErr:  "VSphereMachineConfig test ipPoolRef name is not set or is empty",
+		},
+		{
+			name:     "bottlerocket",
+			osFamily: Bottlerocket,
+			ref:      &Ref{Kind: VSphereIPPoolKind, Name: "pool"},
+			wantErr:  "VSphereMachineConfig test ipPoolRef is not supported for osFamily bottlerocket",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: VSphereMachineConfigSpec{
+					ResourcePool: "poolA",
+					Datastore:    "ds-aaa",
+					OSFamily:     tt.osFamily,
+					IPPoolRef:    tt.ref,
+					Users: []UserConfiguration{
+						{
+							Name:              "capv",
+							SshAuthorizedKeys: []string{"ssh_rsa"},
+						},
+					},
+				},
+			}
+			if tt.osFamily == Bottlerocket {
+				config.Spec.Users[0].Name = "ec2-user"
+			}
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestVSphereMachineConfigValidateAdditionalNetworksAndDataDisks(t *testing.T) {
+	validDisk := VSphereDataDisk{SizeGiB: 50, Device: "/dev/sdb", MountPath: "/var/lib/containerd", Filesystem: "ext4", Label: "containerd"}
+	tests := []struct {
+		name      string
+		osFamily  OSFamily
+		networks  []VSphereMachineNetwork
+		dataDisks []VSphereDataDisk
+		wantErr   string
+	}{
+		{
+			name:     "valid",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{
+				{Network: "/dc/network/storage"},
+				{Network: "/dc/network/other", IPPoolRef: &Ref{Kind: VSphereIPPoolKind, Name: "pool"}},
+			},
+			dataDisks: []VSphereDataDisk{validDisk},
+		},
+		{
+			name:     "network missing",
+			osFamily: Ubuntu,
+			networks: []VSphereMachineNetwork{{}},
+			wantErr:  "VSphereMachineConfig test additionalNetworks[0].network is not set or is empty",
+		},
+		{
+			name:     "network invalid pool kind",
+			osFamily: RedHat,
+			networks: []VSphereMachineNetwork{{Network: "storage", IPPoolRef: &Ref{Kind: SnowIPPoolKind, Name: "pool"}}},
+			wantErr:  "VSphereMachineConfig test additionalNetworks[0].ipPoolRef kind SnowIPPool is not supported, please use VSphereIPPool",
+		},
+		{
+			name:     "networks bottlerocket",
+			osFamily: Bottlerocket,
+			networks: []VSphereMachineNetwork{{Network: "storage"}},
+			wantErr:  "VSphereMachineConfig test additionalNetworks is not supported for osFamily bottlerocket",
+		},
+		{
+			name:      "disks bottlerocket",
+			osFamily:  Bottlerocket,
+			dataDisks: []VSphereDataDisk{validDisk},
+			wantErr:   "VSphereMachineConfig test dataDisks is not supported for osFamily bottlerocket",
+		},
+		{
+			name:      "disk size",
+			osFamily:  Ubuntu,
+			dataDisks: []VSphereDataDisk{{Device: "/dev/sdb", MountPath: "/data", Filesystem: "ext4", Label: "data"}},
+			wantErr:   "VSphereMachineConfig test dataDisks[0].sizeGiB must be greater than 0",
+		},
+		{
+			name:      "disk device",
+			osFamily:  Ubuntu,
+			dataDisks: []VSphereDataDisk{{SizeGiB: 10, Device: "sdb", MountPath: "/data", Filesystem: "ext4", Label: "data"}},
+			wantErr:   "VSphereMachineConfig test dataDisks[0].device sdb is invalid, it must start with /dev/",
+		},
+		{
+			name:      "disk mount path",
+			osFamily:  Ubuntu,
+			dataDisks: []VSphereDataDisk{{SizeGiB: 10, Device: "/dev/sdb", MountPath: "data", Filesystem: "ext4", Label: "data"}},
+			wantErr:   "VSphereMachineConfig test dataDisks[0].mountPath data is invalid, it must be non-empty and start with /",
+		},
+		{
+			name:      "disk filesystem",
+			osFamily:  Ubuntu,
+			dataDisks: []VSphereDataDisk{{SizeGiB: 10, Device: "/dev/sdb", MountPath: "/data", Label: "data"}},
+			wantErr:   "VSphereMachineConfig test dataDisks[0].filesystem is not set or is empty",
+		},
+		{
+			name:      "disk label",
+			osFamily:  Ubuntu,
+			dataDisks: []VSphereDataDisk{{SizeGiB: 10, Device: "/dev/sdb", MountPath: "/data", Filesystem: "ext4"}},
+			wantErr:   "VSphereMachineConfig test dataDisks[0].label is not set or is empty",
+		},
+		{
+			name:     "disk duplicated device",
+			osFamily: Ubuntu,
+			dataDisks: []VSphereDataDisk{
+				validDisk,
+				{SizeGiB: 10, Device: "/dev/sdb", MountPath: "/data", Filesystem: "ext4", Label: "data"},
+			},
+			wantErr: "VSphereMachineConfig test dataDisks[1].device /dev/sdb is duplicated",
+		},
+		{
+			name:     "disk duplicated mount path",
+			osFamily: Ubuntu,
+			dataDisks: []VSphereDataDisk{
+				validDisk,
+				{SizeGiB: 10, Device: "/dev/sdc", MountPath: "/var/lib/containerd", Filesystem: "ext4", Label: "data"},
+			},
+			wantErr: "VSphereMachineConfig test dataDisks[1].mountPath /var/lib/containerd is duplicated",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &VSphereMachineConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: VSphereMachineConfigSpec{
+					ResourcePool:       "poolA",
+					Datastore:          "ds-aaa",
+					OSFamily:           tt.osFamily,
+					AdditionalNetworks: tt.networks,
+					DataDisks:          tt.dataDisks,
+					Users: []UserConfiguration{
+						{
+							Name:              "capv",
+							SshAuthorizedKeys: []string{"ssh_rsa"},
+						},
+					},
+				},
+			}
+			if tt.osFamily == Bottlerocket {
+				config.Spec.Users[0].Name = "ec2-user"
+			}
+
+			err := config.Validate()
+			if tt.wantErr == "" {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestVSphereMachineConfigTotalDiskGiB(t *testing.T) {
+	g := NewWithT(t)
+	config := &VSphereMachineConfig{
+		Spec: VSphereMachineConfigSpec{
+			DiskGiB:   25,
+			DataDisks: []VSphereDataDisk{{SizeGiB: 50}, {SizeGiB: 100}},
+		},
+	}
+	g.Expect(config.TotalDiskGiB()).To(Equal(175))
+}
@@ -28,6 +28,86 @@ type VSphereMachineConfigSpec struct {
 	TagIDs              []string             `json:"tags,omitempty"`
 	CloneMode           CloneMode            `json:"cloneMode,omitempty"`
 	HostOSConfiguration *HostOSConfiguration `json:"hostOSConfiguration,omitempty"`
+	// IPPoolRef references a VSphereIPPool to assign static ips to the machines from.
+	// If not set, the machines get their ip through DHCP.
+	IPPoolRef *Ref `json:"ipPoolRef,omitempty"`
+	// AdditionalNetworks is a list of networks to attach extra network interfaces to the machines,
+	// besides the primary one connected to the VSphereDatacenterConfig network.
+	AdditionalNetworks []VSphereMachineNetwork `json:"additionalNetworks,omitempty"`
+	// DataDisks is a list of extra disks to attach to the machines, formatted and mounted on boot.
+	DataDisks []VSphereDataDisk `json:"dataDisks,omitempty"`
+}
+
+// VSphereMachineNetwork defines an additional network interface of a vSphere machine.
+type VSphereMachineNetwork struct {
+	// Network is the name or inventory path of the vSphere network the interface is connected to.
+	Network string `json:"network"`
+	// IPPoolRef references a VSphereIPPool to assign static ips to the interface from.
+	// If not set, the interface gets its ip through DHCP.
+	IPPoolRef *Ref `json:"ipPoolRef,omitempty"`
+}
+
+// VSphereDataDisk defines an additional disk of a vSphere machine.
+type VSphereDataDisk struct {
+	// SizeGiB is the size of the disk in GiB.
+	SizeGiB int `json:"sizeGiB"`
+	// Device is the device name of the disk in the VM, as shown by lsblk. For example, /dev/sdb.
+	Device string `json:"device"`
+	// MountPath is the path the disk filesystem is mounted to in the VM.
+	MountPath string `json:"mountPath"`
+	// Filesystem is the filesystem used to format the disk partition.
+	Filesystem string `json:"filesystem"`
+	// Label is the label of the disk partition, used to mount it.
+	Label string `json:"label"`
+}
+
+// IPPoolNames returns the names of the VSphereIPPools referenced by the machine config, one per network interface using a pool.
+func (c *VSphereMachineConfig) IPPoolNames() []string {
+	var names []string
+	if c.Spec.IPPoolRef != nil {
+		names = append(names, c.Spec.IPPoolRef.Name)
+	}
+	for _, n := range c.Spec.AdditionalNetworks {
+		if n.IPPoolRef != nil {
+			names = append(names, n.IPPoolRef.Name)
+		}
+	}
+	return names
+}
+
+// TotalDiskGiB returns the sum of the sizes of the main disk and the data disks of the machines.
+func (c *VSphereMachineConfig) TotalDiskGiB() int {
+	total := c.Spec.DiskGiB
+	for _, d := range c.Spec.DataDisks {
+		total += d.SizeGiB
+	}
+	return total
+}
+
+// AdditionalNetworksEqual checks if two lists of additional networks are the same, in the same order.
+func AdditionalNetworksEqual(a, b []VSphereMachineNetwork) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Network != b[i].Network || !a[i].IPPoolRef.Equal(b[i].IPPoolRef) {
+			return false
+		}
+	}
+	return true
+}
+
+// DataDisksEqual checks if two lists of data disks are the same, in the same order.
+func DataDisksEqual(a, b []VSphereDataDisk) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // ResourcePaths returns a map of vSphere resource paths defined in the VSphereMachineConfig.
@@ -5,14 +5,40 @@ import (
 	"errors"
 	"fmt"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/types"
 )
 
+// clusterctlLabel is set by clusterctl on every object it installs, including cert-manager.
+const clusterctlLabel = "clusterctl.cluster.x-k8s.io"
+
+// clusterctlResourceTypes are the resource types installed by clusterctl that are removed
+// from an existing bootstrap cluster during cleanup.
+var clusterctlResourceTypes = []string{
+	"validatingwebhookconfigurations",
+	"mutatingwebhookconfigurations",
+	"clusterrolebindings",
+	"clusterroles",
+	"customresourcedefinitions",
+	"namespaces",
+}
+
+// eksaClusterObjects are the cluster scoped objects installed by the EKS-A components.
+var eksaClusterObjects = []struct{ resourceType, name string }{
+	{"validatingwebhookconfigurations", "eksa-validating-webhook-configuration"},
+	{"mutatingwebhookconfigurations", "eksa-mutating-webhook-configuration"},
+	{"clusterrolebindings", "eksa-manager-rolebinding"},
+	{"clusterroles", "eksa-manager-role"},
+}
+
 type Bootstrapper struct {
-	clusterClient ClusterClient
+	clusterClient             ClusterClient
+	existingClusterKubeconfig string
 }
 
 type ClusterClient interface {
@@ -27,6 +53,13 @@ type ClusterClient interface {
 	WithExtraDockerMounts() BootstrapClusterClientOption
 	WithExtraPortMappings([]int) BootstrapClusterClientOption
 	WithEnv(env map[string]string) BootstrapClusterClientOption
+	NamespaceExists(ctx context.Context, kubeconfig, namespace string) (bool, error)
+	DeleteNamespace(ctx context.Context, kubeconfig, namespace string) error
+	DeleteClusterObject(ctx context.Context, kubeconfig, resourceType, name string) error
+	DeleteObjectsWithLabel(ctx context.Context, kubeconfig, resourceType, label string) error
+	GetEKSAClusters(ctx context.Context, kubeconfig string) ([]v1alpha1.Cluster, error)
+	GetCRDs(ctx context.Context, kubeconfig string) ([]apiextensionsv1.CustomResourceDefinition, error)
+	RemoveFinalizers(ctx context.Context, kubeconfig, resourceType, name, namespace string) error
 }
 
 type (
@@ -34,14 +67,41 @@ type (
 	BootstrapClusterOption       func(b *Bootstrapper) BootstrapClusterClientOption
 )
 
+// Opt allows to customize a Bootstrapper on construction.
+type Opt func(*Bootstrapper)
+
+// WithExistingCluster configures the Bootstrapper to use the Kubernetes cluster
+// accessible with kubeconfig as bootstrap cluster instead of creating a kind cluster.
+func WithExistingCluster(kubeconfig string) Opt {
+	return func(b *Bootstrapper) {
+		b.existingClusterKubeconfig = kubeconfig
+	}
+}
+
 // New constructs a new bootstrapper.
-func New(clusterClient ClusterClient) *Bootstrapper {
-	return &Bootstrapper{
+func New(clusterClient ClusterClient, opts ...Opt) *Bootstrapper {
+	b := &Bootstrapper{
 		clusterClient: clusterClient,
 	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// UsesExistingCluster returns true if the bootstrap cluster is an existing cluster
+// provided by the user instead of a kind cluster.
+func (b *Bootstrapper) UsesExistingCluster() bool {
+	return b.existingClusterKubeconfig != ""
 }
 
 func (b *Bootstrapper) CreateBootstrapCluster(ctx context.Context, clusterSpec *cluster.Spec, opts ...BootstrapClusterOption) (*types.Cluster, error) {
+	if b.UsesExistingCluster() {
+		return b.setupExistingCluster(ctx, clusterSpec)
+	}
+
 	kubeconfigFile, err := b.clusterClient.CreateBootstrapCluster(ctx, clusterSpec, b.getClientOptions(opts)...)
 	if err != nil {
 		return nil, fmt.Errorf("creating bootstrap cluster: %v", err)
@@ -60,6 +120,10 @@ func (b *Bootstrapper) CreateBootstrapCluster(ctx context.Context, clusterSpec *
 }
 
 func (b *Bootstrapper) DeleteBootstrapCluster(ctx context.Context, cluster *types.Cluster, operationType constants.Operation, isForceCleanup bool) error {
+	if b.UsesExistingCluster() {
+		return b.cleanupExistingCluster(ctx, cluster, operationType, isForceCleanup)
+	}
+
 	clusterExists, err := b.clusterClient.KindClusterExists(ctx, cluster.Name)
 	if err != nil {
 		return fmt.Errorf("deleting bootstrap cluster: %v", err)
@@ -68,6 +132,14 @@ func (b *Bootstrapper) DeleteBootstrapCluster(ctx context.Context, cluster *type
 		logger.V(4).Info("Skipping delete bootstrap cluster, cluster doesn't exist")
 		return nil
 	}
+	if err := b.validateNoManagementInCluster(ctx, cluster, operationType, isForceCleanup); err != nil {
+		return err
+	}
+
+	return b.clusterClient.DeleteKindCluster(ctx, cluster)
+}
+
+func (b *Bootstrapper) validateNoManagementInCluster(ctx context.Context, cluster *types.Cluster, operationType constants.Operation, isForceCleanup bool) error {
 	mgmtCluster, err := b.managementInCluster(ctx, cluster)
 	if err != nil {
 		return fmt.Errorf("deleting bootstrap cluster: %v", err)
@@ -79,7 +151,123 @@ func (b *Bootstrapper) DeleteBootstrapCluster(ctx context.Context, cluster *type
 		}
 	}
 
-	return b.clusterClient.DeleteKindCluster(ctx, cluster)
+	return nil
+}
+
+// setupExistingCluster prepares an existing cluster to be used as bootstrap cluster.
+// The cluster can't have Cluster API or EKS-A already installed, since all the components
+// installed in it are removed during cleanup.
+func (b *Bootstrapper) setupExistingCluster(ctx context.Context, clusterSpec *cluster.Spec) (*types.Cluster, error) {
+	kubeconfig := b.existingClusterKubeconfig
+	for _, namespace := range []string{constants.EksaSystemNamespace, constants.CapiSystemNamespace} {
+		exists, err := b.clusterClient.NamespaceExists(ctx, kubeconfig, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("validating existing bootstrap cluster: %v", err)
+		}
+		if exists {
+			return nil, fmt.Errorf("existing bootstrap cluster already contains namespace %s, a cluster without EKS-A or Cluster API components is required", namespace)
+		}
+	}
+
+	c := &types.Cluster{
+		Name:           clusterSpec.Cluster.Name,
+		KubeconfigFile: kubeconfig,
+	}
+
+	if err := b.clusterClient.CreateNamespace(ctx, c.KubeconfigFile, constants.EksaSystemNamespace); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// cleanupExistingCluster removes the EKS-A and Cluster API components installed in an existing
+// bootstrap cluster, leaving the cluster itself untouched.
+func (b *Bootstrapper) cleanupExistingCluster(ctx context.Context, cluster *types.Cluster, operationType constants.Operation, isForceCleanup bool) error {
+	kubeconfig := b.existingClusterKubeconfig
+	exists, err := b.clusterClient.NamespaceExists(ctx, kubeconfig, constants.EksaSystemNamespace)
+	if err != nil {
+		return fmt.Errorf("cleaning up existing bootstrap cluster: %v", err)
+	}
+	if !exists {
+		logger.V(4).Info("Skipping cleanup of existing bootstrap cluster, EKS-A components are not installed")
+		return nil
+	}
+
+	c := &types.Cluster{
+		Name:           cluster.Name,
+		KubeconfigFile: kubeconfig,
+	}
+	if err := b.validateNoManagementInCluster(ctx, c, operationType, isForceCleanup); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Removing EKS-A components from existing bootstrap cluster")
+	if err := b.deleteEKSAComponents(ctx, kubeconfig); err != nil {
+		return fmt.Errorf("cleaning up existing bootstrap cluster: %v", err)
+	}
+
+	logger.V(3).Info("Removing Cluster API components from existing bootstrap cluster")
+	for _, resourceType := range clusterctlResourceTypes {
+		if err := b.clusterClient.DeleteObjectsWithLabel(ctx, kubeconfig, resourceType, clusterctlLabel); err != nil {
+			return fmt.Errorf("cleaning up existing bootstrap cluster: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteEKSAComponents removes the EKS-A controller and CRDs, together with all the EKS-A objects
+// left behind in the cluster. The controller is removed first so the finalizers in the EKS-A
+// clusters can be removed without it acting on their deletion.
+func (b *Bootstrapper) deleteEKSAComponents(ctx context.Context, kubeconfig string) error {
+	for _, o := range eksaClusterObjects {
+		if err := b.clusterClient.DeleteClusterObject(ctx, kubeconfig, o.resourceType, o.name); err != nil {
+			return err
+		}
+	}
+
+	if err := b.clusterClient.DeleteNamespace(ctx, kubeconfig, constants.EksaSystemNamespace); err != nil {
+		return err
+	}
+
+	crds, err := b.clusterClient.GetCRDs(ctx, kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	var eksaCRDs []string
+	for _, crd := range crds {
+		if crd.Spec.Group == v1alpha1.GroupVersion.Group {
+			eksaCRDs = append(eksaCRDs, crd.Name)
+		}
+	}
+
+	if len(eksaCRDs) == 0 {
+		return nil
+	}
+
+	clusters, err := b.clusterClient.GetEKSAClusters(ctx, kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range clusters {
+		if len(c.Finalizers) == 0 {
+			continue
+		}
+		if err := b.clusterClient.RemoveFinalizers(ctx, kubeconfig, c.ResourceType(), c.Name, c.Namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, crd := range eksaCRDs {
+		if err := b.clusterClient.DeleteClusterObject(ctx, kubeconfig, "customresourcedefinitions", crd); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (b *Bootstrapper) managementInCluster(ctx context.Context, cluster *types.Cluster) (*types.CAPICluster, error) {
@@ -7,8 +7,11 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/bootstrapper"
 	"github.com/aws/eks-anywhere/pkg/bootstrapper/mocks"
 	"github.com/aws/eks-anywhere/pkg/cluster"
@@ -289,6 +292,156 @@ func TestBootstrapperDeleteBootstrapClusterUpgrade(t *testing.T) {
 	}
 }
 
+func TestBootstrapperCreateBootstrapClusterExistingCluster(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	clusterName := "cluster-name"
+	clusterSpec, wantCluster := given(t, clusterName, kubeconfigFile)
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(false, nil)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.CapiSystemNamespace).Return(false, nil)
+	client.EXPECT().CreateNamespace(ctx, kubeconfigFile, constants.EksaSystemNamespace)
+
+	got, err := b.CreateBootstrapCluster(ctx, clusterSpec, bootstrapper.WithExtraDockerMounts())
+	if err != nil {
+		t.Fatalf("Bootstrapper.CreateBootstrapCluster() error = %v, wantErr nil", err)
+	}
+
+	if !reflect.DeepEqual(got, wantCluster) {
+		t.Fatalf("Bootstrapper.CreateBootstrapCluster() cluster = %#v, want %#v", got, wantCluster)
+	}
+}
+
+func TestBootstrapperCreateBootstrapClusterExistingClusterWithCAPI(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	clusterSpec, _ := given(t, "cluster-name", kubeconfigFile)
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(false, nil)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.CapiSystemNamespace).Return(true, nil)
+
+	_, err := b.CreateBootstrapCluster(ctx, clusterSpec)
+	if err == nil {
+		t.Fatal("Bootstrapper.CreateBootstrapCluster() error == nil, want existing namespace error")
+	}
+}
+
+func TestBootstrapperCreateBootstrapClusterExistingClusterUnreachable(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	clusterSpec, _ := given(t, "cluster-name", kubeconfigFile)
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(false, errors.New("connection refused"))
+
+	_, err := b.CreateBootstrapCluster(ctx, clusterSpec)
+	if err == nil {
+		t.Fatal("Bootstrapper.CreateBootstrapCluster() error == nil, wantErr connection refused")
+	}
+}
+
+func TestBootstrapperDeleteBootstrapClusterExistingCluster(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	cluster := &types.Cluster{
+		Name:           "cluster-name",
+		KubeconfigFile: kubeconfigFile,
+	}
+	eksaCluster := v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "cluster-name",
+			Namespace:  "default",
+			Finalizers: []string{"clusters.anywhere.eks.amazonaws.com/finalizer"},
+		},
+	}
+	crds := []apiextensionsv1.CustomResourceDefinition{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "clusters.anywhere.eks.amazonaws.com"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "anywhere.eks.amazonaws.com"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "clusters.cluster.x-k8s.io"},
+			Spec:       apiextensionsv1.CustomResourceDefinitionSpec{Group: "cluster.x-k8s.io"},
+		},
+	}
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	gomock.InOrder(
+		client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(true, nil),
+		client.EXPECT().GetCAPIClusterCRD(ctx, cluster).Return(nil),
+		client.EXPECT().GetCAPIClusters(ctx, cluster).Return(nil, nil),
+		client.EXPECT().DeleteClusterObject(ctx, kubeconfigFile, "validatingwebhookconfigurations", "eksa-validating-webhook-configuration"),
+		client.EXPECT().DeleteClusterObject(ctx, kubeconfigFile, "mutatingwebhookconfigurations", "eksa-mutating-webhook-configuration"),
+		client.EXPECT().DeleteClusterObject(ctx, kubeconfigFile, "clusterrolebindings", "eksa-manager-rolebinding"),
+		client.EXPECT().DeleteClusterObject(ctx, kubeconfigFile, "clusterroles", "eksa-manager-role"),
+		client.EXPECT().DeleteNamespace(ctx, kubeconfigFile, constants.EksaSystemNamespace),
+		client.EXPECT().GetCRDs(ctx, kubeconfigFile).Return(crds, nil),
+		client.EXPECT().GetEKSAClusters(ctx, kubeconfigFile).Return([]v1alpha1.Cluster{eksaCluster}, nil),
+		client.EXPECT().RemoveFinalizers(ctx, kubeconfigFile, "clusters.anywhere.eks.amazonaws.com", "cluster-name", "default"),
+		client.EXPECT().DeleteClusterObject(ctx, kubeconfigFile, "customresourcedefinitions", "clusters.anywhere.eks.amazonaws.com"),
+	)
+	for _, resourceType := range []string{
+		"validatingwebhookconfigurations",
+		"mutatingwebhookconfigurations",
+		"clusterrolebindings",
+		"clusterroles",
+		"customresourcedefinitions",
+		"namespaces",
+	} {
+		client.EXPECT().DeleteObjectsWithLabel(ctx, kubeconfigFile, resourceType, "clusterctl.cluster.x-k8s.io")
+	}
+
+	if err := b.DeleteBootstrapCluster(ctx, cluster, constants.Create, false); err != nil {
+		t.Fatalf("Bootstrapper.DeleteBootstrapCluster() error = %v, wantErr nil", err)
+	}
+}
+
+func TestBootstrapperDeleteBootstrapClusterExistingClusterNotInstalled(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	cluster := &types.Cluster{
+		Name:           "cluster-name",
+		KubeconfigFile: kubeconfigFile,
+	}
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(false, nil)
+
+	if err := b.DeleteBootstrapCluster(ctx, cluster, constants.Delete, false); err != nil {
+		t.Fatalf("Bootstrapper.DeleteBootstrapCluster() error = %v, wantErr nil", err)
+	}
+}
+
+func TestBootstrapperDeleteBootstrapClusterExistingClusterWithManagement(t *testing.T) {
+	kubeconfigFile := "existing.kubeconfig"
+	cluster := &types.Cluster{
+		Name:           "cluster-name",
+		KubeconfigFile: kubeconfigFile,
+	}
+	capiClusters := []types.CAPICluster{
+		{
+			Metadata: types.Metadata{
+				Name: "cluster-name",
+			},
+			Status: types.ClusterStatus{
+				Phase: "Provisioned",
+			},
+		},
+	}
+
+	ctx := context.Background()
+	b, client := newExistingClusterBootstrapper(t, kubeconfigFile)
+	client.EXPECT().NamespaceExists(ctx, kubeconfigFile, constants.EksaSystemNamespace).Return(true, nil)
+	client.EXPECT().GetCAPIClusterCRD(ctx, cluster).Return(nil)
+	client.EXPECT().GetCAPIClusters(ctx, cluster).Return(capiClusters, nil)
+
+	if err := b.DeleteBootstrapCluster(ctx, cluster, constants.Create, false); err == nil {
+		t.Fatal("Bootstrapper.DeleteBootstrapCluster() error == nil, want management cluster in bootstrap cluster error")
+	}
+}
+
 func newBootstrapper(t *testing.T) (*bootstrapper.Bootstrapper, *mocks.MockClusterClient) {
 	mockCtrl := gomock.NewController(t)
 
@@ -297,6 +450,14 @@ func newBootstrapper(t *testing.T) (*bootstrapper.Bootstrapper, *mocks.MockClust
 	return b, client
 }
 
+func newExistingClusterBootstrapper(t *testing.T, kubeconfig string) (*bootstrapper.Bootstrapper, *mocks.MockClusterClient) {
+	mockCtrl := gomock.NewController(t)
+
+	client := mocks.NewMockClusterClient(mockCtrl)
+	b := bootstrapper.New(client, bootstrapper.WithExistingCluster(kubeconfig))
+	return b, client
+}
+
 func given(t *testing.T, clusterName, kubeconfig string) (clusterSpec *cluster.Spec, wantCluster *types.Cluster) {
 	return test.NewClusterSpec(func(s *cluster.Spec) {
 			s.Cluster.Name = clusterName
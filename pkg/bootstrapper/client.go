@@ -2,11 +2,21 @@ package bootstrapper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 // KindClient is a Kind client.
@@ -26,6 +36,11 @@ type KubernetesClient interface {
 	GetClusters(ctx context.Context, cluster *types.Cluster) ([]types.CAPICluster, error)
 	ValidateClustersCRD(ctx context.Context, cluster *types.Cluster) error
 	CreateNamespaceIfNotPresent(ctx context.Context, kubeconfig string, namespace string) error
+	DeleteNamespace(ctx context.Context, kubeconfig string, namespace string) error
+	Get(ctx context.Context, resourceType, kubeconfig string, obj runtime.Object, opts ...kubernetes.KubectlGetOption) error
+	Delete(ctx context.Context, resourceType, kubeconfig string, opts ...kubernetes.KubectlDeleteOption) error
+	DeleteClusterObject(ctx context.Context, resourceType, name, kubeconfig string) error
+	MergePatchResource(ctx context.Context, resource, name, patch, kubeconfig, namespace string) error
 }
 
 // RetrierClientOpt allows to customize a RetrierClient
@@ -147,3 +162,114 @@ func (c RetrierClient) DeleteKindCluster(ctx context.Context, cluster *types.Clu
 		},
 	)
 }
+
+// NamespaceExists checks whether a namespace exists in a K8s cluster.
+func (c RetrierClient) NamespaceExists(ctx context.Context, kubeconfig, namespace string) (bool, error) {
+	var exists bool
+	err := c.retrier.Retry(
+		func() error {
+			err := c.k8s.Get(ctx, "namespace", kubeconfig, &corev1.Namespace{},
+				&kubernetes.KubectlGetOptions{Name: namespace, ClusterScoped: ptr.Bool(true)},
+			)
+			if apierrors.IsNotFound(err) {
+				exists = false
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			exists = true
+			return nil
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// DeleteNamespace deletes a namespace and all the objects it contains.
+func (c RetrierClient) DeleteNamespace(ctx context.Context, kubeconfig, namespace string) error {
+	return c.retrier.Retry(
+		func() error {
+			return c.k8s.DeleteNamespace(ctx, kubeconfig, namespace)
+		},
+	)
+}
+
+// DeleteClusterObject deletes a cluster scoped object. It doesn't fail if the object doesn't exist.
+func (c RetrierClient) DeleteClusterObject(ctx context.Context, kubeconfig, resourceType, name string) error {
+	return c.retrier.Retry(
+		func() error {
+			err := c.k8s.DeleteClusterObject(ctx, resourceType, name, kubeconfig)
+			if err != nil && !strings.Contains(err.Error(), "NotFound") {
+				return err
+			}
+			return nil
+		},
+	)
+}
+
+// DeleteObjectsWithLabel deletes all the objects of a type that have a label, in all namespaces.
+func (c RetrierClient) DeleteObjectsWithLabel(ctx context.Context, kubeconfig, resourceType, label string) error {
+	return c.retrier.Retry(
+		func() error {
+			return c.k8s.Delete(ctx, resourceType, kubeconfig,
+				&kubernetes.KubectlDeleteOptions{HasLabels: map[string]string{label: ""}},
+			)
+		},
+	)
+}
+
+// GetEKSAClusters gets all the EKS-A clusters in a K8s cluster.
+func (c RetrierClient) GetEKSAClusters(ctx context.Context, kubeconfig string) ([]anywherev1.Cluster, error) {
+	clusters := &anywherev1.ClusterList{}
+	err := c.retrier.Retry(
+		func() error {
+			err := c.k8s.Get(ctx, "clusters.anywhere.eks.amazonaws.com", kubeconfig, clusters)
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusters.Items, nil
+}
+
+// GetCRDs gets all the CRDs in a K8s cluster.
+func (c RetrierClient) GetCRDs(ctx context.Context, kubeconfig string) ([]apiextensionsv1.CustomResourceDefinition, error) {
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	err := c.retrier.Retry(
+		func() error {
+			err := c.k8s.Get(ctx, "customresourcedefinitions", kubeconfig, crds,
+				&kubernetes.KubectlGetOptions{ClusterScoped: ptr.Bool(true)},
+			)
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return crds.Items, nil
+}
+
+// RemoveFinalizers removes all the finalizers from an object.
+func (c RetrierClient) RemoveFinalizers(ctx context.Context, kubeconfig, resourceType, name, namespace string) error {
+	return c.retrier.Retry(
+		func() error {
+			if err := c.k8s.MergePatchResource(ctx, resourceType, name, `{"metadata":{"finalizers":null}}`, kubeconfig, namespace); err != nil {
+				return fmt.Errorf("removing finalizers from %s %s: %v", resourceType, name, err)
+			}
+			return nil
+		},
+	)
+}
@@ -7,10 +7,14 @@ import (
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/bootstrapper"
 	"github.com/aws/eks-anywhere/pkg/bootstrapper/mocks"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/retrier"
 	"github.com/aws/eks-anywhere/pkg/types"
@@ -153,3 +157,43 @@ func TestRetrierClientDeleteKindClusterError(t *testing.T) {
 	tt.kind.EXPECT().DeleteBootstrapCluster(tt.ctx, tt.cluster).Return(nil).AnyTimes()
 	tt.Expect(tt.r.DeleteKindCluster(tt.ctx, tt.cluster)).To(MatchError(ContainSubstring("error in DeleteBootstrapCluster")), "retrierClient.DeleteKindCluster() should fail after 5 tries")
 }
+
+func TestRetrierClientNamespaceExists(t *testing.T) {
+	tt := newRetrierTest(t)
+	tt.k8s.EXPECT().Get(tt.ctx, "namespace", "kubeconfig", &corev1.Namespace{}, gomock.Any()).Return(errors.New("error in get")).Times(4)
+	tt.k8s.EXPECT().Get(tt.ctx, "namespace", "kubeconfig", &corev1.Namespace{}, gomock.Any()).Return(nil).Times(1)
+	exists, err := tt.r.NamespaceExists(tt.ctx, "kubeconfig", "eksa-system")
+	tt.Expect(err).To(Succeed(), "retrierClient.NamespaceExists() should succeed after 5 tries")
+	tt.Expect(exists).To(BeTrue())
+}
+
+func TestRetrierClientNamespaceExistsNotFound(t *testing.T) {
+	tt := newRetrierTest(t)
+	tt.k8s.EXPECT().Get(tt.ctx, "namespace", "kubeconfig", &corev1.Namespace{}, gomock.Any()).
+		Return(apierrors.NewNotFound(schema.GroupResource{Resource: "namespace"}, "eksa-system"))
+	exists, err := tt.r.NamespaceExists(tt.ctx, "kubeconfig", "eksa-system")
+	tt.Expect(err).To(Succeed())
+	tt.Expect(exists).To(BeFalse())
+}
+
+func TestRetrierClientDeleteClusterObjectNotFound(t *testing.T) {
+	tt := newRetrierTest(t)
+	tt.k8s.EXPECT().DeleteClusterObject(tt.ctx, "clusterroles", "eksa-manager-role", "kubeconfig").
+		Return(errors.New("Error from server (NotFound): clusterroles.rbac.authorization.k8s.io \"eksa-manager-role\" not found"))
+	tt.Expect(tt.r.DeleteClusterObject(tt.ctx, "kubeconfig", "clusterroles", "eksa-manager-role")).To(Succeed())
+}
+
+func TestRetrierClientDeleteObjectsWithLabel(t *testing.T) {
+	tt := newRetrierTest(t)
+	opts := &kubernetes.KubectlDeleteOptions{HasLabels: map[string]string{"clusterctl.cluster.x-k8s.io": ""}}
+	tt.k8s.EXPECT().Delete(tt.ctx, "namespaces", "kubeconfig", opts).Return(errors.New("error in delete")).Times(4)
+	tt.k8s.EXPECT().Delete(tt.ctx, "namespaces", "kubeconfig", opts).Return(nil).Times(1)
+	tt.Expect(tt.r.DeleteObjectsWithLabel(tt.ctx, "kubeconfig", "namespaces", "clusterctl.cluster.x-k8s.io")).To(Succeed(), "retrierClient.DeleteObjectsWithLabel() should succeed after 5 tries")
+}
+
+func TestRetrierClientRemoveFinalizersError(t *testing.T) {
+	tt := newRetrierTest(t)
+	tt.k8s.EXPECT().MergePatchResource(tt.ctx, "clusters.anywhere.eks.amazonaws.com", "cluster-name", `{"metadata":{"finalizers":null}}`, "kubeconfig", "default").
+		Return(errors.New("error in patch")).Times(5)
+	tt.Expect(tt.r.RemoveFinalizers(tt.ctx, "kubeconfig", "clusters.anywhere.eks.amazonaws.com", "cluster-name", "default")).To(MatchError(ContainSubstring("error in patch")), "retrierClient.RemoveFinalizers() should fail after 5 tries")
+}
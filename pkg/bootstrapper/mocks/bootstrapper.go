@@ -8,10 +8,12 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	bootstrapper "github.com/aws/eks-anywhere/pkg/bootstrapper"
 	cluster "github.com/aws/eks-anywhere/pkg/cluster"
 	types "github.com/aws/eks-anywhere/pkg/types"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 // MockClusterClient is a mock of ClusterClient interface.
@@ -85,6 +87,20 @@ func (mr *MockClusterClientMockRecorder) CreateNamespace(arg0, arg1, arg2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespace", reflect.TypeOf((*MockClusterClient)(nil).CreateNamespace), arg0, arg1, arg2)
 }
 
+// DeleteClusterObject mocks base method.
+func (m *MockClusterClient) DeleteClusterObject(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterObject", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterObject indicates an expected call of DeleteClusterObject.
+func (mr *MockClusterClientMockRecorder) DeleteClusterObject(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterObject", reflect.TypeOf((*MockClusterClient)(nil).DeleteClusterObject), arg0, arg1, arg2, arg3)
+}
+
 // DeleteKindCluster mocks base method.
 func (m *MockClusterClient) DeleteKindCluster(arg0 context.Context, arg1 *types.Cluster) error {
 	m.ctrl.T.Helper()
@@ -99,6 +115,34 @@ func (mr *MockClusterClientMockRecorder) DeleteKindCluster(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKindCluster", reflect.TypeOf((*MockClusterClient)(nil).DeleteKindCluster), arg0, arg1)
 }
 
+// DeleteNamespace mocks base method.
+func (m *MockClusterClient) DeleteNamespace(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockClusterClientMockRecorder) DeleteNamespace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockClusterClient)(nil).DeleteNamespace), arg0, arg1, arg2)
+}
+
+// DeleteObjectsWithLabel mocks base method.
+func (m *MockClusterClient) DeleteObjectsWithLabel(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithLabel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithLabel indicates an expected call of DeleteObjectsWithLabel.
+func (mr *MockClusterClientMockRecorder) DeleteObjectsWithLabel(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithLabel", reflect.TypeOf((*MockClusterClient)(nil).DeleteObjectsWithLabel), arg0, arg1, arg2, arg3)
+}
+
 // GetCAPIClusterCRD mocks base method.
 func (m *MockClusterClient) GetCAPIClusterCRD(arg0 context.Context, arg1 *types.Cluster) error {
 	m.ctrl.T.Helper()
@@ -128,6 +172,36 @@ func (mr *MockClusterClientMockRecorder) GetCAPIClusters(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCAPIClusters", reflect.TypeOf((*MockClusterClient)(nil).GetCAPIClusters), arg0, arg1)
 }
 
+// GetCRDs mocks base method.
+func (m *MockClusterClient) GetCRDs(arg0 context.Context, arg1 string) ([]v1.CustomResourceDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCRDs", arg0, arg1)
+	ret0, _ := ret[0].([]v1.CustomResourceDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCRDs indicates an expected call of GetCRDs.
+func (mr *MockClusterClientMockRecorder) GetCRDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCRDs", reflect.TypeOf((*MockClusterClient)(nil).GetCRDs), arg0, arg1)
+}
+
+// GetEKSAClusters mocks base method.
+func (m *MockClusterClient) GetEKSAClusters(arg0 context.Context, arg1 string) ([]v1alpha1.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEKSAClusters", arg0, arg1)
+	ret0, _ := ret[0].([]v1alpha1.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEKSAClusters indicates an expected call of GetEKSAClusters.
+func (mr *MockClusterClientMockRecorder) GetEKSAClusters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEKSAClusters", reflect.TypeOf((*MockClusterClient)(nil).GetEKSAClusters), arg0, arg1)
+}
+
 // GetKindClusterKubeconfig mocks base method.
 func (m *MockClusterClient) GetKindClusterKubeconfig(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +232,35 @@ func (mr *MockClusterClientMockRecorder) KindClusterExists(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KindClusterExists", reflect.TypeOf((*MockClusterClient)(nil).KindClusterExists), arg0, arg1)
 }
 
+// NamespaceExists mocks base method.
+func (m *MockClusterClient) NamespaceExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NamespaceExists indicates an expected call of NamespaceExists.
+func (mr *MockClusterClientMockRecorder) NamespaceExists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceExists", reflect.TypeOf((*MockClusterClient)(nil).NamespaceExists), arg0, arg1, arg2)
+}
+
+// RemoveFinalizers mocks base method.
+func (m *MockClusterClient) RemoveFinalizers(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFinalizers", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFinalizers indicates an expected call of RemoveFinalizers.
+func (mr *MockClusterClientMockRecorder) RemoveFinalizers(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFinalizers", reflect.TypeOf((*MockClusterClient)(nil).RemoveFinalizers), arg0, arg1, arg2, arg3, arg4)
+}
+
 // WithEnv mocks base method.
 func (m *MockClusterClient) WithEnv(arg0 map[string]string) bootstrapper.BootstrapClusterClientOption {
 	m.ctrl.T.Helper()
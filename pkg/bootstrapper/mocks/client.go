@@ -9,9 +9,11 @@ import (
 	reflect "reflect"
 
 	bootstrapper "github.com/aws/eks-anywhere/pkg/bootstrapper"
+	kubernetes "github.com/aws/eks-anywhere/pkg/clients/kubernetes"
 	cluster "github.com/aws/eks-anywhere/pkg/cluster"
 	types "github.com/aws/eks-anywhere/pkg/types"
 	gomock "github.com/golang/mock/gomock"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // MockKindClient is a mock of KindClient interface.
@@ -194,6 +196,72 @@ func (mr *MockKubernetesClientMockRecorder) CreateNamespaceIfNotPresent(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNamespaceIfNotPresent", reflect.TypeOf((*MockKubernetesClient)(nil).CreateNamespaceIfNotPresent), arg0, arg1, arg2)
 }
 
+// Delete mocks base method.
+func (m *MockKubernetesClient) Delete(arg0 context.Context, arg1, arg2 string, arg3 ...kubernetes.KubectlDeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKubernetesClientMockRecorder) Delete(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubernetesClient)(nil).Delete), varargs...)
+}
+
+// DeleteClusterObject mocks base method.
+func (m *MockKubernetesClient) DeleteClusterObject(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterObject", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterObject indicates an expected call of DeleteClusterObject.
+func (mr *MockKubernetesClientMockRecorder) DeleteClusterObject(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterObject", reflect.TypeOf((*MockKubernetesClient)(nil).DeleteClusterObject), arg0, arg1, arg2, arg3)
+}
+
+// DeleteNamespace mocks base method.
+func (m *MockKubernetesClient) DeleteNamespace(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamespace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamespace indicates an expected call of DeleteNamespace.
+func (mr *MockKubernetesClientMockRecorder) DeleteNamespace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamespace", reflect.TypeOf((*MockKubernetesClient)(nil).DeleteNamespace), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockKubernetesClient) Get(arg0 context.Context, arg1, arg2 string, arg3 runtime.Object, arg4 ...kubernetes.KubectlGetOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockKubernetesClientMockRecorder) Get(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKubernetesClient)(nil).Get), varargs...)
+}
+
 // GetClusters mocks base method.
 func (m *MockKubernetesClient) GetClusters(arg0 context.Context, arg1 *types.Cluster) ([]types.CAPICluster, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +277,20 @@ func (mr *MockKubernetesClientMockRecorder) GetClusters(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusters", reflect.TypeOf((*MockKubernetesClient)(nil).GetClusters), arg0, arg1)
 }
 
+// MergePatchResource mocks base method.
+func (m *MockKubernetesClient) MergePatchResource(arg0 context.Context, arg1, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePatchResource", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergePatchResource indicates an expected call of MergePatchResource.
+func (mr *MockKubernetesClientMockRecorder) MergePatchResource(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePatchResource", reflect.TypeOf((*MockKubernetesClient)(nil).MergePatchResource), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // ValidateClustersCRD mocks base method.
 func (m *MockKubernetesClient) ValidateClustersCRD(arg0 context.Context, arg1 *types.Cluster) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,150 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// SSHRunner runs commands in a remote host over SSH.
+type SSHRunner interface {
+	RunCommand(ctx context.Context, privateKeyPath, username, IP string, command ...string) (string, error)
+	RunCommandWithStdin(ctx context.Context, in []byte, privateKeyPath, username, IP string, command ...string) (string, error)
+}
+
+const (
+	kubernetesPKIDir = "/etc/kubernetes/pki"
+	etcdPKIDir       = "/etc/etcd/pki"
+	kubeletCert      = "/var/lib/kubelet/pki/kubelet-client-current.pem"
+
+	notAfterPrefix = "notAfter="
+	// opensslTimeLayout is the format openssl uses for -enddate.
+	opensslTimeLayout = "Jan _2 15:04:05 2006 MST"
+)
+
+// certificateGlobs returns the paths, as shell globs, where certificates are stored
+// for a node with the given role.
+func certificateGlobs(role NodeRole) []string {
+	if role == EtcdRole {
+		return []string{etcdPKIDir + "/*.crt"}
+	}
+
+	return []string{
+		kubernetesPKIDir + "/*.crt",
+		kubernetesPKIDir + "/etcd/*.crt",
+		kubeletCert,
+	}
+}
+
+// Certificate is a certificate file in a node and its expiration date.
+type Certificate struct {
+	Path     string    `json:"path"`
+	NotAfter time.Time `json:"notAfter"`
+}
+
+// ExpiresWithin returns true if the certificate expires before now + d.
+func (c Certificate) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return c.NotAfter.Before(now.Add(d))
+}
+
+// NodeCertificates is the list of certificates found in a node.
+type NodeCertificates struct {
+	Node         Node          `json:"node"`
+	Certificates []Certificate `json:"certificates"`
+}
+
+// Checker reads the expiration date of the certificates in cluster nodes over SSH.
+type Checker struct {
+	ssh            SSHRunner
+	privateKeyPath string
+}
+
+// NewChecker builds a new Checker.
+func NewChecker(ssh SSHRunner, privateKeyPath string) *Checker {
+	return &Checker{
+		ssh:            ssh,
+		privateKeyPath: privateKeyPath,
+	}
+}
+
+// Check returns the certificates and their expiration dates for all nodes.
+func (c *Checker) Check(ctx context.Context, nodes []Node) ([]NodeCertificates, error) {
+	result := make([]NodeCertificates, 0, len(nodes))
+	for _, node := range nodes {
+		certs, err := c.CheckNode(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, NodeCertificates{Node: node, Certificates: certs})
+	}
+
+	return result, nil
+}
+
+// CheckNode returns the certificates and their expiration dates for a single node.
+func (c *Checker) CheckNode(ctx context.Context, node Node) ([]Certificate, error) {
+	if err := validateOSFamily(node); err != nil {
+		return nil, err
+	}
+
+	out, err := c.ssh.RunCommand(ctx, c.privateKeyPath, node.SSHUser, node.IP, sudoShell(listExpirationScript(certificateGlobs(node.Role))))
+	if err != nil {
+		return nil, fmt.Errorf("reading certificates from node %s: %v", node.Name, err)
+	}
+
+	certs, err := parseExpirations(out)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificates from node %s: %v", node.Name, err)
+	}
+
+	return certs, nil
+}
+
+func validateOSFamily(node Node) error {
+	if node.OSFamily == anywherev1.Bottlerocket {
+		return fmt.Errorf("node %s: certificate operations are not supported for %s", node.Name, node.OSFamily)
+	}
+	return nil
+}
+
+// listExpirationScript prints one line per existing certificate file with the format
+// "<path> notAfter=<date>".
+func listExpirationScript(globs []string) string {
+	return fmt.Sprintf(
+		`for f in %s; do if [ -f "$f" ]; then echo "$f $(openssl x509 -enddate -noout -in "$f")"; fi; done`,
+		strings.Join(globs, " "),
+	)
+}
+
+// sudoShell wraps script in a single quoted sh command run with sudo. Single quotes in the
+// script are escaped, closing the quoted string and adding an escaped quote before reopening it.
+func sudoShell(script string) string {
+	return fmt.Sprintf("sudo sh -c '%s'", strings.ReplaceAll(script, "'", `'\''`))
+}
+
+func parseExpirations(out string) ([]Certificate, error) {
+	var certs []Certificate
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		path, date, found := strings.Cut(line, " "+notAfterPrefix)
+		if !found {
+			return nil, fmt.Errorf("unexpected certificate expiration line [%s]", line)
+		}
+
+		notAfter, err := time.Parse(opensslTimeLayout, strings.TrimSpace(date))
+		if err != nil {
+			return nil, fmt.Errorf("parsing expiration date for %s: %v", path, err)
+		}
+
+		certs = append(certs, Certificate{Path: path, NotAfter: notAfter.UTC()})
+	}
+
+	return certs, nil
+}
@@ -0,0 +1,129 @@
+package certificates_test
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/certificates/mocks"
+)
+
+const privateKey = "/home/user/.ssh/id_rsa"
+
+func controlPlaneNode() certificates.Node {
+	return certificates.Node{
+		Name:     "cp-1",
+		IP:       "10.0.0.1",
+		Role:     certificates.ControlPlaneRole,
+		SSHUser:  "capv",
+		OSFamily: anywherev1.Ubuntu,
+	}
+}
+
+func etcdNode() certificates.Node {
+	return certificates.Node{
+		Name:     "etcd-1",
+		IP:       "10.0.0.10",
+		Role:     certificates.EtcdRole,
+		SSHUser:  "capv",
+		OSFamily: anywherev1.Ubuntu,
+	}
+}
+
+func TestCheckerCheck(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	checker := certificates.NewChecker(ssh, privateKey)
+
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", "10.0.0.1", gomock.Any()).Return(
+		"/etc/kubernetes/pki/apiserver.crt notAfter=Jan  2 15:04:05 2025 GMT\n"+
+			"/var/lib/kubelet/pki/kubelet-client-current.pem notAfter=Dec 12 10:00:00 2025 GMT\n", nil,
+	)
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", "10.0.0.10", gomock.Any()).Return(
+		"/etc/etcd/pki/server.crt notAfter=Mar 20 01:00:00 2025 GMT\n", nil,
+	)
+
+	got, err := checker.Check(ctx, []certificates.Node{controlPlaneNode(), etcdNode()})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(HaveLen(2))
+	g.Expect(got[0].Certificates).To(ConsistOf(
+		certificates.Certificate{Path: "/etc/kubernetes/pki/apiserver.crt", NotAfter: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)},
+		certificates.Certificate{Path: "/var/lib/kubelet/pki/kubelet-client-current.pem", NotAfter: time.Date(2025, 12, 12, 10, 0, 0, 0, time.UTC)},
+	))
+	g.Expect(got[1].Certificates).To(ConsistOf(
+		certificates.Certificate{Path: "/etc/etcd/pki/server.crt", NotAfter: time.Date(2025, 3, 20, 1, 0, 0, 0, time.UTC)},
+	))
+}
+
+func TestCheckerCheckNodeSSHError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	checker := certificates.NewChecker(ssh, privateKey)
+
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", "10.0.0.1", gomock.Any()).Return("", errors.New("connection refused"))
+
+	_, err := checker.CheckNode(ctx, controlPlaneNode())
+	g.Expect(err).To(MatchError("reading certificates from node cp-1: connection refused"))
+}
+
+func TestCheckerCheckNodeInvalidOutput(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	checker := certificates.NewChecker(ssh, privateKey)
+
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", "10.0.0.1", gomock.Any()).Return("/etc/kubernetes/pki/apiserver.crt unable to load certificate", nil)
+
+	_, err := checker.CheckNode(ctx, controlPlaneNode())
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected certificate expiration line")))
+}
+
+func TestCheckerCheckNodeBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	checker := certificates.NewChecker(ssh, privateKey)
+	node := controlPlaneNode()
+	node.OSFamily = anywherev1.Bottlerocket
+
+	_, err := checker.CheckNode(ctx, node)
+	g.Expect(err).To(MatchError("node cp-1: certificate operations are not supported for bottlerocket"))
+}
+
+func TestExpiringCertificates(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodes := []certificates.NodeCertificates{
+		{
+			Node: controlPlaneNode(),
+			Certificates: []certificates.Certificate{
+				{Path: "expired", NotAfter: now.Add(-time.Hour)},
+				{Path: "expiring", NotAfter: now.Add(24 * time.Hour)},
+				{Path: "ok", NotAfter: now.Add(365 * 24 * time.Hour)},
+			},
+		},
+	}
+
+	g.Expect(certificates.ExpiringCertificates(nodes, now, 30*24*time.Hour)).To(Equal(2))
+}
+
+func TestSudoShellEscapesSingleQuotes(t *testing.T) {
+	g := NewWithT(t)
+	script := `printf '%s' "it's quoted"`
+
+	// Run the command without sudo to check the shell gets the original script.
+	cmd := strings.TrimPrefix(certificates.SudoShell(script), "sudo ")
+	out, err := exec.Command("sh", "-c", cmd).Output()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(out)).To(Equal("it's quoted"))
+}
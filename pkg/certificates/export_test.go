@@ -0,0 +1,5 @@
+package certificates
+
+func SudoShell(script string) string {
+	return sudoShell(script)
+}
@@ -0,0 +1,75 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/eks-anywhere/pkg/certificates (interfaces: SSHRunner)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSSHRunner is a mock of SSHRunner interface.
+type MockSSHRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHRunnerMockRecorder
+}
+
+// MockSSHRunnerMockRecorder is the mock recorder for MockSSHRunner.
+type MockSSHRunnerMockRecorder struct {
+	mock *MockSSHRunner
+}
+
+// NewMockSSHRunner creates a new mock instance.
+func NewMockSSHRunner(ctrl *gomock.Controller) *MockSSHRunner {
+	mock := &MockSSHRunner{ctrl: ctrl}
+	mock.recorder = &MockSSHRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHRunner) EXPECT() *MockSSHRunnerMockRecorder {
+	return m.recorder
+}
+
+// RunCommand mocks base method.
+func (m *MockSSHRunner) RunCommand(arg0 context.Context, arg1, arg2, arg3 string, arg4 ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCommand", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCommand indicates an expected call of RunCommand.
+func (mr *MockSSHRunnerMockRecorder) RunCommand(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCommand", reflect.TypeOf((*MockSSHRunner)(nil).RunCommand), varargs...)
+}
+
+// RunCommandWithStdin mocks base method.
+func (m *MockSSHRunner) RunCommandWithStdin(arg0 context.Context, arg1 []byte, arg2, arg3, arg4 string, arg5 ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCommandWithStdin", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCommandWithStdin indicates an expected call of RunCommandWithStdin.
+func (mr *MockSSHRunnerMockRecorder) RunCommandWithStdin(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCommandWithStdin", reflect.TypeOf((*MockSSHRunner)(nil).RunCommandWithStdin), varargs...)
+}
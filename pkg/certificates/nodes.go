@@ -0,0 +1,172 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	snowv1 "github.com/aws/eks-anywhere/pkg/providers/snow/api/v1beta1"
+)
+
+// NodeRole identifies the set of certificates present in a node.
+type NodeRole string
+
+const (
+	// ControlPlaneRole is the role for kubeadm control plane nodes.
+	ControlPlaneRole NodeRole = "control-plane"
+	// EtcdRole is the role for external etcd nodes managed by etcdadm.
+	EtcdRole NodeRole = "etcd"
+)
+
+// Node is a machine of the cluster that holds certificates and can be reached over SSH.
+type Node struct {
+	Name     string
+	IP       string
+	Role     NodeRole
+	SSHUser  string
+	OSFamily anywherev1.OSFamily
+}
+
+// NodeReader discovers the control plane and etcd nodes of a cluster from its CAPI Machines.
+type NodeReader struct {
+	client kubernetes.Reader
+}
+
+// NewNodeReader builds a new NodeReader.
+func NewNodeReader(client kubernetes.Reader) *NodeReader {
+	return &NodeReader{
+		client: client,
+	}
+}
+
+// Read returns the control plane and external etcd nodes for the cluster in the config.
+// The SSH user and OS family of each node are taken from its machine config, unless sshUser
+// is not empty, in which case it overrides the machine config user for all nodes.
+// Etcd nodes are returned first, sorted by name, followed by the control plane nodes.
+func (r *NodeReader) Read(ctx context.Context, config *cluster.Config, sshUser string) ([]Node, error) {
+	machines := &clusterv1.MachineList{}
+	if err := r.client.List(ctx, machines, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return nil, fmt.Errorf("listing machines: %v", err)
+	}
+
+	cpUser, cpOS := machineConfigUser(config, config.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef)
+	var etcdUser string
+	var etcdOS anywherev1.OSFamily
+	if config.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		etcdUser, etcdOS = machineConfigUser(config, config.Cluster.Spec.ExternalEtcdConfiguration.MachineGroupRef)
+	}
+	if sshUser != "" {
+		cpUser = sshUser
+		etcdUser = sshUser
+	}
+
+	var etcdNodes, cpNodes []Node
+	for _, m := range machines.Items {
+		if m.Labels[clusterv1.ClusterNameLabel] != config.Cluster.Name {
+			continue
+		}
+
+		var node Node
+		if _, ok := m.Labels[clusterv1.MachineControlPlaneLabel]; ok {
+			node = Node{Role: ControlPlaneRole, SSHUser: cpUser, OSFamily: cpOS}
+		} else if _, ok := m.Labels[snowv1.MachineEtcdLabelName]; ok {
+			node = Node{Role: EtcdRole, SSHUser: etcdUser, OSFamily: etcdOS}
+		} else {
+			continue
+		}
+
+		node.Name = m.Name
+		node.IP = machineIP(m)
+		if node.IP == "" {
+			return nil, fmt.Errorf("machine %s doesn't have an IP address", m.Name)
+		}
+		if node.SSHUser == "" {
+			return nil, fmt.Errorf("no ssh user found for machine %s, specify one explicitly", m.Name)
+		}
+
+		if node.Role == EtcdRole {
+			etcdNodes = append(etcdNodes, node)
+		} else {
+			cpNodes = append(cpNodes, node)
+		}
+	}
+
+	if len(cpNodes) == 0 {
+		return nil, fmt.Errorf("no control plane machines found for cluster %s", config.Cluster.Name)
+	}
+
+	sortByName(etcdNodes)
+	sortByName(cpNodes)
+
+	return append(etcdNodes, cpNodes...), nil
+}
+
+func sortByName(nodes []Node) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+}
+
+// machineIP returns the first external IP of the machine, falling back to the
+// first internal IP if there are no external ones.
+func machineIP(m clusterv1.Machine) string {
+	var internalIP string
+	for _, a := range m.Status.Addresses {
+		switch a.Type {
+		case clusterv1.MachineExternalIP:
+			return a.Address
+		case clusterv1.MachineInternalIP:
+			if internalIP == "" {
+				internalIP = a.Address
+			}
+		}
+	}
+
+	return internalIP
+}
+
+func machineConfigUser(config *cluster.Config, ref *anywherev1.Ref) (string, anywherev1.OSFamily) {
+	if ref == nil {
+		return "", ""
+	}
+
+	var users []anywherev1.UserConfiguration
+	var osFamily anywherev1.OSFamily
+	switch ref.Kind {
+	case anywherev1.VSphereMachineConfigKind:
+		if m := config.VsphereMachineConfig(ref.Name); m != nil {
+			users, osFamily = m.Spec.Users, m.Spec.OSFamily
+		}
+	case anywherev1.CloudStackMachineConfigKind:
+		if m := config.CloudStackMachineConfig(ref.Name); m != nil {
+			users, osFamily = m.Spec.Users, m.OSFamily()
+		}
+	case anywherev1.NutanixMachineConfigKind:
+		if m := config.NutanixMachineConfig(ref.Name); m != nil {
+			users, osFamily = m.Spec.Users, m.Spec.OSFamily
+		}
+	case anywherev1.TinkerbellMachineConfigKind:
+		if m, ok := config.TinkerbellMachineConfigs[ref.Name]; ok {
+			users, osFamily = m.Spec.Users, m.Spec.OSFamily
+		}
+	case anywherev1.SnowMachineConfigKind:
+		if m := config.SnowMachineConfig(ref.Name); m != nil {
+			return constants.BottlerocketDefaultUser, m.Spec.OSFamily
+		}
+	}
+
+	if len(users) == 0 || users[0].Name == "" {
+		if osFamily == anywherev1.Bottlerocket {
+			return constants.BottlerocketDefaultUser, osFamily
+		}
+		return "", osFamily
+	}
+
+	return users[0].Name, osFamily
+}
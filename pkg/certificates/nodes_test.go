@@ -0,0 +1,128 @@
+package certificates_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+func machine(name string, labels map[string]string, addresses ...clusterv1.MachineAddress) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    labels,
+		},
+		Status: clusterv1.MachineStatus{
+			Addresses: addresses,
+		},
+	}
+}
+
+func clusterConfig() *cluster.Config {
+	return &cluster.Config{
+		Cluster: &anywherev1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+			Spec: anywherev1.ClusterSpec{
+				ControlPlaneConfiguration: anywherev1.ControlPlaneConfiguration{
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "cp"},
+				},
+				ExternalEtcdConfiguration: &anywherev1.ExternalEtcdConfiguration{
+					MachineGroupRef: &anywherev1.Ref{Kind: anywherev1.VSphereMachineConfigKind, Name: "etcd"},
+				},
+			},
+		},
+		VSphereMachineConfigs: map[string]*anywherev1.VSphereMachineConfig{
+			"cp": {
+				Spec: anywherev1.VSphereMachineConfigSpec{
+					OSFamily: anywherev1.Ubuntu,
+					Users:    []anywherev1.UserConfiguration{{Name: "cp-user"}},
+				},
+			},
+			"etcd": {
+				Spec: anywherev1.VSphereMachineConfigSpec{
+					OSFamily: anywherev1.RedHat,
+					Users:    []anywherev1.UserConfiguration{{Name: "etcd-user"}},
+				},
+			},
+		},
+	}
+}
+
+func TestNodeReaderRead(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := test.NewFakeKubeClient(
+		machine("my-cluster-cp-b", map[string]string{clusterv1.ClusterNameLabel: "my-cluster", clusterv1.MachineControlPlaneLabel: ""},
+			clusterv1.MachineAddress{Type: clusterv1.MachineInternalIP, Address: "192.168.0.2"},
+			clusterv1.MachineAddress{Type: clusterv1.MachineExternalIP, Address: "10.0.0.2"},
+		),
+		machine("my-cluster-cp-a", map[string]string{clusterv1.ClusterNameLabel: "my-cluster", clusterv1.MachineControlPlaneLabel: ""},
+			clusterv1.MachineAddress{Type: clusterv1.MachineInternalIP, Address: "192.168.0.1"},
+		),
+		machine("my-cluster-etcd-a", map[string]string{clusterv1.ClusterNameLabel: "my-cluster", "cluster.x-k8s.io/etcd-cluster": ""},
+			clusterv1.MachineAddress{Type: clusterv1.MachineExternalIP, Address: "10.0.0.10"},
+		),
+		machine("my-cluster-md-0-a", map[string]string{clusterv1.ClusterNameLabel: "my-cluster"},
+			clusterv1.MachineAddress{Type: clusterv1.MachineExternalIP, Address: "10.0.0.20"},
+		),
+		machine("other-cp-a", map[string]string{clusterv1.ClusterNameLabel: "other", clusterv1.MachineControlPlaneLabel: ""},
+			clusterv1.MachineAddress{Type: clusterv1.MachineExternalIP, Address: "10.0.0.30"},
+		),
+	)
+	reader := certificates.NewNodeReader(client)
+
+	nodes, err := reader.Read(ctx, clusterConfig(), "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodes).To(Equal([]certificates.Node{
+		{Name: "my-cluster-etcd-a", IP: "10.0.0.10", Role: certificates.EtcdRole, SSHUser: "etcd-user", OSFamily: anywherev1.RedHat},
+		{Name: "my-cluster-cp-a", IP: "192.168.0.1", Role: certificates.ControlPlaneRole, SSHUser: "cp-user", OSFamily: anywherev1.Ubuntu},
+		{Name: "my-cluster-cp-b", IP: "10.0.0.2", Role: certificates.ControlPlaneRole, SSHUser: "cp-user", OSFamily: anywherev1.Ubuntu},
+	}))
+}
+
+func TestNodeReaderReadSSHUserOverride(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := test.NewFakeKubeClient(
+		machine("my-cluster-cp-a", map[string]string{clusterv1.ClusterNameLabel: "my-cluster", clusterv1.MachineControlPlaneLabel: ""},
+			clusterv1.MachineAddress{Type: clusterv1.MachineInternalIP, Address: "192.168.0.1"},
+		),
+	)
+	reader := certificates.NewNodeReader(client)
+
+	nodes, err := reader.Read(ctx, clusterConfig(), "admin")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nodes).To(HaveLen(1))
+	g.Expect(nodes[0].SSHUser).To(Equal("admin"))
+}
+
+func TestNodeReaderReadNoControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	reader := certificates.NewNodeReader(test.NewFakeKubeClient())
+
+	_, err := reader.Read(ctx, clusterConfig(), "")
+	g.Expect(err).To(MatchError("no control plane machines found for cluster my-cluster"))
+}
+
+func TestNodeReaderReadMachineWithoutIP(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := test.NewFakeKubeClient(
+		machine("my-cluster-cp-a", map[string]string{clusterv1.ClusterNameLabel: "my-cluster", clusterv1.MachineControlPlaneLabel: ""}),
+	)
+	reader := certificates.NewNodeReader(client)
+
+	_, err := reader.Read(ctx, clusterConfig(), "")
+	g.Expect(err).To(MatchError("machine my-cluster-cp-a doesn't have an IP address"))
+}
@@ -0,0 +1,78 @@
+package certificates
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	statusOK       = "OK"
+	statusExpiring = "EXPIRING"
+	statusExpired  = "EXPIRED"
+)
+
+// PrintExpirations writes a table with the expiration date of every certificate in every node.
+// Certificates that expire before now + threshold are flagged as expiring.
+func PrintExpirations(w io.Writer, nodes []NodeCertificates, now time.Time, threshold time.Duration) error {
+	tw := tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "NODE\tROLE\tIP\tCERTIFICATE\tEXPIRES\tRESIDUAL TIME\tSTATUS")
+	for _, n := range nodes {
+		for _, c := range n.Certificates {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				n.Node.Name,
+				n.Node.Role,
+				n.Node.IP,
+				c.Path,
+				c.NotAfter.UTC().Format(time.RFC3339),
+				residualTime(c.NotAfter.Sub(now)),
+				status(c, now, threshold),
+			)
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed flushing table writer: %v", err)
+	}
+
+	return nil
+}
+
+// ExpiringCertificates returns the number of certificates that expire before now + threshold.
+func ExpiringCertificates(nodes []NodeCertificates, now time.Time, threshold time.Duration) int {
+	count := 0
+	for _, n := range nodes {
+		for _, c := range n.Certificates {
+			if c.ExpiresWithin(now, threshold) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func status(c Certificate, now time.Time, threshold time.Duration) string {
+	switch {
+	case c.ExpiresWithin(now, 0):
+		return statusExpired
+	case c.ExpiresWithin(now, threshold):
+		return statusExpiring
+	default:
+		return statusOK
+	}
+}
+
+func residualTime(d time.Duration) string {
+	if d <= 0 {
+		return "<expired>"
+	}
+
+	days := int(d.Hours() / 24)
+	if days > 0 {
+		return fmt.Sprintf("%dd", days)
+	}
+
+	return fmt.Sprintf("%dh", int(d.Hours()))
+}
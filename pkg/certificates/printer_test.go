@@ -0,0 +1,33 @@
+package certificates_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+)
+
+func TestPrintExpirations(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodes := []certificates.NodeCertificates{
+		{
+			Node: controlPlaneNode(),
+			Certificates: []certificates.Certificate{
+				{Path: "/etc/kubernetes/pki/apiserver.crt", NotAfter: now.Add(-time.Hour)},
+				{Path: "/etc/kubernetes/pki/front-proxy-client.crt", NotAfter: now.Add(10 * 24 * time.Hour)},
+				{Path: "/etc/kubernetes/pki/ca.crt", NotAfter: now.Add(3650 * 24 * time.Hour)},
+			},
+		},
+	}
+	buf := &bytes.Buffer{}
+
+	g.Expect(certificates.PrintExpirations(buf, nodes, now, 30*24*time.Hour)).To(Succeed())
+	out := buf.String()
+	g.Expect(out).To(MatchRegexp(`cp-1\s+control-plane\s+10.0.0.1\s+/etc/kubernetes/pki/apiserver.crt\s+2024-12-31T23:00:00Z\s+<expired>\s+EXPIRED`))
+	g.Expect(out).To(MatchRegexp(`front-proxy-client.crt\s+2025-01-11T00:00:00Z\s+10d\s+EXPIRING`))
+	g.Expect(out).To(MatchRegexp(`ca.crt\s+2034-12-30T00:00:00Z\s+3650d\s+OK`))
+}
@@ -0,0 +1,267 @@
+package certificates
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	backupDirTimeFormat = "20060102150405"
+
+	apiServerEtcdClientCert = "apiserver-etcd-client.crt"
+	apiServerEtcdClientKey  = "apiserver-etcd-client.key"
+)
+
+// RenewResult describes the outcome of the renewal for a single node.
+type RenewResult struct {
+	Node      Node   `json:"node"`
+	BackupDir string `json:"backupDir"`
+}
+
+// Renewer renews the certificates of control plane and external etcd nodes, one node at a time.
+type Renewer struct {
+	ssh            SSHRunner
+	privateKeyPath string
+	now            func() time.Time
+	healthRetries  int
+	healthInterval time.Duration
+}
+
+// RenewerOpt allows to customize a Renewer on construction.
+type RenewerOpt func(*Renewer)
+
+// WithHealthCheck configures how many times and how often the renewer checks if
+// the components in a node are healthy after restarting them.
+func WithHealthCheck(retries int, interval time.Duration) RenewerOpt {
+	return func(r *Renewer) {
+		r.healthRetries = retries
+		r.healthInterval = interval
+	}
+}
+
+// NewRenewer builds a new Renewer.
+func NewRenewer(ssh SSHRunner, privateKeyPath string, opts ...RenewerOpt) *Renewer {
+	r := &Renewer{
+		ssh:            ssh,
+		privateKeyPath: privateKeyPath,
+		now:            time.Now,
+		healthRetries:  60,
+		healthInterval: 5 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Renew performs a rolling renewal of the certificates in all nodes. External etcd nodes are renewed
+// first, followed by the control plane nodes. The certificates for each node are backed up
+// in the node itself before being renewed, and the renewal stops at the first node that fails, leaving
+// the rest of the nodes untouched.
+func (r *Renewer) Renew(ctx context.Context, nodes []Node) ([]RenewResult, error) {
+	for _, node := range nodes {
+		if err := validateOSFamily(node); err != nil {
+			return nil, err
+		}
+	}
+
+	backupSuffix := r.now().UTC().Format(backupDirTimeFormat)
+	var results []RenewResult
+	var etcdClientCert, etcdClientKey []byte
+
+	for _, node := range nodesWithRole(nodes, EtcdRole) {
+		logger.Info("Renewing etcd certificates", "node", node.Name)
+		backupDir, err := r.renewEtcdNode(ctx, node, backupSuffix)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, RenewResult{Node: node, BackupDir: backupDir})
+
+		// The apiserver etcd client certificate is generated once, in the first etcd node,
+		// and then distributed to all control plane nodes.
+		if etcdClientCert == nil {
+			etcdClientCert, etcdClientKey, err = r.renewAPIServerEtcdClient(ctx, node)
+			if err != nil {
+				return results, err
+			}
+		}
+	}
+
+	for _, node := range nodesWithRole(nodes, ControlPlaneRole) {
+		logger.Info("Renewing control plane certificates", "node", node.Name)
+		backupDir, err := r.renewControlPlaneNode(ctx, node, backupSuffix, etcdClientCert, etcdClientKey)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, RenewResult{Node: node, BackupDir: backupDir})
+	}
+
+	return results, nil
+}
+
+func (r *Renewer) renewEtcdNode(ctx context.Context, node Node, backupSuffix string) (string, error) {
+	backupDir := fmt.Sprintf("%s.bak-%s", etcdPKIDir, backupSuffix)
+	steps := []step{
+		{name: "backing up certificates", script: fmt.Sprintf("cp -a %s %s", etcdPKIDir, backupDir)},
+		{name: "removing expiring certificates", script: fmt.Sprintf("cd %s && rm -f server.* peer.* etcdctl-etcd-client.*", etcdPKIDir)},
+		{name: "generating certificates", script: fmt.Sprintf("etcdadm join phase certificates http://%s:2379 --init-system systemd", node.IP)},
+		{name: "restarting etcd", script: "systemctl restart etcd"},
+	}
+
+	if err := r.runSteps(ctx, node, backupDir, steps); err != nil {
+		return "", err
+	}
+
+	if err := r.waitHealthy(ctx, node, etcdHealthScript); err != nil {
+		return "", fmt.Errorf("waiting for etcd to be healthy in node %s, backup available in %s: %v", node.Name, backupDir, err)
+	}
+
+	return backupDir, nil
+}
+
+// renewAPIServerEtcdClient issues a new client certificate for kube-apiserver signed by the etcd CA
+// and returns the certificate and key.
+func (r *Renewer) renewAPIServerEtcdClient(ctx context.Context, node Node) (cert, key []byte, err error) {
+	if err = r.run(ctx, node, apiServerEtcdClientScript); err != nil {
+		return nil, nil, fmt.Errorf("renewing apiserver etcd client certificate in node %s: %v", node.Name, err)
+	}
+
+	if cert, err = r.readFile(ctx, node, etcdPKIDir+"/"+apiServerEtcdClientCert); err != nil {
+		return nil, nil, err
+	}
+	if key, err = r.readFile(ctx, node, etcdPKIDir+"/"+apiServerEtcdClientKey); err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+func (r *Renewer) renewControlPlaneNode(ctx context.Context, node Node, backupSuffix string, etcdClientCert, etcdClientKey []byte) (string, error) {
+	backupDir := fmt.Sprintf("%s.bak-%s", kubernetesPKIDir, backupSuffix)
+	steps := []step{
+		{name: "backing up certificates", script: fmt.Sprintf("cp -a %s %s && cp -a /etc/kubernetes/*.conf %s/", kubernetesPKIDir, backupDir, backupDir)},
+		{name: "renewing certificates", script: "kubeadm certs renew all"},
+	}
+
+	if etcdClientCert != nil {
+		steps = append(steps,
+			step{name: "copying apiserver etcd client certificate", script: writeFileScript(kubernetesPKIDir + "/" + apiServerEtcdClientCert), input: etcdClientCert},
+			step{name: "copying apiserver etcd client key", script: writeFileScript(kubernetesPKIDir + "/" + apiServerEtcdClientKey), input: etcdClientKey},
+		)
+	}
+
+	steps = append(steps, step{name: "restarting control plane components", script: restartStaticPodsScript})
+
+	if err := r.runSteps(ctx, node, backupDir, steps); err != nil {
+		return "", err
+	}
+
+	if err := r.waitHealthy(ctx, node, apiServerHealthScript); err != nil {
+		return "", fmt.Errorf("waiting for kube-apiserver to be healthy in node %s, backup available in %s: %v", node.Name, backupDir, err)
+	}
+
+	return backupDir, nil
+}
+
+// step is a named script that runs as root in a node. The optional input is
+// streamed to the script's stdin instead of being passed in the command line.
+type step struct {
+	name   string
+	script string
+	input  []byte
+}
+
+func (r *Renewer) runSteps(ctx context.Context, node Node, backupDir string, steps []step) error {
+	for _, s := range steps {
+		if err := r.runWithInput(ctx, node, s.script, s.input); err != nil {
+			return fmt.Errorf("%s in node %s, backup available in %s: %v", s.name, node.Name, backupDir, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Renewer) waitHealthy(ctx context.Context, node Node, script string) error {
+	var err error
+	for i := 0; i < r.healthRetries; i++ {
+		if err = r.run(ctx, node, script); err == nil {
+			return nil
+		}
+		logger.V(4).Info("Component not healthy yet", "node", node.Name, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.healthInterval):
+		}
+	}
+
+	return err
+}
+
+func (r *Renewer) readFile(ctx context.Context, node Node, path string) ([]byte, error) {
+	out, err := r.ssh.RunCommand(ctx, r.privateKeyPath, node.SSHUser, node.IP, sudoShell("base64 -w0 "+path))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s from node %s: %v", path, node.Name, err)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(out))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s from node %s: invalid content: %v", path, node.Name, err)
+	}
+
+	return content, nil
+}
+
+func (r *Renewer) run(ctx context.Context, node Node, script string) error {
+	return r.runWithInput(ctx, node, script, nil)
+}
+
+func (r *Renewer) runWithInput(ctx context.Context, node Node, script string, input []byte) error {
+	if input == nil {
+		_, err := r.ssh.RunCommand(ctx, r.privateKeyPath, node.SSHUser, node.IP, sudoShell(script))
+		return err
+	}
+
+	_, err := r.ssh.RunCommandWithStdin(ctx, input, r.privateKeyPath, node.SSHUser, node.IP, sudoShell(script))
+	return err
+}
+
+func nodesWithRole(nodes []Node, role NodeRole) []Node {
+	var filtered []Node
+	for _, n := range nodes {
+		if n.Role == role {
+			filtered = append(filtered, n)
+		}
+	}
+	return filtered
+}
+
+// writeFileScript writes the script's stdin to path.
+func writeFileScript(path string) string {
+	return "cat > " + path
+}
+
+// restartStaticPodsScript makes kubelet stop the static pods by moving their manifests
+// out of the manifests folder and then moves them back, so the components pick up
+// the new certificates.
+const restartStaticPodsScript = `mkdir -p /etc/kubernetes/manifests.tmp && ` +
+	`mv /etc/kubernetes/manifests/*.yaml /etc/kubernetes/manifests.tmp/ && sleep 20 && ` +
+	`mv /etc/kubernetes/manifests.tmp/*.yaml /etc/kubernetes/manifests/ && rmdir /etc/kubernetes/manifests.tmp`
+
+const apiServerHealthScript = `curl -sfk https://localhost:6443/healthz`
+
+const etcdHealthScript = `ETCDCTL_API=3 etcdctl --endpoints=https://127.0.0.1:2379 ` +
+	`--cacert=/etc/etcd/pki/ca.crt --cert=/etc/etcd/pki/etcdctl-etcd-client.crt --key=/etc/etcd/pki/etcdctl-etcd-client.key endpoint health`
+
+const apiServerEtcdClientScript = `cd /etc/etcd/pki && ` +
+	`openssl req -new -key apiserver-etcd-client.key -subj "/O=system:masters/CN=kube-apiserver-etcd-client" -out apiserver-etcd-client.csr && ` +
+	`echo "extendedKeyUsage=clientAuth" > apiserver-etcd-client.ext && ` +
+	`openssl x509 -req -in apiserver-etcd-client.csr -CA ca.crt -CAkey ca.key -CAcreateserial -days 365 -extfile apiserver-etcd-client.ext -out apiserver-etcd-client.crt && ` +
+	`rm apiserver-etcd-client.csr apiserver-etcd-client.ext`
@@ -0,0 +1,134 @@
+package certificates_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/certificates"
+	"github.com/aws/eks-anywhere/pkg/certificates/mocks"
+)
+
+// scriptContains matches ssh commands that contain the given substring.
+type scriptContains string
+
+func (s scriptContains) Matches(x interface{}) bool {
+	cmd, ok := x.(string)
+	return ok && strings.Contains(cmd, string(s))
+}
+
+func (s scriptContains) String() string {
+	return fmt.Sprintf("contains %q", string(s))
+}
+
+func TestRenewerRenewStackedEtcd(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	renewer := certificates.NewRenewer(ssh, privateKey, certificates.WithHealthCheck(2, 0))
+	cp := controlPlaneNode()
+
+	gomock.InOrder(
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("cp -a /etc/kubernetes/pki /etc/kubernetes/pki.bak-")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("kubeadm certs renew all")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("mv /etc/kubernetes/manifests/*.yaml")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("healthz")).Return("", errors.New("not ready")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("healthz")).Return("ok", nil),
+	)
+
+	results, err := renewer.Renew(ctx, []certificates.Node{cp})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].BackupDir).To(HavePrefix("/etc/kubernetes/pki.bak-"))
+}
+
+func TestRenewerRenewExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	renewer := certificates.NewRenewer(ssh, privateKey, certificates.WithHealthCheck(1, 0))
+	cp := controlPlaneNode()
+	etcd := etcdNode()
+
+	gomock.InOrder(
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("cp -a /etc/etcd/pki /etc/etcd/pki.bak-")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("rm -f server.* peer.*")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("etcdadm join phase certificates http://10.0.0.10:2379")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("systemctl restart etcd")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("endpoint health")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("CN=kube-apiserver-etcd-client")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("base64 -w0 /etc/etcd/pki/apiserver-etcd-client.crt")).Return("Y2VydA==\n", nil),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", etcd.IP, scriptContains("base64 -w0 /etc/etcd/pki/apiserver-etcd-client.key")).Return("a2V5", nil),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("cp -a /etc/kubernetes/pki")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("kubeadm certs renew all")),
+		ssh.EXPECT().RunCommandWithStdin(ctx, []byte("cert"), privateKey, "capv", cp.IP, scriptContains("cat > /etc/kubernetes/pki/apiserver-etcd-client.crt")),
+		ssh.EXPECT().RunCommandWithStdin(ctx, []byte("key"), privateKey, "capv", cp.IP, scriptContains("cat > /etc/kubernetes/pki/apiserver-etcd-client.key")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("mv /etc/kubernetes/manifests/*.yaml")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("healthz")),
+	)
+
+	results, err := renewer.Renew(ctx, []certificates.Node{etcd, cp})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(results).To(HaveLen(2))
+	g.Expect(results[0].Node.Name).To(Equal("etcd-1"))
+	g.Expect(results[1].Node.Name).To(Equal("cp-1"))
+}
+
+func TestRenewerRenewStopsAtFirstFailure(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	renewer := certificates.NewRenewer(ssh, privateKey, certificates.WithHealthCheck(1, 0))
+	cp1 := controlPlaneNode()
+	cp2 := controlPlaneNode()
+	cp2.Name = "cp-2"
+	cp2.IP = "10.0.0.2"
+
+	gomock.InOrder(
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp1.IP, scriptContains("cp -a /etc/kubernetes/pki")),
+		ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp1.IP, scriptContains("kubeadm certs renew all")).Return("", errors.New("kubeadm failed")),
+	)
+
+	results, err := renewer.Renew(ctx, []certificates.Node{cp1, cp2})
+	g.Expect(err).To(MatchError(MatchRegexp(`renewing certificates in node cp-1, backup available in /etc/kubernetes/pki.bak-\d+: kubeadm failed`)))
+	g.Expect(results).To(BeEmpty())
+}
+
+func TestRenewerRenewHealthCheckTimeout(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	renewer := certificates.NewRenewer(ssh, privateKey, certificates.WithHealthCheck(2, 0))
+	cp := controlPlaneNode()
+
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, gomock.Not(scriptContains("healthz"))).Times(3)
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("healthz")).Return("", errors.New("connection refused")).Times(2)
+
+	_, err := renewer.Renew(ctx, []certificates.Node{cp})
+	g.Expect(err).To(MatchError(ContainSubstring("waiting for kube-apiserver to be healthy in node cp-1")))
+}
+
+func TestRenewerRenewHealthCheckContextCancelled(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	ssh := mocks.NewMockSSHRunner(gomock.NewController(t))
+	renewer := certificates.NewRenewer(ssh, privateKey, certificates.WithHealthCheck(5, time.Hour))
+	cp := controlPlaneNode()
+
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, gomock.Not(scriptContains("healthz"))).Times(3)
+	ssh.EXPECT().RunCommand(ctx, privateKey, "capv", cp.IP, scriptContains("healthz")).DoAndReturn(
+		func(_ context.Context, _, _, _ string, _ ...string) (string, error) {
+			cancel()
+			return "", errors.New("connection refused")
+		},
+	)
+
+	_, err := renewer.Renew(ctx, []certificates.Node{cp})
+	g.Expect(err).To(MatchError(ContainSubstring("context canceled")))
+}
@@ -38,6 +38,10 @@ type ListOptions struct {
 	// Namespace represents the namespace to list for, or empty for
 	// non-namespaced objects, or to list across all namespaces.
 	Namespace string
+
+	// HasLabels filters results by label and value. The requirement is an AND match
+	// for all labels.
+	HasLabels map[string]string
 }
 
 // ApplyToList implements ApplyToList.
@@ -45,6 +49,9 @@ func (o ListOptions) ApplyToList(do *ListOptions) {
 	if o.Namespace != "" {
 		do.Namespace = o.Namespace
 	}
+	if o.HasLabels != nil {
+		do.HasLabels = o.HasLabels
+	}
 }
 
 // Writer knows how to create, delete, and update Kubernetes objects.
@@ -22,6 +22,15 @@ func TestListOptionsApplyToList(t *testing.T) {
 				Namespace: "test",
 			},
 		},
+		{
+			name: "with labels",
+			option: &kubernetes.ListOptions{
+				HasLabels: map[string]string{"label": "value"},
+			},
+			want: &kubernetes.ListOptions{
+				HasLabels: map[string]string{"label": "value"},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"context"
+	"path"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+func argoCDEntry() *ConfigManagerEntry {
+	return &ConfigManagerEntry{
+		APIObjectMapping: map[string]APIObjectGenerator{
+			anywherev1.ArgoCDConfigKind: func() APIObject {
+				return &anywherev1.ArgoCDConfig{}
+			},
+		},
+		Processors: []ParsedProcessor{processArgoCD},
+		Defaulters: []Defaulter{
+			setArgoCDDefaults,
+			SetDefaultArgoCDConfigPath,
+		},
+		Validations: []Validation{
+			validateArgoCD,
+			validateArgoCDNamespace,
+		},
+	}
+}
+
+func processArgoCD(c *Config, objects ObjectLookup) {
+	if c.Cluster.Spec.GitOpsRef == nil {
+		return
+	}
+
+	if c.Cluster.Spec.GitOpsRef.Kind == anywherev1.ArgoCDConfigKind {
+		argo := objects.GetFromRef(c.Cluster.APIVersion, *c.Cluster.Spec.GitOpsRef)
+		if argo == nil {
+			return
+		}
+
+		c.ArgoCDConfig = argo.(*anywherev1.ArgoCDConfig)
+	}
+}
+
+func validateArgoCD(c *Config) error {
+	if c.ArgoCDConfig != nil {
+		return c.ArgoCDConfig.Validate()
+	}
+	return nil
+}
+
+func validateArgoCDNamespace(c *Config) error {
+	if c.ArgoCDConfig != nil {
+		if err := validateSameNamespace(c, c.ArgoCDConfig); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setArgoCDDefaults(c *Config) error {
+	if c.ArgoCDConfig != nil {
+		c.ArgoCDConfig.SetDefaults()
+	}
+	return nil
+}
+
+// SetDefaultArgoCDConfigPath defaults the ArgoCDConfig cluster config path to the
+// management cluster directory, same as for FluxConfig.
+func SetDefaultArgoCDConfigPath(c *Config) error {
+	if c.ArgoCDConfig == nil {
+		return nil
+	}
+
+	argoCDConfig := c.ArgoCDConfig
+	if argoCDConfig.Spec.ClusterConfigPath != "" {
+		return nil
+	}
+
+	if c.Cluster.IsSelfManaged() {
+		argoCDConfig.Spec.ClusterConfigPath = path.Join("clusters", c.Cluster.Name)
+	} else {
+		argoCDConfig.Spec.ClusterConfigPath = path.Join("clusters", c.Cluster.ManagedBy())
+	}
+	return nil
+}
+
+func getArgoCDConfig(ctx context.Context, client Client, c *Config) error {
+	if c.Cluster.Spec.GitOpsRef == nil || c.Cluster.Spec.GitOpsRef.Kind != anywherev1.ArgoCDConfigKind {
+		return nil
+	}
+
+	argoCDConfig := &anywherev1.ArgoCDConfig{}
+	if err := client.Get(ctx, c.Cluster.Spec.GitOpsRef.Name, c.Cluster.Namespace, argoCDConfig); err != nil {
+		return err
+	}
+
+	c.ArgoCDConfig = argoCDConfig
+
+	return nil
+}
+
+// GitOpsRepositoryConfig returns the git repository configuration of the GitOps engine used by the cluster,
+// either Flux or Argo CD, in the FluxConfig format the git tooling works with. It returns nil if GitOps is not enabled.
+func (c *Config) GitOpsRepositoryConfig() *anywherev1.FluxConfig {
+	if c.ArgoCDConfig != nil {
+		return c.ArgoCDConfig.ConvertToFluxConfig()
+	}
+	return c.FluxConfig
+}
@@ -0,0 +1,105 @@
+package cluster_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/cluster/mocks"
+)
+
+func TestDefaultConfigClientBuilderArgoCDConfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	b := cluster.NewDefaultConfigClientBuilder()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			GitOpsRef: &anywherev1.Ref{
+				Kind: anywherev1.ArgoCDConfigKind,
+				Name: "my-argocd",
+			},
+		},
+	}
+	argoCDConfig := &anywherev1.ArgoCDConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-argocd",
+			Namespace: "default",
+		},
+	}
+
+	client.EXPECT().Get(ctx, "my-argocd", "default", &anywherev1.ArgoCDConfig{}).DoAndReturn(
+		func(ctx context.Context, name, namespace string, obj runtime.Object) error {
+			c := obj.(*anywherev1.ArgoCDConfig)
+			c.ObjectMeta = argoCDConfig.ObjectMeta
+			return nil
+		},
+	)
+
+	config, err := b.Build(ctx, client, cluster)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config).NotTo(BeNil())
+	g.Expect(config.Cluster).To(Equal(cluster))
+	g.Expect(config.ArgoCDConfig).To(Equal(argoCDConfig))
+}
+
+func TestSetDefaultArgoCDConfigPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  *anywherev1.Cluster
+		wantPath string
+	}{
+		{
+			name: "self-managed cluster",
+			cluster: &anywherev1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "mgmt"},
+			},
+			wantPath: "clusters/mgmt",
+		},
+		{
+			name: "workload cluster",
+			cluster: &anywherev1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "workload"},
+				Spec: anywherev1.ClusterSpec{
+					ManagementCluster: anywherev1.ManagementCluster{Name: "mgmt"},
+				},
+			},
+			wantPath: "clusters/mgmt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := &cluster.Config{
+				Cluster:      tt.cluster,
+				ArgoCDConfig: &anywherev1.ArgoCDConfig{},
+			}
+			g.Expect(cluster.SetDefaultArgoCDConfigPath(config)).To(Succeed())
+			g.Expect(config.ArgoCDConfig.Spec.ClusterConfigPath).To(Equal(tt.wantPath))
+		})
+	}
+}
+
+func TestConfigGitOpsRepositoryConfig(t *testing.T) {
+	g := NewWithT(t)
+	c := &cluster.Config{}
+	g.Expect(c.GitOpsRepositoryConfig()).To(BeNil())
+
+	c.FluxConfig = &anywherev1.FluxConfig{Spec: anywherev1.FluxConfigSpec{Branch: "flux"}}
+	g.Expect(c.GitOpsRepositoryConfig()).To(Equal(c.FluxConfig))
+
+	c.FluxConfig = nil
+	c.ArgoCDConfig = &anywherev1.ArgoCDConfig{Spec: anywherev1.ArgoCDConfigSpec{Branch: "argo"}}
+	g.Expect(c.GitOpsRepositoryConfig().Spec.Branch).To(Equal("argo"))
+}
@@ -27,14 +27,21 @@ type Config struct {
 	AWSIAMConfigs             map[string]*anywherev1.AWSIamConfig
 	GitOpsConfig              *anywherev1.GitOpsConfig
 	FluxConfig                *anywherev1.FluxConfig
+	ArgoCDConfig              *anywherev1.ArgoCDConfig
 	SnowCredentialsSecret     *v1.Secret
 	SnowIPPools               map[string]*anywherev1.SnowIPPool
+	VSphereIPPools            map[string]*anywherev1.VSphereIPPool
 }
 
 func (c *Config) VsphereMachineConfig(name string) *anywherev1.VSphereMachineConfig {
 	return c.VSphereMachineConfigs[name]
 }
 
+// VSphereIPPool returns a VSphereIPPool based on a name.
+func (c *Config) VSphereIPPool(name string) *anywherev1.VSphereIPPool {
+	return c.VSphereIPPools[name]
+}
+
 func (c *Config) CloudStackMachineConfig(name string) *anywherev1.CloudStackMachineConfig {
 	return c.CloudStackMachineConfigs[name]
 }
@@ -71,6 +78,7 @@ func (c *Config) DeepCopy() *Config {
 		TinkerbellDatacenter: c.TinkerbellDatacenter.DeepCopy(),
 		GitOpsConfig:         c.GitOpsConfig.DeepCopy(),
 		FluxConfig:           c.FluxConfig.DeepCopy(),
+		ArgoCDConfig:         c.ArgoCDConfig.DeepCopy(),
 	}
 
 	if c.VSphereMachineConfigs != nil {
@@ -81,6 +89,13 @@ func (c *Config) DeepCopy() *Config {
 		c2.VSphereMachineConfigs[k] = v.DeepCopy()
 	}
 
+	if c.VSphereIPPools != nil {
+		c2.VSphereIPPools = make(map[string]*anywherev1.VSphereIPPool, len(c.VSphereIPPools))
+	}
+	for k, v := range c.VSphereIPPools {
+		c2.VSphereIPPools[k] = v.DeepCopy()
+	}
+
 	if c.CloudStackMachineConfigs != nil {
 		c2.CloudStackMachineConfigs = make(map[string]*anywherev1.CloudStackMachineConfig, len(c.CloudStackMachineConfigs))
 	}
@@ -158,12 +173,17 @@ func (c *Config) ChildObjects() []kubernetes.Object {
 		c.TinkerbellDatacenter,
 		c.GitOpsConfig,
 		c.FluxConfig,
+		c.ArgoCDConfig,
 	)
 
 	for _, e := range c.VSphereMachineConfigs {
 		objs = appendIfNotNil(objs, e)
 	}
 
+	for _, e := range c.VSphereIPPools {
+		objs = appendIfNotNil(objs, e)
+	}
+
 	for _, e := range c.CloudStackMachineConfigs {
 		objs = appendIfNotNil(objs, e)
 	}
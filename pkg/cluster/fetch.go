@@ -23,6 +23,7 @@ type ManagementComponents struct {
 	Docker                 v1alpha1release.DockerBundle
 	Eksa                   v1alpha1release.EksaBundle
 	Flux                   v1alpha1release.FluxBundle
+	ArgoCD                 v1alpha1release.ArgoCDBundle
 	ExternalEtcdBootstrap  v1alpha1release.EtcdadmBootstrapBundle
 	ExternalEtcdController v1alpha1release.EtcdadmControllerBundle
 	Tinkerbell             v1alpha1release.TinkerbellBundle
@@ -57,6 +58,7 @@ func newManagementComponents(vb *v1alpha1release.VersionsBundle) *ManagementComp
 		Docker:                 vb.Docker,
 		Eksa:                   vb.Eksa,
 		Flux:                   vb.Flux,
+		ArgoCD:                 vb.ArgoCD,
 		ExternalEtcdBootstrap:  vb.ExternalEtcdBootstrap,
 		ExternalEtcdController: vb.ExternalEtcdController,
 		Tinkerbell:             vb.Tinkerbell,
@@ -17,10 +17,14 @@ func vsphereEntry() *ConfigManagerEntry {
 			anywherev1.VSphereMachineConfigKind: func() APIObject {
 				return &anywherev1.VSphereMachineConfig{}
 			},
+			anywherev1.VSphereIPPoolKind: func() APIObject {
+				return &anywherev1.VSphereIPPool{}
+			},
 		},
 		Processors: []ParsedProcessor{
 			processVSphereDatacenter,
 			machineConfigsProcessor(processVSphereMachineConfig),
+			vsphereIPPoolsProcessor,
 		},
 		Defaulters: []Defaulter{
 			func(c *Config) error {
@@ -76,6 +80,18 @@ func vsphereEntry() *ConfigManagerEntry {
 				}
 				return nil
 			},
+			func(c *Config) error {
+				for _, p := range c.VSphereIPPools {
+					if err := p.Validate(); err != nil {
+						return err
+					}
+					if err := validateSameNamespace(c, p); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			validateVSphereIPPools,
 		},
 	}
 }
@@ -110,6 +126,84 @@ func processVSphereMachineConfig(c *Config, objects ObjectLookup, machineRef *an
 	c.VSphereMachineConfigs[m.GetName()] = m.(*anywherev1.VSphereMachineConfig)
 }
 
+func vsphereIPPoolsProcessor(c *Config, objects ObjectLookup) {
+	for _, m := range c.VSphereMachineConfigs {
+		ref := m.Spec.IPPoolRef
+		if ref == nil || ref.Kind != anywherev1.VSphereIPPoolKind {
+			continue
+		}
+
+		if c.VSphereIPPools == nil {
+			c.VSphereIPPools = map[string]*anywherev1.VSphereIPPool{}
+		}
+
+		p := objects.GetFromRef(c.Cluster.APIVersion, *ref)
+		if p == nil {
+			continue
+		}
+
+		c.VSphereIPPools[p.GetName()] = p.(*anywherev1.VSphereIPPool)
+	}
+}
+
+// validateVSphereIPPools checks the ip pools referenced by the machine configs exist, don't contain
+// the control plane endpoint and have enough ips for all the machines using them, plus one for rolling upgrades.
+func validateVSphereIPPools(c *Config) error {
+	if c.VSphereMachineConfigs == nil {
+		return nil
+	}
+
+	machines := map[string]int64{}
+	addMachines := func(machineConfigName string, count int) {
+		m := c.VsphereMachineConfig(machineConfigName)
+		if m == nil {
+			return
+		}
+		// Each network interface using a pool takes one ip from it.
+		for _, name := range m.IPPoolNames() {
+			machines[name] += int64(count)
+		}
+	}
+
+	cp := c.Cluster.Spec.ControlPlaneConfiguration
+	if cp.MachineGroupRef != nil {
+		addMachines(cp.MachineGroupRef.Name, cp.Count)
+	}
+	if etcd := c.Cluster.Spec.ExternalEtcdConfiguration; etcd != nil && etcd.MachineGroupRef != nil {
+		addMachines(etcd.MachineGroupRef.Name, etcd.Count)
+	}
+	for _, w := range c.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.MachineGroupRef == nil {
+			continue
+		}
+		count := 0
+		if w.Count != nil {
+			count = *w.Count
+		}
+		if w.AutoScalingConfiguration != nil && w.AutoScalingConfiguration.MaxCount > count {
+			count = w.AutoScalingConfiguration.MaxCount
+		}
+		addMachines(w.MachineGroupRef.Name, count)
+	}
+
+	for name, count := range machines {
+		pool := c.VSphereIPPool(name)
+		if pool == nil {
+			return fmt.Errorf("unable to find VSphereIPPool %s", name)
+		}
+
+		if cp.Endpoint != nil && pool.Contains(cp.Endpoint.Host) {
+			return fmt.Errorf("control plane endpoint %s can't be part of VSphereIPPool %s", cp.Endpoint.Host, name)
+		}
+
+		if pool.Size() < count+1 {
+			return fmt.Errorf("VSphereIPPool %s has %d ips but it needs at least %d for %d machines and a rolling upgrade", name, pool.Size(), count+1, count)
+		}
+	}
+
+	return nil
+}
+
 func getVSphereDatacenter(ctx context.Context, client Client, c *Config) error {
 	if c.Cluster.Spec.DatacenterRef.Kind != anywherev1.VSphereDatacenterKind {
 		return nil
@@ -144,6 +238,31 @@ func getVSphereMachineConfigs(ctx context.Context, client Client, c *Config) err
 		}
 
 		c.VSphereMachineConfigs[machine.Name] = machine
+
+		if err := getVSphereIPPool(ctx, client, c, machine); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getVSphereIPPool(ctx context.Context, client Client, c *Config, machine *anywherev1.VSphereMachineConfig) error {
+	for _, name := range machine.IPPoolNames() {
+		if c.VSphereIPPools == nil {
+			c.VSphereIPPools = map[string]*anywherev1.VSphereIPPool{}
+		}
+
+		if _, ok := c.VSphereIPPools[name]; ok {
+			continue
+		}
+
+		pool := &anywherev1.VSphereIPPool{}
+		if err := client.Get(ctx, name, c.Cluster.Namespace, pool); err != nil {
+			return err
+		}
+
+		c.VSphereIPPools[pool.Name] = pool
 	}
 
 	return nil
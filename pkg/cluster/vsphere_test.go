@@ -43,6 +43,96 @@ func TestValidateVsphereMachineConfigNotFoundError(t *testing.T) {
 	g.Expect(err).To(MatchError(ContainSubstring("VSphereMachineConfig dummy-machine-config not found")))
 }
 
+func TestParseConfigVSphereIPPool(t *testing.T) {
+	g := NewWithT(t)
+	got, err := cluster.ParseConfigFromFile("testdata/cluster_vsphere_with_ip_pool.yaml")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(got.VSphereIPPools).To(HaveLen(1))
+	pool := got.VSphereIPPool("eksa-unit-test-pool")
+	g.Expect(pool).NotTo(BeNil())
+	g.Expect(pool.Spec.Nameservers).To(ConsistOf("10.0.0.2"))
+	g.Expect(got.ChildObjects()).To(ContainElement(pool))
+
+	cm, _ := cluster.NewDefaultConfigManager()
+	g.Expect(cm.Validate(got)).To(Succeed())
+}
+
+func TestValidateVSphereIPPool(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *cluster.Config)
+		wantErr string
+	}{
+		{
+			name: "pool not found",
+			mutate: func(c *cluster.Config) {
+				delete(c.VSphereIPPools, "eksa-unit-test-pool")
+			},
+			wantErr: "unable to find VSphereIPPool eksa-unit-test-pool",
+		},
+		{
+			name: "invalid pool",
+			mutate: func(c *cluster.Config) {
+				c.VSphereIPPool("eksa-unit-test-pool").Spec.Pools[0].Gateway = ""
+			},
+			wantErr: "VSphereIPPool Pools[0].Gateway can not be empty",
+		},
+		{
+			name: "control plane endpoint in pool",
+			mutate: func(c *cluster.Config) {
+				c.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "10.0.0.12"
+			},
+			wantErr: "control plane endpoint 10.0.0.12 can't be part of VSphereIPPool eksa-unit-test-pool",
+		},
+		{
+			name: "pool too small",
+			mutate: func(c *cluster.Config) {
+				c.Cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration = &anywherev1.AutoScalingConfiguration{
+					MinCount: 1,
+					MaxCount: 5,
+				}
+			},
+			wantErr: "VSphereIPPool eksa-unit-test-pool has 6 ips but it needs at least 9 for 8 machines and a rolling upgrade",
+		},
+		{
+			name: "pool too small with additional network",
+			mutate: func(c *cluster.Config) {
+				c.VsphereMachineConfig("eksa-unit-test-cp").Spec.AdditionalNetworks = []anywherev1.VSphereMachineNetwork{
+					{
+						Network:   "/SDDC-Datacenter/network/storage",
+						IPPoolRef: &anywherev1.Ref{Kind: anywherev1.VSphereIPPoolKind, Name: "eksa-unit-test-pool"},
+					},
+				}
+			},
+			wantErr: "VSphereIPPool eksa-unit-test-pool has 6 ips but it needs at least 9 for 8 machines and a rolling upgrade",
+		},
+		{
+			name: "additional network pool not found",
+			mutate: func(c *cluster.Config) {
+				c.VsphereMachineConfig("eksa-unit-test").Spec.AdditionalNetworks = []anywherev1.VSphereMachineNetwork{
+					{
+						Network:   "/SDDC-Datacenter/network/storage",
+						IPPoolRef: &anywherev1.Ref{Kind: anywherev1.VSphereIPPoolKind, Name: "storage-pool"},
+					},
+				}
+			},
+			wantErr: "unable to find VSphereIPPool storage-pool",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := cluster.ParseConfigFromFile("testdata/cluster_vsphere_with_ip_pool.yaml")
+			g.Expect(err).NotTo(HaveOccurred())
+			tt.mutate(got)
+
+			cm, _ := cluster.NewDefaultConfigManager()
+			g.Expect(cm.Validate(got)).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
+
 func TestDefaultConfigClientBuilderVSphereCluster(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -99,6 +189,18 @@ func TestDefaultConfigClientBuilderVSphereCluster(t *testing.T) {
 			Name:      "machine-2",
 			Namespace: "default",
 		},
+		Spec: anywherev1.VSphereMachineConfigSpec{
+			IPPoolRef: &anywherev1.Ref{
+				Kind: anywherev1.VSphereIPPoolKind,
+				Name: "pool",
+			},
+		},
+	}
+	pool := &anywherev1.VSphereIPPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pool",
+			Namespace: "default",
+		},
 	}
 
 	client.EXPECT().Get(ctx, "datacenter", "default", &anywherev1.VSphereDatacenterConfig{}).Return(nil).DoAndReturn(
@@ -122,6 +224,15 @@ func TestDefaultConfigClientBuilderVSphereCluster(t *testing.T) {
 		func(ctx context.Context, name, namespace string, obj runtime.Object) error {
 			m := obj.(*anywherev1.VSphereMachineConfig)
 			m.ObjectMeta = machineWorker.ObjectMeta
+			m.Spec = machineWorker.Spec
+			return nil
+		},
+	)
+
+	client.EXPECT().Get(ctx, "pool", "default", &anywherev1.VSphereIPPool{}).Return(nil).DoAndReturn(
+		func(ctx context.Context, name, namespace string, obj runtime.Object) error {
+			p := obj.(*anywherev1.VSphereIPPool)
+			p.ObjectMeta = pool.ObjectMeta
 			return nil
 		},
 	)
@@ -134,4 +245,5 @@ func TestDefaultConfigClientBuilderVSphereCluster(t *testing.T) {
 	g.Expect(len(config.VSphereMachineConfigs)).To(Equal(2))
 	g.Expect(config.VSphereMachineConfigs["machine-1"]).To(Equal(machineControlPlane))
 	g.Expect(config.VSphereMachineConfigs["machine-2"]).To(Equal(machineWorker))
+	g.Expect(config.VSphereIPPool("pool")).To(Equal(pool))
 }
@@ -296,6 +296,9 @@ func MachineDeployment(clusterSpec *cluster.Spec, workerNodeGroupConfig anywhere
 	SetUpgradeRolloutStrategyInMachineDeployment(md, workerNodeGroupConfig.UpgradeRolloutStrategy)
 
 	ConfigureAutoscalingInMachineDeployment(md, workerNodeGroupConfig.AutoScalingConfiguration)
+	// The providers building their MachineDeployments here, CloudStack and Snow, don't define the node
+	// resources in their machine configs, so the node capacity isn't published and node groups can't scale from zero.
+	ConfigureScaleFromZeroInMachineDeployment(md, workerNodeGroupConfig, nil)
 
 	return md
 }
@@ -1,19 +1,46 @@
 package clusterapi
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
 )
 
 // Autoscaler annotation constants.
 const (
 	NodeGroupMinSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"
 	NodeGroupMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
+
+	// Per node group autoscaling options.
+	ScaleDownUtilizationThresholdAnnotation = "cluster.x-k8s.io/autoscaling-options-scaledownutilizationthreshold"
+	ScaleDownUnneededTimeAnnotation         = "cluster.x-k8s.io/autoscaling-options-scaledownunneededtime"
+
+	// Scale from zero annotations, used by the autoscaler to build a template node for node groups without nodes.
+	CapacityCPUAnnotation           = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+	CapacityMemoryAnnotation        = "capacity.cluster-autoscaler.kubernetes.io/memory"
+	CapacityEphemeralDiskAnnotation = "capacity.cluster-autoscaler.kubernetes.io/ephemeral-disk"
+	CapacityLabelsAnnotation        = "capacity.cluster-autoscaler.kubernetes.io/labels"
+	CapacityTaintsAnnotation        = "capacity.cluster-autoscaler.kubernetes.io/taints"
 )
 
+// NodeCapacity defines the resources of the nodes of a worker node group, as published to the
+// cluster autoscaler so it can scale the group up from zero. Zero values are not published.
+type NodeCapacity struct {
+	CPU           resource.Quantity
+	Memory        resource.Quantity
+	EphemeralDisk resource.Quantity
+}
+
+// ConfigureAutoscalingInMachineDeployment sets the cluster autoscaler min and max size annotations,
+// as well as the per node group autoscaling options, in a MachineDeployment.
 func ConfigureAutoscalingInMachineDeployment(md *clusterv1.MachineDeployment, autoscalingConfig *anywherev1.AutoScalingConfiguration) {
 	if autoscalingConfig == nil {
 		return
@@ -25,4 +52,115 @@ func ConfigureAutoscalingInMachineDeployment(md *clusterv1.MachineDeployment, au
 
 	md.ObjectMeta.Annotations[NodeGroupMinSizeAnnotation] = strconv.Itoa(autoscalingConfig.MinCount)
 	md.ObjectMeta.Annotations[NodeGroupMaxSizeAnnotation] = strconv.Itoa(autoscalingConfig.MaxCount)
+
+	for k, v := range autoscalingOptionsAnnotations(autoscalingConfig) {
+		md.ObjectMeta.Annotations[k] = v
+	}
+}
+
+// ConfigureScaleFromZeroInMachineDeployment sets the annotations the cluster autoscaler needs to scale
+// a MachineDeployment up from zero replicas: the node capacity and the labels and taints of the nodes.
+// It's a no-op unless autoscaling is enabled with a min count of 0.
+func ConfigureScaleFromZeroInMachineDeployment(md *clusterv1.MachineDeployment, workerNodeGroupConfig anywherev1.WorkerNodeGroupConfiguration, capacity *NodeCapacity) {
+	annotations := scaleFromZeroAnnotations(workerNodeGroupConfig, capacity)
+	if len(annotations) == 0 {
+		return
+	}
+
+	if md.ObjectMeta.Annotations == nil {
+		md.ObjectMeta.Annotations = map[string]string{}
+	}
+
+	for k, v := range annotations {
+		md.ObjectMeta.Annotations[k] = v
+	}
+}
+
+// AutoscalingAnnotations returns the cluster autoscaler annotations for a worker node group MachineDeployment,
+// other than the min and max size ones: the per node group autoscaling options and, when the group
+// can scale from zero, the capacity, labels and taints of its nodes.
+func AutoscalingAnnotations(workerNodeGroupConfig anywherev1.WorkerNodeGroupConfiguration, capacity *NodeCapacity) map[string]string {
+	annotations := autoscalingOptionsAnnotations(workerNodeGroupConfig.AutoScalingConfiguration)
+	for k, v := range scaleFromZeroAnnotations(workerNodeGroupConfig, capacity) {
+		annotations[k] = v
+	}
+
+	return annotations
+}
+
+func autoscalingOptionsAnnotations(autoscalingConfig *anywherev1.AutoScalingConfiguration) map[string]string {
+	annotations := map[string]string{}
+	if autoscalingConfig == nil {
+		return annotations
+	}
+
+	if autoscalingConfig.ScaleDownUtilizationThreshold != "" {
+		annotations[ScaleDownUtilizationThresholdAnnotation] = autoscalingConfig.ScaleDownUtilizationThreshold
+	}
+	if autoscalingConfig.ScaleDownUnneededTime != nil {
+		annotations[ScaleDownUnneededTimeAnnotation] = autoscalingConfig.ScaleDownUnneededTime.Duration.String()
+	}
+
+	return annotations
+}
+
+func scaleFromZeroAnnotations(workerNodeGroupConfig anywherev1.WorkerNodeGroupConfiguration, capacity *NodeCapacity) map[string]string {
+	annotations := map[string]string{}
+	if workerNodeGroupConfig.AutoScalingConfiguration == nil || workerNodeGroupConfig.AutoScalingConfiguration.MinCount != 0 {
+		return annotations
+	}
+
+	if capacity != nil {
+		if !capacity.CPU.IsZero() {
+			annotations[CapacityCPUAnnotation] = capacity.CPU.String()
+		}
+		if !capacity.Memory.IsZero() {
+			annotations[CapacityMemoryAnnotation] = capacity.Memory.String()
+		}
+		if !capacity.EphemeralDisk.IsZero() {
+			annotations[CapacityEphemeralDiskAnnotation] = capacity.EphemeralDisk.String()
+		}
+	}
+
+	if len(workerNodeGroupConfig.Labels) > 0 {
+		labels := make([]string, 0, len(workerNodeGroupConfig.Labels))
+		for k, v := range workerNodeGroupConfig.Labels {
+			labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(labels)
+		annotations[CapacityLabelsAnnotation] = strings.Join(labels, ",")
+	}
+
+	if len(workerNodeGroupConfig.Taints) > 0 {
+		taints := make([]string, 0, len(workerNodeGroupConfig.Taints))
+		for _, taint := range workerNodeGroupConfig.Taints {
+			taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		annotations[CapacityTaintsAnnotation] = strings.Join(taints, ",")
+	}
+
+	return annotations
+}
+
+// ExpanderPriorities returns the cluster autoscaler priority expander configuration for a cluster:
+// for each priority, the node group name patterns of the worker node groups with that priority.
+// It returns nil when no worker node group sets an expander priority.
+func ExpanderPriorities(cluster *anywherev1.Cluster) map[int][]string {
+	var priorities map[int][]string
+	for _, wng := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if wng.AutoScalingConfiguration == nil || wng.AutoScalingConfiguration.ExpanderPriority == nil {
+			continue
+		}
+
+		if priorities == nil {
+			priorities = map[int][]string{}
+		}
+
+		priority := *wng.AutoScalingConfiguration.ExpanderPriority
+		// The clusterapi autoscaler provider identifies node groups as <kind>/<namespace>/<name>.
+		pattern := fmt.Sprintf("^MachineDeployment/%s/%s$", constants.EksaSystemNamespace, regexp.QuoteMeta(MachineDeploymentName(cluster, wng)))
+		priorities[priority] = append(priorities[priority], pattern)
+	}
+
+	return priorities
 }
@@ -2,14 +2,17 @@ package clusterapi_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 func TestConfigureAutoscalingInMachineDeployment(t *testing.T) {
@@ -91,3 +94,183 @@ func TestConfigureAutoscalingInMachineDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureAutoscalingInMachineDeploymentWithOptions(t *testing.T) {
+	g := newApiBuilerTest(t)
+	got := wantMachineDeployment()
+	clusterapi.ConfigureAutoscalingInMachineDeployment(got, &v1alpha1.AutoScalingConfiguration{
+		MinCount:                      1,
+		MaxCount:                      3,
+		ScaleDownUtilizationThreshold: "0.4",
+		ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+	})
+	g.Expect(got.Annotations).To(Equal(map[string]string{
+		"cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size":        "1",
+		"cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size":        "3",
+		"cluster.x-k8s.io/autoscaling-options-scaledownutilizationthreshold": "0.4",
+		"cluster.x-k8s.io/autoscaling-options-scaledownunneededtime":         "5m0s",
+	}))
+}
+
+func TestConfigureScaleFromZeroInMachineDeployment(t *testing.T) {
+	tests := []struct {
+		name            string
+		autoscaling     *v1alpha1.AutoScalingConfiguration
+		capacity        *clusterapi.NodeCapacity
+		wantAnnotations map[string]string
+	}{
+		{
+			name:        "no autoscaling config",
+			autoscaling: nil,
+			capacity: &clusterapi.NodeCapacity{
+				CPU: resource.MustParse("2"),
+			},
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name: "min count greater than zero",
+			autoscaling: &v1alpha1.AutoScalingConfiguration{
+				MinCount: 1,
+				MaxCount: 3,
+			},
+			capacity: &clusterapi.NodeCapacity{
+				CPU: resource.MustParse("2"),
+			},
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name: "scale from zero with capacity",
+			autoscaling: &v1alpha1.AutoScalingConfiguration{
+				MinCount: 0,
+				MaxCount: 3,
+			},
+			capacity: &clusterapi.NodeCapacity{
+				CPU:           resource.MustParse("4"),
+				Memory:        resource.MustParse("8192Mi"),
+				EphemeralDisk: resource.MustParse("25Gi"),
+			},
+			wantAnnotations: map[string]string{
+				"capacity.cluster-autoscaler.kubernetes.io/cpu":            "4",
+				"capacity.cluster-autoscaler.kubernetes.io/memory":         "8Gi",
+				"capacity.cluster-autoscaler.kubernetes.io/ephemeral-disk": "25Gi",
+				"capacity.cluster-autoscaler.kubernetes.io/labels":         "group=burst,tier=batch",
+				"capacity.cluster-autoscaler.kubernetes.io/taints":         "burst=true:NoSchedule,spot=:PreferNoSchedule",
+			},
+		},
+		{
+			name: "scale from zero without capacity",
+			autoscaling: &v1alpha1.AutoScalingConfiguration{
+				MinCount: 0,
+				MaxCount: 3,
+			},
+			wantAnnotations: map[string]string{
+				"capacity.cluster-autoscaler.kubernetes.io/labels": "group=burst,tier=batch",
+				"capacity.cluster-autoscaler.kubernetes.io/taints": "burst=true:NoSchedule,spot=:PreferNoSchedule",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newApiBuilerTest(t)
+			wng := v1alpha1.WorkerNodeGroupConfiguration{
+				Name:                     "wng-1",
+				AutoScalingConfiguration: tt.autoscaling,
+				Labels: map[string]string{
+					"tier":  "batch",
+					"group": "burst",
+				},
+				Taints: []v1.Taint{
+					{Key: "burst", Value: "true", Effect: v1.TaintEffectNoSchedule},
+					{Key: "spot", Effect: v1.TaintEffectPreferNoSchedule},
+				},
+			}
+			got := wantMachineDeployment()
+			clusterapi.ConfigureScaleFromZeroInMachineDeployment(got, wng, tt.capacity)
+			g.Expect(got.Annotations).To(Equal(tt.wantAnnotations))
+		})
+	}
+}
+
+func TestAutoscalingAnnotations(t *testing.T) {
+	g := NewWithT(t)
+	wng := v1alpha1.WorkerNodeGroupConfiguration{
+		Name: "wng-1",
+		AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{
+			MinCount:                      0,
+			MaxCount:                      3,
+			ScaleDownUtilizationThreshold: "0.5",
+		},
+	}
+	capacity := &clusterapi.NodeCapacity{
+		CPU:    resource.MustParse("2"),
+		Memory: resource.MustParse("4Gi"),
+	}
+	g.Expect(clusterapi.AutoscalingAnnotations(wng, capacity)).To(Equal(map[string]string{
+		"cluster.x-k8s.io/autoscaling-options-scaledownutilizationthreshold": "0.5",
+		"capacity.cluster-autoscaler.kubernetes.io/cpu":                      "2",
+		"capacity.cluster-autoscaler.kubernetes.io/memory":                   "4Gi",
+	}))
+}
+
+func TestAutoscalingAnnotationsNoAutoscaling(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(clusterapi.AutoscalingAnnotations(v1alpha1.WorkerNodeGroupConfiguration{Name: "wng-1"}, nil)).To(BeEmpty())
+}
+
+func TestExpanderPriorities(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-cluster",
+		},
+		Spec: v1alpha1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{
+						MaxCount:         3,
+						ExpanderPriority: ptr.Int(10),
+					},
+				},
+				{
+					Name: "md-1",
+					AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{
+						MaxCount:         3,
+						ExpanderPriority: ptr.Int(50),
+					},
+				},
+				{
+					Name: "md-2",
+					AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{
+						MaxCount:         3,
+						ExpanderPriority: ptr.Int(10),
+					},
+				},
+				{
+					Name: "md-3",
+				},
+			},
+		},
+	}
+	g.Expect(clusterapi.ExpanderPriorities(cluster)).To(Equal(map[int][]string{
+		10: {"^MachineDeployment/eksa-system/test-cluster-md-0$", "^MachineDeployment/eksa-system/test-cluster-md-2$"},
+		50: {"^MachineDeployment/eksa-system/test-cluster-md-1$"},
+	}))
+}
+
+func TestExpanderPrioritiesNone(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []v1alpha1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{
+						MaxCount: 3,
+					},
+				},
+			},
+		},
+	}
+	g.Expect(clusterapi.ExpanderPriorities(cluster)).To(BeNil())
+}
@@ -71,12 +71,36 @@ func PodIAMAuthExtraArgs(podIAMConfig *v1alpha1.PodIAMConfig) ExtraArgs {
 	return args
 }
 
+// NodeCIDRMaskExtraArgs returns the kube-controller-manager args to set the node CIDR mask sizes.
+// Dual-stack clusters need a separate mask per IP family.
 func NodeCIDRMaskExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
-	if clusterNetwork == nil || clusterNetwork.Nodes == nil || clusterNetwork.Nodes.CIDRMaskSize == nil {
+	if clusterNetwork == nil || clusterNetwork.Nodes == nil {
 		return nil
 	}
 	args := ExtraArgs{}
-	args.AddIfNotEmpty("node-cidr-mask-size", strconv.Itoa(*clusterNetwork.Nodes.CIDRMaskSize))
+	switch {
+	case clusterNetwork.IsDualStack():
+		args.AddIfNotEmpty("node-cidr-mask-size-ipv4", intPtrToString(clusterNetwork.Nodes.CIDRMaskSize))
+		args.AddIfNotEmpty("node-cidr-mask-size-ipv6", intPtrToString(clusterNetwork.Nodes.CIDRMaskSizeIPv6))
+	case clusterNetwork.IsIPv6Primary():
+		args.AddIfNotEmpty("node-cidr-mask-size", intPtrToString(clusterNetwork.Nodes.CIDRMaskSizeIPv6))
+	default:
+		args.AddIfNotEmpty("node-cidr-mask-size", intPtrToString(clusterNetwork.Nodes.CIDRMaskSize))
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return args
+}
+
+// NodeIPExtraArgs returns the kubelet args to select the node IP when IPv6 is the primary IP family
+// of the cluster. The kubelet picks an IPv4 address by default.
+func NodeIPExtraArgs(clusterNetwork *v1alpha1.ClusterNetwork) ExtraArgs {
+	if clusterNetwork == nil || !clusterNetwork.IsIPv6Primary() {
+		return nil
+	}
+	args := ExtraArgs{}
+	args.AddIfNotEmpty("node-ip", "::")
 	return args
 }
 
@@ -164,6 +188,13 @@ func (e ExtraArgs) ToPartialYaml() templater.PartialYaml {
 	return p
 }
 
+func intPtrToString(i *int) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.Itoa(*i)
+}
+
 func requiredClaimToArg(r *v1alpha1.OIDCConfigRequiredClaim) string {
 	if r == nil || r.Claim == "" {
 		return ""
@@ -553,6 +553,8 @@ func TestAppend(t *testing.T) {
 func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 	nodeCidrMaskSize := new(int)
 	*nodeCidrMaskSize = 28
+	nodeCidrMaskSizeIPv6 := new(int)
+	*nodeCidrMaskSizeIPv6 = 72
 	tests := []struct {
 		testName       string
 		clusterNetwork *v1alpha1.ClusterNetwork
@@ -586,6 +588,37 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			testName: "ipv6 with nodes config",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"fd00:10:1::/56"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize, CIDRMaskSizeIPv6: nodeCidrMaskSizeIPv6},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size": "72",
+			},
+		},
+		{
+			testName: "dual-stack with nodes config",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"10.1.0.0/16", "fd00:10:1::/56"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize, CIDRMaskSizeIPv6: nodeCidrMaskSizeIPv6},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size-ipv4": "28",
+				"node-cidr-mask-size-ipv6": "72",
+			},
+		},
+		{
+			testName: "dual-stack with only ipv4 nodes config",
+			clusterNetwork: &v1alpha1.ClusterNetwork{
+				Pods:  v1alpha1.Pods{CidrBlocks: []string{"fd00:10:1::/56", "10.1.0.0/16"}},
+				Nodes: &v1alpha1.Nodes{CIDRMaskSize: nodeCidrMaskSize},
+			},
+			want: clusterapi.ExtraArgs{
+				"node-cidr-mask-size-ipv4": "28",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -597,6 +630,44 @@ func TestNodeCIDRMaskExtraArgs(t *testing.T) {
 	}
 }
 
+func TestNodeIPExtraArgs(t *testing.T) {
+	tests := []struct {
+		testName string
+		pods     []string
+		want     clusterapi.ExtraArgs
+	}{
+		{
+			testName: "ipv4",
+			pods:     []string{"10.1.0.0/16"},
+			want:     nil,
+		},
+		{
+			testName: "dual-stack ipv4 primary",
+			pods:     []string{"10.1.0.0/16", "fd00:10:1::/56"},
+			want:     nil,
+		},
+		{
+			testName: "dual-stack ipv6 primary",
+			pods:     []string{"fd00:10:1::/56", "10.1.0.0/16"},
+			want:     clusterapi.ExtraArgs{"node-ip": "::"},
+		},
+		{
+			testName: "ipv6",
+			pods:     []string{"fd00:10:1::/56"},
+			want:     clusterapi.ExtraArgs{"node-ip": "::"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			clusterNetwork := &v1alpha1.ClusterNetwork{Pods: v1alpha1.Pods{CidrBlocks: tt.pods}}
+			if got := clusterapi.NodeIPExtraArgs(clusterNetwork); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodeIPExtraArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestEtcdEncryptionExtraArgs(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -82,6 +82,9 @@ func registryMirrorConfigContent(registryMirror *registrymirror.RegistryMirror)
 		"mirrorBase":        registryMirror.BaseRegistry,
 		"registryCACert":    registryMirror.CACertContent,
 		"insecureSkip":      registryMirror.InsecureSkipVerify,
+
+		"registryMirrorFallbacks":         containerd.ToAPIEndpointLists(registryMirror.FallbackRegistryMap()),
+		"registryMirrorFallbackEndpoints": registryMirror.Fallbacks,
 	}
 
 	config, err := templater.Execute(containerdConfig, val)
@@ -113,6 +116,17 @@ func registryMirrorConfig(registryMirrorConfig *v1alpha1.RegistryMirrorConfigura
 		})
 	}
 
+	for _, fallback := range registryMirror.Fallbacks {
+		if fallback.CACertContent == "" {
+			continue
+		}
+		files = append(files, bootstrapv1.File{
+			Path:    fmt.Sprintf("/etc/containerd/certs.d/%s/ca.crt", fallback.BaseRegistry),
+			Owner:   "root:root",
+			Content: fallback.CACertContent,
+		})
+	}
+
 	return files, nil
 }
 
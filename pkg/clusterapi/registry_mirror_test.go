@@ -126,6 +126,59 @@ var registryMirrorTests = []struct {
 			CACert:   "xyz",
 		},
 	},
+	{
+		name: "with fallback endpoints",
+		registryMirrorConfig: &v1alpha1.RegistryMirrorConfiguration{
+			Endpoint:      "1.2.3.4",
+			Port:          "443",
+			CACertContent: "xyz",
+			FallbackEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+				{
+					Endpoint:      "5.6.7.8",
+					Port:          "443",
+					CACertContent: "abc",
+				},
+				{
+					Endpoint:           "9.10.11.12",
+					Port:               "443",
+					InsecureSkipVerify: true,
+				},
+			},
+		},
+		wantFiles: []bootstrapv1.File{
+			{
+				Path:  "/etc/containerd/config_append.toml",
+				Owner: "root:root",
+				Content: `[plugins."io.containerd.grpc.v1.cri".registry.mirrors]
+  [plugins."io.containerd.grpc.v1.cri".registry.mirrors."public.ecr.aws"]
+    endpoint = ["https://1.2.3.4:443", "https://5.6.7.8:443", "https://9.10.11.12:443"]
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."1.2.3.4:443".tls]
+    ca_file = "/etc/containerd/certs.d/1.2.3.4:443/ca.crt"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."5.6.7.8:443".tls]
+    ca_file = "/etc/containerd/certs.d/5.6.7.8:443/ca.crt"
+  [plugins."io.containerd.grpc.v1.cri".registry.configs."9.10.11.12:443".tls]
+    insecure_skip_verify = true`,
+			},
+			{
+				Path:    "/etc/containerd/certs.d/1.2.3.4:443/ca.crt",
+				Owner:   "root:root",
+				Content: "xyz",
+			},
+			{
+				Path:    "/etc/containerd/certs.d/5.6.7.8:443/ca.crt",
+				Owner:   "root:root",
+				Content: "abc",
+			},
+		},
+		wantRegistryConfig: bootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4:443",
+			CACert:   "xyz",
+		},
+		wantRegistryConfigEtcd: &etcdbootstrapv1.RegistryMirrorConfiguration{
+			Endpoint: "1.2.3.4:443",
+			CACert:   "xyz",
+		},
+	},
 }
 
 func TestSetRegistryMirrorInKubeadmControlPlaneBottleRocket(t *testing.T) {
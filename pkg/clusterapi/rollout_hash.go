@@ -0,0 +1,100 @@
+package clusterapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// nodeGroupRolloutConfig is the configuration that, when changed, replaces or upgrades
+// the machines of a node group. It mirrors the triggers reported by NodeRolloutChangeDiff.
+type nodeGroupRolloutConfig struct {
+	KubernetesVersion anywherev1.KubernetesVersion `json:"kubernetesVersion"`
+	EksaVersion       *anywherev1.EksaVersion      `json:"eksaVersion,omitempty"`
+	BundlesRef        *anywherev1.BundlesRef       `json:"bundlesRef,omitempty"`
+	MachineConfig     interface{}                  `json:"machineConfig,omitempty"`
+	Kubelet           *unstructured.Unstructured   `json:"kubelet,omitempty"`
+	Labels            map[string]string            `json:"labels,omitempty"`
+	Taints            []corev1.Taint               `json:"taints,omitempty"`
+}
+
+// RolloutHashes returns, per node group, a hash of the configuration that determines its machines.
+// Node groups are keyed with the same names NodeRolloutChangeDiff uses. Comparing the hashes of two
+// configs tells which node groups would be rolled out.
+func RolloutHashes(config *cluster.Config) map[string]string {
+	c := config.Cluster
+	hashes := map[string]string{}
+
+	cp := c.Spec.ControlPlaneConfiguration
+	hashes[KubeadmControlPlaneName(c)] = hashRolloutConfig(nodeGroupRolloutConfig{
+		KubernetesVersion: c.Spec.KubernetesVersion,
+		EksaVersion:       c.Spec.EksaVersion,
+		BundlesRef:        c.Spec.BundlesRef,
+		MachineConfig:     machineGroupSpec(config, cp.MachineGroupRef),
+		Kubelet:           cp.KubeletConfiguration,
+		Labels:            cp.Labels,
+		Taints:            cp.Taints,
+	})
+
+	if etcd := c.Spec.ExternalEtcdConfiguration; etcd != nil {
+		hashes[EtcdClusterName(c.Name)] = hashRolloutConfig(nodeGroupRolloutConfig{
+			KubernetesVersion: c.Spec.KubernetesVersion,
+			EksaVersion:       c.Spec.EksaVersion,
+			BundlesRef:        c.Spec.BundlesRef,
+			MachineConfig:     machineGroupSpec(config, etcd.MachineGroupRef),
+		})
+	}
+
+	for _, w := range c.Spec.WorkerNodeGroupConfigurations {
+		kubernetesVersion := c.Spec.KubernetesVersion
+		if w.KubernetesVersion != nil {
+			kubernetesVersion = *w.KubernetesVersion
+		}
+		hashes[w.Name] = hashRolloutConfig(nodeGroupRolloutConfig{
+			KubernetesVersion: kubernetesVersion,
+			EksaVersion:       c.Spec.EksaVersion,
+			BundlesRef:        c.Spec.BundlesRef,
+			MachineConfig:     machineGroupSpec(config, w.MachineGroupRef),
+			Kubelet:           w.KubeletConfiguration,
+			Labels:            w.Labels,
+			Taints:            w.Taints,
+		})
+	}
+
+	return hashes
+}
+
+// PendingRollouts returns the names of the node groups whose hash changed between applied and desired.
+// Node groups that are only in one of them are not rolled out, they are created or deleted.
+func PendingRollouts(applied, desired map[string]string) []string {
+	var pending []string
+	for name, hash := range desired {
+		if appliedHash, ok := applied[name]; ok && appliedHash != hash {
+			pending = append(pending, name)
+		}
+	}
+	sort.Strings(pending)
+
+	return pending
+}
+
+func machineGroupSpec(config *cluster.Config, ref *anywherev1.Ref) interface{} {
+	if ref == nil {
+		return nil
+	}
+	return machineConfigSpec(config, ref.Name)
+}
+
+func hashRolloutConfig(config nodeGroupRolloutConfig) string {
+	// The config only contains API types, which always marshal.
+	b, _ := json.Marshal(config)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
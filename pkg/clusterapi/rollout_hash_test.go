@@ -0,0 +1,90 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+)
+
+func TestRolloutHashesNoChanges(t *testing.T) {
+	g := NewWithT(t)
+	hashes := clusterapi.RolloutHashes(upgradePlanSpec().Config)
+
+	g.Expect(hashes).To(HaveLen(3))
+	g.Expect(hashes).To(HaveKey("my-cluster"))
+	g.Expect(hashes).To(HaveKey("md-0"))
+	g.Expect(hashes).To(HaveKey("md-1"))
+	g.Expect(clusterapi.RolloutHashes(upgradePlanSpec().Config)).To(Equal(hashes))
+}
+
+func TestRolloutHashesChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*v1alpha1.Cluster)
+		want   []string
+	}{
+		{
+			name: "kubernetes version",
+			change: func(c *v1alpha1.Cluster) {
+				c.Spec.KubernetesVersion = v1alpha1.Kube129
+			},
+			want: []string{"md-0", "md-1", "my-cluster"},
+		},
+		{
+			name: "eksa version",
+			change: func(c *v1alpha1.Cluster) {
+				version := v1alpha1.EksaVersion("v0.20.0")
+				c.Spec.EksaVersion = &version
+			},
+			want: []string{"md-0", "md-1", "my-cluster"},
+		},
+		{
+			name: "worker labels",
+			change: func(c *v1alpha1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations[1].Labels = map[string]string{"team": "a"}
+			},
+			want: []string{"md-1"},
+		},
+		{
+			name: "worker count",
+			change: func(c *v1alpha1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations[0].Count = nil
+			},
+			want: nil,
+		},
+		{
+			name: "new worker group",
+			change: func(c *v1alpha1.Cluster) {
+				c.Spec.WorkerNodeGroupConfigurations = append(c.Spec.WorkerNodeGroupConfigurations, v1alpha1.WorkerNodeGroupConfiguration{Name: "md-2"})
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			applied := clusterapi.RolloutHashes(upgradePlanSpec().Config)
+			newSpec := upgradePlanSpec()
+			tt.change(newSpec.Cluster)
+
+			g.Expect(clusterapi.PendingRollouts(applied, clusterapi.RolloutHashes(newSpec.Config))).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRolloutHashesMachineConfigChange(t *testing.T) {
+	g := NewWithT(t)
+	applied := clusterapi.RolloutHashes(upgradePlanSpec().Config)
+	newSpec := upgradePlanSpec()
+	newSpec.VSphereMachineConfigs["cp"].Spec.MemoryMiB = 16384
+
+	g.Expect(clusterapi.PendingRollouts(applied, clusterapi.RolloutHashes(newSpec.Config))).To(Equal([]string{"my-cluster"}))
+}
+
+func TestPendingRolloutsNoAppliedHashes(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(clusterapi.PendingRollouts(nil, clusterapi.RolloutHashes(upgradePlanSpec().Config))).To(BeEmpty())
+}
@@ -0,0 +1,289 @@
+package clusterapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Default rollout parameters used by CAPI when the cluster doesn't set an upgrade rollout strategy.
+const (
+	defaultMaxSurge       = 1
+	defaultMaxUnavailable = 0
+)
+
+// NodeRolloutChangeDiff returns, for the control plane, the external etcd and each worker node group,
+// whether upgrading from currentSpec to newSpec replaces or upgrades in place their machines, what
+// triggers it and an estimate of how the rollout proceeds given the upgrade rollout strategy.
+func NodeRolloutChangeDiff(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
+	nodeGroups := []types.NodeGroupRollout{controlPlaneRollout(currentSpec, newSpec)}
+
+	if currentSpec.Cluster.Spec.ExternalEtcdConfiguration != nil && newSpec.Cluster.Spec.ExternalEtcdConfiguration != nil {
+		nodeGroups = append(nodeGroups, etcdRollout(currentSpec, newSpec))
+	}
+
+	nodeGroups = append(nodeGroups, workerRollouts(currentSpec, newSpec)...)
+
+	return &types.ChangeDiff{NodeGroups: nodeGroups}
+}
+
+func controlPlaneRollout(currentSpec, newSpec *cluster.Spec) types.NodeGroupRollout {
+	oldCP := currentSpec.Cluster.Spec.ControlPlaneConfiguration
+	newCP := newSpec.Cluster.Spec.ControlPlaneConfiguration
+
+	var reasons []string
+	reasons = append(reasons, kubernetesVersionReasons(currentSpec.Cluster.Spec.KubernetesVersion, newSpec.Cluster.Spec.KubernetesVersion)...)
+	reasons = append(reasons, bundleReasons(currentSpec, newSpec)...)
+	reasons = append(reasons, machineConfigReasons(currentSpec, newSpec, oldCP.MachineGroupRef, newCP.MachineGroupRef)...)
+	reasons = append(reasons, kubeletConfigReasons(oldCP.KubeletConfiguration, newCP.KubeletConfiguration)...)
+	reasons = append(reasons, labelsAndTaintsReasons(oldCP.Labels, newCP.Labels, oldCP.Taints, newCP.Taints)...)
+
+	rollout := types.NodeGroupRollout{
+		Name:           KubeadmControlPlaneName(newSpec.Cluster),
+		Role:           types.NodeGroupRoleControlPlane,
+		MaxSurge:       defaultMaxSurge,
+		MaxUnavailable: defaultMaxUnavailable,
+	}
+
+	inPlace := false
+	if strategy := newCP.UpgradeRolloutStrategy; strategy != nil {
+		inPlace = strategy.Type == anywherev1.InPlaceStrategyType
+		if strategy.RollingUpdate != nil {
+			rollout.MaxSurge = strategy.RollingUpdate.MaxSurge
+		}
+	}
+
+	return completeRollout(rollout, reasons, newCP.Count, inPlace)
+}
+
+func etcdRollout(currentSpec, newSpec *cluster.Spec) types.NodeGroupRollout {
+	oldEtcd := currentSpec.Cluster.Spec.ExternalEtcdConfiguration
+	newEtcd := newSpec.Cluster.Spec.ExternalEtcdConfiguration
+
+	var reasons []string
+	reasons = append(reasons, kubernetesVersionReasons(currentSpec.Cluster.Spec.KubernetesVersion, newSpec.Cluster.Spec.KubernetesVersion)...)
+	reasons = append(reasons, bundleReasons(currentSpec, newSpec)...)
+	reasons = append(reasons, machineConfigReasons(currentSpec, newSpec, oldEtcd.MachineGroupRef, newEtcd.MachineGroupRef)...)
+
+	// The etcdadm controller always replaces etcd machines one at a time.
+	rollout := types.NodeGroupRollout{
+		Name:           EtcdClusterName(newSpec.Cluster.Name),
+		Role:           types.NodeGroupRoleEtcd,
+		MaxSurge:       defaultMaxSurge,
+		MaxUnavailable: defaultMaxUnavailable,
+	}
+
+	return completeRollout(rollout, reasons, newEtcd.Count, false)
+}
+
+func workerRollouts(currentSpec, newSpec *cluster.Spec) []types.NodeGroupRollout {
+	oldWorkers := make(map[string]anywherev1.WorkerNodeGroupConfiguration, len(currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	for _, w := range currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		oldWorkers[w.Name] = w
+	}
+
+	rollouts := make([]types.NodeGroupRollout, 0, len(newSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	newWorkers := make(map[string]struct{}, len(newSpec.Cluster.Spec.WorkerNodeGroupConfigurations))
+	for _, newWorker := range newSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		newWorkers[newWorker.Name] = struct{}{}
+		rollout := types.NodeGroupRollout{
+			Name:           newWorker.Name,
+			Role:           types.NodeGroupRoleWorker,
+			MaxSurge:       defaultMaxSurge,
+			MaxUnavailable: defaultMaxUnavailable,
+		}
+
+		oldWorker, ok := oldWorkers[newWorker.Name]
+		if !ok {
+			rollout.Action = types.NodeRolloutCreate
+			rollout.Nodes = workerNodeCount(newWorker)
+			rollouts = append(rollouts, rollout)
+			continue
+		}
+
+		var reasons []string
+		reasons = append(reasons, kubernetesVersionReasons(workerKubernetesVersion(currentSpec, oldWorker), workerKubernetesVersion(newSpec, newWorker))...)
+		reasons = append(reasons, bundleReasons(currentSpec, newSpec)...)
+		reasons = append(reasons, machineConfigReasons(currentSpec, newSpec, oldWorker.MachineGroupRef, newWorker.MachineGroupRef)...)
+		reasons = append(reasons, kubeletConfigReasons(oldWorker.KubeletConfiguration, newWorker.KubeletConfiguration)...)
+		reasons = append(reasons, labelsAndTaintsReasons(oldWorker.Labels, newWorker.Labels, oldWorker.Taints, newWorker.Taints)...)
+
+		inPlace := false
+		if strategy := newWorker.UpgradeRolloutStrategy; strategy != nil {
+			inPlace = strategy.Type == anywherev1.InPlaceStrategyType
+			if strategy.RollingUpdate != nil {
+				rollout.MaxSurge = strategy.RollingUpdate.MaxSurge
+				rollout.MaxUnavailable = strategy.RollingUpdate.MaxUnavailable
+			}
+		}
+
+		rollouts = append(rollouts, completeRollout(rollout, reasons, workerNodeCount(newWorker), inPlace))
+	}
+
+	for _, oldWorker := range currentSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if _, ok := newWorkers[oldWorker.Name]; ok {
+			continue
+		}
+		rollouts = append(rollouts, types.NodeGroupRollout{
+			Name:   oldWorker.Name,
+			Role:   types.NodeGroupRoleWorker,
+			Action: types.NodeRolloutDelete,
+			Nodes:  workerNodeCount(oldWorker),
+		})
+	}
+
+	return rollouts
+}
+
+// completeRollout sets the action, the number of affected nodes and the estimated number of batches.
+func completeRollout(rollout types.NodeGroupRollout, reasons []string, nodes int, inPlace bool) types.NodeGroupRollout {
+	if len(reasons) == 0 {
+		rollout.Action = types.NodeRolloutNone
+		return rollout
+	}
+
+	rollout.Reasons = reasons
+	rollout.Nodes = nodes
+
+	if inPlace {
+		// In place upgrades don't create new machines and upgrade one node at a time.
+		rollout.Action = types.NodeRolloutInPlace
+		rollout.MaxSurge = 0
+		rollout.MaxUnavailable = 1
+	} else {
+		rollout.Action = types.NodeRolloutReplace
+	}
+
+	parallelism := rollout.MaxSurge + rollout.MaxUnavailable
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	rollout.Batches = (nodes + parallelism - 1) / parallelism
+
+	return rollout
+}
+
+func kubernetesVersionReasons(oldVersion, newVersion anywherev1.KubernetesVersion) []string {
+	if oldVersion == newVersion {
+		return nil
+	}
+	return []string{fmt.Sprintf("Kubernetes version changed from %s to %s", oldVersion, newVersion)}
+}
+
+func bundleReasons(currentSpec, newSpec *cluster.Spec) []string {
+	if currentSpec.Bundles == nil || newSpec.Bundles == nil || currentSpec.Bundles.Spec.Number == newSpec.Bundles.Spec.Number {
+		return nil
+	}
+	return []string{fmt.Sprintf("Bundle changed from %d to %d, which updates the node image and components", currentSpec.Bundles.Spec.Number, newSpec.Bundles.Spec.Number)}
+}
+
+func machineConfigReasons(currentSpec, newSpec *cluster.Spec, oldRef, newRef *anywherev1.Ref) []string {
+	if oldRef == nil || newRef == nil {
+		return nil
+	}
+
+	fields := changedFields(machineConfigSpec(currentSpec.Config, oldRef.Name), machineConfigSpec(newSpec.Config, newRef.Name))
+	if len(fields) == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("Machine config fields changed: %s", strings.Join(fields, ", "))}
+}
+
+func kubeletConfigReasons(oldConfig, newConfig *unstructured.Unstructured) []string {
+	if reflect.DeepEqual(oldConfig, newConfig) {
+		return nil
+	}
+	return []string{"Kubelet configuration changed"}
+}
+
+func labelsAndTaintsReasons(oldLabels, newLabels map[string]string, oldTaints, newTaints []corev1.Taint) []string {
+	var reasons []string
+	if !anywherev1.MapEqual(oldLabels, newLabels) {
+		reasons = append(reasons, "Node labels changed")
+	}
+	if !anywherev1.TaintsSliceEqual(oldTaints, newTaints) {
+		reasons = append(reasons, "Node taints changed")
+	}
+	return reasons
+}
+
+func workerKubernetesVersion(spec *cluster.Spec, worker anywherev1.WorkerNodeGroupConfiguration) anywherev1.KubernetesVersion {
+	if worker.KubernetesVersion != nil {
+		return *worker.KubernetesVersion
+	}
+	return spec.Cluster.Spec.KubernetesVersion
+}
+
+func workerNodeCount(worker anywherev1.WorkerNodeGroupConfiguration) int {
+	if worker.Count != nil {
+		return *worker.Count
+	}
+	if worker.AutoScalingConfiguration != nil {
+		return worker.AutoScalingConfiguration.MinCount
+	}
+	return 0
+}
+
+// machineConfigSpec returns the spec of the provider machine config with the given name,
+// or nil if the provider doesn't have machine configs.
+func machineConfigSpec(spec *cluster.Config, name string) interface{} {
+	if m, ok := spec.VSphereMachineConfigs[name]; ok {
+		return m.Spec
+	}
+	if m, ok := spec.CloudStackMachineConfigs[name]; ok {
+		return m.Spec
+	}
+	if m, ok := spec.SnowMachineConfigs[name]; ok {
+		return m.Spec
+	}
+	if m, ok := spec.NutanixMachineConfigs[name]; ok {
+		return m.Spec
+	}
+	if m, ok := spec.TinkerbellMachineConfigs[name]; ok {
+		return m.Spec
+	}
+	return nil
+}
+
+// changedFields returns the sorted names of the top level fields that differ between two specs.
+func changedFields(oldSpec, newSpec interface{}) []string {
+	oldFields := toFieldMap(oldSpec)
+	newFields := toFieldMap(newSpec)
+
+	var fields []string
+	for name, value := range newFields {
+		if !reflect.DeepEqual(oldFields[name], value) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range oldFields {
+		if _, ok := newFields[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+func toFieldMap(spec interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if spec == nil {
+		return fields
+	}
+
+	// Specs are API types, they always marshal.
+	b, _ := json.Marshal(spec)
+	_ = json.Unmarshal(b, &fields)
+
+	return fields
+}
@@ -0,0 +1,196 @@
+package clusterapi_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func upgradePlanSpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "my-cluster"
+		s.Cluster.Spec.KubernetesVersion = v1alpha1.Kube128
+		s.Cluster.Spec.ControlPlaneConfiguration = v1alpha1.ControlPlaneConfiguration{
+			Count:           3,
+			MachineGroupRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "cp"},
+		}
+		s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{
+			{
+				Name:            "md-0",
+				Count:           ptr.Int(10),
+				MachineGroupRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "worker"},
+			},
+			{
+				Name:            "md-1",
+				Count:           ptr.Int(4),
+				MachineGroupRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "worker"},
+			},
+		}
+		s.VSphereMachineConfigs = map[string]*v1alpha1.VSphereMachineConfig{
+			"cp": {
+				ObjectMeta: metav1.ObjectMeta{Name: "cp"},
+				Spec:       v1alpha1.VSphereMachineConfigSpec{NumCPUs: 2, MemoryMiB: 8192},
+			},
+			"worker": {
+				ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+				Spec:       v1alpha1.VSphereMachineConfigSpec{NumCPUs: 4, MemoryMiB: 16384},
+			},
+		}
+		s.Bundles.Spec.Number = 1
+	})
+}
+
+func TestNodeRolloutChangeDiffNoChanges(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+
+	g.Expect(clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups).To(Equal([]types.NodeGroupRollout{
+		{Name: "my-cluster", Role: types.NodeGroupRoleControlPlane, Action: types.NodeRolloutNone, MaxSurge: 1},
+		{Name: "md-0", Role: types.NodeGroupRoleWorker, Action: types.NodeRolloutNone, MaxSurge: 1},
+		{Name: "md-1", Role: types.NodeGroupRoleWorker, Action: types.NodeRolloutNone, MaxSurge: 1},
+	}))
+}
+
+func TestNodeRolloutChangeDiffKubernetesVersion(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+	newSpec.Cluster.Spec.KubernetesVersion = v1alpha1.Kube129
+	// md-1 is pinned to the current version, so only the control plane and md-0 roll.
+	newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[1].KubernetesVersion = &currentSpec.Cluster.Spec.KubernetesVersion
+
+	g.Expect(clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups).To(Equal([]types.NodeGroupRollout{
+		{
+			Name:     "my-cluster",
+			Role:     types.NodeGroupRoleControlPlane,
+			Action:   types.NodeRolloutReplace,
+			Reasons:  []string{"Kubernetes version changed from 1.28 to 1.29"},
+			Nodes:    3,
+			MaxSurge: 1,
+			Batches:  3,
+		},
+		{
+			Name:     "md-0",
+			Role:     types.NodeGroupRoleWorker,
+			Action:   types.NodeRolloutReplace,
+			Reasons:  []string{"Kubernetes version changed from 1.28 to 1.29"},
+			Nodes:    10,
+			MaxSurge: 1,
+			Batches:  10,
+		},
+		{Name: "md-1", Role: types.NodeGroupRoleWorker, Action: types.NodeRolloutNone, MaxSurge: 1},
+	}))
+}
+
+func TestNodeRolloutChangeDiffBundleAndMachineConfig(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+	newSpec.Bundles.Spec.Number = 2
+	newSpec.VSphereMachineConfigs["worker"].Spec.NumCPUs = 8
+	newSpec.VSphereMachineConfigs["worker"].Spec.Template = "new-template"
+
+	nodeGroups := clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups
+	g.Expect(nodeGroups).To(HaveLen(3))
+	g.Expect(nodeGroups[0].Reasons).To(Equal([]string{
+		"Bundle changed from 1 to 2, which updates the node image and components",
+	}))
+	g.Expect(nodeGroups[1].Reasons).To(Equal([]string{
+		"Bundle changed from 1 to 2, which updates the node image and components",
+		"Machine config fields changed: numCPUs, template",
+	}))
+}
+
+func TestNodeRolloutChangeDiffKubeletLabelsAndTaints(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+	newSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration = &unstructured.Unstructured{
+		Object: map[string]interface{}{"maxPods": int64(50)},
+	}
+	newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].Labels = map[string]string{"team": "a"}
+	newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[1].Taints = []corev1.Taint{{Key: "k", Value: "v", Effect: corev1.TaintEffectNoSchedule}}
+
+	nodeGroups := clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups
+	g.Expect(nodeGroups[0].Reasons).To(Equal([]string{"Kubelet configuration changed"}))
+	g.Expect(nodeGroups[1].Reasons).To(Equal([]string{"Node labels changed"}))
+	g.Expect(nodeGroups[2].Reasons).To(Equal([]string{"Node taints changed"}))
+}
+
+func TestNodeRolloutChangeDiffRolloutStrategy(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+	newSpec.Cluster.Spec.KubernetesVersion = v1alpha1.Kube129
+	newSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &v1alpha1.ControlPlaneUpgradeRolloutStrategy{
+		Type: v1alpha1.InPlaceStrategyType,
+	}
+	newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &v1alpha1.WorkerNodesUpgradeRolloutStrategy{
+		RollingUpdate: &v1alpha1.WorkerNodesRollingUpdateParams{MaxSurge: 2, MaxUnavailable: 1},
+	}
+
+	nodeGroups := clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups
+	g.Expect(nodeGroups[0].Action).To(Equal(types.NodeRolloutInPlace))
+	g.Expect(nodeGroups[0].MaxSurge).To(Equal(0))
+	g.Expect(nodeGroups[0].MaxUnavailable).To(Equal(1))
+	g.Expect(nodeGroups[0].Batches).To(Equal(3))
+
+	g.Expect(nodeGroups[1].Action).To(Equal(types.NodeRolloutReplace))
+	g.Expect(nodeGroups[1].Nodes).To(Equal(10))
+	g.Expect(nodeGroups[1].Batches).To(Equal(4))
+}
+
+func TestNodeRolloutChangeDiffWorkerGroupsAddedAndRemoved(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	newSpec := upgradePlanSpec()
+	newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[1] = v1alpha1.WorkerNodeGroupConfiguration{
+		Name:                     "md-2",
+		AutoScalingConfiguration: &v1alpha1.AutoScalingConfiguration{MinCount: 2, MaxCount: 5},
+		MachineGroupRef:          &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "worker"},
+	}
+
+	nodeGroups := clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups
+	g.Expect(nodeGroups).To(HaveLen(4))
+	g.Expect(nodeGroups[2]).To(Equal(types.NodeGroupRollout{
+		Name: "md-2", Role: types.NodeGroupRoleWorker, Action: types.NodeRolloutCreate, Nodes: 2, MaxSurge: 1,
+	}))
+	g.Expect(nodeGroups[3]).To(Equal(types.NodeGroupRollout{
+		Name: "md-1", Role: types.NodeGroupRoleWorker, Action: types.NodeRolloutDelete, Nodes: 4,
+	}))
+}
+
+func TestNodeRolloutChangeDiffExternalEtcd(t *testing.T) {
+	g := NewWithT(t)
+	currentSpec := upgradePlanSpec()
+	currentSpec.Cluster.Spec.ExternalEtcdConfiguration = &v1alpha1.ExternalEtcdConfiguration{
+		Count:           3,
+		MachineGroupRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereMachineConfigKind, Name: "cp"},
+	}
+	newSpec := upgradePlanSpec()
+	newSpec.Cluster.Spec.ExternalEtcdConfiguration = currentSpec.Cluster.Spec.ExternalEtcdConfiguration.DeepCopy()
+	newSpec.Cluster.Spec.KubernetesVersion = v1alpha1.Kube129
+
+	nodeGroups := clusterapi.NodeRolloutChangeDiff(currentSpec, newSpec).NodeGroups
+	g.Expect(nodeGroups).To(HaveLen(4))
+	g.Expect(nodeGroups[1]).To(Equal(types.NodeGroupRollout{
+		Name:     "my-cluster-etcd",
+		Role:     types.NodeGroupRoleEtcd,
+		Action:   types.NodeRolloutReplace,
+		Reasons:  []string{"Kubernetes version changed from 1.28 to 1.29"},
+		Nodes:    3,
+		MaxSurge: 1,
+		Batches:  3,
+	}))
+}
@@ -0,0 +1,140 @@
+package clusterbackup
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// helmReleaseSecretType is the type of the secrets helm uses to store releases.
+const helmReleaseSecretType corev1.SecretType = "helm.sh/release.v1"
+
+// CAPIClient saves and restores the CAPI and provider objects of a management cluster.
+type CAPIClient interface {
+	BackupManagementToDirectory(ctx context.Context, cluster *types.Cluster, dir string) error
+	RestoreManagementFromDirectory(ctx context.Context, cluster *types.Cluster, dir, clusterName string) error
+}
+
+// Backuper snapshots the state of a management cluster and the workload clusters it manages.
+type Backuper struct {
+	client     kubernetes.Reader
+	capi       CAPIClient
+	cliVersion string
+	now        func() time.Time
+}
+
+// NewBackuper builds a new Backuper. cliVersion is recorded in the backup manifest.
+func NewBackuper(client kubernetes.Reader, capi CAPIClient, cliVersion string) *Backuper {
+	return &Backuper{
+		client:     client,
+		capi:       capi,
+		cliVersion: cliVersion,
+		now:        time.Now,
+	}
+}
+
+// Backup saves to dir the EKS-A objects of the management cluster and all its workload clusters,
+// the secrets in the eksa-system namespace and the CAPI and provider objects.
+func (b *Backuper) Backup(ctx context.Context, managementCluster *types.Cluster, dir string) (*Manifest, error) {
+	clusters, err := b.clusters(ctx, managementCluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		ManagementCluster: managementCluster.Name,
+		CreatedAt:         b.now().UTC(),
+		CLIVersion:        b.cliVersion,
+	}
+
+	for _, c := range clusters {
+		logger.V(3).Info("Saving EKS-A objects", "cluster", c.Name)
+		config, err := cluster.NewDefaultConfigClientBuilder().Build(ctx, b.client, c)
+		if err != nil {
+			return nil, fmt.Errorf("reading eks-a objects for cluster %s: %v", c.Name, err)
+		}
+
+		ref := ClusterRef{Name: c.Name, Namespace: c.Namespace}
+		if err := writeObjects(clusterObjectsFile(dir, ref), config.ClusterAndChildren()); err != nil {
+			return nil, err
+		}
+
+		manifest.Clusters = append(manifest.Clusters, ref)
+	}
+
+	logger.V(3).Info("Saving secrets", "namespace", constants.EksaSystemNamespace)
+	if err := b.backupSecrets(ctx, dir); err != nil {
+		return nil, err
+	}
+
+	logger.V(3).Info("Saving CAPI objects")
+	if err := b.capi.BackupManagementToDirectory(ctx, managementCluster, filepath.Join(dir, capiDirName)); err != nil {
+		return nil, err
+	}
+
+	if err := writeManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// clusters returns the management cluster followed by the workload clusters it manages.
+func (b *Backuper) clusters(ctx context.Context, managementClusterName string) ([]*anywherev1.Cluster, error) {
+	list := &anywherev1.ClusterList{}
+	if err := b.client.List(ctx, list); err != nil {
+		return nil, fmt.Errorf("listing eks-a clusters: %v", err)
+	}
+
+	var management *anywherev1.Cluster
+	var workloads []*anywherev1.Cluster
+	for i := range list.Items {
+		c := &list.Items[i]
+		switch {
+		case c.Name == managementClusterName:
+			management = c
+		case c.ManagedBy() == managementClusterName:
+			workloads = append(workloads, c)
+		}
+	}
+
+	if management == nil {
+		return nil, fmt.Errorf("eks-a cluster %s not found", managementClusterName)
+	}
+
+	if !management.IsSelfManaged() {
+		return nil, fmt.Errorf("cluster %s is not a management cluster", managementClusterName)
+	}
+
+	return append([]*anywherev1.Cluster{management}, workloads...), nil
+}
+
+// backupSecrets saves the secrets in the eksa-system namespace, like provider credentials.
+// Secrets owned by other objects are skipped, since clusterctl saves them together with their owners.
+func (b *Backuper) backupSecrets(ctx context.Context, dir string) error {
+	list := &corev1.SecretList{}
+	if err := b.client.List(ctx, list, kubernetes.ListOptions{Namespace: constants.EksaSystemNamespace}); err != nil {
+		return fmt.Errorf("listing secrets: %v", err)
+	}
+
+	var secrets []kubernetes.Object
+	for i := range list.Items {
+		s := &list.Items[i]
+		if len(s.OwnerReferences) > 0 || s.Type == corev1.SecretTypeServiceAccountToken || s.Type == helmReleaseSecretType {
+			continue
+		}
+		secrets = append(secrets, s)
+	}
+
+	return writeObjects(filepath.Join(dir, secretsFileName), secrets)
+}
@@ -0,0 +1,157 @@
+package clusterbackup_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup/mocks"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type backupTest struct {
+	*WithT
+	ctx        context.Context
+	capi       *mocks.MockCAPIClient
+	management *types.Cluster
+	dir        string
+}
+
+func newBackupTest(t *testing.T) *backupTest {
+	return &backupTest{
+		WithT:      NewWithT(t),
+		ctx:        context.Background(),
+		capi:       mocks.NewMockCAPIClient(gomock.NewController(t)),
+		management: &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"},
+		dir:        filepath.Join(t.TempDir(), "backup"),
+	}
+}
+
+func eksaCluster(name, managedBy string) *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			ResourceVersion: "10",
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube129,
+			ManagementCluster: anywherev1.ManagementCluster{Name: managedBy},
+			DatacenterRef: anywherev1.Ref{
+				Kind: anywherev1.DockerDatacenterKind,
+				Name: "docker",
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ObservedGeneration: 1,
+		},
+	}
+}
+
+func managementObjects() []client.Object {
+	return []client.Object{
+		eksaCluster("mgmt", "mgmt"),
+		eksaCluster("workload", "mgmt"),
+		eksaCluster("other-mgmt", "other-mgmt"),
+		&anywherev1.DockerDatacenterConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "docker", Namespace: "default"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: constants.EksaSystemNamespace},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "mgmt-kubeconfig",
+				Namespace:       constants.EksaSystemNamespace,
+				OwnerReferences: []metav1.OwnerReference{{APIVersion: "cluster.x-k8s.io/v1beta1", Kind: "Cluster", Name: "mgmt", UID: "uid"}},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.cilium.v1", Namespace: constants.EksaSystemNamespace},
+			Type:       "helm.sh/release.v1",
+		},
+	}
+}
+
+func (tt *backupTest) expectCAPIBackup() {
+	capiDir := filepath.Join(tt.dir, "capi")
+	tt.capi.EXPECT().BackupManagementToDirectory(tt.ctx, tt.management, capiDir).DoAndReturn(
+		func(_ context.Context, _ *types.Cluster, dir string) error {
+			return os.MkdirAll(dir, os.ModePerm)
+		},
+	)
+}
+
+func TestBackuperBackupSuccess(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.expectCAPIBackup()
+	b := clusterbackup.NewBackuper(test.NewFakeKubeClient(managementObjects()...), tt.capi, "v0.0.0-dev")
+
+	manifest, err := b.Backup(tt.ctx, tt.management, tt.dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(manifest.ManagementCluster).To(Equal("mgmt"))
+	tt.Expect(manifest.CLIVersion).To(Equal("v0.0.0-dev"))
+	tt.Expect(manifest.Clusters).To(Equal([]clusterbackup.ClusterRef{
+		{Name: "mgmt", Namespace: "default"},
+		{Name: "workload", Namespace: "default"},
+	}))
+
+	tt.Expect(filepath.Join(tt.dir, "backup.yaml")).To(BeAnExistingFile())
+	tt.Expect(filepath.Join(tt.dir, "eksa", "default", "other-mgmt.yaml")).NotTo(BeAnExistingFile())
+
+	mgmt, err := os.ReadFile(filepath.Join(tt.dir, "eksa", "default", "mgmt.yaml"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(string(mgmt)).To(ContainSubstring("kind: Cluster"))
+	tt.Expect(string(mgmt)).To(ContainSubstring("kind: DockerDatacenterConfig"))
+	tt.Expect(string(mgmt)).NotTo(ContainSubstring("resourceVersion"))
+	tt.Expect(string(mgmt)).NotTo(ContainSubstring("observedGeneration"))
+
+	secrets, err := os.ReadFile(filepath.Join(tt.dir, "secrets.yaml"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(string(secrets)).To(ContainSubstring("name: credentials"))
+	tt.Expect(string(secrets)).NotTo(ContainSubstring("mgmt-kubeconfig"))
+	tt.Expect(string(secrets)).NotTo(ContainSubstring("sh.helm.release"))
+
+	info, err := os.Stat(filepath.Join(tt.dir, "secrets.yaml"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+}
+
+func TestBackuperBackupClusterNotFound(t *testing.T) {
+	tt := newBackupTest(t)
+	b := clusterbackup.NewBackuper(test.NewFakeKubeClient(), tt.capi, "")
+
+	_, err := b.Backup(tt.ctx, tt.management, tt.dir)
+	tt.Expect(err).To(MatchError("eks-a cluster mgmt not found"))
+}
+
+func TestBackuperBackupNotManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	b := clusterbackup.NewBackuper(test.NewFakeKubeClient(eksaCluster("mgmt", "other-mgmt")), tt.capi, "")
+
+	_, err := b.Backup(tt.ctx, tt.management, tt.dir)
+	tt.Expect(err).To(MatchError("cluster mgmt is not a management cluster"))
+}
+
+func TestBackuperBackupCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.capi.EXPECT().BackupManagementToDirectory(tt.ctx, tt.management, filepath.Join(tt.dir, "capi")).Return(errors.New("clusterctl failed"))
+	b := clusterbackup.NewBackuper(test.NewFakeKubeClient(managementObjects()...), tt.capi, "")
+
+	_, err := b.Backup(tt.ctx, tt.management, tt.dir)
+	tt.Expect(err).To(MatchError("clusterctl failed"))
+	tt.Expect(filepath.Join(tt.dir, "backup.yaml")).NotTo(BeAnExistingFile())
+}
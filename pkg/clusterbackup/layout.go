@@ -0,0 +1,127 @@
+package clusterbackup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/tar"
+)
+
+// A backup is a directory, optionally packaged as a gzipped tarball, with the following layout:
+//
+//	backup.yaml                      manifest describing the backup
+//	eksa/<namespace>/<cluster>.yaml  EKS-A Cluster and child objects of each cluster
+//	secrets.yaml                     secrets in the eksa-system namespace not owned by other objects
+//	capi/                            CAPI and provider objects, as saved by clusterctl
+const (
+	manifestFileName = "backup.yaml"
+	eksaDirName      = "eksa"
+	secretsFileName  = "secrets.yaml"
+	capiDirName      = "capi"
+)
+
+// Manifest describes the contents of a backup.
+type Manifest struct {
+	// ManagementCluster is the name of the management cluster the backup was taken from.
+	ManagementCluster string `json:"managementCluster"`
+	// Clusters are the EKS-A clusters included in the backup, starting with the management cluster.
+	Clusters []ClusterRef `json:"clusters"`
+	// CreatedAt is the time the backup was taken.
+	CreatedAt time.Time `json:"createdAt"`
+	// CLIVersion is the version of the CLI that took the backup.
+	CLIVersion string `json:"cliVersion,omitempty"`
+}
+
+// ClusterRef identifies an EKS-A cluster in a backup.
+type ClusterRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+func (c ClusterRef) String() string {
+	return c.Namespace + "/" + c.Name
+}
+
+// IsArchive returns true if the backup path refers to a gzipped tarball instead of a directory.
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// Archive packages the backup directory into a gzipped tarball and removes the directory.
+func Archive(dir, archive string) error {
+	if err := tar.GzipTarFolder(dir, archive); err != nil {
+		return fmt.Errorf("packaging backup into %s: %v", archive, err)
+	}
+
+	if err := os.Chmod(archive, 0o600); err != nil {
+		return fmt.Errorf("setting backup file permissions: %v", err)
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("cleaning up backup directory %s: %v", dir, err)
+	}
+
+	return nil
+}
+
+// Extract unpackages a backup gzipped tarball into the given directory.
+func Extract(archive, dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("creating directory to extract backup: %v", err)
+	}
+
+	if err := tar.UnGzipTarFile(archive, dir); err != nil {
+		return fmt.Errorf("extracting backup from %s: %v", archive, err)
+	}
+
+	return nil
+}
+
+func writeManifest(dir string, m *Manifest) error {
+	content, err := yaml.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("marshalling backup manifest: %v", err)
+	}
+
+	return writeFile(filepath.Join(dir, manifestFileName), content)
+}
+
+func readManifest(dir string) (*Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("reading backup manifest: %v", err)
+	}
+
+	m := &Manifest{}
+	if err := yaml.UnmarshalStrict(content, m); err != nil {
+		return nil, fmt.Errorf("parsing backup manifest: %v", err)
+	}
+
+	if m.ManagementCluster == "" || len(m.Clusters) == 0 {
+		return nil, fmt.Errorf("invalid backup manifest: no clusters found")
+	}
+
+	return m, nil
+}
+
+func clusterObjectsFile(dir string, c ClusterRef) string {
+	return filepath.Join(dir, eksaDirName, c.Namespace, c.Name+".yaml")
+}
+
+func writeFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("creating backup directory: %v", err)
+	}
+
+	// Backups contain secrets, so they shouldn't be readable by other users.
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("writing backup file %s: %v", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/eks-anywhere/pkg/clusterbackup (interfaces: CAPIClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/eks-anywhere/pkg/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCAPIClient is a mock of CAPIClient interface.
+type MockCAPIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCAPIClientMockRecorder
+}
+
+// MockCAPIClientMockRecorder is the mock recorder for MockCAPIClient.
+type MockCAPIClientMockRecorder struct {
+	mock *MockCAPIClient
+}
+
+// NewMockCAPIClient creates a new mock instance.
+func NewMockCAPIClient(ctrl *gomock.Controller) *MockCAPIClient {
+	mock := &MockCAPIClient{ctrl: ctrl}
+	mock.recorder = &MockCAPIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCAPIClient) EXPECT() *MockCAPIClientMockRecorder {
+	return m.recorder
+}
+
+// BackupManagementToDirectory mocks base method.
+func (m *MockCAPIClient) BackupManagementToDirectory(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupManagementToDirectory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackupManagementToDirectory indicates an expected call of BackupManagementToDirectory.
+func (mr *MockCAPIClientMockRecorder) BackupManagementToDirectory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupManagementToDirectory", reflect.TypeOf((*MockCAPIClient)(nil).BackupManagementToDirectory), arg0, arg1, arg2)
+}
+
+// RestoreManagementFromDirectory mocks base method.
+func (m *MockCAPIClient) RestoreManagementFromDirectory(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreManagementFromDirectory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreManagementFromDirectory indicates an expected call of RestoreManagementFromDirectory.
+func (mr *MockCAPIClientMockRecorder) RestoreManagementFromDirectory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreManagementFromDirectory", reflect.TypeOf((*MockCAPIClient)(nil).RestoreManagementFromDirectory), arg0, arg1, arg2, arg3)
+}
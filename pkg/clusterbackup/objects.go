@@ -0,0 +1,89 @@
+package clusterbackup
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	unstructuredutil "github.com/aws/eks-anywhere/pkg/utils/unstructured"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = anywherev1.AddToScheme(scheme)
+}
+
+// toUnstructured converts a typed object to its unstructured representation, setting its
+// GroupVersionKind and removing the fields set by the API server, so it can be created in
+// a different cluster.
+func toUnstructured(obj kubernetes.Object) (unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("getting kind for object %s: %v", obj.GetName(), err)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("converting %s %s to unstructured: %v", gvk.Kind, obj.GetName(), err)
+	}
+
+	u := unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	sanitize(&u)
+
+	return u, nil
+}
+
+func sanitize(u *unstructured.Unstructured) {
+	u.SetResourceVersion("")
+	u.SetUID("")
+	u.SetGeneration(0)
+	u.SetSelfLink("")
+	u.SetManagedFields(nil)
+	u.SetOwnerReferences(nil)
+	u.SetFinalizers(nil)
+	u.SetDeletionTimestamp(nil)
+	u.SetDeletionGracePeriodSeconds(nil)
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "status")
+}
+
+func writeObjects(path string, objs []kubernetes.Object) error {
+	us := make([]unstructured.Unstructured, 0, len(objs))
+	for _, obj := range objs {
+		u, err := toUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		us = append(us, u)
+	}
+
+	content, err := unstructuredutil.UnstructuredToYaml(us)
+	if err != nil {
+		return fmt.Errorf("marshalling objects for %s: %v", path, err)
+	}
+
+	return writeFile(path, content)
+}
+
+func readObjects(path string) ([]unstructured.Unstructured, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading backup file: %v", err)
+	}
+
+	objs, err := unstructuredutil.YamlToUnstructured(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing backup file %s: %v", path, err)
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,294 @@
+package clusterbackup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Restorer rehydrates a backup taken by Backuper into a management cluster.
+type Restorer struct {
+	client kubernetes.Client
+	capi   CAPIClient
+}
+
+// NewRestorer builds a new Restorer.
+func NewRestorer(client kubernetes.Client, capi CAPIClient) *Restorer {
+	return &Restorer{
+		client: client,
+		capi:   capi,
+	}
+}
+
+// Restore creates the objects saved in the backup dir in the target cluster. The target cluster
+// must have the EKS-A and CAPI components installed and can't contain any of the backed up clusters.
+// If the target already runs its own EKS-A management cluster, only the backed up workload clusters
+// are restored and they are adopted by it. Otherwise, all the backed up clusters are restored.
+// The EKS-A clusters are created paused and only resumed once all the CAPI objects have been restored.
+func (r *Restorer) Restore(ctx context.Context, target *types.Cluster, dir string) (*Manifest, error) {
+	manifest, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	managementCluster, err := r.targetManagementCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := manifest.Clusters
+	if managementCluster != "" {
+		logger.V(3).Info("Target is an EKS-A management cluster, restoring only workload clusters", "managementCluster", managementCluster)
+		clusters = workloadClusters(manifest)
+	}
+
+	if err := r.validate(ctx, clusters); err != nil {
+		return nil, err
+	}
+
+	logger.V(3).Info("Restoring secrets")
+	if err := r.restoreSecrets(ctx, dir); err != nil {
+		return nil, err
+	}
+
+	for _, c := range clusters {
+		logger.V(3).Info("Restoring EKS-A objects", "cluster", c.Name, "namespace", c.Namespace)
+		if err := r.restoreCluster(ctx, dir, c, managementCluster); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.restoreCAPI(ctx, target, dir, clusters, managementCluster != ""); err != nil {
+		return nil, err
+	}
+
+	for _, c := range clusters {
+		logger.V(3).Info("Resuming EKS-A cluster reconciliation", "cluster", c.Name, "namespace", c.Namespace)
+		if err := r.resumeCluster(ctx, c); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Manifest{
+		ManagementCluster: manifest.ManagementCluster,
+		Clusters:          clusters,
+		CreatedAt:         manifest.CreatedAt,
+		CLIVersion:        manifest.CLIVersion,
+	}, nil
+}
+
+// targetManagementCluster returns the name of the EKS-A management cluster running in the target cluster,
+// or an empty string if there is none.
+func (r *Restorer) targetManagementCluster(ctx context.Context) (string, error) {
+	clusters := &anywherev1.ClusterList{}
+	if err := r.client.List(ctx, clusters); err != nil {
+		return "", fmt.Errorf("listing eks-a clusters in the target cluster: %v", err)
+	}
+
+	for _, c := range clusters.Items {
+		if c.IsSelfManaged() {
+			return c.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+func workloadClusters(manifest *Manifest) []ClusterRef {
+	clusters := make([]ClusterRef, 0, len(manifest.Clusters))
+	for _, c := range manifest.Clusters {
+		if c.Name != manifest.ManagementCluster {
+			clusters = append(clusters, c)
+		}
+	}
+
+	return clusters
+}
+
+func (r *Restorer) validate(ctx context.Context, clusters []ClusterRef) error {
+	// The CAPI objects of all clusters live in the eksa-system namespace and are named after the
+	// EKS-A cluster, so clusters with the same name in different namespaces can't be told apart.
+	names := make(map[string]ClusterRef, len(clusters))
+	for _, c := range clusters {
+		if other, ok := names[c.Name]; ok {
+			return fmt.Errorf("eks-a clusters %s and %s have the same name, their CAPI objects can't be restored separately", other, c)
+		}
+		names[c.Name] = c
+	}
+
+	for _, c := range clusters {
+		err := r.client.Get(ctx, c.Name, c.Namespace, &anywherev1.Cluster{})
+		if err == nil {
+			return fmt.Errorf("eks-a cluster %s already exists in the target cluster", c)
+		}
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("checking if eks-a cluster %s exists: %v", c, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreCAPI restores the CAPI and provider objects. When only workload clusters are restored,
+// the objects are filtered by cluster so the ones of the old management cluster are left out.
+func (r *Restorer) restoreCAPI(ctx context.Context, target *types.Cluster, dir string, clusters []ClusterRef, filter bool) error {
+	capiDir := filepath.Join(dir, capiDirName)
+	if !filter {
+		logger.V(3).Info("Restoring CAPI objects")
+		return r.capi.RestoreManagementFromDirectory(ctx, target, capiDir, "")
+	}
+
+	for _, c := range clusters {
+		logger.V(3).Info("Restoring CAPI objects", "cluster", c.Name, "namespace", c.Namespace)
+		if err := r.capi.RestoreManagementFromDirectory(ctx, target, capiDir, c.Name); err != nil {
+			return fmt.Errorf("restoring CAPI objects for eks-a cluster %s: %v", c, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Restorer) restoreSecrets(ctx context.Context, dir string) error {
+	path := filepath.Join(dir, secretsFileName)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	secrets, err := readObjects(path)
+	if err != nil {
+		return err
+	}
+
+	return r.create(ctx, secrets)
+}
+
+// restoreCluster creates the EKS-A objects for a cluster, creating the cluster namespace if needed.
+// The child objects are created before the Cluster so they are available when it's validated.
+// If managementCluster is not empty, the cluster is updated to be managed by it.
+func (r *Restorer) restoreCluster(ctx context.Context, dir string, c ClusterRef, managementCluster string) error {
+	if err := r.ensureNamespace(ctx, c.Namespace); err != nil {
+		return err
+	}
+
+	objs, err := readObjects(clusterObjectsFile(dir, c))
+	if err != nil {
+		return err
+	}
+
+	var cluster *unstructured.Unstructured
+	children := make([]unstructured.Unstructured, 0, len(objs))
+	for i := range objs {
+		if objs[i].GetKind() == anywherev1.ClusterKind {
+			cluster = &objs[i]
+			continue
+		}
+		children = append(children, objs[i])
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("eks-a cluster %s not found in backup", c)
+	}
+
+	if err := r.create(ctx, children); err != nil {
+		return err
+	}
+
+	sanitize(cluster)
+	eksaCluster := &anywherev1.Cluster{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cluster.Object, eksaCluster); err != nil {
+		return fmt.Errorf("parsing eks-a cluster %s from backup: %v", c, err)
+	}
+	if managementCluster != "" {
+		eksaCluster.SetManagedBy(managementCluster)
+	}
+	eksaCluster.PauseReconcile()
+
+	if err := r.client.Create(ctx, eksaCluster); err != nil {
+		return fmt.Errorf("restoring eks-a cluster %s: %v", c, err)
+	}
+
+	return nil
+}
+
+func (r *Restorer) ensureNamespace(ctx context.Context, name string) error {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := r.client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating namespace %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// create creates the objects. Objects that already exist are skipped as long as they match the backup,
+// since objects like datacenter configs can be shared by more than one cluster. It fails if they don't,
+// so clusters are never restored pointing to objects other than the backed up ones.
+func (r *Restorer) create(ctx context.Context, objs []unstructured.Unstructured) error {
+	for i := range objs {
+		obj := &objs[i]
+		sanitize(obj)
+		err := r.client.Create(ctx, obj)
+		if apierrors.IsAlreadyExists(err) {
+			err = r.validateExisting(ctx, obj)
+		}
+		if err != nil {
+			return fmt.Errorf("restoring %s %s: %v", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// validateExisting checks the object already in the target cluster has the same content as the
+// one in the backup. Metadata and status are not compared.
+func (r *Restorer) validateExisting(ctx context.Context, obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := r.client.Get(ctx, obj.GetName(), obj.GetNamespace(), existing); err != nil {
+		return fmt.Errorf("reading existing object: %v", err)
+	}
+
+	if !equality.Semantic.DeepEqual(content(existing), content(obj)) {
+		return fmt.Errorf("object already exists in the target cluster and doesn't match the backup")
+	}
+
+	return nil
+}
+
+// content returns the fields of an object other than metadata and status.
+func content(obj *unstructured.Unstructured) map[string]interface{} {
+	c := make(map[string]interface{}, len(obj.Object))
+	for k, v := range obj.Object {
+		if k != "metadata" && k != "status" {
+			c[k] = v
+		}
+	}
+
+	return c
+}
+
+func (r *Restorer) resumeCluster(ctx context.Context, c ClusterRef) error {
+	cluster := &anywherev1.Cluster{}
+	if err := r.client.Get(ctx, c.Name, c.Namespace, cluster); err != nil {
+		return fmt.Errorf("reading eks-a cluster %s: %v", c, err)
+	}
+
+	cluster.ClearPauseAnnotation()
+	if err := r.client.Update(ctx, cluster); err != nil {
+		return fmt.Errorf("resuming eks-a cluster %s: %v", c, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package clusterbackup_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterbackup"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+var target = &types.Cluster{Name: "new-mgmt", KubeconfigFile: "new-mgmt.kubeconfig"}
+
+func (tt *backupTest) backup() {
+	tt.expectCAPIBackup()
+	b := clusterbackup.NewBackuper(test.NewFakeKubeClient(managementObjects()...), tt.capi, "")
+	_, err := b.Backup(tt.ctx, tt.management, tt.dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestRestorerRestoreSuccess(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient()
+
+	tt.capi.EXPECT().RestoreManagementFromDirectory(tt.ctx, target, filepath.Join(tt.dir, "capi"), "").DoAndReturn(
+		func(_ any, _ any, _, _ string) error {
+			// CAPI objects are restored while the EKS-A clusters are paused.
+			tt.expectPaused(client, "mgmt", true)
+			tt.expectPaused(client, "workload", true)
+			return nil
+		},
+	)
+
+	manifest, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(manifest.ManagementCluster).To(Equal("mgmt"))
+
+	tt.expectPaused(client, "mgmt", false)
+	tt.expectPaused(client, "workload", false)
+	tt.Expect(client.Get(tt.ctx, "docker", "default", &anywherev1.DockerDatacenterConfig{})).To(Succeed())
+
+	secret := &corev1.Secret{}
+	tt.Expect(client.Get(tt.ctx, "credentials", constants.EksaSystemNamespace, secret)).To(Succeed())
+	tt.Expect(secret.Data).To(HaveKeyWithValue("password", []byte("secret")))
+}
+
+func TestRestorerRestoreIntoManagementCluster(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient(eksaCluster("new-mgmt", "new-mgmt"))
+	tt.capi.EXPECT().RestoreManagementFromDirectory(tt.ctx, target, filepath.Join(tt.dir, "capi"), "workload")
+
+	manifest, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(manifest.Clusters).To(Equal([]clusterbackup.ClusterRef{{Name: "workload", Namespace: "default"}}))
+
+	workload := &anywherev1.Cluster{}
+	tt.Expect(client.Get(tt.ctx, "workload", "default", workload)).To(Succeed())
+	tt.Expect(workload.ManagedBy()).To(Equal("new-mgmt"))
+	tt.Expect(workload.IsReconcilePaused()).To(BeFalse())
+	tt.Expect(client.Get(tt.ctx, "mgmt", "default", &anywherev1.Cluster{})).NotTo(Succeed())
+}
+
+func (tt *backupTest) expectPaused(client kubernetes.Client, name string, paused bool) {
+	cluster := &anywherev1.Cluster{}
+	tt.Expect(client.Get(tt.ctx, name, "default", cluster)).To(Succeed())
+	tt.Expect(cluster.IsReconcilePaused()).To(Equal(paused), "cluster %s paused", name)
+}
+
+func TestRestorerRestoreClusterExists(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient(eksaCluster("workload", "new-mgmt"))
+
+	_, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError("eks-a cluster default/workload already exists in the target cluster"))
+}
+
+func TestRestorerRestoreExistingObjectMismatch(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: constants.EksaSystemNamespace},
+		Data:       map[string][]byte{"password": []byte("other")},
+	})
+
+	_, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError("restoring Secret credentials: object already exists in the target cluster and doesn't match the backup"))
+}
+
+func TestRestorerRestoreExistingObjectMatches(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient(&corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: constants.EksaSystemNamespace, Labels: map[string]string{"existing": "true"}},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+	tt.capi.EXPECT().RestoreManagementFromDirectory(tt.ctx, target, filepath.Join(tt.dir, "capi"), "")
+
+	_, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestRestorerRestoreCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient()
+	tt.capi.EXPECT().RestoreManagementFromDirectory(tt.ctx, target, filepath.Join(tt.dir, "capi"), "").Return(errors.New("clusterctl failed"))
+
+	_, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError("clusterctl failed"))
+	tt.expectPaused(client, "mgmt", true)
+}
+
+func TestRestorerRestoreIntoManagementClusterCAPIError(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	client := test.NewFakeKubeClient(eksaCluster("new-mgmt", "new-mgmt"))
+	tt.capi.EXPECT().RestoreManagementFromDirectory(tt.ctx, target, filepath.Join(tt.dir, "capi"), "workload").Return(errors.New("clusterctl failed"))
+
+	_, err := clusterbackup.NewRestorer(client, tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError("restoring CAPI objects for eks-a cluster default/workload: clusterctl failed"))
+}
+
+func TestRestorerRestoreClustersWithSameName(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	manifest := `managementCluster: mgmt
+clusters:
+- name: mgmt
+  namespace: default
+- name: workload
+  namespace: default
+- name: workload
+  namespace: other
+createdAt: "2024-01-01T00:00:00Z"
+`
+	tt.Expect(os.WriteFile(filepath.Join(tt.dir, "backup.yaml"), []byte(manifest), 0o600)).To(Succeed())
+
+	_, err := clusterbackup.NewRestorer(test.NewFakeKubeClient(), tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError("eks-a clusters default/workload and other/workload have the same name, their CAPI objects can't be restored separately"))
+}
+
+func TestRestorerRestoreMissingManifest(t *testing.T) {
+	tt := newBackupTest(t)
+
+	_, err := clusterbackup.NewRestorer(test.NewFakeKubeClient(), tt.capi).Restore(tt.ctx, target, tt.dir)
+	tt.Expect(err).To(MatchError(ContainSubstring("reading backup manifest")))
+}
+
+func TestArchiveAndExtract(t *testing.T) {
+	tt := newBackupTest(t)
+	tt.backup()
+	archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	tt.Expect(clusterbackup.IsArchive(archive)).To(BeTrue())
+	tt.Expect(clusterbackup.IsArchive(tt.dir)).To(BeFalse())
+	tt.Expect(clusterbackup.Archive(tt.dir, archive)).To(Succeed())
+	tt.Expect(tt.dir).NotTo(BeAnExistingFile())
+
+	info, err := os.Stat(archive)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o600)))
+
+	extracted := filepath.Join(t.TempDir(), "extracted")
+	tt.Expect(clusterbackup.Extract(archive, extracted)).To(Succeed())
+	tt.Expect(filepath.Join(extracted, "backup.yaml")).To(BeAnExistingFile())
+	tt.Expect(filepath.Join(extracted, "eksa", "default", "workload.yaml")).To(BeAnExistingFile())
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
@@ -149,8 +151,16 @@ func (a Applier) Run(ctx context.Context, spec *cluster.Spec, managementCluster
 		if c.Status.FailureMessage != nil && *c.Status.FailureMessage != "" {
 			return fmt.Errorf("cluster has an error: %s", *c.Status.FailureMessage)
 		}
+		if condition := conditions.Get(c, anywherev1.RollingChangesAppliedCondition); condition != nil &&
+			condition.Status == corev1.ConditionFalse && condition.Reason == anywherev1.OutsideMaintenanceWindowReason {
+			return &rollingChangesDeferredError{message: condition.Message}
+		}
 		return nil
 	}); err != nil {
+		deferredErr := &rollingChangesDeferredError{}
+		if errors.As(err, &deferredErr) {
+			return deferredErr
+		}
 		return fmt.Errorf("cluster has a validation error that doesn't seem transient: %s", err)
 	}
 
@@ -190,8 +200,27 @@ func (a Applier) retrierForWait(waitStartTime time.Time) *retrier.Retrier {
 }
 
 func (a Applier) retrierForFailureMessage() *retrier.Retrier {
+	backOff := retrier.BackOffPolicy(a.retryBackOff)
 	return retrier.New(
 		a.waitForFailureMessage,
-		retrier.WithRetryPolicy(retrier.BackOffPolicy(a.retryBackOff)),
+		retrier.WithRetryPolicy(func(totalRetries int, err error) (retry bool, wait time.Duration) {
+			// The controller won't roll out the changes until the next maintenance window,
+			// there is no point in waiting for them.
+			deferredErr := &rollingChangesDeferredError{}
+			if errors.As(err, &deferredErr) {
+				return false, 0
+			}
+			return backOff(totalRetries, err)
+		}),
 	)
 }
+
+// rollingChangesDeferredError is returned when the controller has deferred the changes
+// that roll the cluster nodes because the cluster is outside its maintenance windows.
+type rollingChangesDeferredError struct {
+	message string
+}
+
+func (e *rollingChangesDeferredError) Error() string {
+	return fmt.Sprintf("changes that roll nodes are deferred until the next maintenance window: %s", e.message)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
 
 	"github.com/aws/eks-anywhere/internal/test"
@@ -192,6 +193,19 @@ func TestApplierRunFailureMessage(t *testing.T) {
 	tt.Expect(a.Run(tt.ctx, tt.spec, tt.mgmtCluster)).To(MatchError(ContainSubstring("cluster has a validation error that doesn't seem transient")))
 }
 
+func TestApplierRunRollingChangesDeferred(t *testing.T) {
+	tt := newApplierTest(t)
+	tt.buildClient(tt.spec.ClusterAndChildren()...)
+	conditions.MarkFalse(tt.spec.Cluster, anywherev1.RollingChangesAppliedCondition, anywherev1.OutsideMaintenanceWindowReason, clusterv1.ConditionSeverityInfo,
+		"Changes that roll nodes in %s are deferred", tt.spec.Cluster.Name)
+	tt.Expect(tt.client.Update(tt.ctx, tt.spec.Cluster)).To(Succeed())
+	a := clustermanager.NewApplier(tt.log, tt.clientFactory,
+		clustermanager.WithApplierRetryBackOff(time.Minute),
+	)
+
+	tt.Expect(a.Run(tt.ctx, tt.spec, tt.mgmtCluster)).To(MatchError(ContainSubstring("changes that roll nodes are deferred until the next maintenance window")))
+}
+
 func TestApplierRunControlPlaneNotReady(t *testing.T) {
 	tt := newApplierTest(t)
 	tt.buildClient()
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/clustermanager/internal"
+	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/diagnostics"
 	"github.com/aws/eks-anywhere/pkg/executables"
@@ -287,6 +288,12 @@ func (c *ClusterManager) backupCAPI(ctx context.Context, cluster *types.Cluster,
 }
 
 func (c *ClusterManager) MoveCAPI(ctx context.Context, from, to *types.Cluster, clusterName string, clusterSpec *cluster.Spec, checkers ...types.NodeReadyChecker) error {
+	// When an existing cluster is used as bootstrap cluster, a wrong kubeconfig could make the
+	// source and target the same cluster, in which case clusterctl would delete all the moved objects.
+	if from.KubeconfigFile != "" && from.KubeconfigFile == to.KubeconfigFile {
+		return fmt.Errorf("moving CAPI management: source and target clusters are the same cluster (%s)", from.KubeconfigFile)
+	}
+
 	logger.V(3).Info("Waiting for management machines to be ready before move")
 	labels := []string{clusterv1.MachineControlPlaneNameLabel, clusterv1.MachineDeploymentNameLabel}
 	if err := c.waitForNodesReady(ctx, from, clusterName, labels, checkers...); err != nil {
@@ -357,6 +364,7 @@ func (c *ClusterManager) MoveCAPI(ctx context.Context, from, to *types.Cluster,
 }
 
 // CreateRegistryCredSecret creates the registry-credentials secret on a managment cluster.
+// It includes the credentials of the registry mirror fallback endpoints set in the env.
 func (c *ClusterManager) CreateRegistryCredSecret(ctx context.Context, mgmt *types.Cluster) error {
 	secret := &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
@@ -372,6 +380,9 @@ func (c *ClusterManager) CreateRegistryCredSecret(ctx context.Context, mgmt *typ
 			"password": []byte(os.Getenv("REGISTRY_PASSWORD")),
 		},
 	}
+	for k, v := range config.FallbackCredentialsSecretData() {
+		secret.Data[k] = v
+	}
 
 	return c.clusterClient.Apply(ctx, mgmt.KubeconfigFile, secret)
 }
@@ -624,6 +624,27 @@ func TestClusterManagerMoveCAPIErrorMove(t *testing.T) {
 	}
 }
 
+func TestClusterManagerMoveCAPIErrorSameCluster(t *testing.T) {
+	from := &types.Cluster{
+		Name:           "from-cluster",
+		KubeconfigFile: "bootstrap.kubeconfig",
+	}
+	to := &types.Cluster{
+		Name:           "to-cluster",
+		KubeconfigFile: "bootstrap.kubeconfig",
+	}
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = from.Name
+	})
+	ctx := context.Background()
+
+	c, _ := newClusterManager(t)
+
+	if err := c.MoveCAPI(ctx, from, to, from.Name, clusterSpec); err == nil {
+		t.Error("ClusterManager.MoveCAPI() error = nil, wantErr not nil")
+	}
+}
+
 func TestClusterManagerMoveCAPIErrorWaitForClusterReady(t *testing.T) {
 	from := &types.Cluster{
 		Name: "from-cluster",
@@ -421,3 +421,42 @@ func TestWriteClusterConfigSnow(t *testing.T) {
 	g.Expect(clustermarshaller.WriteClusterConfig(clusterSpec, datacenterConfig, machineConfigs, writer)).To(Succeed())
 	test.AssertFilesEquals(t, filepath.Join(folder, "testcluster-eks-a-cluster.yaml"), "testdata/expected_marshalled_snow.yaml")
 }
+
+func TestMarshalClusterSpecVSphereIPPool(t *testing.T) {
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "testcluster"
+		s.VSphereIPPools = map[string]*v1alpha1.VSphereIPPool{
+			"ippool": {
+				TypeMeta: v1.TypeMeta{
+					Kind:       v1alpha1.VSphereIPPoolKind,
+					APIVersion: v1alpha1.GroupVersion.String(),
+				},
+				ObjectMeta: v1.ObjectMeta{
+					Name: "ippool",
+				},
+				Spec: v1alpha1.VSphereIPPoolSpec{
+					Pools: []v1alpha1.IPPool{
+						{
+							IPStart: "10.0.0.10",
+							IPEnd:   "10.0.0.20",
+							Gateway: "10.0.0.1",
+							Subnet:  "10.0.0.0/24",
+						},
+					},
+				},
+			},
+		}
+	})
+	datacenterConfig := &v1alpha1.VSphereDatacenterConfig{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "testvsphere",
+		},
+	}
+
+	g := NewWithT(t)
+	got, err := clustermarshaller.MarshalClusterSpec(clusterSpec, datacenterConfig, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(got)).To(ContainSubstring("kind: VSphereIPPool"))
+	g.Expect(string(got)).To(ContainSubstring("namespace: default"))
+	g.Expect(string(got)).To(ContainSubstring("ipStart: 10.0.0.10"))
+}
@@ -0,0 +1,200 @@
+package clusterstatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OutputTable prints reports as human readable tables.
+	OutputTable = "table"
+	// OutputJSON prints reports as JSON.
+	OutputJSON = "json"
+	// OutputYAML prints reports as YAML.
+	OutputYAML = "yaml"
+)
+
+// ValidateOutputFormat returns an error if the output format is not supported.
+func ValidateOutputFormat(format string) error {
+	switch format {
+	case OutputTable, OutputJSON, OutputYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format [%s], valid options: %s|%s|%s", format, OutputTable, OutputJSON, OutputYAML)
+	}
+}
+
+// PrintSummary writes a one line per cluster summary of the reports.
+// For JSON and YAML it writes the full reports.
+func PrintSummary(w io.Writer, reports []*Report, format string) error {
+	if format != OutputTable {
+		return printSerialized(w, reports, format)
+	}
+
+	tw := newTabWriter(w)
+	fmt.Fprintln(tw, "NAMESPACE\tNAME\tVERSION\tREADY\tCONTROL PLANE\tWORKERS\tGENERATION\tFAILURE")
+	for _, r := range reports {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.Namespace,
+			r.Name,
+			r.KubernetesVersion,
+			r.Ready(),
+			controlPlaneReplicas(r.ControlPlane),
+			workerReplicas(r.WorkerNodeGroups),
+			generations(r),
+			valueOrNone(r.FailureReason),
+		)
+	}
+
+	return flush(tw)
+}
+
+// PrintDetails writes a detailed view of a single cluster report, including
+// conditions and per worker node group machine readiness.
+func PrintDetails(w io.Writer, report *Report, format string) error {
+	if format != OutputTable {
+		return printSerialized(w, report, format)
+	}
+
+	tw := newTabWriter(w)
+	fmt.Fprintf(tw, "Name:\t%s\n", report.Name)
+	fmt.Fprintf(tw, "Namespace:\t%s\n", report.Namespace)
+	fmt.Fprintf(tw, "Kubernetes Version:\t%s\n", report.KubernetesVersion)
+	fmt.Fprintf(tw, "EKS-A Version:\t%s\n", valueOrNone(report.EksaVersion))
+	fmt.Fprintf(tw, "Management Cluster:\t%s\n", report.ManagementCluster)
+	fmt.Fprintf(tw, "Ready:\t%s\n", report.Ready())
+	fmt.Fprintf(tw, "Generation:\t%d\n", report.Generation)
+	fmt.Fprintf(tw, "Observed Generation:\t%d\n", report.ObservedGeneration)
+	fmt.Fprintf(tw, "Reconciled Generation:\t%d\n", report.ReconciledGeneration)
+	fmt.Fprintf(tw, "Children Reconciled Generation:\t%d\n", report.ChildrenReconciledGeneration)
+	fmt.Fprintf(tw, "Failure Reason:\t%s\n", valueOrNone(report.FailureReason))
+	fmt.Fprintf(tw, "Failure Message:\t%s\n", valueOrNone(report.FailureMessage))
+	if err := flush(tw); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nConditions:")
+	if err := printConditions(w, report.Conditions); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nCAPI Cluster:")
+	if report.CAPICluster == nil {
+		fmt.Fprintln(w, "  <not found>")
+	} else {
+		tw = newTabWriter(w)
+		fmt.Fprintln(tw, "  NAME\tPHASE\tINFRASTRUCTURE READY\tCONTROL PLANE READY")
+		fmt.Fprintf(tw, "  %s\t%s\t%t\t%t\n", report.CAPICluster.Name, report.CAPICluster.Phase, report.CAPICluster.InfrastructureReady, report.CAPICluster.ControlPlaneReady)
+		if err := flush(tw); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(w, "\nControl Plane:")
+	if report.ControlPlane == nil {
+		fmt.Fprintln(w, "  <not found>")
+	} else {
+		cp := report.ControlPlane
+		tw = newTabWriter(w)
+		fmt.Fprintln(tw, "  NAME\tVERSION\tDESIRED\tREPLICAS\tREADY\tUPDATED\tUNAVAILABLE\tGENERATION")
+		fmt.Fprintf(tw, "  %s\t%s\t%d\t%d\t%d\t%d\t%d\t%d/%d\n", cp.Name, cp.KubernetesVersion, cp.DesiredReplicas, cp.Replicas, cp.ReadyReplicas, cp.UpdatedReplicas, cp.UnavailableReplicas, cp.ObservedGeneration, cp.Generation)
+		if err := flush(tw); err != nil {
+			return err
+		}
+		if err := printConditions(w, cp.Conditions); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(w, "\nWorker Node Groups:")
+	if len(report.WorkerNodeGroups) == 0 {
+		fmt.Fprintln(w, "  <none>")
+		return nil
+	}
+	tw = newTabWriter(w)
+	fmt.Fprintln(tw, "  NAME\tMACHINE DEPLOYMENT\tVERSION\tPHASE\tDESIRED\tREPLICAS\tREADY\tUPDATED\tUNAVAILABLE\tGENERATION")
+	for _, g := range report.WorkerNodeGroups {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d/%d\n", g.Name, g.MachineDeployment, valueOrNone(g.KubernetesVersion), valueOrNone(g.Phase), g.DesiredReplicas, g.Replicas, g.ReadyReplicas, g.UpdatedReplicas, g.UnavailableReplicas, g.ObservedGeneration, g.Generation)
+	}
+
+	return flush(tw)
+}
+
+func printConditions(w io.Writer, conditions []Condition) error {
+	if len(conditions) == 0 {
+		fmt.Fprintln(w, "  <none>")
+		return nil
+	}
+
+	tw := newTabWriter(w)
+	fmt.Fprintln(tw, "  TYPE\tSTATUS\tSEVERITY\tREASON\tMESSAGE")
+	for _, c := range conditions {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", c.Type, c.Status, c.Severity, c.Reason, c.Message)
+	}
+
+	return flush(tw)
+}
+
+func printSerialized(w io.Writer, obj interface{}, format string) error {
+	var out []byte
+	var err error
+	switch format {
+	case OutputJSON:
+		out, err = json.MarshalIndent(obj, "", "  ")
+		out = append(out, '\n')
+	case OutputYAML:
+		out, err = yaml.Marshal(obj)
+	default:
+		return ValidateOutputFormat(format)
+	}
+	if err != nil {
+		return fmt.Errorf("serializing cluster status to %s: %v", format, err)
+	}
+
+	_, err = w.Write(out)
+	return err
+}
+
+func newTabWriter(w io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+}
+
+func flush(tw *tabwriter.Writer) error {
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed flushing table writer: %v", err)
+	}
+	return nil
+}
+
+func controlPlaneReplicas(cp *ControlPlaneStatus) string {
+	if cp == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%d/%d", cp.ReadyReplicas, cp.DesiredReplicas)
+}
+
+func workerReplicas(groups []WorkerNodeGroupStatus) string {
+	var ready, desired int32
+	for _, g := range groups {
+		ready += g.ReadyReplicas
+		desired += g.DesiredReplicas
+	}
+	return fmt.Sprintf("%d/%d", ready, desired)
+}
+
+// generations displays the observed and reconciled generations against the
+// current cluster generation.
+func generations(r *Report) string {
+	return fmt.Sprintf("%d (observed %d, reconciled %d)", r.Generation, r.ObservedGeneration, r.ReconciledGeneration)
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
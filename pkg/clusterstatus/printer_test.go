@@ -0,0 +1,84 @@
+package clusterstatus_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/clusterstatus"
+)
+
+func report() *clusterstatus.Report {
+	return &clusterstatus.Report{
+		Name:                 "my-cluster",
+		Namespace:            "default",
+		KubernetesVersion:    "1.29",
+		ManagementCluster:    "my-cluster",
+		Generation:           2,
+		ObservedGeneration:   2,
+		ReconciledGeneration: 2,
+		Conditions: []clusterstatus.Condition{
+			{Type: "Ready", Status: corev1.ConditionTrue},
+		},
+		ControlPlane: &clusterstatus.ControlPlaneStatus{
+			Name:            "my-cluster",
+			DesiredReplicas: 3,
+			ReadyReplicas:   3,
+		},
+		WorkerNodeGroups: []clusterstatus.WorkerNodeGroupStatus{
+			{Name: "md-0", MachineDeployment: "my-cluster-md-0", DesiredReplicas: 2, ReadyReplicas: 1},
+			{Name: "md-1", MachineDeployment: "my-cluster-md-1", DesiredReplicas: 1, ReadyReplicas: 1},
+		},
+	}
+}
+
+func TestPrintSummaryTable(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+
+	g.Expect(clusterstatus.PrintSummary(buf, []*clusterstatus.Report{report()}, clusterstatus.OutputTable)).To(Succeed())
+	g.Expect(buf.String()).To(ContainSubstring("NAMESPACE"))
+	g.Expect(buf.String()).To(MatchRegexp(`default\s+my-cluster\s+1.29\s+True\s+3/3\s+2/3\s+2 \(observed 2, reconciled 2\)\s+<none>`))
+}
+
+func TestPrintSummaryJSON(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+
+	g.Expect(clusterstatus.PrintSummary(buf, []*clusterstatus.Report{report()}, clusterstatus.OutputJSON)).To(Succeed())
+	got := []*clusterstatus.Report{}
+	g.Expect(json.Unmarshal(buf.Bytes(), &got)).To(Succeed())
+	g.Expect(got).To(HaveLen(1))
+	g.Expect(got[0].WorkerNodeGroups).To(HaveLen(2))
+}
+
+func TestPrintDetailsYAML(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+
+	g.Expect(clusterstatus.PrintDetails(buf, report(), clusterstatus.OutputYAML)).To(Succeed())
+	got := &clusterstatus.Report{}
+	g.Expect(yaml.Unmarshal(buf.Bytes(), got)).To(Succeed())
+	g.Expect(got.ControlPlane.DesiredReplicas).To(BeEquivalentTo(3))
+}
+
+func TestPrintDetailsTable(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+
+	g.Expect(clusterstatus.PrintDetails(buf, report(), clusterstatus.OutputTable)).To(Succeed())
+	out := buf.String()
+	g.Expect(out).To(ContainSubstring("Reconciled Generation:"))
+	g.Expect(out).To(ContainSubstring("CAPI Cluster:\n  <not found>"))
+	g.Expect(out).To(MatchRegexp(`md-0\s+my-cluster-md-0`))
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(clusterstatus.ValidateOutputFormat("json")).To(Succeed())
+	g.Expect(clusterstatus.ValidateOutputFormat("xml")).To(MatchError(ContainSubstring("invalid output format [xml]")))
+}
@@ -0,0 +1,206 @@
+package clusterstatus
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+// Reader builds status reports for EKS-A clusters by reading the EKS-A Cluster
+// and the CAPI objects it owns from a management cluster.
+type Reader struct {
+	client kubernetes.Reader
+}
+
+// NewReader builds a new Reader.
+func NewReader(client kubernetes.Reader) *Reader {
+	return &Reader{
+		client: client,
+	}
+}
+
+// Read builds a Report for the EKS-A cluster with the given name and namespace.
+// Missing CAPI objects are not considered an error, since they might not have been
+// created yet, and are just omitted from the report.
+func (r *Reader) Read(ctx context.Context, name, namespace string) (*Report, error) {
+	if namespace == "" {
+		namespace = constants.DefaultNamespace
+	}
+
+	cluster := &anywherev1.Cluster{}
+	if err := r.client.Get(ctx, name, namespace, cluster); err != nil {
+		return nil, fmt.Errorf("reading eks-a cluster: %v", err)
+	}
+
+	return r.report(ctx, cluster)
+}
+
+// ReadAll builds a Report for every EKS-A cluster in the given namespace.
+// If the namespace is empty, it reads the clusters in all namespaces.
+func (r *Reader) ReadAll(ctx context.Context, namespace string) ([]*Report, error) {
+	clusters := &anywherev1.ClusterList{}
+	if err := r.client.List(ctx, clusters, kubernetes.ListOptions{Namespace: namespace}); err != nil {
+		return nil, fmt.Errorf("listing eks-a clusters: %v", err)
+	}
+
+	reports := make([]*Report, 0, len(clusters.Items))
+	for i := range clusters.Items {
+		report, err := r.report(ctx, &clusters.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func (r *Reader) report(ctx context.Context, cluster *anywherev1.Cluster) (*Report, error) {
+	report := &Report{
+		Name:                         cluster.Name,
+		Namespace:                    cluster.Namespace,
+		KubernetesVersion:            string(cluster.Spec.KubernetesVersion),
+		ManagementCluster:            cluster.ManagedBy(),
+		Generation:                   cluster.Generation,
+		ObservedGeneration:           cluster.Status.ObservedGeneration,
+		ReconciledGeneration:         cluster.Status.ReconciledGeneration,
+		ChildrenReconciledGeneration: cluster.Status.ChildrenReconciledGeneration,
+		Conditions:                   toConditions(cluster.Status.Conditions),
+		WorkerNodeGroups:             []WorkerNodeGroupStatus{},
+	}
+
+	if cluster.Spec.EksaVersion != nil {
+		report.EksaVersion = string(*cluster.Spec.EksaVersion)
+	}
+	if cluster.Status.FailureReason != nil {
+		report.FailureReason = string(*cluster.Status.FailureReason)
+	}
+	if cluster.Status.FailureMessage != nil {
+		report.FailureMessage = *cluster.Status.FailureMessage
+	}
+
+	capiCluster := &clusterv1.Cluster{}
+	found, err := r.get(ctx, clusterapi.ClusterName(cluster), capiCluster)
+	if err != nil {
+		return nil, fmt.Errorf("reading capi cluster: %v", err)
+	}
+	if found {
+		report.CAPICluster = capiClusterStatus(capiCluster)
+	}
+
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	found, err = r.get(ctx, clusterapi.KubeadmControlPlaneName(cluster), kcp)
+	if err != nil {
+		return nil, fmt.Errorf("reading kubeadm control plane: %v", err)
+	}
+	if found {
+		report.ControlPlane = controlPlaneStatus(kcp)
+	}
+
+	for _, wng := range cluster.Spec.WorkerNodeGroupConfigurations {
+		mdName := clusterapi.MachineDeploymentName(cluster, wng)
+		status := WorkerNodeGroupStatus{
+			Name:              wng.Name,
+			MachineDeployment: mdName,
+			Conditions:        []Condition{},
+		}
+
+		md := &clusterv1.MachineDeployment{}
+		found, err = r.get(ctx, mdName, md)
+		if err != nil {
+			return nil, fmt.Errorf("reading machine deployment for worker node group %s: %v", wng.Name, err)
+		}
+		if found {
+			updateWorkerNodeGroupStatus(&status, md)
+		}
+
+		report.WorkerNodeGroups = append(report.WorkerNodeGroups, status)
+	}
+
+	return report, nil
+}
+
+// get reads a CAPI object from the eksa-system namespace and returns false,
+// without error, if the object doesn't exist.
+func (r *Reader) get(ctx context.Context, name string, obj kubernetes.Object) (bool, error) {
+	err := r.client.Get(ctx, name, constants.EksaSystemNamespace, obj)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func capiClusterStatus(c *clusterv1.Cluster) *CAPIClusterStatus {
+	return &CAPIClusterStatus{
+		Name:                c.Name,
+		Phase:               c.Status.Phase,
+		InfrastructureReady: c.Status.InfrastructureReady,
+		ControlPlaneReady:   c.Status.ControlPlaneReady,
+		Conditions:          toConditions(c.Status.Conditions),
+	}
+}
+
+func controlPlaneStatus(kcp *controlplanev1.KubeadmControlPlane) *ControlPlaneStatus {
+	status := &ControlPlaneStatus{
+		Name:                kcp.Name,
+		KubernetesVersion:   kcp.Spec.Version,
+		Replicas:            kcp.Status.Replicas,
+		ReadyReplicas:       kcp.Status.ReadyReplicas,
+		UpdatedReplicas:     kcp.Status.UpdatedReplicas,
+		UnavailableReplicas: kcp.Status.UnavailableReplicas,
+		Generation:          kcp.Generation,
+		ObservedGeneration:  kcp.Status.ObservedGeneration,
+		Conditions:          toConditions(kcp.Status.Conditions),
+	}
+	if kcp.Spec.Replicas != nil {
+		status.DesiredReplicas = *kcp.Spec.Replicas
+	}
+
+	return status
+}
+
+func updateWorkerNodeGroupStatus(status *WorkerNodeGroupStatus, md *clusterv1.MachineDeployment) {
+	status.Phase = md.Status.Phase
+	status.Replicas = md.Status.Replicas
+	status.ReadyReplicas = md.Status.ReadyReplicas
+	status.UpdatedReplicas = md.Status.UpdatedReplicas
+	status.AvailableReplicas = md.Status.AvailableReplicas
+	status.UnavailableReplicas = md.Status.UnavailableReplicas
+	status.Generation = md.Generation
+	status.ObservedGeneration = md.Status.ObservedGeneration
+	status.Conditions = toConditions(md.Status.Conditions)
+	if md.Spec.Replicas != nil {
+		status.DesiredReplicas = *md.Spec.Replicas
+	}
+	if md.Spec.Template.Spec.Version != nil {
+		status.KubernetesVersion = *md.Spec.Template.Spec.Version
+	}
+}
+
+func toConditions(capiConditions clusterv1.Conditions) []Condition {
+	conditions := make([]Condition, 0, len(capiConditions))
+	for _, c := range capiConditions {
+		conditions = append(conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			Severity:           string(c.Severity),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Time,
+		})
+	}
+
+	return conditions
+}
@@ -0,0 +1,171 @@
+package clusterstatus_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterstatus"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func eksaCluster() *anywherev1.Cluster {
+	failureReason := anywherev1.FailureReasonType("MissingDependentObjects")
+	return &anywherev1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       anywherev1.ClusterKind,
+			APIVersion: anywherev1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-cluster",
+			Namespace:  "default",
+			Generation: 3,
+		},
+		Spec: anywherev1.ClusterSpec{
+			KubernetesVersion: anywherev1.Kube129,
+			ManagementCluster: anywherev1.ManagementCluster{Name: "mgmt"},
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{Name: "md-0", Count: ptr.Int(2)},
+				{Name: "md-1", Count: ptr.Int(1)},
+			},
+		},
+		Status: anywherev1.ClusterStatus{
+			ObservedGeneration:   3,
+			ReconciledGeneration: 2,
+			FailureReason:        &failureReason,
+			FailureMessage:       ptr.String("something is missing"),
+			Conditions: []anywherev1.Condition{
+				{
+					Type:   anywherev1.ReadyCondition,
+					Status: corev1.ConditionFalse,
+					Reason: anywherev1.RollingUpgradeInProgress,
+				},
+			},
+		},
+	}
+}
+
+func capiObjects() (*clusterv1.Cluster, *controlplanev1.KubeadmControlPlane, *clusterv1.MachineDeployment) {
+	capiCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: constants.EksaSystemNamespace},
+		Status: clusterv1.ClusterStatus{
+			Phase:               "Provisioned",
+			InfrastructureReady: true,
+			ControlPlaneReady:   true,
+		},
+	}
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: constants.EksaSystemNamespace, Generation: 4},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Replicas: ptr.Int32(3),
+			Version:  "v1.29.1-eks-1-29-4",
+		},
+		Status: controlplanev1.KubeadmControlPlaneStatus{
+			Replicas:           3,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    1,
+			ObservedGeneration: 4,
+		},
+	}
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-md-0", Namespace: constants.EksaSystemNamespace, Generation: 2},
+		Spec: clusterv1.MachineDeploymentSpec{
+			Replicas: ptr.Int32(2),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{Version: ptr.String("v1.28.1-eks-1-28-8")},
+			},
+		},
+		Status: clusterv1.MachineDeploymentStatus{
+			Phase:              "Running",
+			Replicas:           2,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    2,
+			ObservedGeneration: 2,
+		},
+	}
+
+	return capiCluster, kcp, md
+}
+
+func TestReaderRead(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	capiCluster, kcp, md := capiObjects()
+	client := test.NewFakeKubeClient(eksaCluster(), capiCluster, kcp, md)
+	reader := clusterstatus.NewReader(client)
+
+	report, err := reader.Read(ctx, "my-cluster", "default")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(report.Name).To(Equal("my-cluster"))
+	g.Expect(report.KubernetesVersion).To(Equal("1.29"))
+	g.Expect(report.ManagementCluster).To(Equal("mgmt"))
+	g.Expect(report.Generation).To(BeEquivalentTo(3))
+	g.Expect(report.ObservedGeneration).To(BeEquivalentTo(3))
+	g.Expect(report.ReconciledGeneration).To(BeEquivalentTo(2))
+	g.Expect(report.UpToDate()).To(BeFalse())
+	g.Expect(report.FailureReason).To(Equal("MissingDependentObjects"))
+	g.Expect(report.FailureMessage).To(Equal("something is missing"))
+	g.Expect(report.Ready()).To(Equal(corev1.ConditionFalse))
+
+	g.Expect(report.CAPICluster).NotTo(BeNil())
+	g.Expect(report.CAPICluster.Phase).To(Equal("Provisioned"))
+	g.Expect(report.CAPICluster.ControlPlaneReady).To(BeTrue())
+
+	g.Expect(report.ControlPlane).NotTo(BeNil())
+	g.Expect(report.ControlPlane.DesiredReplicas).To(BeEquivalentTo(3))
+	g.Expect(report.ControlPlane.ReadyReplicas).To(BeEquivalentTo(2))
+	g.Expect(report.ControlPlane.KubernetesVersion).To(Equal("v1.29.1-eks-1-29-4"))
+
+	g.Expect(report.WorkerNodeGroups).To(HaveLen(2))
+	g.Expect(report.WorkerNodeGroups[0].MachineDeployment).To(Equal("my-cluster-md-0"))
+	g.Expect(report.WorkerNodeGroups[0].ReadyReplicas).To(BeEquivalentTo(2))
+	g.Expect(report.WorkerNodeGroups[0].KubernetesVersion).To(Equal("v1.28.1-eks-1-28-8"))
+	g.Expect(report.WorkerNodeGroups[0].Phase).To(Equal("Running"))
+	g.Expect(report.WorkerNodeGroups[1].MachineDeployment).To(Equal("my-cluster-md-1"))
+	g.Expect(report.WorkerNodeGroups[1].Phase).To(BeEmpty())
+}
+
+func TestReaderReadMissingCAPIObjects(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := test.NewFakeKubeClient(eksaCluster())
+	reader := clusterstatus.NewReader(client)
+
+	report, err := reader.Read(ctx, "my-cluster", "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(report.CAPICluster).To(BeNil())
+	g.Expect(report.ControlPlane).To(BeNil())
+	g.Expect(report.WorkerNodeGroups).To(HaveLen(2))
+}
+
+func TestReaderReadClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := test.NewFakeKubeClient()
+	reader := clusterstatus.NewReader(client)
+
+	_, err := reader.Read(ctx, "my-cluster", "default")
+	g.Expect(err).To(MatchError(ContainSubstring("reading eks-a cluster")))
+}
+
+func TestReaderReadAll(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	other := eksaCluster()
+	other.Name = "other"
+	client := test.NewFakeKubeClient(eksaCluster(), other)
+	reader := clusterstatus.NewReader(client)
+
+	reports, err := reader.ReadAll(ctx, "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(reports).To(HaveLen(2))
+}
@@ -0,0 +1,95 @@
+package clusterstatus
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// Report is a point in time snapshot of the status of an EKS-A cluster and the
+// CAPI objects that back it.
+type Report struct {
+	Name                         string                  `json:"name"`
+	Namespace                    string                  `json:"namespace"`
+	KubernetesVersion            string                  `json:"kubernetesVersion"`
+	ManagementCluster            string                  `json:"managementCluster"`
+	EksaVersion                  string                  `json:"eksaVersion,omitempty"`
+	Generation                   int64                   `json:"generation"`
+	ObservedGeneration           int64                   `json:"observedGeneration"`
+	ReconciledGeneration         int64                   `json:"reconciledGeneration"`
+	ChildrenReconciledGeneration int64                   `json:"childrenReconciledGeneration"`
+	FailureReason                string                  `json:"failureReason,omitempty"`
+	FailureMessage               string                  `json:"failureMessage,omitempty"`
+	Conditions                   []Condition             `json:"conditions"`
+	CAPICluster                  *CAPIClusterStatus      `json:"capiCluster,omitempty"`
+	ControlPlane                 *ControlPlaneStatus     `json:"controlPlane,omitempty"`
+	WorkerNodeGroups             []WorkerNodeGroupStatus `json:"workerNodeGroups"`
+}
+
+// Condition is a simplified view of a status condition.
+type Condition struct {
+	Type               string                 `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Severity           string                 `json:"severity,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime time.Time              `json:"lastTransitionTime"`
+}
+
+// CAPIClusterStatus summarizes the status of the CAPI Cluster owned by the EKS-A cluster.
+type CAPIClusterStatus struct {
+	Name                string      `json:"name"`
+	Phase               string      `json:"phase"`
+	InfrastructureReady bool        `json:"infrastructureReady"`
+	ControlPlaneReady   bool        `json:"controlPlaneReady"`
+	Conditions          []Condition `json:"conditions"`
+}
+
+// ControlPlaneStatus summarizes the status of the KubeadmControlPlane owned by the EKS-A cluster.
+type ControlPlaneStatus struct {
+	Name                string      `json:"name"`
+	KubernetesVersion   string      `json:"kubernetesVersion"`
+	DesiredReplicas     int32       `json:"desiredReplicas"`
+	Replicas            int32       `json:"replicas"`
+	ReadyReplicas       int32       `json:"readyReplicas"`
+	UpdatedReplicas     int32       `json:"updatedReplicas"`
+	UnavailableReplicas int32       `json:"unavailableReplicas"`
+	Generation          int64       `json:"generation"`
+	ObservedGeneration  int64       `json:"observedGeneration"`
+	Conditions          []Condition `json:"conditions"`
+}
+
+// WorkerNodeGroupStatus summarizes the status of the MachineDeployment backing a worker node group.
+type WorkerNodeGroupStatus struct {
+	Name                string      `json:"name"`
+	MachineDeployment   string      `json:"machineDeployment"`
+	KubernetesVersion   string      `json:"kubernetesVersion,omitempty"`
+	Phase               string      `json:"phase,omitempty"`
+	DesiredReplicas     int32       `json:"desiredReplicas"`
+	Replicas            int32       `json:"replicas"`
+	ReadyReplicas       int32       `json:"readyReplicas"`
+	UpdatedReplicas     int32       `json:"updatedReplicas"`
+	AvailableReplicas   int32       `json:"availableReplicas"`
+	UnavailableReplicas int32       `json:"unavailableReplicas"`
+	Generation          int64       `json:"generation"`
+	ObservedGeneration  int64       `json:"observedGeneration"`
+	Conditions          []Condition `json:"conditions"`
+}
+
+// Ready returns the status of the Ready condition for the cluster or Unknown if not present.
+func (r *Report) Ready() corev1.ConditionStatus {
+	for _, c := range r.Conditions {
+		if c.Type == string(anywherev1.ReadyCondition) {
+			return c.Status
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+// UpToDate returns true when the controller has observed and successfully
+// reconciled the latest cluster generation.
+func (r *Report) UpToDate() bool {
+	return r.ObservedGeneration == r.Generation && r.ReconciledGeneration == r.Generation
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -56,3 +58,75 @@ func SetCredentialsEnv(username, password string) error {
 
 	return nil
 }
+
+// ReadFallbackCredentials reads the username and password of the registry mirror fallback endpoint
+// at the given index from the REGISTRY_USERNAME_<index+1> and REGISTRY_PASSWORD_<index+1> env vars.
+func ReadFallbackCredentials(index int) (username, password string, err error) {
+	usernameEnv, passwordEnv := fallbackCredentialsEnv(index)
+	username, ok := os.LookupEnv(usernameEnv)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", usernameEnv)
+	}
+
+	password, ok = os.LookupEnv(passwordEnv)
+	if !ok {
+		return "", "", fmt.Errorf("please set %s env var", passwordEnv)
+	}
+
+	return username, password, nil
+}
+
+// ReadFallbackCredentialsFromSecret reads the username and password of the registry mirror fallback endpoint
+// at the given index from Kubernetes secret registry-credentials.
+func ReadFallbackCredentialsFromSecret(ctx context.Context, client client.Client, index int) (username, password string, err error) {
+	registryAuthSecret := &corev1.Secret{}
+	key := types.NamespacedName{Name: registryAuthSecretName, Namespace: constants.EksaSystemNamespace}
+	if err := client.Get(ctx, key, registryAuthSecret); err != nil {
+		return "", "", errors.Wrap(err, "fetching registry auth secret")
+	}
+
+	rUsername := registryAuthSecret.Data[fmt.Sprintf("username-%d", index+1)]
+	rPassword := registryAuthSecret.Data[fmt.Sprintf("password-%d", index+1)]
+
+	return string(rUsername), string(rPassword), nil
+}
+
+// SetFallbackCredentialsEnv sets the username and password env variables of the registry mirror fallback endpoint
+// at the given index.
+func SetFallbackCredentialsEnv(index int, username, password string) error {
+	usernameEnv, passwordEnv := fallbackCredentialsEnv(index)
+	if err := os.Setenv(usernameEnv, username); err != nil {
+		return fmt.Errorf("failed setting env %s: %v", usernameEnv, err)
+	}
+
+	if err := os.Setenv(passwordEnv, password); err != nil {
+		return fmt.Errorf("failed setting env %s: %v", passwordEnv, err)
+	}
+
+	return nil
+}
+
+// FallbackCredentialsSecretData returns the credentials of the registry mirror fallback endpoints set in the env,
+// keyed as they are stored in the registry-credentials secret.
+func FallbackCredentialsSecretData() map[string][]byte {
+	data := map[string][]byte{}
+	for _, env := range os.Environ() {
+		name, username, _ := strings.Cut(env, "=")
+		suffix, ok := strings.CutPrefix(name, constants.RegistryUsername+"_")
+		if !ok {
+			continue
+		}
+		position, err := strconv.Atoi(suffix)
+		if err != nil || position < 1 {
+			continue
+		}
+		data[fmt.Sprintf("username-%d", position)] = []byte(username)
+		data[fmt.Sprintf("password-%d", position)] = []byte(os.Getenv(fmt.Sprintf("%s_%d", constants.RegistryPassword, position)))
+	}
+
+	return data
+}
+
+func fallbackCredentialsEnv(index int) (usernameEnv, passwordEnv string) {
+	return fmt.Sprintf("%s_%d", constants.RegistryUsername, index+1), fmt.Sprintf("%s_%d", constants.RegistryPassword, index+1)
+}
@@ -71,3 +71,60 @@ func TestReadCredentialsFromSecretNotFound(t *testing.T) {
 	assert.Empty(t, u)
 	assert.Empty(t, p)
 }
+
+func TestReadFallbackCredentials(t *testing.T) {
+	_, _, err := ReadFallbackCredentials(0)
+	assert.EqualError(t, err, "please set REGISTRY_USERNAME_1 env var")
+
+	t.Setenv("REGISTRY_USERNAME_1", "testuser")
+	_, _, err = ReadFallbackCredentials(0)
+	assert.EqualError(t, err, "please set REGISTRY_PASSWORD_1 env var")
+
+	t.Setenv("REGISTRY_PASSWORD_1", "testpass")
+	username, password, err := ReadFallbackCredentials(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", username)
+	assert.Equal(t, "testpass", password)
+}
+
+func TestSetFallbackCredentialsEnv(t *testing.T) {
+	t.Setenv("REGISTRY_USERNAME_2", "")
+	t.Setenv("REGISTRY_PASSWORD_2", "")
+	assert.NoError(t, SetFallbackCredentialsEnv(1, "testuser", "testpass"))
+	assert.Equal(t, "testuser", os.Getenv("REGISTRY_USERNAME_2"))
+	assert.Equal(t, "testpass", os.Getenv("REGISTRY_PASSWORD_2"))
+}
+
+func TestReadFallbackCredentialsFromSecret(t *testing.T) {
+	ctx := context.Background()
+	sec := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      registryAuthSecretName,
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Data: map[string][]byte{
+			"username":   []byte("primaryuser"),
+			"password":   []byte("primarypass"),
+			"username-1": []byte("testuser"),
+			"password-1": []byte("testpass"),
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithRuntimeObjects(sec).Build()
+	u, p, err := ReadFallbackCredentialsFromSecret(ctx, cl, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "testuser", u)
+	assert.Equal(t, "testpass", p)
+}
+
+func TestFallbackCredentialsSecretData(t *testing.T) {
+	t.Setenv("REGISTRY_USERNAME_1", "testuser")
+	t.Setenv("REGISTRY_PASSWORD_1", "testpass")
+	t.Setenv("REGISTRY_USERNAME_INVALID", "ignored")
+
+	data := FallbackCredentialsSecretData()
+	assert.Equal(t, map[string][]byte{
+		"username-1": []byte("testuser"),
+		"password-1": []byte("testpass"),
+	}, data)
+}
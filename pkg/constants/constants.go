@@ -35,6 +35,7 @@ const (
 	DefaultHttpsPort                        = "443"
 	DefaultWorkerNodeGroupName              = "md-0"
 	DefaultNodeCidrMaskSize                 = 24
+	DefaultNodeCidrMaskSizeIPv6             = 64
 
 	VSphereProviderName    = "vsphere"
 	DockerProviderName     = "docker"
@@ -123,7 +124,7 @@ const (
 	// fields depending on your signing requirements.
 	// We are excluding some fields from the versionbundle object from signing/verifying the signature to allow users to override images.
 	// To check the fields we are excluding for signing/verifying the signature base64 decode the Excludes field.
-	Excludes = "LnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYm9vdHN0cmFwCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmJvdHRsZXJvY2tldEhvc3RDb250YWluZXJzCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNlcnRNYW5hZ2VyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNpbGl1bQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jbG91ZFN0YWNrCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNsdXN0ZXJBUEkKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY29udHJvbFBsYW5lCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmRvY2tlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5la3NhCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmVrc0QuY29tcG9uZW50cwouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5la3NELm1hbmlmZXN0VXJsCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmV0Y2RhZG1Cb290c3RyYXAKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZXRjZGFkbUNvbnRyb2xsZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZmx1eAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5oYXByb3h5Ci5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmtpbmRuZXRkCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLm51dGFuaXgKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10ucGFja2FnZUNvbnRyb2xsZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uc25vdwouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS50aW5rZXJiZWxsCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnVwZ3JhZGVyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnZTcGhlcmU="
+	Excludes = "LnNwZWMudmVyc2lvbnNCdW5kbGVzW10uYXJnb0NECi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmJvb3RzdHJhcAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ib3R0bGVyb2NrZXRIb3N0Q29udGFpbmVycwouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jZXJ0TWFuYWdlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jaWxpdW0KLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uY2xvdWRTdGFjawouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5jbHVzdGVyQVBJCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmNvbnRyb2xQbGFuZQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5kb2NrZXIKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZWtzYQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5la3NELmNvbXBvbmVudHMKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uZWtzRC5tYW5pZmVzdFVybAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5ldGNkYWRtQm9vdHN0cmFwCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmV0Y2RhZG1Db250cm9sbGVyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLmZsdXgKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10uaGFwcm94eQouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5raW5kbmV0ZAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS5udXRhbml4Ci5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnBhY2thZ2VDb250cm9sbGVyCi5zcGVjLnZlcnNpb25zQnVuZGxlc1tdLnNub3cKLnNwZWMudmVyc2lvbnNCdW5kbGVzW10udGlua2VyYmVsbAouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS51cGdyYWRlcgouc3BlYy52ZXJzaW9uc0J1bmRsZXNbXS52U3BoZXJl"
 	// EKSDistroExcludes is a base64-encoded, newline-delimited list of JSON/YAML paths to remove
 	// from the EKS Distro manifest prior to computing the digest. You can add or remove
 	// fields depending on your signing requirements.
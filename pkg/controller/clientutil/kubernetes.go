@@ -37,6 +37,9 @@ func (c *KubeClient) List(ctx context.Context, list kubernetes.ObjectList, opts
 
 	clientOptions := &client.ListOptions{}
 	clientOptions.Namespace = o.Namespace
+	if o.HasLabels != nil {
+		clientOptions.LabelSelector = labels.SelectorFromValidatedSet(o.HasLabels)
+	}
 
 	return c.client.List(ctx, list, clientOptions)
 }
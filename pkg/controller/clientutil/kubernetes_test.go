@@ -147,6 +147,41 @@ func TestKubeClientListOptsWithNamespace(t *testing.T) {
 	g.Expect(receiveClusters.Items).NotTo(ConsistOf(*cluster1))
 }
 
+func TestKubeClientListOptsWithLabels(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster1 := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+			Labels:    map[string]string{"type": "cp"},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       anywherev1.ClusterKind,
+			APIVersion: anywherev1.GroupVersion.String(),
+		},
+	}
+	cluster2 := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-2",
+			Namespace: "default",
+			Labels:    map[string]string{"type": "worker"},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       anywherev1.ClusterKind,
+			APIVersion: anywherev1.GroupVersion.String(),
+		},
+	}
+	cb := fake.NewClientBuilder()
+	cl := cb.WithRuntimeObjects(cluster1, cluster2).Build()
+
+	client := clientutil.NewKubeClient(cl)
+	receiveClusters := &anywherev1.ClusterList{}
+	opts := kubernetes.ListOptions{HasLabels: map[string]string{"type": "worker"}}
+	g.Expect(client.List(ctx, receiveClusters, opts)).To(Succeed())
+	g.Expect(receiveClusters.Items).To(ConsistOf(*cluster2))
+}
+
 func TestKubeClientCreate(t *testing.T) {
 	g := NewWithT(t)
 	ctx := context.Background()
@@ -0,0 +1,477 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/controller"
+)
+
+const (
+	// CanaryReadyAtAnnotation records in a canary MachineDeployment when all its nodes became ready.
+	CanaryReadyAtAnnotation = "anywhere.eks.amazonaws.com/canary-ready-at"
+
+	// CanaryFailedAnnotation marks a canary MachineDeployment whose health checks didn't pass
+	// before the canary timeout. The message of the failure is stored as its value.
+	CanaryFailedAnnotation = "anywhere.eks.amazonaws.com/canary-failed"
+
+	canaryMachineDeploymentSuffix = "-canary"
+
+	defaultCanarySoakTime = 5 * time.Minute
+	defaultCanaryTimeout  = 30 * time.Minute
+
+	canaryRequeueTime       = 30 * time.Second
+	failedCanaryRequeueTime = 5 * time.Minute
+)
+
+// RemoteClientRegistry gets clients for the workload clusters.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// CanaryMachineDeploymentName returns the name of the MachineDeployment that runs the canary nodes
+// for the MachineDeployment of a worker node group.
+func CanaryMachineDeploymentName(machineDeploymentName string) string {
+	return machineDeploymentName + canaryMachineDeploymentSuffix
+}
+
+// canaryState is the progress of the canaries of a cluster.
+type canaryState struct {
+	inProgress []string
+	failed     []string
+}
+
+// ReconcileCanaries modifies the Workers spec so worker node groups with a Canary upgrade rollout strategy
+// upgrade a few nodes first. Until those nodes pass the health checks, the MachineDeployment of the group
+// stays on its current machine template and the new template is only used by a separate canary
+// MachineDeployment. Once the checks pass, the group is rolled and the canary MachineDeployment removed.
+// If they don't pass before the canary timeout, the rollout of the group is paused.
+func ReconcileCanaries(ctx context.Context, log logr.Logger, c client.Client, remoteClients RemoteClientRegistry, cluster *anywherev1.Cluster, w *Workers) (controller.Result, error) {
+	canaryGroups := map[string]anywherev1.WorkerNodeGroupConfiguration{}
+	for _, wng := range cluster.Spec.WorkerNodeGroupConfigurations {
+		if wng.UpgradeRolloutStrategy != nil && wng.UpgradeRolloutStrategy.Type == anywherev1.CanaryStrategyType {
+			canaryGroups[clusterapi.MachineDeploymentName(cluster, wng)] = wng
+		}
+	}
+
+	if len(canaryGroups) == 0 {
+		conditions.Delete(cluster, anywherev1.CanaryUpgradeHealthyCondition)
+		return controller.Result{}, nil
+	}
+
+	state := &canaryState{}
+	// Canary groups are appended to w.Groups, so only iterate over the ones set by the provider.
+	groups := len(w.Groups)
+	for i := 0; i < groups; i++ {
+		g := &w.Groups[i]
+		wng, ok := canaryGroups[g.MachineDeployment.Name]
+		if !ok {
+			continue
+		}
+
+		canary, err := reconcileCanary(ctx, log, c, remoteClients, cluster, wng, g, state)
+		if err != nil {
+			return controller.Result{}, errors.Wrapf(err, "reconciling canary for worker node group %s", wng.Name)
+		}
+		if canary != nil {
+			w.Groups = append(w.Groups, *canary)
+		}
+	}
+
+	switch {
+	case len(state.failed) > 0:
+		conditions.MarkFalse(cluster, anywherev1.CanaryUpgradeHealthyCondition, anywherev1.CanaryHealthChecksFailedReason, clusterv1.ConditionSeverityError,
+			"Upgrade paused, canary health checks failed: %s", strings.Join(state.failed, "; "))
+		return controller.ResultWithRequeue(failedCanaryRequeueTime), nil
+	case len(state.inProgress) > 0:
+		conditions.MarkFalse(cluster, anywherev1.CanaryUpgradeHealthyCondition, anywherev1.CanaryUpgradeInProgressReason, clusterv1.ConditionSeverityInfo,
+			"Upgrading canary nodes for %s", strings.Join(state.inProgress, ", "))
+		return controller.ResultWithRequeue(canaryRequeueTime), nil
+	default:
+		conditions.MarkTrue(cluster, anywherev1.CanaryUpgradeHealthyCondition)
+		return controller.Result{}, nil
+	}
+}
+
+// reconcileCanary updates the MachineDeployment of worker group g according to the progress of its canary
+// and returns the worker group for the canary MachineDeployment if it needs to exist.
+func reconcileCanary(ctx context.Context, log logr.Logger, c client.Client, remoteClients RemoteClientRegistry,
+	cluster *anywherev1.Cluster, wng anywherev1.WorkerNodeGroupConfiguration, g *WorkerGroup, state *canaryState,
+) (*WorkerGroup, error) {
+	desired := g.MachineDeployment
+	current, err := getMachineDeployment(ctx, c, desired.Namespace, desired.Name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		// New worker node groups don't have nodes to protect.
+		return nil, nil
+	}
+
+	canaryName := CanaryMachineDeploymentName(desired.Name)
+	canary, err := getMachineDeployment(ctx, c, desired.Namespace, canaryName)
+	if err != nil {
+		return nil, err
+	}
+
+	params := canaryParams(wng.UpgradeRolloutStrategy.Canary)
+	canaryReplicas := canaryNodes(params, desired)
+	autoscaled := wng.AutoScalingConfiguration != nil
+
+	if sameMachineTemplate(current, desired) {
+		if canary == nil {
+			return nil, nil
+		}
+		if !sameMachineTemplate(canary, desired) {
+			// The change being tested by the canary was reverted.
+			log.Info("Deleting outdated canary machine deployment", "machineDeployment", canaryName)
+			return nil, deleteMachineDeployment(ctx, c, canary)
+		}
+
+		// The group is being rolled after the canary passed. Keep the canary nodes
+		// until the rest of the group is updated to avoid losing capacity.
+		if !machineDeploymentRolledOut(current) {
+			state.inProgress = append(state.inProgress, wng.Name)
+			reduceReplicas(desired, canaryReplicas, autoscaled)
+			return newCanaryGroup(g, canary, canaryReplicas), nil
+		}
+
+		log.Info("Worker node group upgraded, deleting canary machine deployment", "machineDeployment", canaryName)
+		return nil, deleteMachineDeployment(ctx, c, canary)
+	}
+
+	if canary != nil && !sameMachineTemplate(canary, desired) {
+		// The spec changed while a canary was running, start over with the new one.
+		log.Info("Canary machine deployment is outdated, recreating it", "machineDeployment", canaryName)
+		state.inProgress = append(state.inProgress, wng.Name)
+		desired.Spec.Template = current.Spec.Template
+		return nil, deleteMachineDeployment(ctx, c, canary)
+	}
+
+	canaryGroup := newCanaryGroup(g, canary, canaryReplicas)
+	// Until the canary passes, the group stays in its current machine template.
+	desired.Spec.Template = current.Spec.Template
+
+	if canary == nil {
+		log.Info("Creating canary machine deployment", "machineDeployment", canaryName, "replicas", canaryReplicas)
+		state.inProgress = append(state.inProgress, wng.Name)
+		return canaryGroup, nil
+	}
+
+	if message, ok := canary.Annotations[CanaryFailedAnnotation]; ok {
+		// The group keeps all its nodes in the old configuration while paused.
+		state.failed = append(state.failed, fmt.Sprintf("%s: %s", wng.Name, message))
+		return canaryGroup, nil
+	}
+
+	groupReplicas := desired.Spec.Replicas
+	// Nodes are only removed from the group once the canary nodes are ready to replace them.
+	if _, ok := canary.Annotations[CanaryReadyAtAnnotation]; ok || machineDeploymentReady(canary, canaryReplicas) {
+		reduceReplicas(desired, canaryReplicas, autoscaled)
+	}
+
+	healthErr := checkCanary(ctx, c, remoteClients, cluster, canary, canaryGroup, canaryReplicas, params)
+	if healthErr == nil {
+		log.Info("Canary health checks passed, upgrading worker node group", "workerNodeGroup", wng.Name)
+		desired.Spec.Template = *canaryGroup.MachineDeployment.Spec.Template.DeepCopy()
+		state.inProgress = append(state.inProgress, wng.Name)
+		return canaryGroup, nil
+	}
+
+	deadline := canary.CreationTimestamp.Add(params.SoakTime.Duration + params.Timeout.Duration)
+	if time.Now().After(deadline) {
+		log.Info("Canary health checks failed, pausing upgrade of worker node group", "workerNodeGroup", wng.Name, "reason", healthErr.Error())
+		canaryGroup.MachineDeployment.Annotations[CanaryFailedAnnotation] = healthErr.Error()
+		desired.Spec.Replicas = groupReplicas
+		state.failed = append(state.failed, fmt.Sprintf("%s: %s", wng.Name, healthErr))
+		return canaryGroup, nil
+	}
+
+	log.Info("Waiting for canary health checks", "workerNodeGroup", wng.Name, "reason", healthErr.Error())
+	state.inProgress = append(state.inProgress, wng.Name)
+	return canaryGroup, nil
+}
+
+// checkCanary returns an error describing the first health check the canary doesn't pass.
+// It records in canaryGroup when the canary nodes became ready.
+func checkCanary(ctx context.Context, c client.Client, remoteClients RemoteClientRegistry, cluster *anywherev1.Cluster,
+	canary *clusterv1.MachineDeployment, canaryGroup *WorkerGroup, replicas int32, params anywherev1.WorkerNodesCanaryParams,
+) error {
+	readyAt, ok := canary.Annotations[CanaryReadyAtAnnotation]
+	if !ok {
+		if !machineDeploymentReady(canary, replicas) {
+			return fmt.Errorf("%d of %d canary nodes ready", canary.Status.ReadyReplicas, replicas)
+		}
+		readyAt = time.Now().UTC().Format(time.RFC3339)
+		canaryGroup.MachineDeployment.Annotations[CanaryReadyAtAnnotation] = readyAt
+	}
+
+	readyTime, err := time.Parse(time.RFC3339, readyAt)
+	if err != nil {
+		return fmt.Errorf("invalid %s annotation: %v", CanaryReadyAtAnnotation, err)
+	}
+	if soak := params.SoakTime.Duration - time.Since(readyTime); soak > 0 {
+		return fmt.Errorf("canary nodes soaking for another %s", soak.Round(time.Second))
+	}
+
+	remoteClient, err := remoteClients.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return fmt.Errorf("getting workload cluster client: %v", err)
+	}
+
+	nodes, err := canaryNodeNames(ctx, c, canary)
+	if err != nil {
+		return err
+	}
+	if int32(len(nodes)) < replicas {
+		return fmt.Errorf("%d of %d canary machines have nodes", len(nodes), replicas)
+	}
+
+	if err := checkNodesReady(ctx, remoteClient, nodes); err != nil {
+		return err
+	}
+
+	pods := &corev1.PodList{}
+	if err := remoteClient.List(ctx, pods); err != nil {
+		return fmt.Errorf("listing pods: %v", err)
+	}
+
+	if err := checkPodsRescheduled(pods.Items, readyTime); err != nil {
+		return err
+	}
+
+	if err := checkCanaryNodePodsReady(pods.Items, nodes); err != nil {
+		return err
+	}
+
+	for _, check := range params.PodsReady {
+		if err := checkPodsReady(ctx, remoteClient, check); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func canaryNodeNames(ctx context.Context, c client.Client, canary *clusterv1.MachineDeployment) ([]string, error) {
+	machines := &clusterv1.MachineList{}
+	if err := c.List(ctx, machines,
+		client.InNamespace(canary.Namespace),
+		client.MatchingLabels{clusterv1.MachineDeploymentNameLabel: canary.Name},
+	); err != nil {
+		return nil, fmt.Errorf("listing canary machines: %v", err)
+	}
+
+	nodes := make([]string, 0, len(machines.Items))
+	for _, m := range machines.Items {
+		if m.Status.NodeRef != nil {
+			nodes = append(nodes, m.Status.NodeRef.Name)
+		}
+	}
+	sort.Strings(nodes)
+
+	return nodes, nil
+}
+
+func checkNodesReady(ctx context.Context, c client.Client, names []string) error {
+	for _, name := range names {
+		node := &corev1.Node{}
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, node); err != nil {
+			return fmt.Errorf("getting canary node %s: %v", name, err)
+		}
+		if !nodeReady(node) {
+			return fmt.Errorf("canary node %s is not ready", name)
+		}
+	}
+
+	return nil
+}
+
+// checkPodsRescheduled checks that the pods created after the canary nodes became ready, which includes
+// the ones that replaced the pods in the nodes removed from the group, have been scheduled.
+func checkPodsRescheduled(pods []corev1.Pod, since time.Time) error {
+	for _, pod := range pods {
+		if pod.CreationTimestamp.Time.Before(since) {
+			continue
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse && c.Reason == corev1.PodReasonUnschedulable {
+				return fmt.Errorf("pod %s/%s can't be scheduled: %s", pod.Namespace, pod.Name, c.Message)
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkCanaryNodePodsReady(pods []corev1.Pod, nodes []string) error {
+	canaryNodes := map[string]struct{}{}
+	for _, n := range nodes {
+		canaryNodes[n] = struct{}{}
+	}
+
+	for _, pod := range pods {
+		if _, ok := canaryNodes[pod.Spec.NodeName]; !ok {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+		if !podReady(&pod) {
+			return fmt.Errorf("pod %s/%s in canary node %s is not ready", pod.Namespace, pod.Name, pod.Spec.NodeName)
+		}
+	}
+
+	return nil
+}
+
+func checkPodsReady(ctx context.Context, c client.Client, check anywherev1.CanaryPodsReadyCheck) error {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(check.Namespace), client.MatchingLabels(check.Selector)); err != nil {
+		return fmt.Errorf("listing pods in namespace %s: %v", check.Namespace, err)
+	}
+
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no pods matching %v in namespace %s", check.Selector, check.Namespace)
+	}
+
+	for i := range pods.Items {
+		if !podReady(&pods.Items[i]) {
+			return fmt.Errorf("pod %s/%s is not ready", pods.Items[i].Namespace, pods.Items[i].Name)
+		}
+	}
+
+	return nil
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// newCanaryGroup builds the worker group for the canary of g. It uses the new machine template and
+// keeps the state annotations of the existing canary MachineDeployment, if there is one.
+func newCanaryGroup(g *WorkerGroup, existing *clusterv1.MachineDeployment, replicas int32) *WorkerGroup {
+	md := g.MachineDeployment.DeepCopy()
+	md.Name = CanaryMachineDeploymentName(g.MachineDeployment.Name)
+	md.ResourceVersion = ""
+	md.Spec.Replicas = &replicas
+	// The canary is not managed by the cluster autoscaler.
+	md.Annotations = map[string]string{}
+	if existing != nil {
+		for _, a := range []string{CanaryReadyAtAnnotation, CanaryFailedAnnotation} {
+			if v, ok := existing.Annotations[a]; ok {
+				md.Annotations[a] = v
+			}
+		}
+	}
+
+	return &WorkerGroup{
+		MachineDeployment:       md,
+		KubeadmConfigTemplate:   g.KubeadmConfigTemplate,
+		ProviderMachineTemplate: g.ProviderMachineTemplate,
+	}
+}
+
+func canaryParams(canary *anywherev1.WorkerNodesCanaryParams) anywherev1.WorkerNodesCanaryParams {
+	params := anywherev1.WorkerNodesCanaryParams{}
+	if canary != nil {
+		params = *canary.DeepCopy()
+	}
+	if params.SoakTime == nil {
+		params.SoakTime = &metav1.Duration{Duration: defaultCanarySoakTime}
+	}
+	if params.Timeout == nil {
+		params.Timeout = &metav1.Duration{Duration: defaultCanaryTimeout}
+	}
+
+	return params
+}
+
+// canaryNodes returns the number of canary nodes for a MachineDeployment.
+func canaryNodes(params anywherev1.WorkerNodesCanaryParams, md *clusterv1.MachineDeployment) int32 {
+	total := 0
+	if md.Spec.Replicas != nil {
+		total = int(*md.Spec.Replicas)
+	}
+
+	return int32(params.NodeCount(total))
+}
+
+// reduceReplicas removes from md the replicas that run as canary nodes. Autoscaled groups keep
+// their replicas since those are managed by the cluster autoscaler.
+func reduceReplicas(md *clusterv1.MachineDeployment, canaryReplicas int32, autoscaled bool) {
+	if autoscaled || md.Spec.Replicas == nil {
+		return
+	}
+
+	replicas := *md.Spec.Replicas - canaryReplicas
+	if replicas < 0 {
+		replicas = 0
+	}
+	md.Spec.Replicas = &replicas
+}
+
+func sameMachineTemplate(a, b *clusterv1.MachineDeployment) bool {
+	return a.Spec.Template.Spec.InfrastructureRef.Name == b.Spec.Template.Spec.InfrastructureRef.Name &&
+		equality.Semantic.DeepEqual(a.Spec.Template.Spec.Bootstrap.ConfigRef, b.Spec.Template.Spec.Bootstrap.ConfigRef) &&
+		equality.Semantic.DeepEqual(a.Spec.Template.Spec.Version, b.Spec.Template.Spec.Version)
+}
+
+func machineDeploymentReady(md *clusterv1.MachineDeployment, replicas int32) bool {
+	return md.Status.ObservedGeneration == md.Generation && md.Status.ReadyReplicas >= replicas
+}
+
+func machineDeploymentRolledOut(md *clusterv1.MachineDeployment) bool {
+	return md.Status.ObservedGeneration == md.Generation &&
+		md.Status.UpdatedReplicas == md.Status.Replicas &&
+		md.Status.ReadyReplicas == md.Status.Replicas
+}
+
+func getMachineDeployment(ctx context.Context, c client.Client, namespace, name string) (*clusterv1.MachineDeployment, error) {
+	md := &clusterv1.MachineDeployment{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, md)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting machine deployment %s", name)
+	}
+
+	return md, nil
+}
+
+func deleteMachineDeployment(ctx context.Context, c client.Client, md *clusterv1.MachineDeployment) error {
+	if err := c.Delete(ctx, md); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting machine deployment %s", md.Name)
+	}
+	return nil
+}
@@ -0,0 +1,436 @@
+package clusters_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters/mocks"
+)
+
+type canaryTest struct {
+	*WithT
+	ctx           context.Context
+	cluster       *anywherev1.Cluster
+	workers       *clusters.Workers
+	remoteClients *mocks.MockRemoteClientRegistry
+	objs          []client.Object
+	remoteObjs    []client.Object
+}
+
+func newCanaryTest(t *testing.T) *canaryTest {
+	ctrl := gomock.NewController(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: constants.EksaSystemNamespace,
+		},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name:  "md-0",
+					Count: ptrInt(4),
+					UpgradeRolloutStrategy: &anywherev1.WorkerNodesUpgradeRolloutStrategy{
+						Type:          anywherev1.CanaryStrategyType,
+						RollingUpdate: &anywherev1.WorkerNodesRollingUpdateParams{MaxSurge: 1},
+						Canary: &anywherev1.WorkerNodesCanaryParams{
+							Nodes:    &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+							SoakTime: &metav1.Duration{Duration: time.Minute},
+							Timeout:  &metav1.Duration{Duration: 10 * time.Minute},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &canaryTest{
+		WithT:         NewWithT(t),
+		ctx:           context.Background(),
+		cluster:       cluster,
+		workers:       canaryWorkers("my-cluster-md-0-2"),
+		remoteClients: mocks.NewMockRemoteClientRegistry(ctrl),
+	}
+}
+
+func (tt *canaryTest) reconcile() (controller.Result, error) {
+	return clusters.ReconcileCanaries(tt.ctx, logr.Discard(), tt.client(), tt.remoteClients, tt.cluster, tt.workers)
+}
+
+func (tt *canaryTest) client() client.Client {
+	return fake.NewClientBuilder().WithScheme(canaryScheme(tt)).WithObjects(tt.objs...).Build()
+}
+
+func (tt *canaryTest) expectRemoteClient() {
+	remote := fake.NewClientBuilder().WithScheme(canaryScheme(tt)).WithObjects(tt.remoteObjs...).Build()
+	tt.remoteClients.EXPECT().GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}).Return(remote, nil)
+}
+
+func (tt *canaryTest) group() *clusters.WorkerGroup {
+	return &tt.workers.Groups[0]
+}
+
+func (tt *canaryTest) canaryGroup() *clusters.WorkerGroup {
+	tt.Expect(tt.workers.Groups).To(HaveLen(2))
+	return &tt.workers.Groups[1]
+}
+
+func (tt *canaryTest) expectCondition(status corev1.ConditionStatus, reason string) {
+	condition := conditions.Get(tt.cluster, anywherev1.CanaryUpgradeHealthyCondition)
+	tt.Expect(condition).NotTo(BeNil())
+	tt.Expect(condition.Status).To(Equal(status))
+	tt.Expect(condition.Reason).To(Equal(reason))
+}
+
+func canaryScheme(g Gomega) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+func ptrInt(i int) *int {
+	return &i
+}
+
+func canaryWorkers(template string) *clusters.Workers {
+	return &clusters.Workers{
+		Groups: []clusters.WorkerGroup{
+			{
+				MachineDeployment:       canaryMachineDeployment("my-cluster-md-0", template, 4),
+				KubeadmConfigTemplate:   kubeadmConfigTemplate(template, constants.EksaSystemNamespace),
+				ProviderMachineTemplate: dockerMachineTemplate(template, constants.EksaSystemNamespace),
+			},
+		},
+	}
+}
+
+func canaryMachineDeployment(name, template string, replicas int32) *clusterv1.MachineDeployment {
+	md := machineDeployment(name, constants.EksaSystemNamespace)
+	md.Annotations = map[string]string{}
+	md.Spec.Replicas = &replicas
+	md.Spec.Template.Spec.InfrastructureRef = corev1.ObjectReference{Name: template}
+	md.Spec.Template.Spec.Bootstrap.ConfigRef = &corev1.ObjectReference{Name: template}
+	return md
+}
+
+func readyCanary(template string, replicas int32, annotations map[string]string) *clusterv1.MachineDeployment {
+	md := canaryMachineDeployment("my-cluster-md-0-canary", template, replicas)
+	md.CreationTimestamp = metav1.Now()
+	md.Annotations = annotations
+	md.Status.ReadyReplicas = replicas
+	md.Status.Replicas = replicas
+	md.Status.UpdatedReplicas = replicas
+	return md
+}
+
+func canaryMachine(node string) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      node,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    map[string]string{clusterv1.MachineDeploymentNameLabel: "my-cluster-md-0-canary"},
+		},
+		Status: clusterv1.MachineStatus{
+			NodeRef: &corev1.ObjectReference{Name: node},
+		},
+	}
+}
+
+func node(name string, ready corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func pod(namespace, name, nodeName string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{"app": name},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func soakedAnnotations() map[string]string {
+	return map[string]string{
+		clusters.CanaryReadyAtAnnotation: time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339),
+	}
+}
+
+func TestReconcileCanariesNoCanaryGroups(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = nil
+	conditions.MarkTrue(tt.cluster, anywherev1.CanaryUpgradeHealthyCondition)
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.Result{}))
+	tt.Expect(tt.workers.Groups).To(HaveLen(1))
+	tt.Expect(conditions.Has(tt.cluster, anywherev1.CanaryUpgradeHealthyCondition)).To(BeFalse())
+}
+
+func TestReconcileCanariesNewWorkerNodeGroup(t *testing.T) {
+	tt := newCanaryTest(t)
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.Result{}))
+	tt.Expect(tt.workers.Groups).To(HaveLen(1))
+	tt.expectCondition(corev1.ConditionTrue, "")
+}
+
+func TestReconcileCanariesNoChanges(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.objs = append(tt.objs, canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-2", 4))
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.Result{}))
+	tt.Expect(tt.workers.Groups).To(HaveLen(1))
+	tt.expectCondition(corev1.ConditionTrue, "")
+}
+
+func TestReconcileCanariesCreateCanary(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.group().MachineDeployment.Annotations["cluster.x-k8s.io/cluster-api-autoscaler-node-group-min-size"] = "1"
+	tt.objs = append(tt.objs, canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4))
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+
+	md := tt.group().MachineDeployment
+	tt.Expect(md.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+	tt.Expect(*md.Spec.Replicas).To(BeEquivalentTo(4))
+
+	canary := tt.canaryGroup()
+	tt.Expect(canary.MachineDeployment.Name).To(Equal("my-cluster-md-0-canary"))
+	tt.Expect(canary.MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-2"))
+	tt.Expect(*canary.MachineDeployment.Spec.Replicas).To(BeEquivalentTo(1))
+	tt.Expect(canary.MachineDeployment.Annotations).To(BeEmpty())
+	tt.Expect(canary.KubeadmConfigTemplate).To(Equal(tt.group().KubeadmConfigTemplate))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+}
+
+func TestReconcileCanariesCanaryNotReady(t *testing.T) {
+	tt := newCanaryTest(t)
+	canary := readyCanary("my-cluster-md-0-2", 1, nil)
+	canary.Status.ReadyReplicas = 0
+	tt.objs = append(tt.objs, canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4), canary)
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(4))
+	tt.Expect(tt.canaryGroup().MachineDeployment.Annotations).NotTo(HaveKey(clusters.CanaryReadyAtAnnotation))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+}
+
+func TestReconcileCanariesCanaryReadyStartsSoak(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		readyCanary("my-cluster-md-0-2", 1, nil),
+	)
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(3))
+	tt.Expect(tt.canaryGroup().MachineDeployment.Annotations).To(HaveKey(clusters.CanaryReadyAtAnnotation))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+}
+
+func TestReconcileCanariesHealthChecksPass(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy.Canary.PodsReady = []anywherev1.CanaryPodsReadyCheck{
+		{Namespace: "monitoring", Selector: map[string]string{"app": "agent"}},
+	}
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()),
+		canaryMachine("canary-node"),
+	)
+	tt.remoteObjs = append(tt.remoteObjs,
+		node("canary-node", corev1.ConditionTrue),
+		pod("default", "app", "canary-node", corev1.ConditionTrue),
+		pod("monitoring", "agent", "old-node", corev1.ConditionTrue),
+	)
+	tt.expectRemoteClient()
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-2"))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(3))
+	tt.Expect(*tt.canaryGroup().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(1))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+}
+
+func TestReconcileCanariesHealthChecksPending(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteObjs []client.Object
+	}{
+		{
+			name:       "node not ready",
+			remoteObjs: []client.Object{node("canary-node", corev1.ConditionFalse)},
+		},
+		{
+			name: "pod in canary node not ready",
+			remoteObjs: []client.Object{
+				node("canary-node", corev1.ConditionTrue),
+				pod("default", "app", "canary-node", corev1.ConditionFalse),
+			},
+		},
+		{
+			name: "rescheduled pod unschedulable",
+			remoteObjs: []client.Object{
+				node("canary-node", corev1.ConditionTrue),
+				func() client.Object {
+					p := pod("default", "app", "", corev1.ConditionFalse)
+					p.CreationTimestamp = metav1.Now()
+					p.Status.Phase = corev1.PodPending
+					p.Status.Conditions = []corev1.PodCondition{{
+						Type:   corev1.PodScheduled,
+						Status: corev1.ConditionFalse,
+						Reason: corev1.PodReasonUnschedulable,
+					}}
+					return p
+				}(),
+			},
+		},
+		{
+			name:       "no pods for pods ready check",
+			remoteObjs: []client.Object{node("canary-node", corev1.ConditionTrue)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newCanaryTest(t)
+			tt.cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy.Canary.PodsReady = []anywherev1.CanaryPodsReadyCheck{
+				{Namespace: "monitoring"},
+			}
+			tt.objs = append(tt.objs,
+				canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+				readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()),
+				canaryMachine("canary-node"),
+			)
+			tt.remoteObjs = tc.remoteObjs
+			tt.expectRemoteClient()
+
+			tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+			tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+			tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(3))
+			tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+		})
+	}
+}
+
+func TestReconcileCanariesHealthChecksFailAfterTimeout(t *testing.T) {
+	tt := newCanaryTest(t)
+	canary := readyCanary("my-cluster-md-0-2", 1, soakedAnnotations())
+	canary.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		canary,
+		canaryMachine("canary-node"),
+	)
+	tt.remoteObjs = append(tt.remoteObjs, node("canary-node", corev1.ConditionFalse))
+	tt.expectRemoteClient()
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(5 * time.Minute)))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(4))
+	tt.Expect(tt.canaryGroup().MachineDeployment.Annotations).To(HaveKeyWithValue(clusters.CanaryFailedAnnotation, "canary node canary-node is not ready"))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryHealthChecksFailedReason)
+}
+
+func TestReconcileCanariesPausedAfterFailure(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		readyCanary("my-cluster-md-0-2", 1, map[string]string{clusters.CanaryFailedAnnotation: "canary node canary-node is not ready"}),
+	)
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(5 * time.Minute)))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(4))
+	tt.Expect(tt.canaryGroup().MachineDeployment.Annotations).To(HaveKey(clusters.CanaryFailedAnnotation))
+	condition := conditions.Get(tt.cluster, anywherev1.CanaryUpgradeHealthyCondition)
+	tt.Expect(condition.Severity).To(Equal(clusterv1.ConditionSeverityError))
+	tt.Expect(condition.Message).To(ContainSubstring("md-0: canary node canary-node is not ready"))
+}
+
+func TestReconcileCanariesWaitsForGroupRollout(t *testing.T) {
+	tt := newCanaryTest(t)
+	current := canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-2", 3)
+	current.Status.Replicas = 4
+	current.Status.UpdatedReplicas = 2
+	tt.objs = append(tt.objs, current, readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()))
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(3))
+	tt.Expect(tt.canaryGroup().MachineDeployment.Name).To(Equal("my-cluster-md-0-canary"))
+	tt.expectCondition(corev1.ConditionFalse, anywherev1.CanaryUpgradeInProgressReason)
+}
+
+func TestReconcileCanariesDeletesCanaryAfterRollout(t *testing.T) {
+	tt := newCanaryTest(t)
+	current := canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-2", 3)
+	current.Status.Replicas = 3
+	current.Status.UpdatedReplicas = 3
+	current.Status.ReadyReplicas = 3
+	tt.objs = append(tt.objs, current, readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()))
+	c := tt.client()
+
+	tt.Expect(clusters.ReconcileCanaries(tt.ctx, logr.Discard(), c, tt.remoteClients, tt.cluster, tt.workers)).To(Equal(controller.Result{}))
+	tt.Expect(tt.workers.Groups).To(HaveLen(1))
+	tt.Expect(*tt.group().MachineDeployment.Spec.Replicas).To(BeEquivalentTo(4))
+	err := c.Get(tt.ctx, client.ObjectKey{Name: "my-cluster-md-0-canary", Namespace: constants.EksaSystemNamespace}, &clusterv1.MachineDeployment{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	tt.expectCondition(corev1.ConditionTrue, "")
+}
+
+func TestReconcileCanariesRecreatesOutdatedCanary(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.workers = canaryWorkers("my-cluster-md-0-3")
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()),
+	)
+	c := tt.client()
+
+	tt.Expect(clusters.ReconcileCanaries(tt.ctx, logr.Discard(), c, tt.remoteClients, tt.cluster, tt.workers)).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(tt.workers.Groups).To(HaveLen(1))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+	err := c.Get(tt.ctx, client.ObjectKey{Name: "my-cluster-md-0-canary", Namespace: constants.EksaSystemNamespace}, &clusterv1.MachineDeployment{})
+	tt.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestReconcileCanariesRemoteClientError(t *testing.T) {
+	tt := newCanaryTest(t)
+	tt.objs = append(tt.objs,
+		canaryMachineDeployment("my-cluster-md-0", "my-cluster-md-0-1", 4),
+		readyCanary("my-cluster-md-0-2", 1, soakedAnnotations()),
+	)
+	tt.remoteClients.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	tt.Expect(tt.reconcile()).To(Equal(controller.ResultWithRequeue(30 * time.Second)))
+	tt.Expect(tt.group().MachineDeployment.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-1"))
+}
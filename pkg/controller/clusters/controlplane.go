@@ -2,7 +2,6 @@ package clusters
 
 import (
 	"context"
-	"reflect"
 	"time"
 
 	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
@@ -58,13 +57,17 @@ func (cp *ControlPlane) AllObjects() []client.Object {
 }
 
 func (cp *ControlPlane) etcdObjects() []client.Object {
+	// The machine template is not set when the rollout of the control plane is deferred.
+	if isNil(cp.EtcdMachineTemplate) {
+		return []client.Object{cp.EtcdCluster}
+	}
 	return []client.Object{cp.EtcdMachineTemplate, cp.EtcdCluster}
 }
 
 func (cp *ControlPlane) nonEtcdObjects() []client.Object {
 	objs := make([]client.Object, 0, 4+len(cp.Other))
 	objs = append(objs, cp.Cluster, cp.ProviderCluster, cp.KubeadmControlPlane)
-	if !reflect.ValueOf(cp.ControlPlaneMachineTemplate).IsNil() {
+	if !isNil(cp.ControlPlaneMachineTemplate) {
 		objs = append(objs, cp.ControlPlaneMachineTemplate)
 	}
 	objs = append(objs, cp.Other...)
@@ -0,0 +1,101 @@
+package clusters
+
+import (
+	"context"
+
+	etcdv1 "github.com/aws/etcdadm-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+)
+
+// RollingChangesDeferred returns true when the cluster controller has deferred the changes that
+// replace or upgrade nodes because the cluster is outside its maintenance windows.
+func RollingChangesDeferred(cluster *anywherev1.Cluster) bool {
+	condition := conditions.Get(cluster, anywherev1.RollingChangesAppliedCondition)
+	return condition != nil &&
+		condition.Status == corev1.ConditionFalse &&
+		condition.Reason == anywherev1.OutsideMaintenanceWindowReason
+}
+
+// ReconcileControlPlaneForEKSA orchestrates the ControlPlane reconciliation logic for a particular EKS-A cluster.
+// When the cluster's rolling changes are deferred, the current KubeadmControlPlane and EtcdadmCluster specs are
+// kept, except for the control plane replicas, and the new machine templates are not applied. Any other change
+// to the control plane objects is applied.
+func ReconcileControlPlaneForEKSA(ctx context.Context, log logr.Logger, c client.Client, cluster *anywherev1.Cluster, cp *ControlPlane) (controller.Result, error) {
+	if RollingChangesDeferred(cluster) {
+		if err := deferControlPlaneRollout(ctx, log, c, cp); err != nil {
+			return controller.Result{}, errors.Wrap(err, "deferring control plane rollout")
+		}
+	}
+
+	return ReconcileControlPlane(ctx, log, c, cp)
+}
+
+func deferControlPlaneRollout(ctx context.Context, log logr.Logger, c client.Client, cp *ControlPlane) error {
+	kcp := &controlplanev1.KubeadmControlPlane{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(cp.KubeadmControlPlane), kcp)
+	if apierrors.IsNotFound(err) {
+		// A new control plane has nothing to roll out.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "reading kubeadm control plane")
+	}
+
+	log.Info("Outside maintenance window, keeping current control plane machines spec", "kcp", kcp.Name)
+	replicas := cp.KubeadmControlPlane.Spec.Replicas
+	cp.KubeadmControlPlane.Spec = *kcp.Spec.DeepCopy()
+	cp.KubeadmControlPlane.Spec.Replicas = replicas
+	cp.ControlPlaneMachineTemplate = nil
+
+	if cp.EtcdCluster == nil {
+		return nil
+	}
+
+	etcdadmCluster := &etcdv1.EtcdadmCluster{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(cp.EtcdCluster), etcdadmCluster)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "reading etcdadm cluster")
+	}
+
+	cp.EtcdCluster.Spec = *etcdadmCluster.Spec.DeepCopy()
+	cp.EtcdMachineTemplate = nil
+
+	return nil
+}
+
+// deferWorkersRollout modifies the Workers spec so the existing MachineDeployments keep their current
+// machine template, bootstrap config and version. The new templates of those groups are not applied.
+// Any other change, like the number of replicas, and new worker groups are applied.
+func deferWorkersRollout(ctx context.Context, log logr.Logger, c client.Client, w *Workers) error {
+	for i := range w.Groups {
+		g := &w.Groups[i]
+		md := &clusterv1.MachineDeployment{}
+		err := c.Get(ctx, client.ObjectKeyFromObject(g.MachineDeployment), md)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "reading machine deployment")
+		}
+
+		log.Info("Outside maintenance window, keeping current worker machines spec", "machineDeployment", md.Name)
+		g.MachineDeployment.Spec.Template = *md.Spec.Template.DeepCopy()
+		g.KubeadmConfigTemplate = nil
+		g.ProviderMachineTemplate = nil
+	}
+
+	return nil
+}
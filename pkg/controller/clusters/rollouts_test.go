@@ -0,0 +1,139 @@
+package clusters_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/controller/clusters"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func deferredRolloutCluster() *anywherev1.Cluster {
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "ns",
+		},
+	}
+	conditions.MarkFalse(cluster, anywherev1.RollingChangesAppliedCondition, anywherev1.OutsideMaintenanceWindowReason, clusterv1.ConditionSeverityInfo, "")
+
+	return cluster
+}
+
+func TestRollingChangesDeferred(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster func() *anywherev1.Cluster
+		want    bool
+	}{
+		{
+			name:    "outside maintenance window",
+			cluster: deferredRolloutCluster,
+			want:    true,
+		},
+		{
+			name: "rolling out changes",
+			cluster: func() *anywherev1.Cluster {
+				cluster := deferredRolloutCluster()
+				conditions.MarkFalse(cluster, anywherev1.RollingChangesAppliedCondition, anywherev1.RollingChangesInProgressReason, clusterv1.ConditionSeverityInfo, "")
+				return cluster
+			},
+			want: false,
+		},
+		{
+			name: "no condition",
+			cluster: func() *anywherev1.Cluster {
+				return &anywherev1.Cluster{}
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(clusters.RollingChangesDeferred(tt.cluster())).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileControlPlaneForEKSADeferredKeepsMachinesSpec(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	log := test.NewNullLogger()
+	ns := "ns"
+
+	existing := controlPlaneExternalEtcd(ns)
+	existing.KubeadmControlPlane.Spec.Version = "v1.29.0"
+	existing.KubeadmControlPlane.Spec.Replicas = ptr.Int32(3)
+	existing.EtcdCluster.Spec.Replicas = ptr.Int32(3)
+	c := fake.NewClientBuilder().WithObjects(existing.AllObjects()...).Build()
+
+	cp := controlPlaneExternalEtcd(ns)
+	cp.KubeadmControlPlane.Spec.Version = "v1.30.0"
+	cp.KubeadmControlPlane.Spec.Replicas = ptr.Int32(5)
+	cp.EtcdCluster.Spec.Replicas = ptr.Int32(5)
+
+	_, err := clusters.ReconcileControlPlaneForEKSA(ctx, log, c, deferredRolloutCluster(), cp)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(cp.KubeadmControlPlane.Spec.Version).To(Equal("v1.29.0"))
+	g.Expect(cp.KubeadmControlPlane.Spec.Replicas).To(Equal(ptr.Int32(5)))
+	g.Expect(cp.ControlPlaneMachineTemplate).To(BeNil())
+	g.Expect(cp.EtcdMachineTemplate).To(BeNil())
+	g.Expect(cp.EtcdCluster.Spec.Replicas).To(Equal(ptr.Int32(3)))
+}
+
+func TestReconcileControlPlaneForEKSAErrorReadingKubeadmControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	log := test.NewNullLogger()
+	c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+
+	g.Expect(
+		clusters.ReconcileControlPlaneForEKSA(ctx, log, c, deferredRolloutCluster(), controlPlaneStackedEtcd("ns")),
+	).Error().To(MatchError(ContainSubstring("deferring control plane rollout")))
+}
+
+func TestReconcileWorkersForEKSADeferredKeepsMachinesSpec(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	ns := "ns"
+	capiCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "eksa-system",
+		},
+	}
+	w := workers(ns)
+	existing := machineDeployment("my-cluster-md-0", ns)
+	existing.Spec.Template.Spec.Version = ptr.String("v1.29.0")
+	existing.Spec.Template.Spec.InfrastructureRef = corev1.ObjectReference{Name: "my-cluster-md-0-0"}
+	c := fake.NewClientBuilder().WithObjects(capiCluster, existing, w.Groups[1].MachineDeployment).Build()
+
+	w.Groups[0].MachineDeployment.Spec.Replicas = ptr.Int32(4)
+	w.Groups[0].MachineDeployment.Spec.Template.Spec.Version = ptr.String("v1.30.0")
+
+	g.Expect(
+		clusters.ReconcileWorkersForEKSA(ctx, test.NewNullLogger(), c, nil, deferredRolloutCluster(), w),
+	).To(Equal(controller.Result{}))
+
+	md := &clusterv1.MachineDeployment{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(existing), md)).To(Succeed())
+	g.Expect(md.Spec.Replicas).To(Equal(ptr.Int32(4)))
+	g.Expect(md.Spec.Template.Spec.Version).To(Equal(ptr.String("v1.29.0")))
+	g.Expect(md.Spec.Template.Spec.InfrastructureRef.Name).To(Equal("my-cluster-md-0-0"))
+	g.Expect(w.Groups[0].KubeadmConfigTemplate).To(BeNil())
+	g.Expect(w.Groups[0].ProviderMachineTemplate).To(BeNil())
+}
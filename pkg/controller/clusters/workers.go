@@ -47,15 +47,24 @@ type WorkerGroup struct {
 }
 
 func (g *WorkerGroup) objects() []client.Object {
-	objs := []client.Object{g.KubeadmConfigTemplate, g.MachineDeployment}
+	objs := []client.Object{g.MachineDeployment}
 
-	if !reflect.ValueOf(g.ProviderMachineTemplate).IsNil() {
+	// The templates are not set when the rollout of the group is deferred.
+	if g.KubeadmConfigTemplate != nil {
+		objs = append(objs, g.KubeadmConfigTemplate)
+	}
+
+	if !isNil(g.ProviderMachineTemplate) {
 		objs = append(objs, g.ProviderMachineTemplate)
 	}
 
 	return objs
 }
 
+func isNil(obj client.Object) bool {
+	return obj == nil || reflect.ValueOf(obj).IsNil()
+}
+
 // ToWorkers converts the generic clusterapi Workers definition to the concrete one defined
 // here. It's just a helper for callers generating workers spec using the clusterapi package.
 func ToWorkers[M clusterapi.Object[M]](capiWorkers *clusterapi.Workers[M]) *Workers {
@@ -76,8 +85,11 @@ func ToWorkers[M clusterapi.Object[M]](capiWorkers *clusterapi.Workers[M]) *Work
 
 // ReconcileWorkersForEKSA orchestrates the worker node reconciliation logic for a particular EKS-A cluster.
 // It takes care of applying all desired objects in the Workers spec and deleting the
-// old MachineDeployments that are not in it.
-func ReconcileWorkersForEKSA(ctx context.Context, log logr.Logger, c client.Client, cluster *anywherev1.Cluster, w *Workers) (controller.Result, error) {
+// old MachineDeployments that are not in it. Worker node groups with a Canary upgrade rollout
+// strategy are only upgraded after their canary nodes pass the health checks, which are run
+// against the workload cluster using remoteClients. When the cluster's rolling changes are deferred,
+// the existing worker groups keep their current machines spec.
+func ReconcileWorkersForEKSA(ctx context.Context, log logr.Logger, c client.Client, remoteClients RemoteClientRegistry, cluster *anywherev1.Cluster, w *Workers) (controller.Result, error) {
 	capiCluster, err := controller.GetCAPICluster(ctx, c, cluster)
 	if err != nil {
 		return controller.Result{}, errors.Wrap(err, "reconciling workers for EKS-A cluster")
@@ -89,7 +101,23 @@ func ReconcileWorkersForEKSA(ctx context.Context, log logr.Logger, c client.Clie
 		return controller.ResultWithRequeue(5 * time.Second), nil
 	}
 
-	return ReconcileWorkers(ctx, c, capiCluster, w)
+	if RollingChangesDeferred(cluster) {
+		if err := deferWorkersRollout(ctx, log, c, w); err != nil {
+			return controller.Result{}, errors.Wrap(err, "deferring workers rollout")
+		}
+	}
+
+	canaryResult, err := ReconcileCanaries(ctx, log, c, remoteClients, cluster, w)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "reconciling canary upgrades")
+	}
+
+	result, err := ReconcileWorkers(ctx, c, capiCluster, w)
+	if err != nil || result.Return() {
+		return result, err
+	}
+
+	return canaryResult, nil
 }
 
 // ReconcileWorkers orchestrates the worker node reconciliation logic.
@@ -205,7 +205,7 @@ func TestReconcileWorkersForEKSAErrorGettingCAPICluster(t *testing.T) {
 	}
 
 	g.Expect(
-		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, cluster, w),
+		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, nil, cluster, w),
 	).Error().To(MatchError(ContainSubstring("reconciling workers for EKS-A cluster")))
 }
 
@@ -223,7 +223,7 @@ func TestReconcileWorkersForEKSANoCAPICluster(t *testing.T) {
 	}
 
 	g.Expect(
-		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, cluster, w),
+		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, nil, cluster, w),
 	).To(Equal(controller.Result{Result: &reconcile.Result{RequeueAfter: 5 * time.Second}}))
 }
 
@@ -257,7 +257,7 @@ func TestReconcileWorkersForEKSASuccess(t *testing.T) {
 	)
 
 	g.Expect(
-		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, cluster, w),
+		clusters.ReconcileWorkersForEKSA(ctx, env.Manager().GetLogger(), c, nil, cluster, w),
 	).To(Equal(controller.Result{}))
 
 	api.ShouldEventuallyExist(ctx, w.Groups[0].MachineDeployment)
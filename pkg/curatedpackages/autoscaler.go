@@ -0,0 +1,151 @@
+package curatedpackages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clusterapi"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const clusterAutoscalerPackageName = "cluster-autoscaler"
+
+// SetClusterAutoscalerConfig configures the cluster-autoscaler packages to use the priority expander
+// when any of the cluster worker node groups sets an expander priority. Other packages are left untouched.
+func SetClusterAutoscalerConfig(packages []packagesv1.Package, cluster *anywherev1.Cluster) {
+	priorities := clusterapi.ExpanderPriorities(cluster)
+	if priorities == nil {
+		return
+	}
+
+	for i := range packages {
+		if strings.EqualFold(packages[i].Spec.PackageName, clusterAutoscalerPackageName) {
+			packages[i].Spec.Config = clusterAutoscalerConfig(cluster.Name, priorities)
+		}
+	}
+}
+
+// UpdateClusterAutoscalerPackages configures the priority expander, when any of the cluster worker
+// node groups sets an expander priority, in the cluster-autoscaler packages already installed for the cluster.
+// The rest of the packages configuration is kept.
+func UpdateClusterAutoscalerPackages(ctx context.Context, kubectl KubectlRunner, cluster *anywherev1.Cluster, kubeConfig string) error {
+	priorities := clusterapi.ExpanderPriorities(cluster)
+	if priorities == nil {
+		return nil
+	}
+
+	namespace := constants.EksaPackagesName + "-" + cluster.Name
+	params := []string{"get", "packages", "-o", "json", "--kubeconfig", kubeConfig, "--namespace", namespace}
+	stdOut, err := kubectl.ExecuteCommand(ctx, params...)
+	if err != nil {
+		return fmt.Errorf("listing packages for cluster %s: %v", cluster.Name, err)
+	}
+	packages := &packagesv1.PackageList{}
+	if err := json.Unmarshal(stdOut.Bytes(), packages); err != nil {
+		return fmt.Errorf("unmarshaling packages for cluster %s: %w", cluster.Name, err)
+	}
+
+	for _, p := range packages.Items {
+		if !strings.EqualFold(p.Spec.PackageName, clusterAutoscalerPackageName) {
+			continue
+		}
+
+		config, err := mergeClusterAutoscalerConfig(p.Spec.Config, cluster.Name, priorities)
+		if err != nil {
+			return fmt.Errorf("configuring package %s: %v", p.Name, err)
+		}
+		if config == p.Spec.Config {
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{"spec": map[string]string{"config": config}})
+		if err != nil {
+			return err
+		}
+		params := []string{"patch", "packages", p.Name, "--type", "merge", "-p", string(patch), "--kubeconfig", kubeConfig, "--namespace", namespace}
+		if _, err := kubectl.ExecuteCommand(ctx, params...); err != nil {
+			return fmt.Errorf("updating package %s: %v", p.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func clusterAutoscalerConfig(clusterName string, priorities map[int][]string) string {
+	var b strings.Builder
+	b.WriteString("cloudProvider: clusterapi\n")
+	fmt.Fprintf(&b, "autoDiscovery:\n  clusterName: %s\n", clusterName)
+	b.WriteString("extraArgs:\n  expander: priority\n")
+	// The priorities are passed as a string so the chart writes them verbatim to the priority expander
+	// ConfigMap, keeping the integer keys the autoscaler expects.
+	b.WriteString("expanderPriorities: |-\n")
+	for _, line := range strings.Split(expanderPrioritiesValue(priorities), "\n") {
+		fmt.Fprintf(&b, "  %s\n", line)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// mergeClusterAutoscalerConfig sets the priority expander configuration in an existing
+// cluster-autoscaler package config, keeping the rest of the values.
+func mergeClusterAutoscalerConfig(config, clusterName string, priorities map[int][]string) (string, error) {
+	if strings.TrimSpace(config) == "" {
+		return clusterAutoscalerConfig(clusterName, priorities), nil
+	}
+
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(config), &values); err != nil {
+		return "", fmt.Errorf("parsing config: %v", err)
+	}
+
+	values["cloudProvider"] = "clusterapi"
+	setNestedValue(values, clusterName, "autoDiscovery", "clusterName")
+	setNestedValue(values, "priority", "extraArgs", "expander")
+	values["expanderPriorities"] = expanderPrioritiesValue(priorities)
+
+	merged, err := yaml.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSuffix(merged, []byte("\n"))), nil
+}
+
+// expanderPrioritiesValue returns the priority expander configuration, highest priority first.
+func expanderPrioritiesValue(priorities map[int][]string) string {
+	keys := make([]int, 0, len(priorities))
+	for p := range priorities {
+		keys = append(keys, p)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+
+	var lines []string
+	for _, p := range keys {
+		lines = append(lines, fmt.Sprintf("%d:", p))
+		for _, pattern := range priorities[p] {
+			lines = append(lines, fmt.Sprintf("- '%s'", pattern))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func setNestedValue(values map[string]interface{}, value interface{}, path ...string) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := values[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[key] = next
+		}
+		values = next
+	}
+	values[path[len(path)-1]] = value
+}
@@ -0,0 +1,154 @@
+package curatedpackages_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages"
+	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+func TestSetClusterAutoscalerConfig(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+						MaxCount:         3,
+						ExpanderPriority: ptr.Int(10),
+					},
+				},
+				{
+					Name: "burst",
+					AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+						MaxCount:         5,
+						ExpanderPriority: ptr.Int(50),
+					},
+				},
+			},
+		},
+	}
+	packages := []packagesv1.Package{
+		{Spec: packagesv1.PackageSpec{PackageName: "cluster-autoscaler"}},
+		{Spec: packagesv1.PackageSpec{PackageName: "harbor"}},
+	}
+
+	curatedpackages.SetClusterAutoscalerConfig(packages, cluster)
+
+	g.Expect(packages[0].Spec.Config).To(Equal(`cloudProvider: clusterapi
+autoDiscovery:
+  clusterName: my-cluster
+extraArgs:
+  expander: priority
+expanderPriorities: |-
+  50:
+  - '^MachineDeployment/eksa-system/my-cluster-burst$'
+  10:
+  - '^MachineDeployment/eksa-system/my-cluster-md-0$'`))
+	g.Expect(packages[1].Spec.Config).To(BeEmpty())
+}
+
+func TestSetClusterAutoscalerConfigNoPriorities(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+						MaxCount: 3,
+					},
+				},
+			},
+		},
+	}
+	packages := []packagesv1.Package{
+		{Spec: packagesv1.PackageSpec{PackageName: "cluster-autoscaler"}},
+	}
+
+	curatedpackages.SetClusterAutoscalerConfig(packages, cluster)
+
+	g.Expect(packages[0].Spec.Config).To(BeEmpty())
+}
+
+func clusterWithExpanderPriority() *anywherev1.Cluster {
+	return &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+		Spec: anywherev1.ClusterSpec{
+			WorkerNodeGroupConfigurations: []anywherev1.WorkerNodeGroupConfiguration{
+				{
+					Name: "md-0",
+					AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+						MaxCount:         3,
+						ExpanderPriority: ptr.Int(10),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestUpdateClusterAutoscalerPackages(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	packages := &packagesv1.PackageList{
+		Items: []packagesv1.Package{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-autoscaler"},
+				Spec: packagesv1.PackageSpec{
+					PackageName: "cluster-autoscaler",
+					Config:      "cloudProvider: clusterapi\nextraArgs:\n  scale-down-delay-after-add: 5m\n",
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "harbor"},
+				Spec:       packagesv1.PackageSpec{PackageName: "harbor"},
+			},
+		},
+	}
+	wantPatch := `{"spec":{"config":"autoDiscovery:\n  clusterName: my-cluster\ncloudProvider: clusterapi\n` +
+		`expanderPriorities: |-\n  10:\n  - '^MachineDeployment/eksa-system/my-cluster-md-0$'\n` +
+		`extraArgs:\n  expander: priority\n  scale-down-delay-after-add: 5m"}}`
+
+	gomock.InOrder(
+		kubectl.EXPECT().ExecuteCommand(ctx, "get", "packages", "-o", "json", "--kubeconfig", "kubeconfig", "--namespace", "eksa-packages-my-cluster").
+			Return(convertJsonToBytes(packages), nil),
+		kubectl.EXPECT().ExecuteCommand(ctx, "patch", "packages", "my-autoscaler", "--type", "merge", "-p", wantPatch, "--kubeconfig", "kubeconfig", "--namespace", "eksa-packages-my-cluster"),
+	)
+
+	err := curatedpackages.UpdateClusterAutoscalerPackages(ctx, kubectl, clusterWithExpanderPriority(), "kubeconfig")
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestUpdateClusterAutoscalerPackagesNoPriorities(t *testing.T) {
+	g := NewWithT(t)
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	cluster := clusterWithExpanderPriority()
+	cluster.Spec.WorkerNodeGroupConfigurations[0].AutoScalingConfiguration.ExpanderPriority = nil
+
+	err := curatedpackages.UpdateClusterAutoscalerPackages(context.Background(), kubectl, cluster, "kubeconfig")
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestUpdateClusterAutoscalerPackagesListError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	kubectl := mocks.NewMockKubectlRunner(gomock.NewController(t))
+	kubectl.EXPECT().ExecuteCommand(ctx, gomock.Any()).Return(convertJsonToBytes(nil), errors.New("connection refused"))
+
+	err := curatedpackages.UpdateClusterAutoscalerPackages(ctx, kubectl, clusterWithExpanderPriority(), "kubeconfig")
+	g.Expect(err).To(MatchError(ContainSubstring("listing packages for cluster my-cluster: connection refused")))
+}
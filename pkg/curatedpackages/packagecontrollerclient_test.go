@@ -103,7 +103,7 @@ func newPackageControllerTests(t *testing.T) []*packageControllerTest {
 		CACertContent:      "-----BEGIN CERTIFICATE-----\nabc\nefg\n-----END CERTIFICATE-----\n",
 		InsecureSkipVerify: true,
 	}
-	writer, _ := filewriter.NewWriter(clusterName)
+	writer, _ := filewriter.NewWriter(filepath.Join(t.TempDir(), clusterName))
 	clusterSpec := &cluster.Spec{
 		Config: &cluster.Config{
 			Cluster: &v1alpha1.Cluster{
@@ -302,7 +302,7 @@ func newPackageControllerTests(t *testing.T) []*packageControllerTest {
 func TestEnableSuccess(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -345,7 +345,7 @@ func TestEnableSucceedInWorkloadCluster(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -388,7 +388,7 @@ func TestEnableSucceedInWorkloadClusterWhenPackageBundleControllerNotExist(t *te
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -464,7 +464,7 @@ func TestEnableWithProxy(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		httpProxy := fmt.Sprintf("proxy.HTTP_PROXY=%s", tt.httpProxy)
 		httpsProxy := fmt.Sprintf("proxy.HTTPS_PROXY=%s", tt.httpsProxy)
 		noProxy := fmt.Sprintf("proxy.NO_PROXY=%s", strings.Join(tt.noProxy, "\\,"))
@@ -518,7 +518,7 @@ func TestEnableWithEmptyProxy(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -567,7 +567,7 @@ func TestEnableWithSkipWait(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -606,7 +606,7 @@ func TestEnableWithSkipWait(t *testing.T) {
 func TestEnableFail(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -636,7 +636,7 @@ func TestEnableFail(t *testing.T) {
 func TestEnableFailNoActiveBundle(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -666,7 +666,7 @@ func TestEnableFailNoActiveBundle(t *testing.T) {
 func TestEnableSuccessWhenCronJobFails(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -734,7 +734,7 @@ func TestEnableActiveBundleCustomTimeout(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -773,7 +773,7 @@ func TestEnableActiveBundleCustomTimeout(t *testing.T) {
 func TestEnableActiveBundleWaitLoops(t *testing.T) {
 	for _, tt := range newPackageControllerTests(t) {
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -833,7 +833,7 @@ func TestEnableActiveBundleTimesOut(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -879,7 +879,7 @@ func TestEnableActiveBundleNamespaceTimesOut(t *testing.T) {
 			curatedpackages.WithValuesFileWriter(tt.writer),
 		)
 		clusterName := fmt.Sprintf("clusterName=%s", "billy")
-		valueFilePath := filepath.Join("billy", filewriter.DefaultTmpFolder, valueFileName)
+		valueFilePath := filepath.Join(tt.writer.TempDir(), valueFileName)
 		ociURI := fmt.Sprintf("%s%s", "oci://", tt.registryMirror.ReplaceRegistry(tt.chart.Image()))
 		sourceRegistry, defaultRegistry, defaultImageRegistry := tt.command.GetCuratedPackagesRegistries(context.Background())
 		sourceRegistry = fmt.Sprintf("sourceRegistry=%s", sourceRegistry)
@@ -931,7 +931,7 @@ func TestCreateHelmOverrideValuesYaml(t *testing.T) {
 		}
 		filePath, content, err := tt.command.CreateHelmOverrideValuesYaml()
 		tt.Expect(err).To(BeNil())
-		tt.Expect(filePath).To(Equal(filepath.Join(tt.clusterName, filewriter.DefaultTmpFolder, "values.yaml")))
+		tt.Expect(filePath).To(Equal(filepath.Join(tt.writer.TempDir(), "values.yaml")))
 		test.AssertContentToFile(t, string(content), tt.wantValueFile)
 	}
 }
@@ -946,7 +946,7 @@ func TestCreateHelmOverrideValuesYamlFail(t *testing.T) {
 			tt.Expect(filePath).To(Equal(""))
 		} else {
 			tt.Expect(err).To(BeNil())
-			tt.Expect(filePath).To(Equal(filepath.Join(tt.clusterName, filewriter.DefaultTmpFolder, "values.yaml")))
+			tt.Expect(filePath).To(Equal(filepath.Join(tt.writer.TempDir(), "values.yaml")))
 			test.AssertContentToFile(t, string(content), tt.wantValueFile)
 		}
 	}
@@ -1194,6 +1194,8 @@ func TestReconcileDeleteHelmErrorsHandling(t *testing.T) {
 }
 
 func TestEnableFullLifecyclePath(t *testing.T) {
+	// EnableFullLifecycle writes the helm values to a folder named after the cluster.
+	test.ChdirTemp(t)
 	log := testr.New(t)
 	ctrl := gomock.NewController(t)
 	k := mocks.NewMockKubectlRunner(ctrl)
@@ -1275,7 +1277,7 @@ func TestGetCuratedPackagesRegistries(s *testing.T) {
 		}
 		// eksaRegion := "test-region"
 		clusterName := "billy"
-		writer, _ := filewriter.NewWriter(clusterName)
+		writer, _ := filewriter.NewWriter(filepath.Join(t.TempDir(), clusterName))
 		client := curatedpackages.NewPackageControllerClient(
 			cm, k, clusterName, kubeConfig, chart, nil,
 			curatedpackages.WithManagementClusterName(clusterName),
@@ -1302,7 +1304,7 @@ func TestGetCuratedPackagesRegistries(s *testing.T) {
 		}
 		// eksaRegion := "test-region"
 		clusterName := "billy"
-		writer, _ := filewriter.NewWriter(clusterName)
+		writer, _ := filewriter.NewWriter(filepath.Join(t.TempDir(), clusterName))
 		client := curatedpackages.NewPackageControllerClient(
 			cm, k, clusterName, kubeConfig, chart, nil,
 			curatedpackages.WithManagementClusterName(clusterName),
@@ -1327,7 +1329,7 @@ func TestGetCuratedPackagesRegistries(s *testing.T) {
 			URI:  "test_registry/eks-anywhere/eks-anywhere-packages:v1",
 		}
 		clusterName := "billy"
-		writer, _ := filewriter.NewWriter(clusterName)
+		writer, _ := filewriter.NewWriter(filepath.Join(t.TempDir(), clusterName))
 		client := curatedpackages.NewPackageControllerClient(
 			cm, k, clusterName, kubeConfig, chart, nil,
 			curatedpackages.WithManagementClusterName(clusterName),
@@ -1356,7 +1358,7 @@ func TestGetCuratedPackagesRegistries(s *testing.T) {
 			URI:  "test_registry/w9m0f3l5/eks-anywhere-packages:v1",
 		}
 		clusterName := "billy"
-		writer, _ := filewriter.NewWriter(clusterName)
+		writer, _ := filewriter.NewWriter(filepath.Join(t.TempDir(), clusterName))
 		client := curatedpackages.NewPackageControllerClient(
 			cm, k, clusterName, kubeConfig, chart, nil,
 			curatedpackages.WithManagementClusterName(clusterName),
@@ -1377,6 +1379,9 @@ func TestGetCuratedPackagesRegistries(s *testing.T) {
 }
 
 func TestReconcile(s *testing.T) {
+	// Reconcile writes the helm values to a folder named after the cluster.
+	test.ChdirTemp(s)
+
 	s.Run("golden path", func(t *testing.T) {
 		ctx := context.Background()
 		log := testr.New(t)
@@ -64,6 +64,11 @@ func (pi *Installer) InstallCuratedPackages(ctx context.Context) {
 	err = pi.installPackages(ctx)
 	if err != nil {
 		logger.MarkWarning("  Failed installing curated packages on the cluster; please install through eksctl anywhere create packages command after the cluster creation succeeds", "error", err)
+		return
+	}
+
+	if err = UpdateClusterAutoscalerPackages(ctx, pi.kubectl, pi.spec.Cluster, pi.mgmtKubeconfig); err != nil {
+		logger.MarkWarning("  Failed configuring the cluster autoscaler expander priorities", "error", err)
 	}
 }
 
@@ -81,6 +86,11 @@ func (pi *Installer) UpgradeCuratedPackages(ctx context.Context) {
 
 	if err := pi.installPackages(ctx); err != nil {
 		logger.MarkWarning("Failed upgrading curated packages on the cluster.", "error", err)
+		return
+	}
+
+	if err := UpdateClusterAutoscalerPackages(ctx, pi.kubectl, pi.spec.Cluster, pi.mgmtKubeconfig); err != nil {
+		logger.MarkWarning("Failed configuring the cluster autoscaler expander priorities.", "error", err)
 	}
 }
 
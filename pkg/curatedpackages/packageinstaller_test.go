@@ -9,11 +9,13 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	packagesv1 "github.com/aws/eks-anywhere-packages/api/v1alpha1"
 	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages"
 	"github.com/aws/eks-anywhere/pkg/curatedpackages/mocks"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 type packageInstallerTest struct {
@@ -83,6 +85,26 @@ func TestPackageInstallerFailWhenPackageFails(t *testing.T) {
 	tt.command.InstallCuratedPackages(tt.ctx)
 }
 
+func TestPackageInstallerConfiguresClusterAutoscaler(t *testing.T) {
+	tt := newPackageInstallerTest(t)
+	tt.spec.Cluster.Spec.WorkerNodeGroupConfigurations = []anywherev1.WorkerNodeGroupConfiguration{
+		{
+			Name: "md-0",
+			AutoScalingConfiguration: &anywherev1.AutoScalingConfiguration{
+				MaxCount:         3,
+				ExpanderPriority: ptr.Int(10),
+			},
+		},
+	}
+
+	tt.packageControllerClient.EXPECT().Enable(tt.ctx).Return(nil)
+	tt.packageClient.EXPECT().CreatePackages(tt.ctx, tt.packagePath, tt.kubeConfigPath).Return(nil)
+	tt.kubectlRunner.EXPECT().ExecuteCommand(tt.ctx, "get", "packages", "-o", "json", "--kubeconfig", tt.kubeConfigPath, "--namespace", "eksa-packages-test-cluster").
+		Return(convertJsonToBytes(&packagesv1.PackageList{}), nil)
+
+	tt.command.UpgradeCuratedPackages(tt.ctx)
+}
+
 func TestPackageInstallerDisabled(t *testing.T) {
 	tt := newPackageInstallerTest(t)
 	tt.spec.Cluster.Spec.Packages = &anywherev1.PackageConfiguration{
@@ -30,6 +30,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/files"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	gitfactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
 	"github.com/aws/eks-anywhere/pkg/gitops/flux"
 	"github.com/aws/eks-anywhere/pkg/govmomi"
 	"github.com/aws/eks-anywhere/pkg/helm"
@@ -37,7 +38,10 @@ import (
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/manifests"
 	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/networking"
 	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+	ciliumreconciler "github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
+	"github.com/aws/eks-anywhere/pkg/networking/custom"
 	"github.com/aws/eks-anywhere/pkg/networkutils"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack"
@@ -76,11 +80,14 @@ type Dependencies struct {
 	UnAuthKubeClient            *kubernetes.UnAuthClient
 	CNIInstaller                workload.CNIInstaller
 	CiliumTemplater             *cilium.Templater
+	NetworkingProviders         *networking.Registry
 	AwsIamAuth                  *awsiamauth.Installer
 	ClusterManager              *clustermanager.ClusterManager
 	KubernetesRetrierClient     *clustermanager.KubernetesRetrierClient
 	Bootstrapper                *bootstrapper.Bootstrapper
 	GitOpsFlux                  *flux.Flux
+	GitOpsArgoCD                *argocd.ArgoCD
+	GitOps                      interfaces.GitOpsManager
 	Git                         *gitfactory.GitTools
 	EksdInstaller               *eksd.Installer
 	EksdUpgrader                *eksd.Upgrader
@@ -116,6 +123,7 @@ type Dependencies struct {
 	DeleteClusterDefaulter      cli.DeleteClusterDefaulter
 	ClusterDeleter              clustermanager.Deleter
 	ClusterMover                *clustermanager.Mover
+	SSH                         *executables.SSH
 }
 
 // KubeClients defines super struct that exposes all behavior.
@@ -165,11 +173,13 @@ type executablesConfig struct {
 	useDockerContainer bool
 	dockerClient       executables.DockerClient
 	mountDirs          []string
+	containerRuntime   executables.ContainerRuntime
 }
 
 type config struct {
-	bundlesOverride string
-	noTimeouts      bool
+	bundlesOverride             string
+	noTimeouts                  bool
+	existingBootstrapKubeconfig string
 }
 
 type buildStep func(ctx context.Context) error
@@ -324,13 +334,34 @@ func dockerLogin(ctx context.Context, registry string, docker executables.Docker
 	return nil
 }
 
+// registryMirrorLogin performs a docker login into the registry mirror and into each of its fallback
+// endpoints that require authentication, reading their credentials from the ENV VARS.
+func registryMirrorLogin(ctx context.Context, registryMirror *registrymirror.RegistryMirror, docker executables.DockerClient) error {
+	if err := dockerLogin(ctx, registryMirror.BaseRegistry, docker); err != nil {
+		return err
+	}
+	for i, fallback := range registryMirror.Fallbacks {
+		if !fallback.Auth {
+			continue
+		}
+		username, password, err := cliconfig.ReadFallbackCredentials(i)
+		if err != nil {
+			return err
+		}
+		if err := docker.Login(ctx, fallback.BaseRegistry, username, password); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // WithDockerLogin adds a docker login to the build steps.
 func (f *Factory) WithDockerLogin() *Factory {
 	f.WithDocker()
 
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.registryMirror != nil {
-			err := dockerLogin(ctx, f.registryMirror.BaseRegistry, f.executablesConfig.dockerClient)
+			err := registryMirrorLogin(ctx, f.registryMirror, f.executablesConfig.dockerClient)
 			if err != nil {
 				return err
 			}
@@ -345,7 +376,7 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 	// because we bind mount the cluster directory into the tools container. If the directory
 	// doesn't exist, dockerd (running as root) creates the hostpath for the bind mount with root
 	// ownership. This prevents further files from being written to the cluster directory.
-	f.WithWriter()
+	f.WithWriter().WithContainerRuntime()
 
 	if f.executablesConfig.useDockerContainer {
 		f.WithExecutableImage().WithDocker()
@@ -364,7 +395,8 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 			if f.registryMirror != nil {
 				image = f.registryMirror.ReplaceRegistry(image)
 			}
-			b, err := executables.NewInDockerExecutablesBuilder(
+			b, err := executables.NewInContainerExecutablesBuilder(
+				f.executablesConfig.containerRuntime,
 				f.executablesConfig.dockerClient,
 				image,
 				f.executablesConfig.mountDirs...,
@@ -374,7 +406,7 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 			}
 			f.executablesConfig.builder = b
 		} else {
-			f.executablesConfig.builder = executables.NewLocalExecutablesBuilder()
+			f.executablesConfig.builder = executables.NewLocalExecutablesBuilderForRuntime(f.executablesConfig.containerRuntime)
 		}
 
 		f.dependencies.ExecutableBuilder = f.executablesConfig.builder
@@ -385,7 +417,7 @@ func (f *Factory) WithExecutableBuilder() *Factory {
 		}
 		if f.registryMirror != nil && f.registryMirror.Auth {
 			docker := f.executablesConfig.builder.BuildDockerExecutable()
-			err := dockerLogin(ctx, f.registryMirror.BaseRegistry, docker)
+			err := registryMirrorLogin(ctx, f.registryMirror, docker)
 			if err != nil {
 				return err
 			}
@@ -629,6 +661,26 @@ func (f *Factory) WithClusterCreator(clusterConfig *v1alpha1.Cluster) *Factory {
 	return f
 }
 
+// WithContainerRuntime adds a build step to detect the container runtime selected with
+// EKSA_CONTAINER_RUNTIME, used to run the tools container and the bootstrap cluster.
+func (f *Factory) WithContainerRuntime() *Factory {
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.executablesConfig.containerRuntime != nil {
+			return nil
+		}
+
+		runtime, err := executables.ContainerRuntimeFromEnv(ctx)
+		if err != nil {
+			return fmt.Errorf("detecting container runtime: %v", err)
+		}
+		f.executablesConfig.containerRuntime = runtime
+
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) WithDocker() *Factory {
 	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
 		if f.dependencies.DockerClient != nil {
@@ -661,6 +713,22 @@ func (f *Factory) WithKubectl() *Factory {
 	return f
 }
 
+// WithSSH builds an ssh executable.
+func (f *Factory) WithSSH() *Factory {
+	f.WithExecutableBuilder()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.SSH != nil {
+			return nil
+		}
+
+		f.dependencies.SSH = f.executablesConfig.builder.BuildSSHExecutable()
+		return nil
+	})
+
+	return f
+}
+
 func (f *Factory) WithGovc() *Factory {
 	f.WithExecutableBuilder().WithWriter()
 
@@ -881,6 +949,25 @@ func (f *Factory) WithCiliumTemplater() *Factory {
 	return f
 }
 
+// WithNetworkingProviders builds the registry of CNI providers supported by EKS Anywhere.
+func (f *Factory) WithNetworkingProviders() *Factory {
+	f.WithCiliumTemplater()
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.NetworkingProviders != nil {
+			return nil
+		}
+		f.dependencies.NetworkingProviders = networking.NewRegistry(
+			ciliumreconciler.NewProvider(ciliumreconciler.New(f.dependencies.CiliumTemplater, nil)),
+			custom.NewProvider(),
+		)
+
+		return nil
+	})
+
+	return f
+}
+
 // WithAwsIamAuth builds dependencies for AWS IAM Auth.
 func (f *Factory) WithAwsIamAuth(clusterConfig *v1alpha1.Cluster) *Factory {
 	f.WithKubectl().WithWriter().WithKubeconfigWriter(clusterConfig)
@@ -939,12 +1026,20 @@ func (f *Factory) WithBootstrapper() *Factory {
 			)
 		}
 
+		var bootstrapperOpts []bootstrapper.Opt
+		if f.config.existingBootstrapKubeconfig != "" {
+			bootstrapperOpts = append(bootstrapperOpts,
+				bootstrapper.WithExistingCluster(f.config.existingBootstrapKubeconfig),
+			)
+		}
+
 		f.dependencies.Bootstrapper = bootstrapper.New(
 			bootstrapper.NewRetrierClient(
 				f.dependencies.Kind,
 				f.dependencies.Kubectl,
 				opts...,
 			),
+			bootstrapperOpts...,
 		)
 		return nil
 	})
@@ -1074,6 +1169,13 @@ func (f *Factory) WithNoTimeouts() *Factory {
 	return f
 }
 
+// WithExistingBootstrapCluster configures the bootstrapper to use the cluster accessible with
+// kubeconfig as bootstrap cluster instead of creating a kind cluster.
+func (f *Factory) WithExistingBootstrapCluster(kubeconfig string) *Factory {
+	f.config.existingBootstrapKubeconfig = kubeconfig
+	return f
+}
+
 // WithCliConfig builds a cli config.
 func (f *Factory) WithCliConfig(cliConfig *cliconfig.CliConfig) *Factory {
 	f.dependencies.CliConfig = cliConfig
@@ -1284,13 +1386,6 @@ func (f *Factory) WithGit(clusterConfig *v1alpha1.Cluster, fluxConfig *v1alpha1.
 			return fmt.Errorf("creating Git provider: %v", err)
 		}
 
-		if fluxConfig.Spec.Git != nil {
-			err = tools.Client.ValidateRemoteExists(ctx)
-			if err != nil {
-				return err
-			}
-		}
-
 		if tools.Provider != nil {
 			err = tools.Provider.Validate(ctx)
 			if err != nil {
@@ -1313,6 +1408,10 @@ func (f *Factory) WithGitOpsFlux(clusterConfig *v1alpha1.Cluster, fluxConfig *v1
 			return nil
 		}
 
+		if cliConfig != nil && f.dependencies.Git != nil && f.dependencies.Git.KnownHostsFile != "" {
+			cliConfig.GitKnownHostsFile = f.dependencies.Git.KnownHostsFile
+		}
+
 		f.dependencies.GitOpsFlux = flux.NewFlux(f.dependencies.Flux, f.dependencies.Kubectl, f.dependencies.Git, cliConfig)
 
 		return nil
@@ -1321,6 +1420,53 @@ func (f *Factory) WithGitOpsFlux(clusterConfig *v1alpha1.Cluster, fluxConfig *v1
 	return f
 }
 
+// WithGitOpsArgoCD builds a gitops argo cd.
+func (f *Factory) WithGitOpsArgoCD(clusterConfig *v1alpha1.Cluster, argoCDConfig *v1alpha1.ArgoCDConfig, cliConfig *cliconfig.CliConfig) *Factory {
+	f.WithWriter().WithKubectl().WithFileReader().WithGit(clusterConfig, argoCDConfig.ConvertToFluxConfig())
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.GitOpsArgoCD != nil {
+			return nil
+		}
+
+		if cliConfig != nil && f.dependencies.Git != nil && f.dependencies.Git.KnownHostsFile != "" {
+			cliConfig.GitKnownHostsFile = f.dependencies.Git.KnownHostsFile
+		}
+
+		f.dependencies.GitOpsArgoCD = argocd.NewArgoCD(f.dependencies.Kubectl, f.dependencies.Git, f.dependencies.FileReader, cliConfig)
+
+		return nil
+	})
+
+	return f
+}
+
+// WithGitOps builds the gitops manager for the engine configured in the cluster spec,
+// Argo CD when an ArgoCDConfig is present and Flux otherwise.
+func (f *Factory) WithGitOps(clusterSpec *cluster.Spec, cliConfig *cliconfig.CliConfig) *Factory {
+	if clusterSpec.ArgoCDConfig != nil {
+		f.WithGitOpsArgoCD(clusterSpec.Cluster, clusterSpec.ArgoCDConfig, cliConfig)
+	} else {
+		f.WithGitOpsFlux(clusterSpec.Cluster, clusterSpec.FluxConfig, cliConfig)
+	}
+
+	f.buildSteps = append(f.buildSteps, func(ctx context.Context) error {
+		if f.dependencies.GitOps != nil {
+			return nil
+		}
+
+		if f.dependencies.GitOpsArgoCD != nil {
+			f.dependencies.GitOps = f.dependencies.GitOpsArgoCD
+		} else {
+			f.dependencies.GitOps = f.dependencies.GitOpsFlux
+		}
+
+		return nil
+	})
+
+	return f
+}
+
 // WithPackageManager builds a package manager.
 func (f *Factory) WithPackageManager(spec *cluster.Spec, packagesLocation, kubeConfig string) *Factory {
 	f.WithKubectl().WithPackageControllerClient(spec, kubeConfig).WithPackageClient()
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/constants"
 	"github.com/aws/eks-anywhere/pkg/dependencies"
+	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/helm"
 	"github.com/aws/eks-anywhere/pkg/providers/cloudstack/decoder"
 	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
@@ -323,6 +324,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 		WithVSphereValidator().
 		WithHelmExecutableBuilder().
 		WithCiliumTemplater().
+		WithNetworkingProviders().
 		WithIPValidator().
 		WithClusterApplier().
 		WithValidatorClients().
@@ -350,6 +352,7 @@ func TestFactoryBuildWithMultipleDependencies(t *testing.T) {
 	tt.Expect(deps.VSphereValidator).NotTo(BeNil())
 	tt.Expect(deps.ExecutableBuilder).NotTo(BeNil())
 	tt.Expect(deps.CiliumTemplater).NotTo(BeNil())
+	tt.Expect(deps.NetworkingProviders).NotTo(BeNil())
 	tt.Expect(deps.IPValidator).NotTo(BeNil())
 	tt.Expect(deps.ClusterApplier).NotTo(BeNil())
 	tt.Expect(deps.UnAuthKubectlClient).NotTo(BeNil())
@@ -487,6 +490,41 @@ func TestFactoryBuildWithCuratedPackagesCustomRegistry(t *testing.T) {
 	tt.Expect(deps.BundleRegistry).NotTo(BeNil())
 }
 
+func TestFactoryBuildWithSSH(t *testing.T) {
+	tt := newTest(t, vsphere)
+	deps, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithSSH().
+		Build(context.Background())
+
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deps.SSH).NotTo(BeNil())
+}
+
+func TestFactoryBuildWithGitOpsFlux(t *testing.T) {
+	tt := newTest(t, vsphere)
+	deps, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithGitOps(tt.clusterSpec, nil).
+		Build(context.Background())
+
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deps.GitOpsFlux).NotTo(BeNil())
+	tt.Expect(deps.GitOpsArgoCD).To(BeNil())
+	tt.Expect(deps.GitOps).To(Equal(deps.GitOpsFlux))
+}
+
+func TestFactoryBuildWithGitOpsArgoCDNoProvider(t *testing.T) {
+	tt := newTest(t, vsphere)
+	tt.clusterSpec.ArgoCDConfig = &anywherev1.ArgoCDConfig{}
+	_, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithGitOps(tt.clusterSpec, nil).
+		Build(context.Background())
+
+	tt.Expect(err).To(MatchError(ContainSubstring("creating Git provider")))
+}
+
 func TestFactoryBuildWithPackageClient(t *testing.T) {
 	tt := newTest(t, vsphere)
 	deps, err := dependencies.NewFactory().
@@ -676,6 +714,49 @@ func TestFactoryBuildWithExecutablesUsingDocker(t *testing.T) {
 	tt.Expect(deps.Helm).NotTo(BeNil())
 }
 
+func TestFactoryBuildWithDockerLoginRegistryMirrorFallbacks(t *testing.T) {
+	tt := newTest(t, vsphere)
+	t.Setenv(constants.RegistryUsername, "username")
+	t.Setenv(constants.RegistryPassword, "password")
+	t.Setenv(constants.RegistryUsername+"_2", "username-2")
+	t.Setenv(constants.RegistryPassword+"_2", "password-2")
+	client := &loginRecorderDockerClient{}
+
+	_, err := dependencies.NewFactory().
+		UseExecutablesDockerClient(client).
+		WithRegistryMirror(&registrymirror.RegistryMirror{
+			BaseRegistry: "mirror:443",
+			Auth:         true,
+			Fallbacks: []registrymirror.Endpoint{
+				{BaseRegistry: "fallback-1:443"},
+				{BaseRegistry: "fallback-2:443", Auth: true},
+			},
+		}).
+		WithDockerLogin().
+		Build(context.Background())
+
+	tt.Expect(err).To(BeNil())
+	tt.Expect(client.logins).To(Equal([]string{"mirror:443 username", "fallback-2:443 username-2"}))
+}
+
+func TestFactoryBuildWithDockerLoginRegistryMirrorFallbackMissingCredentials(t *testing.T) {
+	tt := newTest(t, vsphere)
+	client := &loginRecorderDockerClient{}
+
+	_, err := dependencies.NewFactory().
+		UseExecutablesDockerClient(client).
+		WithRegistryMirror(&registrymirror.RegistryMirror{
+			BaseRegistry: "mirror:443",
+			Fallbacks: []registrymirror.Endpoint{
+				{BaseRegistry: "fallback:443", Auth: true},
+			},
+		}).
+		WithDockerLogin().
+		Build(context.Background())
+
+	tt.Expect(err).To(MatchError(ContainSubstring("please set REGISTRY_USERNAME_1 env var")))
+}
+
 func TestFactoryBuildWithClusterApplierNoTimeout(t *testing.T) {
 	tt := newTest(t, vsphere)
 	deps, err := dependencies.NewFactory().
@@ -736,6 +817,30 @@ func TestFactoryBuildWithBootstrapperNoTimeout(t *testing.T) {
 	tt.Expect(deps.Bootstrapper).NotTo(BeNil())
 }
 
+func TestFactoryBuildWithExistingBootstrapCluster(t *testing.T) {
+	tt := newTest(t, vsphere)
+	deps, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithExistingBootstrapCluster("bootstrap.kubeconfig").
+		WithBootstrapper().
+		Build(context.Background())
+
+	tt.Expect(err).To(BeNil())
+	tt.Expect(deps.Bootstrapper).NotTo(BeNil())
+	tt.Expect(deps.Bootstrapper.UsesExistingCluster()).To(BeTrue())
+}
+
+func TestFactoryBuildWithUnsupportedContainerRuntime(t *testing.T) {
+	tt := newTest(t, vsphere)
+	t.Setenv(executables.ContainerRuntimeEnv, "crio")
+	_, err := dependencies.NewFactory().
+		WithLocalExecutables().
+		WithKind().
+		Build(context.Background())
+
+	tt.Expect(err).To(MatchError(ContainSubstring("detecting container runtime: unsupported container runtime crio")))
+}
+
 func TestFactoryBuildWithEksdUpgraderNoTimeout(t *testing.T) {
 	tt := newTest(t, vsphere)
 	deps, err := dependencies.NewFactory().
@@ -773,3 +878,13 @@ func (b dummyDockerClient) Execute(ctx context.Context, args ...string) (stdout
 func (b dummyDockerClient) Login(ctx context.Context, endpoint, username, password string) error {
 	return nil
 }
+
+type loginRecorderDockerClient struct {
+	dummyDockerClient
+	logins []string
+}
+
+func (b *loginRecorderDockerClient) Login(ctx context.Context, endpoint, username, password string) error {
+	b.logins = append(b.logins, endpoint+" "+username)
+	return nil
+}
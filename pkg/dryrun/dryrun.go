@@ -0,0 +1,268 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clustermarshaller"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
+	"github.com/aws/eks-anywhere/pkg/gitops/flux"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	// OutputDir is the folder, inside the cluster folder, where the dry run manifests are written.
+	OutputDir = "dry-run"
+
+	clusterConfigFileName = "eksa-cluster.yaml"
+	controlPlaneFileName  = "capi-control-plane.yaml"
+	workersFileName       = "capi-workers.yaml"
+	ciliumFileName        = "cilium.yaml"
+	diffFileName          = "upgrade.diff"
+	gitOpsDirName         = "gitops"
+	eksaSystemDirName     = "eksa-system"
+)
+
+// CNITemplater generates the CNI manifest for a cluster.
+type CNITemplater interface {
+	GenerateManifest(ctx context.Context, spec *cluster.Spec, opts ...cilium.ManifestOpt) ([]byte, error)
+}
+
+// Differ computes the differences between manifests and the live objects in a cluster.
+type Differ interface {
+	DiffFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) (string, error)
+}
+
+// Renderer renders all the manifests a cluster create or upgrade would apply, without
+// creating or modifying any infrastructure.
+type Renderer struct {
+	provider providers.Provider
+	cni      CNITemplater
+	writer   filewriter.FileWriter
+	differ   Differ
+}
+
+// NewRenderer builds a Renderer. differ is only used for upgrades.
+func NewRenderer(provider providers.Provider, cni CNITemplater, writer filewriter.FileWriter, differ Differ) *Renderer {
+	return &Renderer{
+		provider: provider,
+		cni:      cni,
+		writer:   writer,
+		differ:   differ,
+	}
+}
+
+// Result contains the outcome of a dry run.
+type Result struct {
+	// Dir is the folder where the manifests have been written.
+	Dir string
+	// Files are the paths of the written manifests.
+	Files []string
+	// Diff are the differences between the manifests and the live objects. Only set for upgrades.
+	Diff string
+}
+
+type manifest struct {
+	fileName string
+	content  []byte
+	// cluster is where the objects in the manifest are applied, used to diff them against the live ones.
+	cluster *types.Cluster
+}
+
+// RenderCreate renders the manifests for the creation of a new cluster.
+func (r *Renderer) RenderCreate(ctx context.Context, managementCluster *types.Cluster, spec *cluster.Spec) (*Result, error) {
+	controlPlaneSpec, workersSpec, err := r.provider.GenerateCAPISpecForCreate(ctx, managementCluster, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := r.manifests(ctx, spec, controlPlaneSpec, workersSpec, managementCluster, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.write(spec, manifests)
+}
+
+// RenderUpgrade renders the manifests for the upgrade of an existing cluster and diffs them
+// against the live objects.
+func (r *Renderer) RenderUpgrade(ctx context.Context, managementCluster, workloadCluster *types.Cluster, currentSpec, newSpec *cluster.Spec) (*Result, error) {
+	controlPlaneSpec, workersSpec, err := r.provider.GenerateCAPISpecForUpgrade(ctx, managementCluster, workloadCluster, currentSpec, newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := r.manifests(ctx, newSpec, controlPlaneSpec, workersSpec, managementCluster, workloadCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.write(newSpec, manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range manifests {
+		if m.cluster == nil {
+			continue
+		}
+
+		logger.V(4).Info("Diffing manifest against live objects", "manifest", m.fileName)
+		diff, err := r.differ.DiffFromBytes(ctx, m.cluster, m.content)
+		if err != nil {
+			return nil, fmt.Errorf("diffing %s: %v", m.fileName, err)
+		}
+		result.Diff += diff
+	}
+
+	if result.Diff != "" {
+		w, err := r.writer.WithDir(OutputDir)
+		if err != nil {
+			return nil, fmt.Errorf("creating dry run output directory: %v", err)
+		}
+		diffPath, err := w.Write(diffFileName, []byte(result.Diff), filewriter.PersistentFile)
+		if err != nil {
+			return nil, fmt.Errorf("writing dry run diff: %v", err)
+		}
+		result.Files = append(result.Files, diffPath)
+	}
+
+	return result, nil
+}
+
+// manifests builds the manifests to write. workloadCluster is nil for creates, since the cluster
+// doesn't exist yet and there is nothing to diff against.
+func (r *Renderer) manifests(ctx context.Context, spec *cluster.Spec, controlPlaneSpec, workersSpec []byte, managementCluster, workloadCluster *types.Cluster) ([]manifest, error) {
+	clusterConfig, err := clustermarshaller.MarshalClusterSpec(spec, r.provider.DatacenterConfig(spec), r.provider.MachineConfigs(spec))
+	if err != nil {
+		return nil, err
+	}
+
+	diffCluster := managementCluster
+	if workloadCluster == nil {
+		diffCluster = nil
+	}
+
+	manifests := []manifest{
+		{fileName: clusterConfigFileName, content: clusterConfig, cluster: diffCluster},
+		{fileName: controlPlaneFileName, content: controlPlaneSpec, cluster: diffCluster},
+		{fileName: workersFileName, content: workersSpec, cluster: diffCluster},
+	}
+
+	if spec.Cluster.Spec.ClusterNetwork.CNIConfig != nil && spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil {
+		ciliumManifest, err := r.cni.GenerateManifest(ctx, spec, cilium.WithPolicyAllowedNamespaces(r.providerNamespaces()))
+		if err != nil {
+			return nil, fmt.Errorf("generating cilium manifest: %v", err)
+		}
+		manifests = append(manifests, manifest{fileName: ciliumFileName, content: ciliumManifest, cluster: workloadCluster})
+	}
+
+	return manifests, nil
+}
+
+func (r *Renderer) providerNamespaces() []string {
+	namespaces := make([]string, 0, len(r.provider.GetDeployments()))
+	for namespace := range r.provider.GetDeployments() {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+func (r *Renderer) write(spec *cluster.Spec, manifests []manifest) (*Result, error) {
+	w, err := r.writer.WithDir(OutputDir)
+	if err != nil {
+		return nil, fmt.Errorf("creating dry run output directory: %v", err)
+	}
+
+	result := &Result{Dir: w.Dir()}
+	for _, m := range manifests {
+		filePath, err := w.Write(m.fileName, m.content, filewriter.PersistentFile)
+		if err != nil {
+			return nil, fmt.Errorf("writing %s: %v", m.fileName, err)
+		}
+		result.Files = append(result.Files, filePath)
+	}
+
+	if spec.FluxConfig != nil {
+		if err := r.writeFluxFiles(w, spec); err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, path.Join(w.Dir(), gitOpsDirName))
+	}
+
+	if spec.ArgoCDConfig != nil {
+		if err := r.writeArgoCDFiles(w, spec); err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, path.Join(w.Dir(), gitOpsDirName))
+	}
+
+	return result, nil
+}
+
+// writeFluxFiles writes the files that would be committed to the Flux git repository.
+func (r *Renderer) writeFluxFiles(w filewriter.FileWriter, spec *cluster.Spec) error {
+	eksaSystemDir := path.Join(gitOpsDirName, spec.FluxConfig.Spec.ClusterConfigPath, spec.Cluster.Name, eksaSystemDirName)
+	fluxSystemDir := path.Join(gitOpsDirName, spec.FluxConfig.Spec.ClusterConfigPath, spec.FluxConfig.Spec.SystemNamespace)
+
+	g := flux.NewFileGenerator()
+	if err := g.Init(w, eksaSystemDir, fluxSystemDir); err != nil {
+		return err
+	}
+
+	if err := g.WriteEksaFiles(spec, r.provider.DatacenterConfig(spec), r.provider.MachineConfigs(spec)); err != nil {
+		return fmt.Errorf("writing eks-a gitops files: %v", err)
+	}
+
+	// Flux system files are only generated for management clusters.
+	if spec.Cluster.IsSelfManaged() {
+		if err := g.WriteFluxSystemFiles(cluster.ManagementComponentsFromBundles(spec.Bundles), spec); err != nil {
+			return fmt.Errorf("writing flux system gitops files: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// writeArgoCDFiles writes the files that would be committed to the Argo CD git repository.
+// The Argo CD install manifest and the git known hosts are not rendered: they are downloaded
+// from the bundle and read from the git server when the files are committed.
+func (r *Renderer) writeArgoCDFiles(w filewriter.FileWriter, spec *cluster.Spec) error {
+	eksaSystemDir := path.Join(gitOpsDirName, spec.ArgoCDConfig.Spec.ClusterConfigPath, spec.Cluster.Name, eksaSystemDirName)
+	argoCDSystemDir := path.Join(gitOpsDirName, spec.ArgoCDConfig.Spec.ClusterConfigPath, spec.ArgoCDConfig.Spec.SystemNamespace)
+
+	g := argocd.NewFileGenerator()
+	if err := g.Init(w, eksaSystemDir, argoCDSystemDir); err != nil {
+		return err
+	}
+
+	if err := g.WriteEksaFiles(spec, r.provider.DatacenterConfig(spec), r.provider.MachineConfigs(spec)); err != nil {
+		return fmt.Errorf("writing eks-a gitops files: %v", err)
+	}
+
+	// Argo CD system files are only generated for management clusters.
+	if !spec.Cluster.IsSelfManaged() {
+		return nil
+	}
+
+	if err := g.WriteArgoCDNamespace(spec); err != nil {
+		return fmt.Errorf("writing argo cd system gitops files: %v", err)
+	}
+	if err := g.WriteArgoCDKustomization(cluster.ManagementComponentsFromBundles(spec.Bundles), spec, false); err != nil {
+		return fmt.Errorf("writing argo cd system gitops files: %v", err)
+	}
+	if err := g.WriteArgoCDApplications(spec); err != nil {
+		return fmt.Errorf("writing argo cd system gitops files: %v", err)
+	}
+	logger.Info("Argo CD install manifest and git known hosts are not included in the dry run, they are added when the files are committed")
+
+	return nil
+}
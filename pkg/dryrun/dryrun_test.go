@@ -0,0 +1,228 @@
+package dryrun_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/dryrun"
+	"github.com/aws/eks-anywhere/pkg/dryrun/mocks"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	providermocks "github.com/aws/eks-anywhere/pkg/providers/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+type rendererTest struct {
+	*WithT
+	t                 *testing.T
+	ctx               context.Context
+	dir               string
+	provider          *providermocks.MockProvider
+	cni               *mocks.MockCNITemplater
+	differ            *mocks.MockDiffer
+	renderer          *dryrun.Renderer
+	spec              *cluster.Spec
+	managementCluster *types.Cluster
+	workloadCluster   *types.Cluster
+}
+
+func newRendererTest(t *testing.T) *rendererTest {
+	ctrl := gomock.NewController(t)
+	dir, writer := test.NewWriter(t)
+	provider := providermocks.NewMockProvider(ctrl)
+	cni := mocks.NewMockCNITemplater(ctrl)
+	differ := mocks.NewMockDiffer(ctrl)
+
+	datacenterConfig := &v1alpha1.VSphereDatacenterConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.VSphereDatacenterKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster"},
+	}
+	provider.EXPECT().DatacenterConfig(gomock.Any()).Return(datacenterConfig).AnyTimes()
+	provider.EXPECT().MachineConfigs(gomock.Any()).Return([]providers.MachineConfig{}).AnyTimes()
+	provider.EXPECT().GetDeployments().Return(map[string][]string{"capv-system": {"capv-controller-manager"}}).AnyTimes()
+
+	return &rendererTest{
+		WithT:    NewWithT(t),
+		t:        t,
+		ctx:      context.Background(),
+		dir:      dir,
+		provider: provider,
+		cni:      cni,
+		differ:   differ,
+		renderer: dryrun.NewRenderer(provider, cni, writer, differ),
+		spec: test.NewClusterSpec(func(s *cluster.Spec) {
+			s.Cluster.Name = "my-cluster"
+			s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}}
+		}),
+		managementCluster: &types.Cluster{Name: "mgmt", KubeconfigFile: "mgmt.kubeconfig"},
+		workloadCluster:   &types.Cluster{Name: "my-cluster", KubeconfigFile: "my-cluster.kubeconfig"},
+	}
+}
+
+func (tt *rendererTest) expectFile(name, content string) {
+	tt.t.Helper()
+	got, err := os.ReadFile(filepath.Join(tt.dir, dryrun.OutputDir, name))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(string(got)).To(Equal(content))
+}
+
+func TestRendererRenderCreateSuccess(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+
+	result, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Dir).To(Equal(filepath.Join(tt.dir, dryrun.OutputDir)))
+	tt.Expect(result.Files).To(HaveLen(4))
+	tt.Expect(result.Diff).To(BeEmpty())
+
+	got, err := os.ReadFile(filepath.Join(result.Dir, "eksa-cluster.yaml"))
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(string(got)).To(ContainSubstring("name: my-cluster"))
+	tt.Expect(string(got)).To(ContainSubstring("kind: VSphereDatacenterConfig"))
+
+	tt.expectFile("capi-control-plane.yaml", "control-plane")
+	tt.expectFile("capi-workers.yaml", "workers")
+	tt.expectFile("cilium.yaml", "cilium")
+}
+
+func TestRendererRenderCreateWithoutCilium(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig = nil
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+
+	result, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Files).To(HaveLen(3))
+	_, err = os.Stat(filepath.Join(result.Dir, "cilium.yaml"))
+	tt.Expect(os.IsNotExist(err)).To(BeTrue())
+}
+
+func TestRendererRenderCreateWithFlux(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.spec.FluxConfig = &v1alpha1.FluxConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.FluxConfigKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "flux"},
+		Spec: v1alpha1.FluxConfigSpec{
+			SystemNamespace:   "flux-system",
+			ClusterConfigPath: "clusters/mgmt",
+			Branch:            "main",
+		},
+	}
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+
+	result, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Files).To(ContainElement(filepath.Join(result.Dir, "gitops")))
+
+	_, err = os.Stat(filepath.Join(result.Dir, "gitops", "clusters/mgmt", "my-cluster", "eksa-system", "eksa-cluster.yaml"))
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestRendererRenderCreateWithArgoCD(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.spec.ArgoCDConfig = &v1alpha1.ArgoCDConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.ArgoCDConfigKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd"},
+		Spec: v1alpha1.ArgoCDConfigSpec{
+			SystemNamespace:   "argocd",
+			ClusterConfigPath: "clusters/mgmt",
+			Branch:            "main",
+			Git:               &v1alpha1.GitProviderConfig{RepositoryUrl: "ssh://git@example.com/clusters.git"},
+		},
+	}
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+
+	result, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Files).To(ContainElement(filepath.Join(result.Dir, "gitops")))
+
+	for _, f := range []string{
+		filepath.Join("clusters/mgmt", "my-cluster", "eksa-system", "eksa-cluster.yaml"),
+		filepath.Join("clusters/mgmt", "argocd", "namespace.yaml"),
+		filepath.Join("clusters/mgmt", "argocd", "kustomization.yaml"),
+		filepath.Join("clusters/mgmt", "argocd", "argocd-apps.yaml"),
+	} {
+		_, err = os.Stat(filepath.Join(result.Dir, "gitops", f))
+		tt.Expect(err).NotTo(HaveOccurred(), f)
+	}
+}
+
+func TestRendererRenderCreateErrorGeneratingCAPISpec(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return(nil, nil, errors.New("error in provider"))
+
+	_, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("error in provider")))
+}
+
+func TestRendererRenderCreateErrorGeneratingCilium(t *testing.T) {
+	tt := newRendererTest(t)
+	tt.provider.EXPECT().GenerateCAPISpecForCreate(tt.ctx, tt.managementCluster, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return(nil, errors.New("error in helm"))
+
+	_, err := tt.renderer.RenderCreate(tt.ctx, tt.managementCluster, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("generating cilium manifest: error in helm")))
+}
+
+func TestRendererRenderUpgradeSuccess(t *testing.T) {
+	tt := newRendererTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	tt.provider.EXPECT().GenerateCAPISpecForUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, tt.managementCluster, gomock.Any()).Return("", nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, tt.managementCluster, []byte("control-plane")).Return("control plane diff\n", nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, tt.managementCluster, []byte("workers")).Return("workers diff\n", nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, tt.workloadCluster, []byte("cilium")).Return("", nil)
+
+	result, err := tt.renderer.RenderUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Diff).To(Equal("control plane diff\nworkers diff\n"))
+	tt.Expect(result.Files).To(HaveLen(5))
+	tt.expectFile("upgrade.diff", "control plane diff\nworkers diff\n")
+}
+
+func TestRendererRenderUpgradeNoChanges(t *testing.T) {
+	tt := newRendererTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	tt.provider.EXPECT().GenerateCAPISpecForUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, gomock.Any(), gomock.Any()).Return("", nil).Times(4)
+
+	result, err := tt.renderer.RenderUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Diff).To(BeEmpty())
+	tt.Expect(result.Files).To(HaveLen(4))
+}
+
+func TestRendererRenderUpgradeErrorDiffing(t *testing.T) {
+	tt := newRendererTest(t)
+	currentSpec := tt.spec.DeepCopy()
+	tt.provider.EXPECT().GenerateCAPISpecForUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec).Return([]byte("control-plane"), []byte("workers"), nil)
+	tt.cni.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Any()).Return([]byte("cilium"), nil)
+	tt.differ.EXPECT().DiffFromBytes(tt.ctx, tt.managementCluster, gomock.Any()).Return("", errors.New("error in kubectl"))
+
+	_, err := tt.renderer.RenderUpgrade(tt.ctx, tt.managementCluster, tt.workloadCluster, currentSpec, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("diffing eksa-cluster.yaml: error in kubectl")))
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/dryrun/dryrun.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	cluster "github.com/aws/eks-anywhere/pkg/cluster"
+	cilium "github.com/aws/eks-anywhere/pkg/networking/cilium"
+	types "github.com/aws/eks-anywhere/pkg/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCNITemplater is a mock of CNITemplater interface.
+type MockCNITemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockCNITemplaterMockRecorder
+}
+
+// MockCNITemplaterMockRecorder is the mock recorder for MockCNITemplater.
+type MockCNITemplaterMockRecorder struct {
+	mock *MockCNITemplater
+}
+
+// NewMockCNITemplater creates a new mock instance.
+func NewMockCNITemplater(ctrl *gomock.Controller) *MockCNITemplater {
+	mock := &MockCNITemplater{ctrl: ctrl}
+	mock.recorder = &MockCNITemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCNITemplater) EXPECT() *MockCNITemplaterMockRecorder {
+	return m.recorder
+}
+
+// GenerateManifest mocks base method.
+func (m *MockCNITemplater) GenerateManifest(ctx context.Context, spec *cluster.Spec, opts ...cilium.ManifestOpt) ([]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, spec}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenerateManifest", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateManifest indicates an expected call of GenerateManifest.
+func (mr *MockCNITemplaterMockRecorder) GenerateManifest(ctx, spec interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, spec}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateManifest", reflect.TypeOf((*MockCNITemplater)(nil).GenerateManifest), varargs...)
+}
+
+// MockDiffer is a mock of Differ interface.
+type MockDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDifferMockRecorder
+}
+
+// MockDifferMockRecorder is the mock recorder for MockDiffer.
+type MockDifferMockRecorder struct {
+	mock *MockDiffer
+}
+
+// NewMockDiffer creates a new mock instance.
+func NewMockDiffer(ctrl *gomock.Controller) *MockDiffer {
+	mock := &MockDiffer{ctrl: ctrl}
+	mock.recorder = &MockDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiffer) EXPECT() *MockDifferMockRecorder {
+	return m.recorder
+}
+
+// DiffFromBytes mocks base method.
+func (m *MockDiffer) DiffFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffFromBytes", ctx, cluster, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffFromBytes indicates an expected call of DiffFromBytes.
+func (mr *MockDifferMockRecorder) DiffFromBytes(ctx, cluster, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffFromBytes", reflect.TypeOf((*MockDiffer)(nil).DiffFromBytes), ctx, cluster, data)
+}
@@ -0,0 +1,184 @@
+package reconciler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+const (
+	// rewritePageSize is the number of objects listed at once when rewriting a resource.
+	rewritePageSize = 500
+	requeueAfter    = 30 * time.Second
+)
+
+// RemoteClientRegistry defines methods for remote cluster controller clients.
+type RemoteClientRegistry interface {
+	GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+}
+
+// Reconciler re-encrypts the resources configured for etcd encryption in a workload
+// cluster once its control plane has rolled out a new encryption configuration. Rewriting
+// every object makes the kube-apiserver store it again using the first provider, which
+// is what completes a key rotation and encrypts data written before encryption was enabled.
+type Reconciler struct {
+	client               client.Client
+	remoteClientRegistry RemoteClientRegistry
+}
+
+// New returns a new Reconciler.
+func New(client client.Client, remoteClientRegistry RemoteClientRegistry) *Reconciler {
+	return &Reconciler{
+		client:               client,
+		remoteClientRegistry: remoteClientRegistry,
+	}
+}
+
+// Reconcile re-encrypts the configured resources if they haven't been rewritten with the
+// current etcd encryption configuration yet. It requeues until the control plane runs with
+// that configuration and records it in the cluster status when the rewrite completes.
+func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *anywherev1.Cluster) (controller.Result, error) {
+	if cluster.Spec.EtcdEncryption == nil || len(*cluster.Spec.EtcdEncryption) == 0 {
+		cluster.Status.ReencryptedEtcdEncryptionHash = ""
+		return controller.Result{}, nil
+	}
+
+	hash := ReencryptionHash(*cluster.Spec.EtcdEncryption)
+	if hash == cluster.Status.ReencryptedEtcdEncryptionHash {
+		return controller.Result{}, nil
+	}
+
+	log = log.WithValues("phase", "etcdReencryption")
+
+	kcp, err := controller.GetKubeadmControlPlane(ctx, r.client, cluster)
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting kubeadm control plane to re-encrypt resources")
+	}
+	if kcp == nil {
+		log.Info("KubeadmControlPlane not found, requeuing re-encryption")
+		return controller.ResultWithRequeue(requeueAfter), nil
+	}
+
+	encryptionConfig, err := common.GenerateKMSEncryptionConfiguration(cluster.Spec.EtcdEncryption)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	if !hasFile(kcp, encryptionConfig) || !rolledOut(kcp) {
+		log.Info("Control plane is not running the etcd encryption config yet, requeuing re-encryption")
+		return controller.ResultWithRequeue(requeueAfter), nil
+	}
+
+	rClient, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(cluster))
+	if err != nil {
+		return controller.Result{}, errors.Wrap(err, "getting workload cluster's client to re-encrypt resources")
+	}
+
+	for _, conf := range *cluster.Spec.EtcdEncryption {
+		for _, resource := range conf.Resources {
+			if strings.Contains(resource, "*") {
+				log.Info("Skipping re-encryption of wildcard resource, it needs to be rewritten manually", "resource", resource)
+				continue
+			}
+			log.Info("Re-encrypting resource", "resource", resource)
+			if err := rewrite(ctx, rClient, resource); err != nil {
+				return controller.Result{}, errors.Wrapf(err, "re-encrypting %s", resource)
+			}
+		}
+	}
+
+	cluster.Status.ReencryptedEtcdEncryptionHash = hash
+	return controller.Result{}, nil
+}
+
+// ReencryptionHash returns a hash of the parts of the etcd encryption configuration that
+// require rewriting the encrypted resources when they change: the resources themselves and
+// the provider used to encrypt them. The rest of the providers are only used to decrypt.
+func ReencryptionHash(confs []anywherev1.EtcdEncryption) string {
+	type reencryptionConfig struct {
+		Resources []string `json:"resources"`
+		Provider  string   `json:"provider"`
+	}
+	configs := make([]reencryptionConfig, 0, len(confs))
+	for _, conf := range confs {
+		c := reencryptionConfig{Resources: conf.Resources}
+		if len(conf.Providers) > 0 && conf.Providers[0].KMS != nil {
+			c.Provider = conf.Providers[0].KMS.Name
+		}
+		configs = append(configs, c)
+	}
+
+	// The config only contains strings, which always marshal.
+	b, _ := json.Marshal(configs)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// hasFile checks if the KubeadmControlPlane writes a file with the given content in the nodes.
+func hasFile(kcp *controlplanev1.KubeadmControlPlane, content string) bool {
+	for _, f := range kcp.Spec.KubeadmConfigSpec.Files {
+		if strings.TrimSpace(f.Content) == strings.TrimSpace(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// rolledOut checks if all the control plane machines are up to date and ready.
+func rolledOut(kcp *controlplanev1.KubeadmControlPlane) bool {
+	replicas := kcp.Status.Replicas
+	if kcp.Spec.Replicas != nil {
+		replicas = *kcp.Spec.Replicas
+	}
+	return kcp.Status.ObservedGeneration == kcp.Generation &&
+		kcp.Status.Replicas == replicas &&
+		kcp.Status.UpdatedReplicas == replicas &&
+		kcp.Status.ReadyReplicas == replicas
+}
+
+// rewrite updates, without changes, all the objects of a resource so the kube-apiserver
+// stores them again with the current encryption config.
+func rewrite(ctx context.Context, c client.Client, resource string) error {
+	gvk, err := c.RESTMapper().KindFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		return errors.Wrap(err, "getting kind for resource")
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	continueToken := ""
+	for {
+		if err := c.List(ctx, list, client.Limit(rewritePageSize), client.Continue(continueToken)); err != nil {
+			return errors.Wrap(err, "listing objects")
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			// Conflicts and deleted objects mean the object has been written since it was listed,
+			// which already stored it with the current encryption config.
+			if err := c.Update(ctx, obj); err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "updating %s %s", gvk.Kind, client.ObjectKeyFromObject(obj))
+			}
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package reconciler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/etcdencryption/reconciler"
+	"github.com/aws/eks-anywhere/pkg/etcdencryption/reconciler/mocks"
+	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
+)
+
+type reconcileTest struct {
+	*WithT
+	t                    *testing.T
+	ctx                  context.Context
+	cluster              *anywherev1.Cluster
+	kcp                  *controlplanev1.KubeadmControlPlane
+	remoteClientRegistry *mocks.MockRemoteClientRegistry
+	remoteClient         client.Client
+}
+
+func newReconcileTest(t *testing.T) *reconcileTest {
+	ctrl := gomock.NewController(t)
+	cluster := &anywherev1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: anywherev1.ClusterSpec{
+			EtcdEncryption: &[]anywherev1.EtcdEncryption{
+				{
+					Providers: []anywherev1.EtcdEncryptionProvider{
+						{
+							KMS: &anywherev1.KMS{
+								Name:                "new-key",
+								SocketListenAddress: "unix:///var/run/kmsplugin/new-key.sock",
+								CacheSize:           anywherev1.DefaultKMSCacheSize,
+								Timeout:             &anywherev1.DefaultKMSTimeout,
+							},
+						},
+						{
+							KMS: &anywherev1.KMS{
+								Name:                "old-key",
+								SocketListenAddress: "unix:///var/run/kmsplugin/old-key.sock",
+								CacheSize:           anywherev1.DefaultKMSCacheSize,
+								Timeout:             &anywherev1.DefaultKMSTimeout,
+							},
+						},
+					},
+					Resources: []string{"secrets", "*.apps"},
+				},
+			},
+		},
+	}
+
+	encryptionConfig, err := common.GenerateKMSEncryptionConfiguration(cluster.Spec.EtcdEncryption)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "my-cluster",
+			Namespace:  constants.EksaSystemNamespace,
+			Generation: 2,
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Replicas: ptr.Int32(3),
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				Files: []bootstrapv1.File{
+					{
+						Path:    "/var/lib/kubeadm/encryption-config.yaml",
+						Content: encryptionConfig,
+					},
+				},
+			},
+		},
+		Status: controlplanev1.KubeadmControlPlaneStatus{
+			ObservedGeneration: 2,
+			Replicas:           3,
+			UpdatedReplicas:    3,
+			ReadyReplicas:      3,
+		},
+	}
+
+	remoteScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(remoteScheme)
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+	remoteClient := fake.NewClientBuilder().WithScheme(remoteScheme).WithRESTMapper(restMapper).WithObjects(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "default"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s2", Namespace: "kube-system"}},
+	).Build()
+
+	return &reconcileTest{
+		t:                    t,
+		WithT:                NewWithT(t),
+		ctx:                  context.Background(),
+		cluster:              cluster,
+		kcp:                  kcp,
+		remoteClientRegistry: mocks.NewMockRemoteClientRegistry(ctrl),
+		remoteClient:         remoteClient,
+	}
+}
+
+func (tt *reconcileTest) reconciler() *reconciler.Reconciler {
+	scheme := runtime.NewScheme()
+	_ = controlplanev1.AddToScheme(scheme)
+	_ = anywherev1.AddToScheme(scheme)
+	cb := fake.NewClientBuilder().WithScheme(scheme)
+	if tt.kcp != nil {
+		cb = cb.WithObjects(tt.kcp)
+	}
+	return reconciler.New(cb.Build(), tt.remoteClientRegistry)
+}
+
+func (tt *reconcileTest) secretResourceVersion(name, namespace string) string {
+	s := &corev1.Secret{}
+	tt.Expect(tt.remoteClient.Get(tt.ctx, client.ObjectKey{Name: name, Namespace: namespace}, s)).To(Succeed())
+	return s.ResourceVersion
+}
+
+func TestReconcileNoEtcdEncryption(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.cluster.Spec.EtcdEncryption = nil
+	tt.cluster.Status.ReencryptedEtcdEncryptionHash = "old-hash"
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReconcileAlreadyReencrypted(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.cluster.Status.ReencryptedEtcdEncryptionHash = reconciler.ReencryptionHash(*tt.cluster.Spec.EtcdEncryption)
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
+func TestReconcileReencryptsResources(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.cluster.Status.ReencryptedEtcdEncryptionHash = "old-hash"
+	s1Version := tt.secretResourceVersion("s1", "default")
+	s2Version := tt.secretResourceVersion("s2", "kube-system")
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, client.ObjectKey{Name: "my-cluster", Namespace: constants.EksaSystemNamespace}).Return(tt.remoteClient, nil)
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(Equal(reconciler.ReencryptionHash(*tt.cluster.Spec.EtcdEncryption)))
+	tt.Expect(tt.secretResourceVersion("s1", "default")).NotTo(Equal(s1Version))
+	tt.Expect(tt.secretResourceVersion("s2", "kube-system")).NotTo(Equal(s2Version))
+}
+
+func TestReconcileMissingKubeadmControlPlane(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.kcp = nil
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeTrue())
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReconcileControlPlaneNotRolledOut(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.kcp.Status.UpdatedReplicas = 1
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeTrue())
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReconcileControlPlaneWithOldEncryptionConfig(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.kcp.Spec.KubeadmConfigSpec.Files[0].Content = "old config"
+
+	result, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result.Return()).To(BeTrue())
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReconcileRemoteClientError(t *testing.T) {
+	tt := newReconcileTest(t)
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(nil, errors.New("connection refused"))
+
+	_, err := tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("connection refused")))
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReconcileUnknownResource(t *testing.T) {
+	tt := newReconcileTest(t)
+	(*tt.cluster.Spec.EtcdEncryption)[0].Resources = []string{"widgets.example.com"}
+	encryptionConfig, err := common.GenerateKMSEncryptionConfiguration(tt.cluster.Spec.EtcdEncryption)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.kcp.Spec.KubeadmConfigSpec.Files[0].Content = encryptionConfig
+	tt.remoteClientRegistry.EXPECT().GetClient(tt.ctx, gomock.Any()).Return(tt.remoteClient, nil)
+
+	_, err = tt.reconciler().Reconcile(tt.ctx, nullLog(), tt.cluster)
+	tt.Expect(err).To(MatchError(ContainSubstring("re-encrypting widgets.example.com")))
+	tt.Expect(tt.cluster.Status.ReencryptedEtcdEncryptionHash).To(BeEmpty())
+}
+
+func TestReencryptionHashIgnoresDecryptionProviders(t *testing.T) {
+	g := NewWithT(t)
+	confs := []anywherev1.EtcdEncryption{
+		{
+			Providers: []anywherev1.EtcdEncryptionProvider{
+				{KMS: &anywherev1.KMS{Name: "new-key"}},
+				{KMS: &anywherev1.KMS{Name: "old-key"}},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+	withoutOldKey := []anywherev1.EtcdEncryption{
+		{
+			Providers: []anywherev1.EtcdEncryptionProvider{
+				{KMS: &anywherev1.KMS{Name: "new-key", Plugin: &anywherev1.KMSPlugin{Image: "plugin:v2"}}},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+	rotated := []anywherev1.EtcdEncryption{
+		{
+			Providers: []anywherev1.EtcdEncryptionProvider{
+				{KMS: &anywherev1.KMS{Name: "newer-key"}},
+				{KMS: &anywherev1.KMS{Name: "new-key"}},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+
+	g.Expect(reconciler.ReencryptionHash(withoutOldKey)).To(Equal(reconciler.ReencryptionHash(confs)))
+	g.Expect(reconciler.ReencryptionHash(rotated)).NotTo(Equal(reconciler.ReencryptionHash(confs)))
+}
+
+func nullLog() logr.Logger {
+	return logr.New(logf.NullLogSink{})
+}
@@ -0,0 +1,77 @@
+// Package events records machine-readable progress events for long running operations like
+// cluster create, upgrade and delete. Events are written as JSON lines, for example:
+//
+//	{"schemaVersion":"v1","time":"2024-01-01T00:00:00Z","type":"TaskFinished","task":"bootstrap-cluster-init","durationSeconds":95.2}
+package events
+
+import "time"
+
+// SchemaVersion is the version of the Event schema. It changes only when fields are renamed or
+// removed, new fields and event types can be added without changing it.
+const SchemaVersion = "v1"
+
+// Type identifies what an Event describes.
+type Type string
+
+const (
+	// WorkflowStarted is emitted when a workflow or task runner starts executing its tasks.
+	WorkflowStarted Type = "WorkflowStarted"
+	// WorkflowFinished is emitted when all tasks of a workflow completed successfully.
+	WorkflowFinished Type = "WorkflowFinished"
+	// WorkflowFailed is emitted when a workflow stops because of an error.
+	WorkflowFailed Type = "WorkflowFailed"
+	// TaskStarted is emitted when a task starts running.
+	TaskStarted Type = "TaskStarted"
+	// TaskFinished is emitted when a task completes successfully.
+	TaskFinished Type = "TaskFinished"
+	// TaskFailed is emitted when a task completes with an error.
+	TaskFailed Type = "TaskFailed"
+	// TaskRestored is emitted when a task is skipped because it completed in a previous run.
+	TaskRestored Type = "TaskRestored"
+	// Retry is emitted when an operation fails and is going to be retried.
+	Retry Type = "Retry"
+)
+
+// Event is a machine-readable progress update.
+type Event struct {
+	// SchemaVersion is the version of the schema the event follows.
+	SchemaVersion string `json:"schemaVersion"`
+	// Time is the moment the event was recorded, in UTC.
+	Time time.Time `json:"time"`
+	// Type is the kind of event.
+	Type Type `json:"type"`
+	// Task is the name of the task the event refers to. Empty for workflow events and for retry
+	// events when several tasks are running.
+	Task string `json:"task,omitempty"`
+	// Tasks are the names of the tasks running in parallel when a retry event is recorded, since
+	// the retry can belong to any of them. Only set for retry events.
+	Tasks []string `json:"tasks,omitempty"`
+	// DurationSeconds is how long the task or workflow took. Only set for finished and failed events.
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	// Attempt is the number of the attempt that failed. Only set for retry events.
+	Attempt int `json:"attempt,omitempty"`
+	// WaitSeconds is the time until the next attempt. Only set for retry events.
+	WaitSeconds float64 `json:"waitSeconds,omitempty"`
+	// Error is the error message. Only set for failed and retry events.
+	Error string `json:"error,omitempty"`
+}
+
+// Started returns a started event of type t for task.
+func Started(t Type, task string) Event {
+	return Event{Type: t, Task: task}
+}
+
+// Finished returns a finished event for task that started at start. If err is not nil, the event
+// is of type failedType, otherwise it's of type finishedType.
+func Finished(finishedType, failedType Type, task string, start time.Time, err error) Event {
+	e := Event{
+		Type:            finishedType,
+		Task:            task,
+		DurationSeconds: time.Since(start).Seconds(),
+	}
+	if err != nil {
+		e.Type = failedType
+		e.Error = err.Error()
+	}
+	return e
+}
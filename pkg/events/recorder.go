@@ -0,0 +1,167 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// Recorder records progress events.
+type Recorder interface {
+	Record(Event)
+}
+
+// JSONRecorder writes events to an io.Writer as JSON lines, one event per line.
+// It's safe for concurrent use.
+type JSONRecorder struct {
+	w    io.Writer
+	lock sync.Mutex
+}
+
+// NewJSONRecorder builds a new JSONRecorder.
+func NewJSONRecorder(w io.Writer) *JSONRecorder {
+	return &JSONRecorder{w: w}
+}
+
+// Record writes e as a single JSON line, setting its schema version and time.
+// Errors are logged and otherwise ignored, since events should never interrupt an operation.
+func (r *JSONRecorder) Record(e Event) {
+	e.SchemaVersion = SchemaVersion
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		logger.V(4).Info("Failed marshalling progress event", "error", err)
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, err = r.w.Write(append(line, '\n')); err != nil {
+		logger.V(4).Info("Failed writing progress event", "error", err)
+	}
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) Record(Event) {}
+
+var (
+	recorder Recorder = nopRecorder{}
+	output   io.Closer
+	lock     sync.RWMutex
+
+	// running counts the tasks running by name, to attribute retry events to them.
+	running     = map[string]int{}
+	runningLock sync.Mutex
+)
+
+// StdoutPath is the conventional path for stdout. The CLI writes its logs to stderr when
+// events are written to stdout.
+const StdoutPath = "-"
+
+// Options represents a set of arguments for initializing the package recorder.
+type Options struct {
+	// OutputFilePath is the file where events are written as JSON lines. The file is created
+	// if it doesn't exist and truncated if it does. StdoutPath writes them to stdout.
+	// If empty, events are discarded.
+	OutputFilePath string
+}
+
+// Init initializes the package recorder. Events are discarded until Init is called.
+func Init(opts Options) error {
+	switch opts.OutputFilePath {
+	case "":
+		SetRecorder(nopRecorder{})
+	case StdoutPath:
+		// Stdout is not closed by Close.
+		SetRecorder(NewJSONRecorder(os.Stdout))
+	default:
+		f, err := os.Create(opts.OutputFilePath)
+		if err != nil {
+			return fmt.Errorf("creating events file: %v", err)
+		}
+		SetRecorder(NewJSONRecorder(f))
+		lock.Lock()
+		output = f
+		lock.Unlock()
+	}
+
+	return nil
+}
+
+// Close closes the events file opened by Init, if any, and stops recording events.
+func Close() error {
+	SetRecorder(nopRecorder{})
+	lock.Lock()
+	defer lock.Unlock()
+	if output == nil {
+		return nil
+	}
+	err := output.Close()
+	output = nil
+	return err
+}
+
+// SetRecorder replaces the package recorder and forgets the running tasks. A nil recorder
+// discards all events.
+func SetRecorder(r Recorder) {
+	if r == nil {
+		r = nopRecorder{}
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	recorder = r
+
+	runningLock.Lock()
+	defer runningLock.Unlock()
+	running = map[string]int{}
+}
+
+// Record records e with the package recorder.
+// Retry events without a task are attributed to the running task. When several tasks run in
+// parallel, the retry can't be attributed to one of them, so all of them are set in Tasks.
+func Record(e Event) {
+	trackTask(&e)
+
+	lock.RLock()
+	r := recorder
+	lock.RUnlock()
+	r.Record(e)
+}
+
+func trackTask(e *Event) {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+
+	switch e.Type {
+	case TaskStarted:
+		running[e.Task]++
+	case TaskFinished, TaskFailed:
+		if running[e.Task]--; running[e.Task] <= 0 {
+			delete(running, e.Task)
+		}
+	case Retry:
+		if e.Task != "" || len(running) == 0 {
+			return
+		}
+		if len(running) == 1 {
+			for task := range running {
+				e.Task = task
+			}
+			return
+		}
+		for task := range running {
+			e.Tasks = append(e.Tasks, task)
+		}
+		sort.Strings(e.Tasks)
+	}
+}
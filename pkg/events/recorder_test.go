@@ -0,0 +1,140 @@
+package events_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/events"
+)
+
+func TestJSONRecorderRecord(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+	r := events.NewJSONRecorder(buf)
+
+	r.Record(events.Started(events.TaskStarted, "create-cluster"))
+	r.Record(events.Finished(events.TaskFinished, events.TaskFailed, "create-cluster", time.Now(), errors.New("failed")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	g.Expect(lines).To(HaveLen(2))
+
+	started := events.Event{}
+	g.Expect(json.Unmarshal([]byte(lines[0]), &started)).To(Succeed())
+	g.Expect(started.SchemaVersion).To(Equal(events.SchemaVersion))
+	g.Expect(started.Type).To(Equal(events.TaskStarted))
+	g.Expect(started.Task).To(Equal("create-cluster"))
+	g.Expect(started.Time.IsZero()).To(BeFalse())
+
+	failed := events.Event{}
+	g.Expect(json.Unmarshal([]byte(lines[1]), &failed)).To(Succeed())
+	g.Expect(failed.Type).To(Equal(events.TaskFailed))
+	g.Expect(failed.Error).To(Equal("failed"))
+}
+
+func TestJSONRecorderRecordSchema(t *testing.T) {
+	g := NewWithT(t)
+	buf := &bytes.Buffer{}
+	r := events.NewJSONRecorder(buf)
+
+	r.Record(events.Event{
+		Time:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Type:        events.Retry,
+		Attempt:     2,
+		WaitSeconds: 5,
+		Error:       "connection refused",
+	})
+
+	g.Expect(buf.String()).To(Equal(`{"schemaVersion":"v1","time":"2024-01-01T00:00:00Z","type":"Retry","attempt":2,"waitSeconds":5,"error":"connection refused"}` + "\n"))
+}
+
+func TestFinishedWithoutError(t *testing.T) {
+	g := NewWithT(t)
+
+	e := events.Finished(events.WorkflowFinished, events.WorkflowFailed, "", time.Now().Add(-time.Minute), nil)
+	g.Expect(e.Type).To(Equal(events.WorkflowFinished))
+	g.Expect(e.Error).To(BeEmpty())
+	g.Expect(e.DurationSeconds).To(BeNumerically(">=", 60))
+}
+
+func TestInitWithFile(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	g.Expect(events.Init(events.Options{OutputFilePath: path})).To(Succeed())
+	events.Record(events.Started(events.WorkflowStarted, ""))
+	g.Expect(events.Close()).To(Succeed())
+	// Events recorded after closing are discarded.
+	events.Record(events.Started(events.TaskStarted, "discarded"))
+
+	content, err := os.ReadFile(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(HaveLen(1))
+	g.Expect(string(content)).To(ContainSubstring(`"type":"WorkflowStarted"`))
+}
+
+func TestInitWithInvalidFile(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "missing", "events.jsonl")
+
+	g.Expect(events.Init(events.Options{OutputFilePath: path})).To(MatchError(ContainSubstring("creating events file")))
+}
+
+func TestInitWithStdout(t *testing.T) {
+	g := NewWithT(t)
+	stdout, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	g.Expect(err).NotTo(HaveOccurred())
+	defer stdout.Close()
+	originalStdout := os.Stdout
+	os.Stdout = stdout
+	t.Cleanup(func() { os.Stdout = originalStdout })
+
+	g.Expect(events.Init(events.Options{OutputFilePath: events.StdoutPath})).To(Succeed())
+	events.Record(events.Started(events.WorkflowStarted, ""))
+	g.Expect(events.Close()).To(Succeed())
+
+	// Closing the recorder doesn't close stdout.
+	_, err = stdout.WriteString("log line\n")
+	g.Expect(err).NotTo(HaveOccurred())
+	content, err := os.ReadFile(stdout.Name())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(content)).To(ContainSubstring(`"type":"WorkflowStarted"`))
+	g.Expect(string(content)).To(HaveSuffix("log line\n"))
+}
+
+type memoryRecorder struct {
+	events []events.Event
+}
+
+func (r *memoryRecorder) Record(e events.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestRecordRetryAttributedToRunningTask(t *testing.T) {
+	g := NewWithT(t)
+	r := &memoryRecorder{}
+	events.SetRecorder(r)
+	t.Cleanup(func() { events.SetRecorder(nil) })
+
+	events.Record(events.Started(events.TaskStarted, "install-capi"))
+	events.Record(events.Event{Type: events.Retry, Attempt: 1})
+	events.Record(events.Started(events.TaskStarted, "install-gitops"))
+	events.Record(events.Event{Type: events.Retry, Attempt: 1})
+	events.Record(events.Finished(events.TaskFinished, events.TaskFailed, "install-capi", time.Now(), nil))
+	events.Record(events.Finished(events.TaskFinished, events.TaskFailed, "install-gitops", time.Now(), nil))
+	events.Record(events.Event{Type: events.Retry, Attempt: 1})
+
+	g.Expect(r.events[1].Task).To(Equal("install-capi"))
+	g.Expect(r.events[1].Tasks).To(BeEmpty())
+	g.Expect(r.events[3].Task).To(BeEmpty())
+	g.Expect(r.events[3].Tasks).To(Equal([]string{"install-capi", "install-gitops"}))
+	g.Expect(r.events[6].Task).To(BeEmpty())
+	g.Expect(r.events[6].Tasks).To(BeEmpty())
+}
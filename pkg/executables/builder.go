@@ -22,16 +22,23 @@ type ExecutableBuilder interface {
 
 type ExecutablesBuilder struct {
 	executableBuilder ExecutableBuilder
+	kindEnv           map[string]string
+	dockerBinary      string
 }
 
 func NewExecutablesBuilder(executableBuilder ExecutableBuilder) *ExecutablesBuilder {
 	return &ExecutablesBuilder{
 		executableBuilder: executableBuilder,
+		dockerBinary:      dockerPath,
 	}
 }
 
 func (b *ExecutablesBuilder) BuildKindExecutable(writer filewriter.FileWriter) *Kind {
-	return NewKind(b.executableBuilder.Build(kindPath), writer)
+	executable := b.executableBuilder.Build(kindPath)
+	if len(b.kindEnv) > 0 {
+		executable = withEnv(executable, b.kindEnv)
+	}
+	return NewKind(executable, writer)
 }
 
 func (b *ExecutablesBuilder) BuildClusterAwsAdmExecutable() *Clusterawsadm {
@@ -78,9 +85,10 @@ func (b *ExecutablesBuilder) BuildHelm(opts ...helm.Opt) helm.Client {
 	return b.BuildHelmExecutable(opts...)
 }
 
-// BuildDockerExecutable initializes a docker executable and returns it.
+// BuildDockerExecutable initializes a docker executable and returns it. Host executables use the
+// CLI of the selected container runtime, while the tools container always ships the docker CLI.
 func (b *ExecutablesBuilder) BuildDockerExecutable() *Docker {
-	return NewDocker(b.executableBuilder.Build(dockerPath))
+	return NewDocker(b.executableBuilder.Build(b.dockerBinary))
 }
 
 // BuildSSHExecutable initializes a SSH executable and returns it.
@@ -101,9 +109,11 @@ func BuildSonobuoyExecutable() *Sonobuoy {
 	})
 }
 
+// BuildDockerExecutable builds a Docker executable that runs the CLI of the container
+// runtime selected with ContainerRuntimeName directly in the host.
 func BuildDockerExecutable() *Docker {
 	return NewDocker(&executable{
-		cli: dockerPath,
+		cli: ContainerRuntimeName(),
 	})
 }
 
@@ -136,20 +146,38 @@ func InitInDockerExecutablesBuilder(ctx context.Context, image string, mountDirs
 
 // NewInDockerExecutablesBuilder builds an executables builder for docker.
 func NewInDockerExecutablesBuilder(dockerClient DockerClient, image string, mountDirs ...string) (*ExecutablesBuilder, error) {
+	return NewInContainerExecutablesBuilder(dockerRuntime{}, dockerClient, image, mountDirs...)
+}
+
+// NewInContainerExecutablesBuilder builds an executables builder that runs the executables in a
+// long running container managed with the given container runtime.
+func NewInContainerExecutablesBuilder(runtime ContainerRuntime, dockerClient DockerClient, image string, mountDirs ...string) (*ExecutablesBuilder, error) {
 	currentDir, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("getting current directory: %v", err)
 	}
 	mountDirs = append(mountDirs, currentDir)
 
-	dockerContainer := newDockerContainer(image, currentDir, mountDirs, dockerClient)
-	dockerExecutableBuilder := NewDockerExecutableBuilder(dockerContainer)
+	dockerContainer := newDockerContainer(image, currentDir, mountDirs, dockerClient, runtime)
+	dockerExecutableBuilder := NewContainerExecutableBuilder(dockerContainer, runtime)
 
 	return NewExecutablesBuilder(dockerExecutableBuilder), nil
 }
 
 func NewLocalExecutablesBuilder() *ExecutablesBuilder {
-	return NewExecutablesBuilder(newLocalExecutableBuilder())
+	b := NewExecutablesBuilder(newLocalExecutableBuilder())
+	b.dockerBinary = ContainerRuntimeName()
+	return b
+}
+
+// NewLocalExecutablesBuilderForRuntime builds an executables builder for the host binaries
+// that configures kind to create the bootstrap cluster with the given container runtime.
+func NewLocalExecutablesBuilderForRuntime(runtime ContainerRuntime) *ExecutablesBuilder {
+	b := NewLocalExecutablesBuilder()
+	if provider := runtime.KindProvider(); provider != "" {
+		b.kindEnv = map[string]string{kindProviderEnv: provider}
+	}
+	return b
 }
 
 func DefaultEksaImage() string {
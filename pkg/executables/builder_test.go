@@ -1,6 +1,7 @@
 package executables_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
@@ -118,3 +119,68 @@ func TestLocalExecutablesBuilder(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(closer(ctx)).To(Succeed())
 }
+
+func TestInContainerExecutablesBuilderPodman(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	image := "image"
+	ctrl := gomock.NewController(t)
+	e := mocks.NewMockExecutable(ctrl)
+	e.EXPECT().Execute(ctx, "info", "--format", "json").Return(
+		*bytes.NewBufferString(`{"host":{"security":{"rootless":true},"remoteSocket":{"path":"/run/user/1000/podman/podman.sock","exists":true}}}`), nil,
+	)
+	runtime, err := executables.DetectContainerRuntime(ctx, executables.PodmanRuntime, e)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	c := mocks.NewMockDockerClient(ctrl)
+	c.EXPECT().PullImage(ctx, image)
+	c.EXPECT().Execute(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, args ...string) (bytes.Buffer, error) {
+		g.Expect(args).To(ContainElements("--security-opt", "label=disable", "/run/user/1000/podman/podman.sock:/var/run/docker.sock"))
+		return bytes.Buffer{}, nil
+	}) // Init container
+	c.EXPECT().Execute(ctx, gomock.Any()) // Remove container
+
+	b, err := executables.NewInContainerExecutablesBuilder(runtime, c, image)
+	g.Expect(err).NotTo(HaveOccurred())
+	closer, err := b.Init(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(b.BuildKubectlExecutable()).NotTo(BeNil())
+	g.Expect(closer(ctx)).To(Succeed())
+}
+
+func TestLocalExecutablesBuilderForRuntime(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	e := mocks.NewMockExecutable(gomock.NewController(t))
+	e.EXPECT().Execute(ctx, "info", "--format", "{{json .}}").Return(*bytes.NewBufferString(`{"SecurityOptions":[]}`), nil)
+	runtime, err := executables.DetectContainerRuntime(ctx, executables.NerdctlRuntime, e)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	b := executables.NewLocalExecutablesBuilderForRuntime(runtime)
+	_, writer := test.NewWriter(t)
+	g.Expect(b.BuildKindExecutable(writer)).NotTo(BeNil())
+}
+
+func TestEnvExecutable(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	e := mocks.NewMockExecutable(gomock.NewController(t))
+	env := map[string]string{"KIND_EXPERIMENTAL_PROVIDER": "podman"}
+	e.EXPECT().Run(gomock.Any()).DoAndReturn(func(cmd *executables.Command) (bytes.Buffer, error) {
+		g.Expect(executables.CommandEnvVars(cmd)).To(Equal(env))
+		return bytes.Buffer{}, nil
+	})
+	e.EXPECT().Run(gomock.Any()).DoAndReturn(func(cmd *executables.Command) (bytes.Buffer, error) {
+		g.Expect(executables.CommandEnvVars(cmd)).To(Equal(map[string]string{
+			"KIND_EXPERIMENTAL_PROVIDER": "podman",
+			"OTHER":                      "value",
+		}))
+		return bytes.Buffer{}, nil
+	})
+
+	w := executables.WithEnv(e, env)
+	_, err := w.Execute(ctx, "get", "clusters")
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = w.ExecuteWithEnv(ctx, map[string]string{"OTHER": "value"}, "create", "cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+}
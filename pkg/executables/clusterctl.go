@@ -174,6 +174,45 @@ func (c *Clusterctl) BackupManagement(ctx context.Context, cluster *types.Cluste
 	return nil
 }
 
+// BackupManagementToDirectory saves the CAPI resources of all the clusters in the management cluster
+// to the provided directory, which can then be used with RestoreManagementFromDirectory.
+func (c *Clusterctl) BackupManagementToDirectory(ctx context.Context, cluster *types.Cluster, dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("could not create backup directory for CAPI objects: %v", err)
+	}
+
+	_, err := c.Execute(
+		ctx, "move",
+		"--to-directory", dir,
+		"--kubeconfig", cluster.KubeconfigFile,
+		"--namespace", constants.EksaSystemNamespace,
+	)
+	if err != nil {
+		return fmt.Errorf("failed taking backup of CAPI objects: %v", err)
+	}
+	return nil
+}
+
+// RestoreManagementFromDirectory creates in the cluster the CAPI resources saved in the provided directory
+// and resumes their reconciliation. If `clusterName` is provided, it filters and restores only the provided cluster.
+func (c *Clusterctl) RestoreManagementFromDirectory(ctx context.Context, cluster *types.Cluster, dir, clusterName string) error {
+	params := []string{
+		"move",
+		"--from-directory", dir,
+		"--to-kubeconfig", cluster.KubeconfigFile,
+		"--namespace", constants.EksaSystemNamespace,
+	}
+	if clusterName != "" {
+		params = append(params, "--filter-cluster", clusterName)
+	}
+
+	_, err := c.Execute(ctx, params...)
+	if err != nil {
+		return fmt.Errorf("failed restoring CAPI objects: %v", err)
+	}
+	return nil
+}
+
 // MoveManagement moves management components `from` cluster `to` cluster
 // If `clusterName` is provided, it filters and moves only the provided cluster.
 func (c *Clusterctl) MoveManagement(ctx context.Context, from, to *types.Cluster, clusterName string) error {
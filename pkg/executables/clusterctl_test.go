@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -274,6 +275,81 @@ func TestClusterctlBackupManagementFailed(t *testing.T) {
 	}
 }
 
+func TestClusterctlBackupManagementToDirectory(t *testing.T) {
+	tt := newClusterctlTest(t)
+	dir := filepath.Join(t.TempDir(), "capi")
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--to-directory", dir, "--kubeconfig", "cluster.kubeconfig", "--namespace", constants.EksaSystemNamespace)
+
+	if err := tt.clusterctl.BackupManagementToDirectory(tt.ctx, cluster, dir); err != nil {
+		t.Fatalf("Clusterctl.BackupManagementToDirectory() error = %v, want nil", err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("Clusterctl.BackupManagementToDirectory() didn't create backup directory: %v", err)
+	}
+}
+
+func TestClusterctlBackupManagementToDirectoryFailed(t *testing.T) {
+	tt := newClusterctlTest(t)
+	dir := filepath.Join(t.TempDir(), "capi")
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--to-directory", dir, "--kubeconfig", "cluster.kubeconfig", "--namespace", constants.EksaSystemNamespace).Return(bytes.Buffer{}, fmt.Errorf("error backing up management cluster resources"))
+
+	if err := tt.clusterctl.BackupManagementToDirectory(tt.ctx, cluster, dir); err == nil {
+		t.Fatal("Clusterctl.BackupManagementToDirectory() error = nil, want not nil")
+	}
+}
+
+func TestClusterctlRestoreManagementFromDirectory(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "backup/capi", "--to-kubeconfig", "cluster.kubeconfig", "--namespace", constants.EksaSystemNamespace, "--filter-cluster", "workload")
+
+	if err := tt.clusterctl.RestoreManagementFromDirectory(tt.ctx, cluster, "backup/capi", "workload"); err != nil {
+		t.Fatalf("Clusterctl.RestoreManagementFromDirectory() error = %v, want nil", err)
+	}
+}
+
+func TestClusterctlRestoreManagementFromDirectoryAllClusters(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "backup/capi", "--to-kubeconfig", "cluster.kubeconfig", "--namespace", constants.EksaSystemNamespace)
+
+	if err := tt.clusterctl.RestoreManagementFromDirectory(tt.ctx, cluster, "backup/capi", ""); err != nil {
+		t.Fatalf("Clusterctl.RestoreManagementFromDirectory() error = %v, want nil", err)
+	}
+}
+
+func TestClusterctlRestoreManagementFromDirectoryFailed(t *testing.T) {
+	tt := newClusterctlTest(t)
+	cluster := &types.Cluster{
+		Name:           "cluster",
+		KubeconfigFile: "cluster.kubeconfig",
+	}
+
+	tt.e.EXPECT().Execute(tt.ctx, "move", "--from-directory", "backup/capi", "--to-kubeconfig", "cluster.kubeconfig", "--namespace", constants.EksaSystemNamespace).Return(bytes.Buffer{}, fmt.Errorf("error restoring"))
+
+	if err := tt.clusterctl.RestoreManagementFromDirectory(tt.ctx, cluster, "backup/capi", ""); err == nil {
+		t.Fatal("Clusterctl.RestoreManagementFromDirectory() error = nil, want not nil")
+	}
+}
+
 func TestClusterctlMoveManagement(t *testing.T) {
 	tests := []struct {
 		testName     string
@@ -336,6 +412,8 @@ func TestClusterctlMoveManagement(t *testing.T) {
 
 func TestClusterctlUpgradeAllProvidersSucess(t *testing.T) {
 	tt := newClusterctlTest(t)
+	// The overrides layer is written under the cluster name folder.
+	tt.cluster.Name = filepath.Join(t.TempDir(), tt.cluster.Name)
 
 	changeDiff := &clusterapi.CAPIChangeDiff{
 		Core: &types.ComponentChangeDiff{
@@ -385,6 +463,8 @@ func TestClusterctlUpgradeAllProvidersSucess(t *testing.T) {
 
 func TestClusterctlUpgradeInfrastructureProvidersSucess(t *testing.T) {
 	tt := newClusterctlTest(t)
+	// The overrides layer is written under the cluster name folder.
+	tt.cluster.Name = filepath.Join(t.TempDir(), tt.cluster.Name)
 
 	changeDiff := &clusterapi.CAPIChangeDiff{
 		InfrastructureProvider: &types.ComponentChangeDiff{
@@ -407,6 +487,8 @@ func TestClusterctlUpgradeInfrastructureProvidersSucess(t *testing.T) {
 
 func TestClusterctlUpgradeInfrastructureProvidersError(t *testing.T) {
 	tt := newClusterctlTest(t)
+	// The overrides layer is written under the cluster name folder.
+	tt.cluster.Name = filepath.Join(t.TempDir(), tt.cluster.Name)
 
 	changeDiff := &clusterapi.CAPIChangeDiff{
 		InfrastructureProvider: &types.ComponentChangeDiff{
@@ -0,0 +1,212 @@
+package executables
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const (
+	// ContainerRuntimeEnv is the env var used to select the container runtime.
+	ContainerRuntimeEnv = "EKSA_CONTAINER_RUNTIME"
+
+	// DockerRuntime is the name of the Docker container runtime.
+	DockerRuntime = "docker"
+	// PodmanRuntime is the name of the Podman container runtime.
+	PodmanRuntime = "podman"
+	// NerdctlRuntime is the name of the nerdctl (containerd) container runtime.
+	NerdctlRuntime = "nerdctl"
+
+	dockerSocket = "/var/run/docker.sock"
+)
+
+// ContainerRuntimes are the supported container runtimes.
+var ContainerRuntimes = []string{DockerRuntime, PodmanRuntime, NerdctlRuntime}
+
+// ContainerRuntime is a container engine used to run the tools container, the kind
+// bootstrap cluster and any other container the CLI runs in the admin machine.
+type ContainerRuntime interface {
+	// Name returns the name of the container runtime.
+	Name() string
+
+	// Binary returns the name of the container runtime CLI.
+	Binary() string
+
+	// Rootless returns true if the runtime runs containers without root privileges.
+	Rootless() bool
+
+	// APISocket returns the host path of a Docker compatible API socket. It's mounted in the
+	// tools container so the Docker CLI it ships can manage containers in the host. It returns
+	// an empty string if the runtime doesn't expose one.
+	APISocket() string
+
+	// KindProvider returns the kind node provider to use with the runtime when kind runs
+	// directly in the host. It returns an empty string for the kind default.
+	KindProvider() string
+
+	// RunFlags returns extra flags needed to run the tools container with the runtime.
+	RunFlags() []string
+}
+
+// ValidateContainerRuntimeName returns an error if name is not a supported container runtime.
+func ValidateContainerRuntimeName(name string) error {
+	for _, r := range ContainerRuntimes {
+		if name == r {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported container runtime %s, supported runtimes are %s", name, strings.Join(ContainerRuntimes, ", "))
+}
+
+// selectedContainerRuntime is the container runtime selected with SetContainerRuntimeName.
+// It takes precedence over the EKSA_CONTAINER_RUNTIME env var.
+var selectedContainerRuntime string
+
+// SetContainerRuntimeName selects the container runtime used by the CLI without changing the
+// process env, so it doesn't leak to the executables it runs. An empty name clears the selection.
+func SetContainerRuntimeName(name string) {
+	selectedContainerRuntime = strings.ToLower(name)
+}
+
+// ContainerRuntimeName returns the name of the container runtime selected with SetContainerRuntimeName
+// or, if none, through the EKSA_CONTAINER_RUNTIME env var. It defaults to docker.
+func ContainerRuntimeName() string {
+	if selectedContainerRuntime != "" {
+		return selectedContainerRuntime
+	}
+	if env, ok := os.LookupEnv(ContainerRuntimeEnv); ok && env != "" {
+		return strings.ToLower(env)
+	}
+	return DockerRuntime
+}
+
+// ContainerRuntimeFromEnv detects the features of the container runtime selected through the
+// EKSA_CONTAINER_RUNTIME env var, checking that it's installed and running.
+func ContainerRuntimeFromEnv(ctx context.Context) (ContainerRuntime, error) {
+	name := ContainerRuntimeName()
+	if err := ValidateContainerRuntimeName(name); err != nil {
+		return nil, err
+	}
+
+	// Docker is validated on its own through the minimum version and allocated memory checks.
+	if name == DockerRuntime {
+		return DetectContainerRuntime(ctx, name, nil)
+	}
+
+	if _, err := exec.LookPath(name); err != nil {
+		return nil, fmt.Errorf("container runtime %s is selected but the %s binary can't be found in PATH: %v", name, name, err)
+	}
+
+	return DetectContainerRuntime(ctx, name, NewExecutable(name))
+}
+
+// DetectContainerRuntime builds the ContainerRuntime for name, using cli to detect its features.
+func DetectContainerRuntime(ctx context.Context, name string, cli Executable) (ContainerRuntime, error) {
+	switch name {
+	case DockerRuntime:
+		return dockerRuntime{}, nil
+	case PodmanRuntime:
+		return detectPodman(ctx, cli)
+	case NerdctlRuntime:
+		return detectNerdctl(ctx, cli)
+	default:
+		return nil, ValidateContainerRuntimeName(name)
+	}
+}
+
+type dockerRuntime struct{}
+
+func (dockerRuntime) Name() string         { return DockerRuntime }
+func (dockerRuntime) Binary() string       { return dockerPath }
+func (dockerRuntime) Rootless() bool       { return false }
+func (dockerRuntime) APISocket() string    { return dockerSocket }
+func (dockerRuntime) KindProvider() string { return "" }
+func (dockerRuntime) RunFlags() []string   { return nil }
+
+type podmanRuntime struct {
+	rootless bool
+	socket   string
+}
+
+type podmanInfo struct {
+	Host struct {
+		Security struct {
+			Rootless bool `json:"rootless"`
+		} `json:"security"`
+		RemoteSocket struct {
+			Path   string `json:"path"`
+			Exists bool   `json:"exists"`
+		} `json:"remoteSocket"`
+	} `json:"host"`
+}
+
+func detectPodman(ctx context.Context, cli Executable) (ContainerRuntime, error) {
+	out, err := cli.Execute(ctx, "info", "--format", "json")
+	if err != nil {
+		return nil, fmt.Errorf("please check if podman is installed and working: %v", err)
+	}
+
+	info := &podmanInfo{}
+	if err := json.Unmarshal(out.Bytes(), info); err != nil {
+		return nil, fmt.Errorf("parsing podman info: %v", err)
+	}
+
+	r := podmanRuntime{rootless: info.Host.Security.Rootless}
+	if info.Host.RemoteSocket.Exists {
+		r.socket = strings.TrimPrefix(info.Host.RemoteSocket.Path, "unix://")
+	}
+
+	return r, nil
+}
+
+func (podmanRuntime) Name() string         { return PodmanRuntime }
+func (podmanRuntime) Binary() string       { return PodmanRuntime }
+func (p podmanRuntime) Rootless() bool     { return p.rootless }
+func (p podmanRuntime) APISocket() string  { return p.socket }
+func (podmanRuntime) KindProvider() string { return PodmanRuntime }
+
+// RunFlags disables SELinux labeling for the tools container, since it needs access
+// to the cluster folder and the API socket mounted from the host.
+func (podmanRuntime) RunFlags() []string {
+	return []string{"--security-opt", "label=disable"}
+}
+
+type nerdctlRuntime struct {
+	rootless bool
+}
+
+type nerdctlInfo struct {
+	SecurityOptions []string `json:"SecurityOptions"`
+}
+
+func detectNerdctl(ctx context.Context, cli Executable) (ContainerRuntime, error) {
+	out, err := cli.Execute(ctx, "info", "--format", "{{json .}}")
+	if err != nil {
+		return nil, fmt.Errorf("please check if nerdctl is installed and containerd is running: %v", err)
+	}
+
+	info := &nerdctlInfo{}
+	if err := json.Unmarshal(out.Bytes(), info); err != nil {
+		return nil, fmt.Errorf("parsing nerdctl info: %v", err)
+	}
+
+	r := nerdctlRuntime{}
+	for _, o := range info.SecurityOptions {
+		if o == "name=rootless" {
+			r.rootless = true
+		}
+	}
+
+	return r, nil
+}
+
+func (nerdctlRuntime) Name() string         { return NerdctlRuntime }
+func (nerdctlRuntime) Binary() string       { return NerdctlRuntime }
+func (n nerdctlRuntime) Rootless() bool     { return n.rootless }
+func (nerdctlRuntime) APISocket() string    { return "" }
+func (nerdctlRuntime) KindProvider() string { return NerdctlRuntime }
+func (nerdctlRuntime) RunFlags() []string   { return nil }
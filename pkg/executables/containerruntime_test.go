@@ -0,0 +1,180 @@
+package executables_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/executables/mocks"
+)
+
+func TestContainerRuntimeName(t *testing.T) {
+	tests := []struct {
+		name        string
+		envVarValue string
+		want        string
+	}{
+		{
+			name:        "not set",
+			envVarValue: "",
+			want:        executables.DockerRuntime,
+		},
+		{
+			name:        "podman",
+			envVarValue: "Podman",
+			want:        executables.PodmanRuntime,
+		},
+		{
+			name:        "nerdctl",
+			envVarValue: "nerdctl",
+			want:        executables.NerdctlRuntime,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(executables.ContainerRuntimeEnv, tt.envVarValue)
+			g := NewWithT(t)
+			g.Expect(executables.ContainerRuntimeName()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestSetContainerRuntimeName(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(executables.ContainerRuntimeEnv, executables.NerdctlRuntime)
+	executables.SetContainerRuntimeName("Podman")
+	t.Cleanup(func() { executables.SetContainerRuntimeName("") })
+
+	g.Expect(executables.ContainerRuntimeName()).To(Equal(executables.PodmanRuntime))
+	g.Expect(os.Getenv(executables.ContainerRuntimeEnv)).To(Equal(executables.NerdctlRuntime))
+}
+
+func TestValidateContainerRuntimeName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(executables.ValidateContainerRuntimeName(executables.PodmanRuntime)).To(Succeed())
+	g.Expect(executables.ValidateContainerRuntimeName("crio")).To(
+		MatchError("unsupported container runtime crio, supported runtimes are docker, podman, nerdctl"),
+	)
+}
+
+func TestContainerRuntimeFromEnvUnsupported(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(executables.ContainerRuntimeEnv, "crio")
+	_, err := executables.ContainerRuntimeFromEnv(context.Background())
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported container runtime crio")))
+}
+
+func TestDetectContainerRuntimeDocker(t *testing.T) {
+	g := NewWithT(t)
+	r, err := executables.DetectContainerRuntime(context.Background(), executables.DockerRuntime, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r.Name()).To(Equal("docker"))
+	g.Expect(r.Binary()).To(Equal("docker"))
+	g.Expect(r.Rootless()).To(BeFalse())
+	g.Expect(r.APISocket()).To(Equal("/var/run/docker.sock"))
+	g.Expect(r.KindProvider()).To(BeEmpty())
+	g.Expect(r.RunFlags()).To(BeEmpty())
+}
+
+func TestDetectContainerRuntimePodman(t *testing.T) {
+	tests := []struct {
+		name         string
+		info         string
+		wantRootless bool
+		wantSocket   string
+	}{
+		{
+			name:       "rootful",
+			info:       `{"host":{"security":{"rootless":false},"remoteSocket":{"path":"/run/podman/podman.sock","exists":true}}}`,
+			wantSocket: "/run/podman/podman.sock",
+		},
+		{
+			name:         "rootless",
+			info:         `{"host":{"security":{"rootless":true},"remoteSocket":{"path":"unix:///run/user/1000/podman/podman.sock","exists":true}}}`,
+			wantRootless: true,
+			wantSocket:   "/run/user/1000/podman/podman.sock",
+		},
+		{
+			name:         "socket not enabled",
+			info:         `{"host":{"security":{"rootless":true},"remoteSocket":{"path":"/run/user/1000/podman/podman.sock","exists":false}}}`,
+			wantRootless: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			e := mocks.NewMockExecutable(gomock.NewController(t))
+			e.EXPECT().Execute(ctx, "info", "--format", "json").Return(*bytes.NewBufferString(tt.info), nil)
+
+			r, err := executables.DetectContainerRuntime(ctx, executables.PodmanRuntime, e)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(r.Name()).To(Equal("podman"))
+			g.Expect(r.Binary()).To(Equal("podman"))
+			g.Expect(r.Rootless()).To(Equal(tt.wantRootless))
+			g.Expect(r.APISocket()).To(Equal(tt.wantSocket))
+			g.Expect(r.KindProvider()).To(Equal("podman"))
+			g.Expect(r.RunFlags()).To(Equal([]string{"--security-opt", "label=disable"}))
+		})
+	}
+}
+
+func TestDetectContainerRuntimePodmanError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	e := mocks.NewMockExecutable(gomock.NewController(t))
+	e.EXPECT().Execute(ctx, "info", "--format", "json").Return(bytes.Buffer{}, errors.New("cannot connect"))
+
+	_, err := executables.DetectContainerRuntime(ctx, executables.PodmanRuntime, e)
+	g.Expect(err).To(MatchError(ContainSubstring("please check if podman is installed and working: cannot connect")))
+}
+
+func TestDetectContainerRuntimeNerdctl(t *testing.T) {
+	tests := []struct {
+		name         string
+		info         string
+		wantRootless bool
+	}{
+		{
+			name: "rootful",
+			info: `{"SecurityOptions":["name=seccomp,profile=default"]}`,
+		},
+		{
+			name:         "rootless",
+			info:         `{"SecurityOptions":["name=seccomp,profile=default","name=rootless"]}`,
+			wantRootless: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			e := mocks.NewMockExecutable(gomock.NewController(t))
+			e.EXPECT().Execute(ctx, "info", "--format", "{{json .}}").Return(*bytes.NewBufferString(tt.info), nil)
+
+			r, err := executables.DetectContainerRuntime(ctx, executables.NerdctlRuntime, e)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(r.Name()).To(Equal("nerdctl"))
+			g.Expect(r.Binary()).To(Equal("nerdctl"))
+			g.Expect(r.Rootless()).To(Equal(tt.wantRootless))
+			g.Expect(r.APISocket()).To(BeEmpty())
+			g.Expect(r.KindProvider()).To(Equal("nerdctl"))
+		})
+	}
+}
+
+func TestDetectContainerRuntimeNerdctlInvalidInfo(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	e := mocks.NewMockExecutable(gomock.NewController(t))
+	e.EXPECT().Execute(ctx, "info", "--format", "{{json .}}").Return(*bytes.NewBufferString("not json"), nil)
+
+	_, err := executables.DetectContainerRuntime(ctx, executables.NerdctlRuntime, e)
+	g.Expect(err).To(MatchError(ContainSubstring("parsing nerdctl info")))
+}
@@ -137,7 +137,8 @@ func (d *Docker) ForceRemove(ctx context.Context, name string) error {
 	return nil
 }
 
-// CheckContainerExistence checks whether a Docker container with the provided name exists
+// CheckContainerExistence checks whether a Docker container with the provided name exists.
+// The not found error is matched case insensitively, since podman and nerdctl don't capitalize it.
 // It returns true if a container with the name exists, false if it doesn't and an error if it encounters some other error.
 func (d *Docker) CheckContainerExistence(ctx context.Context, name string) (bool, error) {
 	params := []string{"container", "inspect", name}
@@ -145,7 +146,7 @@ func (d *Docker) CheckContainerExistence(ctx context.Context, name string) (bool
 	_, err := d.Execute(ctx, params...)
 	if err == nil {
 		return true, nil
-	} else if strings.Contains(err.Error(), "No such container") {
+	} else if strings.Contains(strings.ToLower(err.Error()), "no such container") {
 		return false, nil
 	}
 
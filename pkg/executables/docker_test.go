@@ -264,6 +264,22 @@ func TestDockerCheckContainerExistenceDoesNotExists(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestDockerCheckContainerExistenceDoesNotExistsLowercase(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	name := "basic_test"
+
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	d := executables.NewDocker(executable)
+
+	executable.EXPECT().Execute(ctx, "container", "inspect", name).Return(bytes.Buffer{}, fmt.Errorf("Error: no such container %s", name))
+
+	exists, err := d.CheckContainerExistence(ctx, name)
+	assert.False(t, exists)
+	assert.Nil(t, err)
+}
+
 func TestDockerCheckContainerExistenceOtherError(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
@@ -9,17 +9,25 @@ type DockerContainer interface {
 }
 
 func NewDockerExecutableBuilder(dockerContainer DockerContainer) *dockerExecutableBuilder {
+	return NewContainerExecutableBuilder(dockerContainer, dockerRuntime{})
+}
+
+// NewContainerExecutableBuilder returns a builder for executables that run in dockerContainer
+// through the given container runtime.
+func NewContainerExecutableBuilder(dockerContainer DockerContainer, runtime ContainerRuntime) *dockerExecutableBuilder {
 	return &dockerExecutableBuilder{
-		container: dockerContainer,
+		container:  dockerContainer,
+		runtimeCLI: runtime.Binary(),
 	}
 }
 
 type dockerExecutableBuilder struct {
-	container DockerContainer
+	container  DockerContainer
+	runtimeCLI string
 }
 
 func (d *dockerExecutableBuilder) Build(binaryName string) Executable {
-	return NewDockerExecutable(binaryName, d.container.ContainerName())
+	return NewContainerExecutable(d.runtimeCLI, binaryName, d.container.ContainerName())
 }
 
 func (b *dockerExecutableBuilder) Init(ctx context.Context) (Closer, error) {
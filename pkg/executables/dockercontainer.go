@@ -25,17 +25,19 @@ type dockerContainer struct {
 	mountDirs           []string
 	containerName       string
 	dockerClient        DockerClient
+	runtime             ContainerRuntime
 	initOnce, closeOnce sync.Once
 	*retrier.Retrier
 }
 
-func newDockerContainer(image, workingDir string, mountDirs []string, dockerClient DockerClient) *dockerContainer {
+func newDockerContainer(image, workingDir string, mountDirs []string, dockerClient DockerClient, runtime ContainerRuntime) *dockerContainer {
 	return &dockerContainer{
 		image:         image,
 		workingDir:    workingDir,
 		mountDirs:     mountDirs,
 		containerName: containerNamePrefix + strconv.FormatInt(time.Now().UnixNano(), 10),
 		dockerClient:  dockerClient,
+		runtime:       runtime,
 		Retrier:       retrier.NewWithMaxRetries(maxRetries, backOffPeriod),
 	}
 }
@@ -43,6 +45,7 @@ func newDockerContainer(image, workingDir string, mountDirs []string, dockerClie
 func NewDockerContainerCustomBinary(docker DockerClient) *dockerContainer {
 	return &dockerContainer{
 		dockerClient: docker,
+		runtime:      dockerRuntime{},
 	}
 }
 
@@ -63,7 +66,13 @@ func (d *dockerContainer) Init(ctx context.Context) error {
 			return
 		}
 
-		params := []string{"run", "-d", "--name", d.containerName, "--network", "host", "-w", absWorkingDir, "-v", "/var/run/docker.sock:/var/run/docker.sock"}
+		params := []string{"run", "-d", "--name", d.containerName, "--network", "host", "-w", absWorkingDir}
+		params = append(params, d.runtime.RunFlags()...)
+		// The tools container ships the docker CLI, so the runtime API socket is always
+		// mounted in the default docker location.
+		if socket := d.runtime.APISocket(); socket != "" {
+			params = append(params, "-v", fmt.Sprintf("%s:%s", socket, dockerSocket))
+		}
 
 		for _, m := range d.mountDirs {
 			var absMountDir string
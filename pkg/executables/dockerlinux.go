@@ -11,14 +11,22 @@ const containerNamePrefix = "eksa_"
 type linuxDockerExecutable struct {
 	cli           string
 	containerName string
+	runtimeCLI    string
 }
 
 // This currently returns a linuxDockerExecutable, but if we support other types of docker executables we can change
 // the name of this constructor.
 func NewDockerExecutable(cli string, containerName string) Executable {
+	return NewContainerExecutable(dockerPath, cli, containerName)
+}
+
+// NewContainerExecutable returns an Executable that runs cli in the container containerName
+// using the runtimeCLI container runtime binary.
+func NewContainerExecutable(runtimeCLI, cli, containerName string) Executable {
 	return &linuxDockerExecutable{
 		cli:           cli,
 		containerName: containerName,
+		runtimeCLI:    runtimeCLI,
 	}
 }
 
@@ -39,7 +47,7 @@ func (e *linuxDockerExecutable) Command(ctx context.Context, args ...string) *Co
 }
 
 func (e *linuxDockerExecutable) Run(cmd *Command) (stdout bytes.Buffer, err error) {
-	return execute(cmd.ctx, "docker", cmd.stdIn, cmd.envVars, e.buildCommand(cmd.envVars, e.cli, cmd.args...)...)
+	return execute(cmd.ctx, e.runtimeCLI, cmd.stdIn, cmd.envVars, e.buildCommand(cmd.envVars, e.cli, cmd.args...)...)
 }
 
 func (e *linuxDockerExecutable) buildCommand(envs map[string]string, cli string, args ...string) []string {
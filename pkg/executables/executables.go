@@ -44,6 +44,18 @@ type executable struct {
 	cli string
 }
 
+// ExitError is returned when a command runs and exits with a non zero code.
+type ExitError struct {
+	// Message is the stderr of the command or, when empty, the exec error.
+	Message string
+	// Code is the exit code of the command.
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return e.Message
+}
+
 type Executable interface {
 	Execute(ctx context.Context, args ...string) (stdout bytes.Buffer, err error)
 	ExecuteWithEnv(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) // TODO: remove this from interface in favor of Command
@@ -118,13 +130,13 @@ func execute(ctx context.Context, cli string, in []byte, envVars map[string]stri
 			if logger.MaxLogging() {
 				logger.V(logger.MaxLogLevel).Info(cli, "stderr", stderr.String())
 			}
-			return stdout, errors.New(stderr.String())
+			return stdout, commandError(stderr.String(), err)
 		} else {
 			if !logger.MaxLogging() {
 				logger.V(8).Info(cli, "stdout", stdout.String())
 				logger.V(8).Info(cli, "stderr", stderr.String())
 			}
-			return stdout, errors.New(fmt.Sprint(err))
+			return stdout, commandError(fmt.Sprint(err), err)
 		}
 	}
 	if !logger.MaxLogging() {
@@ -133,3 +145,13 @@ func execute(ctx context.Context, cli string, in []byte, envVars map[string]stri
 	}
 	return stdout, nil
 }
+
+// commandError builds the error for a failed command with the given message,
+// keeping the exit code when the command ran.
+func commandError(message string, err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &ExitError{Message: message, Code: exitErr.ExitCode()}
+	}
+	return errors.New(message)
+}
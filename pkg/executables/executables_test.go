@@ -1,6 +1,8 @@
 package executables_test
 
 import (
+	"context"
+	"errors"
 	"os"
 	"testing"
 
@@ -22,3 +24,18 @@ func TestRedactCreds(t *testing.T) {
 		t.Fatalf("executables.RedactCreds expected = %s, got = %s", expected, redactedStr)
 	}
 }
+
+func TestExecuteExitError(t *testing.T) {
+	_, err := executables.NewExecutable("sh").Execute(context.Background(), "-c", "echo failed >&2; exit 3")
+
+	var exitErr *executables.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("Execute() error = %v, want ExitError", err)
+	}
+	if exitErr.Code != 3 {
+		t.Errorf("Execute() exit code = %d, want 3", exitErr.Code)
+	}
+	if exitErr.Error() != "failed\n" {
+		t.Errorf("Execute() error = %q, want the command stderr", exitErr.Error())
+	}
+}
@@ -12,3 +12,11 @@ func KubectlWaitRetryPolicy(k *Kubectl, totalRetries int, err error) (retry bool
 func CallKubectlPrivateWait(k *Kubectl, ctx context.Context, kubeconfig string, timeoutTime time.Time, forCondition string, property string, namespace string) error {
 	return k.wait(ctx, kubeconfig, timeoutTime, forCondition, property, namespace)
 }
+
+func WithEnv(e Executable, env map[string]string) Executable {
+	return withEnv(e, env)
+}
+
+func CommandEnvVars(c *Command) map[string]string {
+	return c.envVars
+}
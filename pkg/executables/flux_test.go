@@ -149,6 +149,94 @@ func TestFluxInstallGithubToolkitsSuccess(t *testing.T) {
 	}
 }
 
+func TestFluxInstallGitlabToolkitsSuccess(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Setenv("EKSA_GITLAB_TOKEN", "glpat-token")
+
+	owner := "platform/clusters"
+	repo := "gitops-fleet"
+	path := "clusters/cluster-name"
+
+	tests := []struct {
+		testName     string
+		cluster      *types.Cluster
+		fluxConfig   *v1alpha1.FluxConfig
+		wantExecArgs []interface{}
+	}{
+		{
+			testName: "self-hosted",
+			cluster: &types.Cluster{
+				KubeconfigFile: "f.kubeconfig",
+			},
+			fluxConfig: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					ClusterConfigPath: path,
+					Branch:            "main",
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Hostname:   "gitlab.example.com",
+						Owner:      owner,
+						Repository: repo,
+					},
+				},
+			},
+			wantExecArgs: []interface{}{
+				"bootstrap", "gitlab", "--hostname", "gitlab.example.com", "--repository", repo, "--owner", owner, "--path", path, "--ssh-key-algorithm", "ecdsa", "--kubeconfig", "f.kubeconfig", "--branch", "main",
+			},
+		},
+		{
+			testName: "with personal and default hostname",
+			cluster:  &types.Cluster{},
+			fluxConfig: &v1alpha1.FluxConfig{
+				Spec: v1alpha1.FluxConfigSpec{
+					ClusterConfigPath: path,
+					Gitlab: &v1alpha1.GitlabProviderConfig{
+						Owner:      "janedoe",
+						Repository: repo,
+						Personal:   true,
+					},
+				},
+			},
+			wantExecArgs: []interface{}{
+				"bootstrap", "gitlab", "--hostname", "gitlab.com", "--repository", repo, "--owner", "janedoe", "--path", path, "--ssh-key-algorithm", "ecdsa", "--personal",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctx := context.Background()
+			executable := mockexecutables.NewMockExecutable(mockCtrl)
+			env := map[string]string{"GITLAB_TOKEN": "glpat-token"}
+			executable.EXPECT().ExecuteWithEnv(
+				ctx,
+				env,
+				tt.wantExecArgs...,
+			).Return(bytes.Buffer{}, nil)
+
+			f := executables.NewFlux(executable)
+			if err := f.BootstrapGitlab(ctx, tt.cluster, tt.fluxConfig); err != nil {
+				t.Errorf("flux.BootstrapGitlab() error = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestFluxInstallGitlabToolkitsNoToken(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Setenv("EKSA_GITLAB_TOKEN", "")
+	executable := mockexecutables.NewMockExecutable(mockCtrl)
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Gitlab: &v1alpha1.GitlabProviderConfig{Owner: "janedoe", Repository: "gitops-fleet"},
+		},
+	}
+
+	f := executables.NewFlux(executable)
+	if err := f.BootstrapGitlab(context.Background(), &types.Cluster{}, fluxConfig); err == nil {
+		t.Error("flux.BootstrapGitlab() error = nil, want not nil")
+	}
+}
+
 func TestFluxUninstallGitOpsToolkitsComponents(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 
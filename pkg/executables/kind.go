@@ -23,6 +23,9 @@ import (
 
 const kindPath = "kind"
 
+// kindProviderEnv selects the container runtime kind uses for the cluster nodes.
+const kindProviderEnv = "KIND_EXPERIMENTAL_PROVIDER"
+
 //go:embed config/kind.yaml
 var kindConfigTemplate string
 
@@ -49,16 +52,29 @@ type kindExecConfig struct {
 	KubernetesVersion    string
 	RegistryMirrorMap    map[string]string
 	MirrorBase           string
+	MirrorCACert         bool
 	RegistryCACertPath   string
 	RegistryAuth         bool
 	RegistryUsername     string
 	RegistryPassword     string
-	ExtraPortMappings    []int
-	DockerExtraMounts    bool
-	DisableDefaultCNI    bool
-	PodSubnet            string
-	ServiceSubnet        string
-	AuditPolicyPath      string
+	// RegistryMirrorFallbacks maps each registry to the ordered list of its mirrors in the fallback endpoints.
+	RegistryMirrorFallbacks         map[string][]string
+	RegistryMirrorFallbackEndpoints []kindRegistryMirrorEndpoint
+	ExtraPortMappings               []int
+	DockerExtraMounts               bool
+	DisableDefaultCNI               bool
+	PodSubnet                       string
+	ServiceSubnet                   string
+	AuditPolicyPath                 string
+}
+
+type kindRegistryMirrorEndpoint struct {
+	Base               string
+	CACert             bool
+	InsecureSkipVerify bool
+	Auth               bool
+	Username           string
+	Password           string
 }
 
 func NewKind(executable Executable, writer filewriter.FileWriter) *Kind {
@@ -229,14 +245,10 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 		k.execConfig.MirrorBase = registryMirror.BaseRegistry
 		k.execConfig.RegistryMirrorMap = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
 		if registryMirror.CACertContent != "" {
-			path := filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d", registryMirror.BaseRegistry)
-			if err := os.MkdirAll(path, os.ModePerm); err != nil {
+			if err := k.writeRegistryCACert(clusterSpec.Cluster.Name, registryMirror.BaseRegistry, registryMirror.CACertContent); err != nil {
 				return err
 			}
-			if err := os.WriteFile(filepath.Join(path, "ca.crt"), []byte(registryMirror.CACertContent), 0o644); err != nil {
-				return errors.New("error writing the registry certification file")
-			}
-			k.execConfig.RegistryCACertPath = filepath.Join(clusterSpec.Cluster.Name, "generated", "certs.d")
+			k.execConfig.MirrorCACert = true
 		}
 		if registryMirror.Auth {
 			k.execConfig.RegistryAuth = registryMirror.Auth
@@ -247,6 +259,9 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 			k.execConfig.RegistryUsername = username
 			k.execConfig.RegistryPassword = password
 		}
+		if err := k.setupRegistryMirrorFallbacks(clusterSpec.Cluster.Name, registryMirror); err != nil {
+			return err
+		}
 	}
 	if err := k.CreateAuditPolicy(clusterSpec); err != nil {
 		return err
@@ -254,6 +269,48 @@ func (k *Kind) setupExecConfig(clusterSpec *cluster.Spec) error {
 	return nil
 }
 
+// setupRegistryMirrorFallbacks configures containerd in the kind cluster to pull from the registry mirror
+// fallback endpoints, in order, when the registry mirror endpoint is not reachable.
+func (k *Kind) setupRegistryMirrorFallbacks(clusterName string, registryMirror *registrymirror.RegistryMirror) error {
+	k.execConfig.RegistryMirrorFallbacks = containerd.ToAPIEndpointLists(registryMirror.FallbackRegistryMap())
+	for i, fallback := range registryMirror.Fallbacks {
+		endpoint := kindRegistryMirrorEndpoint{
+			Base:               fallback.BaseRegistry,
+			InsecureSkipVerify: fallback.InsecureSkipVerify,
+		}
+		if fallback.CACertContent != "" {
+			if err := k.writeRegistryCACert(clusterName, fallback.BaseRegistry, fallback.CACertContent); err != nil {
+				return err
+			}
+			endpoint.CACert = true
+		}
+		if fallback.Auth {
+			username, password, err := config.ReadFallbackCredentials(i)
+			if err != nil {
+				return err
+			}
+			endpoint.Auth = true
+			endpoint.Username = username
+			endpoint.Password = password
+		}
+		k.execConfig.RegistryMirrorFallbackEndpoints = append(k.execConfig.RegistryMirrorFallbackEndpoints, endpoint)
+	}
+	return nil
+}
+
+// writeRegistryCACert writes the CA certificate of a registry to the certs.d folder mounted in the kind node.
+func (k *Kind) writeRegistryCACert(clusterName, registry, caCert string) error {
+	path := filepath.Join(clusterName, "generated", "certs.d", registry)
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(path, "ca.crt"), []byte(caCert), 0o644); err != nil {
+		return errors.New("error writing the registry certification file")
+	}
+	k.execConfig.RegistryCACertPath = filepath.Join(clusterName, "generated", "certs.d")
+	return nil
+}
+
 func (k *Kind) cleanExecConfig() {
 	k.execConfig = nil
 }
@@ -27,6 +27,7 @@ import (
 type testKindOption func(k *executables.Kind) bootstrapper.BootstrapClusterClientOption
 
 func TestKindCreateBootstrapClusterSuccess(t *testing.T) {
+	wd := test.ChdirTemp(t)
 	_, writer := test.NewWriter(t)
 
 	clusterName := "test_cluster"
@@ -130,7 +131,7 @@ func TestKindCreateBootstrapClusterSuccess(t *testing.T) {
 			).Return(bytes.Buffer{}, nil).Times(1).Do(
 				func(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
 					gotKindConfig := args[9]
-					test.AssertFilesEquals(t, gotKindConfig, tt.wantKindConfig)
+					test.AssertFilesEquals(t, gotKindConfig, filepath.Join(wd, tt.wantKindConfig))
 
 					return bytes.Buffer{}, nil
 				},
@@ -150,6 +151,7 @@ func TestKindCreateBootstrapClusterSuccess(t *testing.T) {
 }
 
 func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
+	wd := test.ChdirTemp(t)
 	_, writer := test.NewWriter(t)
 
 	clusterName := "test_cluster"
@@ -253,6 +255,48 @@ func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
 			env:            map[string]string{},
 			wantKindConfig: "testdata/kind_config_registry_mirror_with_auth.yaml",
 		},
+		{
+			name:           "With registry mirror option, with fallback endpoints",
+			wantKubeconfig: kubeConfigFile,
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = clusterName
+				s.VersionsBundles["1.19"] = versionBundle
+				s.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+					Endpoint:      registryMirror,
+					Port:          constants.DefaultHttpsPort,
+					CACertContent: "test",
+					OCINamespaces: []v1alpha1.OCINamespace{
+						{
+							Registry:  "public.ecr.aws",
+							Namespace: "eks-anywhere",
+						},
+					},
+					FallbackEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+						{
+							Endpoint:      "registry-mirror-2.test",
+							Port:          constants.DefaultHttpsPort,
+							CACertContent: "test-2",
+							Authenticate:  true,
+						},
+						{
+							Endpoint:           "registry-mirror-3.test",
+							Port:               constants.DefaultHttpsPort,
+							InsecureSkipVerify: true,
+						},
+					},
+				}
+				s.Cluster.Spec.ClusterNetwork = v1alpha1.ClusterNetwork{
+					Pods: v1alpha1.Pods{
+						CidrBlocks: []string{"1.1.1.1"},
+					},
+					Services: v1alpha1.Services{
+						CidrBlocks: []string{"2.2.2.2"},
+					},
+				}
+			}),
+			env:            map[string]string{},
+			wantKindConfig: "testdata/kind_config_registry_mirror_with_fallbacks.yaml",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -274,6 +318,10 @@ func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
 				t.Setenv("REGISTRY_USERNAME", "username")
 				t.Setenv("REGISTRY_PASSWORD", "password")
 			}
+			if len(spec.Cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints) > 0 {
+				t.Setenv("REGISTRY_USERNAME_1", "username-1")
+				t.Setenv("REGISTRY_PASSWORD_1", "password-1")
+			}
 
 			executable.EXPECT().ExecuteWithEnv(
 				ctx,
@@ -282,7 +330,7 @@ func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
 			).Return(bytes.Buffer{}, nil).Times(1).Do(
 				func(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
 					gotKindConfig := args[9]
-					test.AssertFilesEquals(t, gotKindConfig, tt.wantKindConfig)
+					test.AssertFilesEquals(t, gotKindConfig, filepath.Join(wd, tt.wantKindConfig))
 
 					return bytes.Buffer{}, nil
 				},
@@ -302,6 +350,7 @@ func TestKindCreateBootstrapClusterSuccessWithRegistryMirror(t *testing.T) {
 }
 
 func TestKindCreateBootstrapClusterExecutableError(t *testing.T) {
+	test.ChdirTemp(t)
 	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
 		s.Cluster.Name = "clusterName"
 		s.VersionsBundles["1.19"] = versionBundle
@@ -325,6 +374,7 @@ func TestKindCreateBootstrapClusterExecutableError(t *testing.T) {
 }
 
 func TestKindCreateBootstrapClusterExecutableWithRegistryMirrorError(t *testing.T) {
+	test.ChdirTemp(t)
 	registryMirror := "registry-mirror.test"
 	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
 		s.Cluster.Name = "clusterName"
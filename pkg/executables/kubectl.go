@@ -317,6 +317,22 @@ func (k *Kubectl) ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Clu
 	return nil
 }
 
+// DiffFromBytes returns the differences between the objects in data and the live objects in the cluster.
+// It returns an empty string when there are no differences.
+func (k *Kubectl) DiffFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) (string, error) {
+	params := []string{"diff", "-f", "-"}
+	if cluster.KubeconfigFile != "" {
+		params = append(params, "--kubeconfig", cluster.KubeconfigFile)
+	}
+	stdOut, err := k.ExecuteWithStdin(ctx, data, params...)
+	// kubectl diff exits with 1 when it finds differences, with the diff in stdout, and with >1 when it fails.
+	var exitErr *ExitError
+	if err != nil && (!errors.As(err, &exitErr) || exitErr.Code != 1) {
+		return "", fmt.Errorf("executing diff: %v", err)
+	}
+	return stdOut.String(), nil
+}
+
 func (k *Kubectl) ApplyKubeSpecFromBytesWithNamespace(ctx context.Context, cluster *types.Cluster, data []byte, namespace string) error {
 	if len(data) == 0 {
 		logger.V(6).Info("Skipping applying empty kube spec from bytes")
@@ -358,6 +374,32 @@ func (k *Kubectl) DeleteManifest(ctx context.Context, kubeconfigPath, manifestPa
 	return nil
 }
 
+// ApplyKustomization renders the kustomization in dir and applies it server-side, taking ownership of
+// any conflicting fields.
+func (k *Kubectl) ApplyKustomization(ctx context.Context, cluster *types.Cluster, dir string) error {
+	params := []string{"apply", "-k", dir, "--server-side", "--force-conflicts"}
+	if cluster.KubeconfigFile != "" {
+		params = append(params, "--kubeconfig", cluster.KubeconfigFile)
+	}
+	if _, err := k.Execute(ctx, params...); err != nil {
+		return fmt.Errorf("executing apply kustomization: %v", err)
+	}
+	return nil
+}
+
+// DeleteKustomization renders the kustomization in dir and deletes all the objects it contains,
+// ignoring the ones that don't exist.
+func (k *Kubectl) DeleteKustomization(ctx context.Context, cluster *types.Cluster, dir string) error {
+	params := []string{"delete", "-k", dir, "--ignore-not-found"}
+	if cluster.KubeconfigFile != "" {
+		params = append(params, "--kubeconfig", cluster.KubeconfigFile)
+	}
+	if _, err := k.Execute(ctx, params...); err != nil {
+		return fmt.Errorf("executing delete kustomization: %v", err)
+	}
+	return nil
+}
+
 func (k *Kubectl) DeleteKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error {
 	params := []string{"delete", "-f", "-"}
 	if cluster.KubeconfigFile != "" {
@@ -182,6 +182,54 @@ func TestKubectlApplyManifestError(t *testing.T) {
 	}
 }
 
+func TestKubectlApplyKustomizationSuccess(t *testing.T) {
+	t.Parallel()
+	dir := "argocd"
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"apply", "-k", dir, "--server-side", "--force-conflicts", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().Execute(ctx, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, nil)
+	if err := k.ApplyKustomization(ctx, cluster, dir); err != nil {
+		t.Errorf("Kubectl.ApplyKustomization() error = %v, want nil", err)
+	}
+}
+
+func TestKubectlApplyKustomizationError(t *testing.T) {
+	t.Parallel()
+	dir := "argocd"
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"apply", "-k", dir, "--server-side", "--force-conflicts", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().Execute(ctx, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, errors.New("error from execute"))
+	if err := k.ApplyKustomization(ctx, cluster, dir); err == nil {
+		t.Errorf("Kubectl.ApplyKustomization() error = nil, want not nil")
+	}
+}
+
+func TestKubectlDeleteKustomizationSuccess(t *testing.T) {
+	t.Parallel()
+	dir := "argocd"
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"delete", "-k", dir, "--ignore-not-found", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().Execute(ctx, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, nil)
+	if err := k.DeleteKustomization(ctx, cluster, dir); err != nil {
+		t.Errorf("Kubectl.DeleteKustomization() error = %v, want nil", err)
+	}
+}
+
+func TestKubectlDeleteKustomizationError(t *testing.T) {
+	t.Parallel()
+	dir := "argocd"
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"delete", "-k", dir, "--ignore-not-found", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().Execute(ctx, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, errors.New("error from execute"))
+	if err := k.DeleteKustomization(ctx, cluster, dir); err == nil {
+		t.Errorf("Kubectl.DeleteKustomization() error = nil, want not nil")
+	}
+}
+
 func TestKubectlApplyKubeSpecFromBytesSuccess(t *testing.T) {
 	t.Parallel()
 	var data []byte
@@ -206,6 +254,62 @@ func TestKubectlApplyKubeSpecFromBytesError(t *testing.T) {
 	}
 }
 
+func TestKubectlDiffFromBytesNoDifferences(t *testing.T) {
+	t.Parallel()
+	data := []byte("data")
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"diff", "-f", "-", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().ExecuteWithStdin(ctx, data, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, nil)
+	diff, err := k.DiffFromBytes(ctx, cluster, data)
+	if err != nil {
+		t.Errorf("Kubectl.DiffFromBytes() error = %v, want nil", err)
+	}
+	if diff != "" {
+		t.Errorf("Kubectl.DiffFromBytes() diff = %s, want empty", diff)
+	}
+}
+
+func TestKubectlDiffFromBytesWithDifferences(t *testing.T) {
+	t.Parallel()
+	data := []byte("data")
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"diff", "-f", "-", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().ExecuteWithStdin(ctx, data, gomock.Eq(expectedParam)).Return(*bytes.NewBufferString("-  replicas: 1\n+  replicas: 2\n"), &executables.ExitError{Message: "exit status 1", Code: 1})
+	diff, err := k.DiffFromBytes(ctx, cluster, data)
+	if err != nil {
+		t.Errorf("Kubectl.DiffFromBytes() error = %v, want nil", err)
+	}
+	if diff != "-  replicas: 1\n+  replicas: 2\n" {
+		t.Errorf("Kubectl.DiffFromBytes() diff = %s, want the kubectl output", diff)
+	}
+}
+
+func TestKubectlDiffFromBytesExitCodeError(t *testing.T) {
+	t.Parallel()
+	data := []byte("data")
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"diff", "-f", "-", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().ExecuteWithStdin(ctx, data, gomock.Eq(expectedParam)).Return(*bytes.NewBufferString("partial output"), &executables.ExitError{Message: "error from diff", Code: 2})
+	if _, err := k.DiffFromBytes(ctx, cluster, data); err == nil {
+		t.Errorf("Kubectl.DiffFromBytes() error = nil, want not nil")
+	}
+}
+
+func TestKubectlDiffFromBytesError(t *testing.T) {
+	t.Parallel()
+	data := []byte("data")
+
+	k, ctx, cluster, e := newKubectl(t)
+	expectedParam := []string{"diff", "-f", "-", "--kubeconfig", cluster.KubeconfigFile}
+	e.EXPECT().ExecuteWithStdin(ctx, data, gomock.Eq(expectedParam)).Return(bytes.Buffer{}, errors.New("error from execute"))
+	if _, err := k.DiffFromBytes(ctx, cluster, data); err == nil {
+		t.Errorf("Kubectl.DiffFromBytes() error = nil, want not nil")
+	}
+}
+
 func TestKubectlDeleteManifestSuccess(t *testing.T) {
 	t.Parallel()
 	spec := "specfile"
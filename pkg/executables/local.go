@@ -1,6 +1,9 @@
 package executables
 
-import "context"
+import (
+	"bytes"
+	"context"
+)
 
 type localExecutableBuilder struct{}
 
@@ -19,3 +22,46 @@ func (b localExecutableBuilder) Init(_ context.Context) (Closer, error) {
 func NoOpClose(ctx context.Context) error {
 	return nil
 }
+
+// envExecutable is an Executable that always runs its commands with a set of env vars.
+type envExecutable struct {
+	Executable
+	env map[string]string
+}
+
+func withEnv(e Executable, env map[string]string) Executable {
+	return &envExecutable{
+		Executable: e,
+		env:        env,
+	}
+}
+
+func (e *envExecutable) Execute(ctx context.Context, args ...string) (stdout bytes.Buffer, err error) {
+	return e.Command(ctx, args...).Run()
+}
+
+func (e *envExecutable) ExecuteWithStdin(ctx context.Context, in []byte, args ...string) (stdout bytes.Buffer, err error) {
+	return e.Command(ctx, args...).WithStdIn(in).Run()
+}
+
+func (e *envExecutable) ExecuteWithEnv(ctx context.Context, envs map[string]string, args ...string) (stdout bytes.Buffer, err error) {
+	return e.Command(ctx, args...).WithEnvVars(envs).Run()
+}
+
+func (e *envExecutable) Command(ctx context.Context, args ...string) *Command {
+	return NewCommand(ctx, e, args...)
+}
+
+func (e *envExecutable) Run(cmd *Command) (stdout bytes.Buffer, err error) {
+	envs := make(map[string]string, len(e.env)+len(cmd.envVars))
+	for k, v := range e.env {
+		envs[k] = v
+	}
+	// Env vars set for a particular command take precedence.
+	for k, v := range cmd.envVars {
+		envs[k] = v
+	}
+	cmd.envVars = envs
+
+	return e.Executable.Run(cmd)
+}
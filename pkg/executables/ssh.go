@@ -24,17 +24,30 @@ func NewSSH(executable Executable) *SSH {
 
 // RunCommand runs a command on the host using SSH.
 func (s *SSH) RunCommand(ctx context.Context, privateKeyPath, username, IP string, command ...string) (string, error) {
-	params := []string{
-		"-i", privateKeyPath,
-		"-o", strictHostCheckFlag,
-		fmt.Sprintf("%s@%s", username, IP),
+	out, err := s.Executable.Execute(ctx, sshParams(privateKeyPath, username, IP, command)...)
+	if err != nil {
+		return "", fmt.Errorf("running SSH command: %v", err)
 	}
-	params = append(params, command...)
 
-	out, err := s.Executable.Execute(ctx, params...)
+	return out.String(), nil
+}
+
+// RunCommandWithStdin runs a command on the host using SSH, streaming in to its stdin.
+// Use it to send sensitive content so it isn't exposed in the command line.
+func (s *SSH) RunCommandWithStdin(ctx context.Context, in []byte, privateKeyPath, username, IP string, command ...string) (string, error) {
+	out, err := s.Executable.ExecuteWithStdin(ctx, in, sshParams(privateKeyPath, username, IP, command)...)
 	if err != nil {
 		return "", fmt.Errorf("running SSH command: %v", err)
 	}
 
 	return out.String(), nil
 }
+
+func sshParams(privateKeyPath, username, IP string, command []string) []string {
+	params := []string{
+		"-i", privateKeyPath,
+		"-o", strictHostCheckFlag,
+		fmt.Sprintf("%s@%s", username, IP),
+	}
+	return append(params, command...)
+}
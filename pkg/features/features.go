@@ -10,6 +10,7 @@ const (
 	APIServerExtraArgsEnabledEnvVar   = "API_SERVER_EXTRA_ARGS_ENABLED"
 	VSphereFailureDomainEnabledEnvVar = "VSPHERE_FAILURE_DOMAIN_ENABLED"
 	K8s133SupportEnvVar               = "K8S_1_33_SUPPORT"
+	SequentialTasksEnvVar             = "SEQUENTIAL_TASKS"
 )
 
 func FeedGates(featureGates []string) {
@@ -82,3 +83,11 @@ func K8s133Support() Feature {
 		IsActive: globalFeatures.isActiveForEnvVar(K8s133SupportEnvVar),
 	}
 }
+
+// SequentialTasks is the feature flag for running tasks that don't depend on each other one at a time.
+func SequentialTasks() Feature {
+	return Feature{
+		Name:     "Run independent tasks sequentially",
+		IsActive: globalFeatures.isActiveForEnvVar(SequentialTasksEnvVar),
+	}
+}
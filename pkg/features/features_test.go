@@ -101,3 +101,11 @@ func TestWithK8s133FeatureFlag(t *testing.T) {
 	g.Expect(os.Setenv(K8s133SupportEnvVar, "true")).To(Succeed())
 	g.Expect(IsActive(K8s133Support())).To(BeTrue())
 }
+
+func TestWithSequentialTasksFeatureFlag(t *testing.T) {
+	g := NewWithT(t)
+	setupContext(t)
+
+	g.Expect(os.Setenv(SequentialTasksEnvVar, "true")).To(Succeed())
+	g.Expect(IsActive(SequentialTasks())).To(BeTrue())
+}
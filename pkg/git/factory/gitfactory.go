@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path"
 	"path/filepath"
-	"strings"
 
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -19,15 +17,22 @@ import (
 	"github.com/aws/eks-anywhere/pkg/git"
 	"github.com/aws/eks-anywhere/pkg/git/gitclient"
 	"github.com/aws/eks-anywhere/pkg/git/gogithub"
+	"github.com/aws/eks-anywhere/pkg/git/gogitlab"
 	"github.com/aws/eks-anywhere/pkg/git/providers/codecommit"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitssh"
 )
 
+const knownHostsFileName = "known_hosts"
+
 type GitTools struct {
 	Provider            git.ProviderClient
 	Client              git.Client
 	Writer              filewriter.FileWriter
 	RepositoryDirectory string
+	// KnownHostsFile is the known hosts file written from the host keys pinned in the FluxConfig, if any.
+	KnownHostsFile string
 }
 
 type GitToolsOpt func(opts *GitTools)
@@ -54,10 +59,32 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 		gitAuth = &http.BasicAuth{Password: githubToken, Username: fluxConfig.Spec.Github.Owner}
 		repo = fluxConfig.Spec.Github.Repository
 		repoUrl = github.RepoUrl(fluxConfig.Spec.Github.Owner, repo)
+	case fluxConfig.Spec.Gitlab != nil:
+		gitlabToken, err := gitlab.GetGitlabAccessTokenFromEnv()
+		if err != nil {
+			return nil, err
+		}
+
+		tools.Provider, err = buildGitlabProvider(gitlabToken, fluxConfig.Spec.Gitlab)
+		if err != nil {
+			return nil, fmt.Errorf("building gitlab provider: %v", err)
+		}
+
+		// GitLab accepts any username with an access token as password.
+		gitAuth = &http.BasicAuth{Password: gitlabToken, Username: "oauth2"}
+		repo = fluxConfig.Spec.Gitlab.Repository
+		repoUrl = gitlab.RepoUrl(gitlab.Hostname(fluxConfig.Spec.Gitlab), fluxConfig.Spec.Gitlab.Owner, repo)
 	case fluxConfig.Spec.Git != nil:
 		privateKeyFile := os.Getenv(config.EksaGitPrivateKeyTokenEnv)
 		privateKeyPassphrase := os.Getenv(config.EksaGitPassphraseTokenEnv)
 		gitKnownHosts := os.Getenv(config.EksaGitKnownHostsFileEnv)
+		if fluxConfig.Spec.Git.KnownHosts != "" {
+			gitKnownHosts, err = writeKnownHosts(writer, fluxConfig.Spec.Git.KnownHosts)
+			if err != nil {
+				return nil, err
+			}
+			tools.KnownHostsFile = gitKnownHosts
+		}
 		if err = os.Setenv(config.SshKnownHostsEnv, gitKnownHosts); err != nil {
 			return nil, fmt.Errorf("unable to set %s: %v", config.SshKnownHostsEnv, err)
 		}
@@ -70,11 +97,20 @@ func Build(ctx context.Context, cluster *v1alpha1.Cluster, fluxConfig *v1alpha1.
 		if codeCommitUserName != "" {
 			user = codeCommitUserName
 		}
-		gitAuth, err = getSSHAuthFromPrivateKey(privateKeyFile, privateKeyPassphrase, user)
+		sshAuth, err := getSSHAuthFromPrivateKey(privateKeyFile, privateKeyPassphrase, user)
 		if err != nil {
 			return nil, err
 		}
-		repo = path.Base(strings.TrimSuffix(repoUrl, filepath.Ext(repoUrl)))
+		// The generic SSH provider is only used when the host keys are pinned in the FluxConfig.
+		// Otherwise the repository is used without a provider, so remote checks are skipped.
+		if tools.KnownHostsFile != "" {
+			if sshAuth.HostKeyCallback, err = gogitssh.NewKnownHostsCallback(tools.KnownHostsFile); err != nil {
+				return nil, fmt.Errorf("reading pinned known hosts: %v", err)
+			}
+			tools.Provider = gitssh.New(gitssh.NewClient(repoUrl, sshAuth), repoUrl)
+		}
+		gitAuth = sshAuth
+		repo = gitssh.RepositoryName(repoUrl)
 	default:
 		return nil, fmt.Errorf("no valid git provider in FluxConfigSpec. Spec: %v", fluxConfig)
 	}
@@ -117,6 +153,33 @@ func buildGithubProvider(ctx context.Context, githubToken string, config *v1alph
 	return provider, nil
 }
 
+func buildGitlabProvider(gitlabToken string, config *v1alpha1.GitlabProviderConfig) (git.ProviderClient, error) {
+	auth := git.TokenAuth{Token: gitlabToken, Username: config.Owner}
+	gitlabProviderClient := gogitlab.New(gogitlab.Options{Auth: auth, Hostname: config.Hostname})
+	provider, err := gitlab.New(gitlabProviderClient, config, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// writeKnownHosts writes the pinned knownHosts next to the local repositories, so the flux CLI can
+// read them as well.
+func writeKnownHosts(writer filewriter.FileWriter, knownHosts string) (string, error) {
+	gitWriter, err := writer.WithDir("git")
+	if err != nil {
+		return "", fmt.Errorf("creating file writer: %v", err)
+	}
+
+	path, err := gitWriter.Write(knownHostsFileName, []byte(knownHosts), filewriter.PersistentFile)
+	if err != nil {
+		return "", fmt.Errorf("writing pinned known hosts: %v", err)
+	}
+
+	return path, nil
+}
+
 func newRepositoryWriter(writer filewriter.FileWriter, repository string) (filewriter.FileWriter, error) {
 	localGitWriterPath := filepath.Join("git", repository)
 	gitwriter, err := writer.WithDir(localGitWriterPath)
@@ -133,7 +196,7 @@ func WithRepositoryDirectory(repoDir string) GitToolsOpt {
 	}
 }
 
-func getSSHAuthFromPrivateKey(privateKeyFile string, passphrase string, user string) (gogitssh.AuthMethod, error) {
+func getSSHAuthFromPrivateKey(privateKeyFile string, passphrase string, user string) (*gogitssh.PublicKeys, error) {
 	signer, err := getSignerFromPrivateKeyFile(privateKeyFile, passphrase)
 	if err != nil {
 		return nil, err
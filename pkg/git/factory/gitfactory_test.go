@@ -2,14 +2,23 @@ package gitfactory_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/config"
 	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
 	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 )
 
 const (
@@ -65,6 +74,104 @@ func TestGitFactoryHappyPath(t *testing.T) {
 	}
 }
 
+func TestGitFactoryGitlab(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "glpat-token")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Gitlab: &v1alpha1.GitlabProviderConfig{
+				Hostname:   "gitlab.example.com",
+				Owner:      "platform",
+				Repository: "fleet",
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tools.Provider).NotTo(BeNil())
+	g.Expect(tools.RepositoryDirectory).To(Equal(filepath.Join("testCluster", "git", "fleet")))
+}
+
+func TestGitFactoryGitlabNoToken(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "")
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Gitlab: &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	_, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	g.Expect(err).To(MatchError(ContainSubstring(gitlab.EksaGitlabTokenEnv)))
+}
+
+func TestGitFactoryGitWithoutPinnedKnownHosts(t *testing.T) {
+	g := NewWithT(t)
+	setupPrivateKey(t)
+	t.Setenv(config.EksaGitKnownHostsFileEnv, "")
+	t.Setenv(config.SshKnownHostsEnv, "")
+
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "ssh://git@git.example.com/platform/fleet.git",
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tools.Provider).To(BeNil())
+	g.Expect(tools.Client).NotTo(BeNil())
+	g.Expect(tools.RepositoryDirectory).To(Equal(filepath.Join("testCluster", "git", "fleet")))
+}
+
+func TestGitFactoryGitPinnedKnownHosts(t *testing.T) {
+	g := NewWithT(t)
+	setupPrivateKey(t)
+	t.Setenv(config.EksaGitKnownHostsFileEnv, "")
+	t.Setenv(config.SshKnownHostsEnv, "")
+
+	knownHosts := "git.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n"
+	cluster := &v1alpha1.Cluster{ObjectMeta: v1.ObjectMeta{Name: "testCluster"}}
+	fluxConfig := &v1alpha1.FluxConfig{
+		Spec: v1alpha1.FluxConfigSpec{
+			Git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "ssh://git@git.example.com/platform/fleet.git",
+				KnownHosts:    knownHosts,
+			},
+		},
+	}
+	_, w := test.NewWriter(t)
+
+	tools, err := gitFactory.Build(context.Background(), cluster, fluxConfig, w)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tools.Provider).NotTo(BeNil())
+	g.Expect(tools.RepositoryDirectory).To(Equal(filepath.Join("testCluster", "git", "fleet")))
+	g.Expect(tools.KnownHostsFile).To(Equal(filepath.Join(w.Dir(), "git", "known_hosts")))
+	g.Expect(os.ReadFile(tools.KnownHostsFile)).To(BeEquivalentTo(knownHosts))
+	g.Expect(os.Getenv(config.SshKnownHostsEnv)).To(Equal(tools.KnownHostsFile))
+}
+
+func setupPrivateKey(t *testing.T) {
+	t.Helper()
+	g := NewWithT(t)
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+	pemKey, err := ssh.MarshalPrivateKey(key, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	g.Expect(os.WriteFile(keyFile, pem.EncodeToMemory(pemKey), 0o600)).To(Succeed())
+	t.Setenv(config.EksaGitPrivateKeyTokenEnv, keyFile)
+}
+
 func setupContext(t *testing.T) {
 	t.Setenv(github.EksaGithubTokenEnv, validPATValue)
 	t.Setenv(github.GithubTokenEnv, validPATValue)
@@ -0,0 +1,328 @@
+package gogitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// DefaultHostname is the hostname of the GitLab SaaS instance.
+const DefaultHostname = "gitlab.com"
+
+// GoGitlab is a client for the subset of the GitLab REST API v4 needed to manage the GitOps repository.
+type GoGitlab struct {
+	Opts    Options
+	Client  HTTPClient
+	baseURL string
+}
+
+type Options struct {
+	Auth git.TokenAuth
+	// Hostname of the GitLab instance. Defaults to DefaultHostname.
+	Hostname string
+}
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Project is a GitLab project, the equivalent of a GitHub repository.
+type Project struct {
+	ID                int       `json:"id"`
+	Name              string    `json:"name"`
+	Path              string    `json:"path"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	HTTPURLToRepo     string    `json:"http_url_to_repo"`
+	SSHURLToRepo      string    `json:"ssh_url_to_repo"`
+	Namespace         Namespace `json:"namespace"`
+}
+
+// Namespace is a GitLab user or group namespace.
+type Namespace struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Kind     string `json:"kind"`
+	FullPath string `json:"full_path"`
+}
+
+// User is a GitLab user.
+type User struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// Group is a GitLab group.
+type Group struct {
+	ID       int    `json:"id"`
+	FullPath string `json:"full_path"`
+}
+
+// PersonalAccessToken is a GitLab personal, project or group access token.
+type PersonalAccessToken struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+	Active bool     `json:"active"`
+}
+
+type treeNode struct {
+	Path string `json:"path"`
+}
+
+// ErrorResponse is returned for GitLab API requests that fail with a non 2xx status.
+type ErrorResponse struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("gitlab api returned %d: %s", e.StatusCode, e.Message)
+}
+
+func New(opts Options) *GoGitlab {
+	hostname := opts.Hostname
+	if hostname == "" {
+		hostname = DefaultHostname
+	}
+
+	return &GoGitlab{
+		Opts:    opts,
+		Client:  &http.Client{},
+		baseURL: fmt.Sprintf("https://%s/api/v4", hostname),
+	}
+}
+
+// NewWithBaseURL returns a GoGitlab that sends the API requests to baseURL using client.
+func NewWithBaseURL(opts Options, client HTTPClient, baseURL string) *GoGitlab {
+	return &GoGitlab{
+		Opts:    opts,
+		Client:  client,
+		baseURL: baseURL,
+	}
+}
+
+// GetRepo describes a remote project. If the project does not exist, it returns a `RepositoryDoesNotExistError`.
+func (g *GoGitlab) GetRepo(ctx context.Context, opts git.GetRepoOpts) (*git.Repository, error) {
+	logger.V(3).Info("Describing GitLab project", "name", opts.Repository, "owner", opts.Owner)
+	project := &Project{}
+	err := g.do(ctx, http.MethodGet, projectPath(opts.Owner, opts.Repository), nil, project)
+	if isNotFound(err) {
+		return nil, &git.RepositoryDoesNotExistError{Err: err}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error when describing project %s: %w", opts.Repository, err)
+	}
+
+	return repositoryFromProject(project), nil
+}
+
+// CreateRepo creates an empty GitLab project in the user namespace if opts.Personal is true or
+// in the opts.Owner group otherwise.
+func (g *GoGitlab) CreateRepo(ctx context.Context, opts git.CreateRepoOpts) (*git.Repository, error) {
+	logger.V(3).Info("Attempting to create new GitLab project", "repo", opts.Name, "owner", opts.Owner)
+	visibility := "public"
+	if opts.Privacy {
+		visibility = "private"
+	}
+
+	body := map[string]interface{}{
+		"name":                   opts.Name,
+		"path":                   opts.Name,
+		"description":            opts.Description,
+		"visibility":             visibility,
+		"initialize_with_readme": opts.AutoInit,
+	}
+
+	if !opts.Personal {
+		group, err := g.Group(ctx, opts.Owner)
+		if err != nil {
+			return nil, err
+		}
+		body["namespace_id"] = group.ID
+	}
+
+	project := &Project{}
+	if err := g.do(ctx, http.MethodPost, "/projects", body, project); err != nil {
+		return nil, fmt.Errorf("failed to create new GitLab project %s: %v", opts.Name, err)
+	}
+
+	logger.V(3).Info("Successfully created new GitLab project", "repo", project.Name, "owner", opts.Owner)
+	return repositoryFromProject(project), nil
+}
+
+// DeleteRepo deletes a GitLab project.
+func (g *GoGitlab) DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error {
+	logger.V(3).Info("Deleting GitLab project", "name", opts.Repository, "owner", opts.Owner)
+	if err := g.do(ctx, http.MethodDelete, projectPath(opts.Owner, opts.Repository), nil, nil); err != nil {
+		return fmt.Errorf("deleting project %s: %v", opts.Repository, err)
+	}
+	return nil
+}
+
+// AddDeployKeyToRepo adds a deploy key to a GitLab project. Keys that aren't read only can push.
+func (g *GoGitlab) AddDeployKeyToRepo(ctx context.Context, opts git.AddDeployKeyOpts) error {
+	logger.V(3).Info("Adding deploy key to project", "repository", opts.Repository, "owner", opts.Owner)
+	body := map[string]interface{}{
+		"title":    opts.Title,
+		"key":      opts.Key,
+		"can_push": !opts.ReadOnly,
+	}
+	if err := g.do(ctx, http.MethodPost, projectPath(opts.Owner, opts.Repository)+"/deploy_keys", body, nil); err != nil {
+		return fmt.Errorf("adding deploy key to project: %v", err)
+	}
+	return nil
+}
+
+// AuthenticatedUser returns the user the access token belongs to.
+func (g *GoGitlab) AuthenticatedUser(ctx context.Context) (*User, error) {
+	user := &User{}
+	if err := g.do(ctx, http.MethodGet, "/user", nil, user); err != nil {
+		return nil, fmt.Errorf("failed while getting the authenticated gitlab user %v", err)
+	}
+	return user, nil
+}
+
+// Group returns the group with the fullPath, including parent groups.
+func (g *GoGitlab) Group(ctx context.Context, fullPath string) (*Group, error) {
+	group := &Group{}
+	if err := g.do(ctx, http.MethodGet, "/groups/"+url.PathEscape(fullPath), nil, group); err != nil {
+		return nil, fmt.Errorf("failed while getting gitlab group %s details %v", fullPath, err)
+	}
+	return group, nil
+}
+
+// AccessTokenScopes returns the scopes of the access token used to authenticate.
+func (g *GoGitlab) AccessTokenScopes(ctx context.Context) ([]string, error) {
+	token := &PersonalAccessToken{}
+	if err := g.do(ctx, http.MethodGet, "/personal_access_tokens/self", nil, token); err != nil {
+		return nil, fmt.Errorf("getting GitLab access token scopes: %v", err)
+	}
+	return token.Scopes, nil
+}
+
+// PathExists checks if a path exists in the remote project. If the owner, project or branch doesn't exist,
+// it returns false and no error.
+func (g *GoGitlab) PathExists(ctx context.Context, owner, repo, branch, filePath string) (bool, error) {
+	dir := path.Dir(filePath)
+	for page := 1; page > 0; {
+		query := url.Values{}
+		query.Set("ref", branch)
+		query.Set("per_page", "100")
+		query.Set("page", strconv.Itoa(page))
+		if dir != "." {
+			query.Set("path", dir)
+		}
+
+		var nodes []treeNode
+		next, err := g.doWithNextPage(ctx, projectPath(owner, repo)+"/repository/tree?"+query.Encode(), &nodes)
+		if isNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed checking if path %s exists in remote gitlab project: %v", filePath, err)
+		}
+
+		for _, n := range nodes {
+			if n.Path == path.Clean(filePath) {
+				return true, nil
+			}
+		}
+		page = next
+	}
+
+	return false, nil
+}
+
+func (g *GoGitlab) do(ctx context.Context, method, apiPath string, body, out interface{}) error {
+	resp, err := g.request(ctx, method, apiPath, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse(resp, out)
+}
+
+func (g *GoGitlab) doWithNextPage(ctx context.Context, apiPath string, out interface{}) (int, error) {
+	resp, err := g.request(ctx, http.MethodGet, apiPath, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if err := decodeResponse(resp, out); err != nil {
+		return 0, err
+	}
+
+	next, _ := strconv.Atoi(resp.Header.Get("X-Next-Page"))
+	return next, nil
+}
+
+func (g *GoGitlab) request(ctx context.Context, method, apiPath string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, g.baseURL+apiPath, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("PRIVATE-TOKEN", g.Opts.Auth.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return g.Client.Do(req)
+}
+
+func decodeResponse(resp *http.Response, out interface{}) error {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(resp.Body)
+		return &ErrorResponse{StatusCode: resp.StatusCode, Message: string(msg)}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding gitlab api response: %v", err)
+	}
+	return nil
+}
+
+func projectPath(owner, repo string) string {
+	return "/projects/" + url.PathEscape(owner+"/"+repo)
+}
+
+func repositoryFromProject(p *Project) *git.Repository {
+	r := &git.Repository{
+		Name:     p.Path,
+		CloneUrl: p.HTTPURLToRepo,
+		Owner:    p.Namespace.FullPath,
+	}
+	if p.Namespace.Kind == "group" {
+		r.Organization = p.Namespace.FullPath
+	}
+	return r
+}
+
+func isNotFound(err error) bool {
+	var e *ErrorResponse
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
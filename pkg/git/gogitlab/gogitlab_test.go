@@ -0,0 +1,180 @@
+package gogitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/gogitlab"
+)
+
+const token = "glpat-token"
+
+type request struct {
+	method string
+	path   string
+	query  string
+	body   map[string]interface{}
+}
+
+func newTestGitlab(t *testing.T, handler func(w http.ResponseWriter, r request)) (*gogitlab.GoGitlab, *[]request) {
+	t.Helper()
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("PRIVATE-TOKEN") != token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := request{method: r.Method, path: r.URL.EscapedPath(), query: r.URL.RawQuery}
+		if r.Body != nil {
+			_ = json.NewDecoder(r.Body).Decode(&req.body)
+		}
+		requests = append(requests, req)
+		handler(w, req)
+	}))
+	t.Cleanup(server.Close)
+
+	opts := gogitlab.Options{Auth: git.TokenAuth{Token: token}}
+	return gogitlab.NewWithBaseURL(opts, server.Client(), server.URL+"/api/v4"), &requests
+}
+
+func TestGetRepo(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		_, _ = w.Write([]byte(`{"id": 1, "name": "fleet", "path": "fleet", "http_url_to_repo": "https://gitlab.example.com/platform/clusters/fleet.git", "namespace": {"kind": "group", "full_path": "platform/clusters"}}`))
+	})
+
+	repo, err := client.GetRepo(context.Background(), git.GetRepoOpts{Owner: "platform/clusters", Repository: "fleet"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(Equal(&git.Repository{
+		Name:         "fleet",
+		Owner:        "platform/clusters",
+		Organization: "platform/clusters",
+		CloneUrl:     "https://gitlab.example.com/platform/clusters/fleet.git",
+	}))
+	g.Expect((*requests)[0].path).To(Equal("/api/v4/projects/platform%2Fclusters%2Ffleet"))
+}
+
+func TestGetRepoNotFound(t *testing.T) {
+	g := NewWithT(t)
+	client, _ := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetRepo(context.Background(), git.GetRepoOpts{Owner: "janedoe", Repository: "fleet"})
+	var notFound *git.RepositoryDoesNotExistError
+	g.Expect(errors.As(err, &notFound)).To(BeTrue())
+}
+
+func TestCreateRepoInGroup(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		if r.method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"id": 42, "full_path": "platform"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1, "name": "fleet", "path": "fleet", "namespace": {"kind": "group", "full_path": "platform"}}`))
+	})
+
+	repo, err := client.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: "platform", Privacy: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo.Name).To(Equal("fleet"))
+	g.Expect(*requests).To(HaveLen(2))
+	g.Expect((*requests)[0].path).To(Equal("/api/v4/groups/platform"))
+	g.Expect((*requests)[1].path).To(Equal("/api/v4/projects"))
+	g.Expect((*requests)[1].body).To(HaveKeyWithValue("namespace_id", BeEquivalentTo(42)))
+	g.Expect((*requests)[1].body).To(HaveKeyWithValue("visibility", "private"))
+}
+
+func TestCreateRepoPersonal(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1, "name": "fleet", "path": "fleet", "namespace": {"kind": "user", "full_path": "janedoe"}}`))
+	})
+
+	repo, err := client.CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet", Owner: "janedoe", Personal: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo.Organization).To(BeEmpty())
+	g.Expect(*requests).To(HaveLen(1))
+	g.Expect((*requests)[0].body).NotTo(HaveKey("namespace_id"))
+}
+
+func TestAddDeployKeyToRepo(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := client.AddDeployKeyToRepo(context.Background(), git.AddDeployKeyOpts{Owner: "janedoe", Repository: "fleet", Key: "ssh-ed25519 AAAA", Title: "flux"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect((*requests)[0].path).To(Equal("/api/v4/projects/janedoe%2Ffleet/deploy_keys"))
+	g.Expect((*requests)[0].body).To(HaveKeyWithValue("can_push", true))
+}
+
+func TestAccessTokenScopes(t *testing.T) {
+	g := NewWithT(t)
+	client, _ := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		_, _ = w.Write([]byte(`{"name": "eksa", "scopes": ["api", "read_repository"], "active": true}`))
+	})
+
+	scopes, err := client.AccessTokenScopes(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(scopes).To(Equal([]string{"api", "read_repository"}))
+}
+
+func TestPathExists(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		if r.query == "page=1&path=clusters&per_page=100&ref=main" {
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"path": "clusters/other"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"path": "clusters/mgmt"}]`))
+	})
+
+	exists, err := client.PathExists(context.Background(), "janedoe", "fleet", "main", "clusters/mgmt")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+	g.Expect(*requests).To(HaveLen(2))
+}
+
+func TestPathExistsBranchNotFound(t *testing.T) {
+	g := NewWithT(t)
+	client, _ := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	exists, err := client.PathExists(context.Background(), "janedoe", "fleet", "main", "clusters/mgmt")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeFalse())
+}
+
+func TestPathExistsError(t *testing.T) {
+	g := NewWithT(t)
+	client, _ := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := client.PathExists(context.Background(), "janedoe", "fleet", "main", "clusters/mgmt")
+	g.Expect(err).To(MatchError(ContainSubstring("gitlab api returned 500")))
+}
+
+func TestDeleteRepo(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestGitlab(t, func(w http.ResponseWriter, r request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	g.Expect(client.DeleteRepo(context.Background(), git.DeleteRepoOpts{Owner: "janedoe", Repository: "fleet"})).To(Succeed())
+	g.Expect((*requests)[0].method).To(Equal(http.MethodDelete))
+}
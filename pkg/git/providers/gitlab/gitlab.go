@@ -0,0 +1,145 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/gogitlab"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const (
+	GitProviderName    = "gitlab"
+	EksaGitlabTokenEnv = "EKSA_GITLAB_TOKEN"
+	GitlabTokenEnv     = "GITLAB_TOKEN"
+	gitlabUrlTemplate  = "https://%v/%v/%v.git"
+	apiScope           = "api"
+)
+
+type gitlabProvider struct {
+	gitlabProviderClient GitlabClient
+	config               *v1alpha1.GitlabProviderConfig
+	auth                 git.TokenAuth
+}
+
+// GitlabClient represents the attributes that the GitLab provider requires of a library to directly connect to and interact with the GitLab API.
+type GitlabClient interface {
+	GetRepo(ctx context.Context, opts git.GetRepoOpts) (repo *git.Repository, err error)
+	CreateRepo(ctx context.Context, opts git.CreateRepoOpts) (repo *git.Repository, err error)
+	AddDeployKeyToRepo(ctx context.Context, opts git.AddDeployKeyOpts) error
+	AuthenticatedUser(ctx context.Context) (*gogitlab.User, error)
+	Group(ctx context.Context, fullPath string) (*gogitlab.Group, error)
+	AccessTokenScopes(ctx context.Context) ([]string, error)
+	PathExists(ctx context.Context, owner, repo, branch, path string) (bool, error)
+	DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error
+}
+
+func New(gitlabProviderClient GitlabClient, config *v1alpha1.GitlabProviderConfig, auth git.TokenAuth) (*gitlabProvider, error) {
+	return &gitlabProvider{
+		gitlabProviderClient: gitlabProviderClient,
+		config:               config,
+		auth:                 auth,
+	}, nil
+}
+
+// CreateRepo creates an empty GitLab project. The project must be initialized locally or
+// files must be added to it via the gitlab api before it can be successfully cloned.
+func (g *gitlabProvider) CreateRepo(ctx context.Context, opts git.CreateRepoOpts) (repository *git.Repository, err error) {
+	return g.gitlabProviderClient.CreateRepo(ctx, opts)
+}
+
+// GetRepo describes a remote project, return the repo name if it exists.
+// If the project does not exist, a nil repo is returned.
+func (g *gitlabProvider) GetRepo(ctx context.Context) (*git.Repository, error) {
+	r := g.config.Repository
+	o := g.config.Owner
+	logger.V(3).Info("Describing GitLab project", "name", r, "owner", o)
+	opts := git.GetRepoOpts{Owner: o, Repository: r}
+	repo, err := g.gitlabProviderClient.GetRepo(ctx, opts)
+	if err != nil {
+		var e *git.RepositoryDoesNotExistError
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unexpected error when describing project %s: %w", r, err)
+	}
+	return repo, err
+}
+
+func (g *gitlabProvider) AddDeployKeyToRepo(ctx context.Context, opts git.AddDeployKeyOpts) error {
+	return g.gitlabProviderClient.AddDeployKeyToRepo(ctx, opts)
+}
+
+// Validate validates the GitLab setup and access.
+func (g *gitlabProvider) Validate(ctx context.Context) error {
+	user, err := g.gitlabProviderClient.AuthenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+	scopes, err := g.gitlabProviderClient.AccessTokenScopes(ctx)
+	if err != nil {
+		return err
+	}
+	if !hasScope(scopes, apiScope) {
+		return fmt.Errorf("gitlab access token does not have the %s scope", apiScope)
+	}
+	logger.MarkPass("GitLab access token has the required api scope")
+	if g.config.Personal {
+		if !strings.EqualFold(g.config.Owner, user.Username) {
+			return fmt.Errorf("the authenticated GitLab user and owner %s specified in the EKS-A gitops spec don't match; confirm access token owner is %s", g.config.Owner, g.config.Owner)
+		}
+		return nil
+	}
+	if _, err = g.gitlabProviderClient.Group(ctx, g.config.Owner); err != nil {
+		return fmt.Errorf("the authenticated gitlab user doesn't have proper access to gitlab group %s, %v", g.config.Owner, err)
+	}
+	return nil
+}
+
+func (g *gitlabProvider) PathExists(ctx context.Context, owner, repo, branch, path string) (bool, error) {
+	return g.gitlabProviderClient.PathExists(ctx, owner, repo, branch, path)
+}
+
+func (g *gitlabProvider) DeleteRepo(ctx context.Context, opts git.DeleteRepoOpts) error {
+	return g.gitlabProviderClient.DeleteRepo(ctx, opts)
+}
+
+// GetGitlabAccessTokenFromEnv returns the GitLab access token in EKSA_GITLAB_TOKEN and sets it in
+// GITLAB_TOKEN, the variable read by the flux CLI.
+func GetGitlabAccessTokenFromEnv() (string, error) {
+	val, ok := os.LookupEnv(EksaGitlabTokenEnv)
+	if !ok || len(val) == 0 {
+		return "", fmt.Errorf("gitlab access token environment variable %s is invalid; could not get var from environment", EksaGitlabTokenEnv)
+	}
+
+	if err := os.Setenv(GitlabTokenEnv, val); err != nil {
+		return "", fmt.Errorf("unable to set %s: %v", GitlabTokenEnv, err)
+	}
+	return val, nil
+}
+
+// Hostname returns the hostname of the GitLab instance in config.
+func Hostname(config *v1alpha1.GitlabProviderConfig) string {
+	if config.Hostname == "" {
+		return gogitlab.DefaultHostname
+	}
+	return config.Hostname
+}
+
+func RepoUrl(hostname, owner, repo string) string {
+	return fmt.Sprintf(gitlabUrlTemplate, hostname, owner, repo)
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
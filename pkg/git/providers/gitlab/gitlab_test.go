@@ -0,0 +1,129 @@
+package gitlab_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/gogitlab"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab/mocks"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *v1alpha1.GitlabProviderConfig
+		scopes   []string
+		groupErr error
+		wantErr  string
+	}{
+		{
+			name:   "personal project",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "JaneDoe", Repository: "fleet", Personal: true},
+			scopes: []string{"api"},
+		},
+		{
+			name:   "group project",
+			config: &v1alpha1.GitlabProviderConfig{Owner: "platform/clusters", Repository: "fleet"},
+			scopes: []string{"read_api", "api"},
+		},
+		{
+			name:    "token without api scope",
+			config:  &v1alpha1.GitlabProviderConfig{Owner: "janedoe", Repository: "fleet", Personal: true},
+			scopes:  []string{"read_repository"},
+			wantErr: "gitlab access token does not have the api scope",
+		},
+		{
+			name:    "personal project of another user",
+			config:  &v1alpha1.GitlabProviderConfig{Owner: "johndoe", Repository: "fleet", Personal: true},
+			scopes:  []string{"api"},
+			wantErr: "the authenticated GitLab user and owner johndoe specified in the EKS-A gitops spec don't match",
+		},
+		{
+			name:     "group not accessible",
+			config:   &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"},
+			scopes:   []string{"api"},
+			groupErr: errors.New("404"),
+			wantErr:  "the authenticated gitlab user doesn't have proper access to gitlab group platform",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			client := mocks.NewMockGitlabClient(gomock.NewController(t))
+			client.EXPECT().AuthenticatedUser(ctx).Return(&gogitlab.User{Username: "janedoe"}, nil)
+			client.EXPECT().AccessTokenScopes(ctx).Return(tt.scopes, nil)
+			if !tt.config.Personal && tt.wantErr != "gitlab access token does not have the api scope" {
+				client.EXPECT().Group(ctx, tt.config.Owner).Return(&gogitlab.Group{}, tt.groupErr)
+			}
+
+			p, err := gitlab.New(client, tt.config, git.TokenAuth{Token: "glpat-token"})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			err = p.Validate(ctx)
+			if tt.wantErr == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+			}
+		})
+	}
+}
+
+func TestGetRepoDoesNotExist(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := mocks.NewMockGitlabClient(gomock.NewController(t))
+	config := &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"}
+	client.EXPECT().GetRepo(ctx, git.GetRepoOpts{Owner: "platform", Repository: "fleet"}).Return(nil, &git.RepositoryDoesNotExistError{})
+
+	p, _ := gitlab.New(client, config, git.TokenAuth{})
+	repo, err := p.GetRepo(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(repo).To(BeNil())
+}
+
+func TestGetRepoError(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := mocks.NewMockGitlabClient(gomock.NewController(t))
+	config := &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "fleet"}
+	client.EXPECT().GetRepo(ctx, git.GetRepoOpts{Owner: "platform", Repository: "fleet"}).Return(nil, errors.New("timeout"))
+
+	p, _ := gitlab.New(client, config, git.TokenAuth{})
+	_, err := p.GetRepo(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("unexpected error when describing project fleet: timeout")))
+}
+
+func TestGetGitlabAccessTokenFromEnv(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "glpat-token")
+	t.Setenv(gitlab.GitlabTokenEnv, "")
+
+	token, err := gitlab.GetGitlabAccessTokenFromEnv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token).To(Equal("glpat-token"))
+	g.Expect(os.Getenv(gitlab.GitlabTokenEnv)).To(Equal("glpat-token"))
+}
+
+func TestGetGitlabAccessTokenFromEnvNotSet(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv(gitlab.EksaGitlabTokenEnv, "")
+
+	_, err := gitlab.GetGitlabAccessTokenFromEnv()
+	g.Expect(err).To(MatchError(ContainSubstring("EKSA_GITLAB_TOKEN")))
+}
+
+func TestRepoUrl(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(gitlab.RepoUrl(gitlab.Hostname(&v1alpha1.GitlabProviderConfig{}), "platform/clusters", "fleet")).To(Equal("https://gitlab.com/platform/clusters/fleet.git"))
+	g.Expect(gitlab.Hostname(&v1alpha1.GitlabProviderConfig{Hostname: "gitlab.example.com"})).To(Equal("gitlab.example.com"))
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/eks-anywhere/pkg/git/providers/gitlab (interfaces: GitlabClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	git "github.com/aws/eks-anywhere/pkg/git"
+	gogitlab "github.com/aws/eks-anywhere/pkg/git/gogitlab"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGitlabClient is a mock of GitlabClient interface.
+type MockGitlabClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitlabClientMockRecorder
+}
+
+// MockGitlabClientMockRecorder is the mock recorder for MockGitlabClient.
+type MockGitlabClientMockRecorder struct {
+	mock *MockGitlabClient
+}
+
+// NewMockGitlabClient creates a new mock instance.
+func NewMockGitlabClient(ctrl *gomock.Controller) *MockGitlabClient {
+	mock := &MockGitlabClient{ctrl: ctrl}
+	mock.recorder = &MockGitlabClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitlabClient) EXPECT() *MockGitlabClientMockRecorder {
+	return m.recorder
+}
+
+// AccessTokenScopes mocks base method.
+func (m *MockGitlabClient) AccessTokenScopes(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessTokenScopes", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccessTokenScopes indicates an expected call of AccessTokenScopes.
+func (mr *MockGitlabClientMockRecorder) AccessTokenScopes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessTokenScopes", reflect.TypeOf((*MockGitlabClient)(nil).AccessTokenScopes), arg0)
+}
+
+// AddDeployKeyToRepo mocks base method.
+func (m *MockGitlabClient) AddDeployKeyToRepo(arg0 context.Context, arg1 git.AddDeployKeyOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDeployKeyToRepo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDeployKeyToRepo indicates an expected call of AddDeployKeyToRepo.
+func (mr *MockGitlabClientMockRecorder) AddDeployKeyToRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDeployKeyToRepo", reflect.TypeOf((*MockGitlabClient)(nil).AddDeployKeyToRepo), arg0, arg1)
+}
+
+// AuthenticatedUser mocks base method.
+func (m *MockGitlabClient) AuthenticatedUser(arg0 context.Context) (*gogitlab.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticatedUser", arg0)
+	ret0, _ := ret[0].(*gogitlab.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticatedUser indicates an expected call of AuthenticatedUser.
+func (mr *MockGitlabClientMockRecorder) AuthenticatedUser(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticatedUser", reflect.TypeOf((*MockGitlabClient)(nil).AuthenticatedUser), arg0)
+}
+
+// CreateRepo mocks base method.
+func (m *MockGitlabClient) CreateRepo(arg0 context.Context, arg1 git.CreateRepoOpts) (*git.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepo", arg0, arg1)
+	ret0, _ := ret[0].(*git.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRepo indicates an expected call of CreateRepo.
+func (mr *MockGitlabClientMockRecorder) CreateRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepo", reflect.TypeOf((*MockGitlabClient)(nil).CreateRepo), arg0, arg1)
+}
+
+// DeleteRepo mocks base method.
+func (m *MockGitlabClient) DeleteRepo(arg0 context.Context, arg1 git.DeleteRepoOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRepo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRepo indicates an expected call of DeleteRepo.
+func (mr *MockGitlabClientMockRecorder) DeleteRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepo", reflect.TypeOf((*MockGitlabClient)(nil).DeleteRepo), arg0, arg1)
+}
+
+// GetRepo mocks base method.
+func (m *MockGitlabClient) GetRepo(arg0 context.Context, arg1 git.GetRepoOpts) (*git.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepo", arg0, arg1)
+	ret0, _ := ret[0].(*git.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepo indicates an expected call of GetRepo.
+func (mr *MockGitlabClientMockRecorder) GetRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepo", reflect.TypeOf((*MockGitlabClient)(nil).GetRepo), arg0, arg1)
+}
+
+// Group mocks base method.
+func (m *MockGitlabClient) Group(arg0 context.Context, arg1 string) (*gogitlab.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Group", arg0, arg1)
+	ret0, _ := ret[0].(*gogitlab.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Group indicates an expected call of Group.
+func (mr *MockGitlabClientMockRecorder) Group(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockGitlabClient)(nil).Group), arg0, arg1)
+}
+
+// PathExists mocks base method.
+func (m *MockGitlabClient) PathExists(arg0 context.Context, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PathExists", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PathExists indicates an expected call of PathExists.
+func (mr *MockGitlabClientMockRecorder) PathExists(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PathExists", reflect.TypeOf((*MockGitlabClient)(nil).PathExists), arg0, arg1, arg2, arg3, arg4)
+}
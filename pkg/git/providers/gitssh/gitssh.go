@@ -0,0 +1,175 @@
+package gitssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+const GitProviderName = "ssh"
+
+// Client is the subset of git remote operations the generic SSH provider needs.
+type Client interface {
+	// ListRefs lists the references of the remote repository.
+	ListRefs(ctx context.Context) ([]*plumbing.Reference, error)
+	// PathExists checks if path exists in branch of the remote repository.
+	PathExists(ctx context.Context, branch, path string) (bool, error)
+}
+
+// sshProvider is a git provider for repositories served over SSH by any git server. It can't manage
+// repositories so they must be created before creating the cluster.
+type sshProvider struct {
+	client        Client
+	repositoryURL string
+}
+
+func New(client Client, repositoryURL string) *sshProvider {
+	return &sshProvider{
+		client:        client,
+		repositoryURL: repositoryURL,
+	}
+}
+
+// GetRepo describes the remote repository. If the repository does not exist, a nil repo is returned.
+func (p *sshProvider) GetRepo(ctx context.Context) (*git.Repository, error) {
+	logger.V(3).Info("Describing git repository", "url", p.repositoryURL)
+	_, err := p.client.ListRefs(ctx)
+	if errors.Is(err, transport.ErrRepositoryNotFound) {
+		return nil, nil
+	}
+	if err != nil && !errors.Is(err, transport.ErrEmptyRemoteRepository) {
+		return nil, fmt.Errorf("unexpected error when describing repository %s: %w", p.repositoryURL, err)
+	}
+
+	return &git.Repository{
+		Name:     RepositoryName(p.repositoryURL),
+		CloneUrl: p.repositoryURL,
+	}, nil
+}
+
+func (p *sshProvider) CreateRepo(_ context.Context, opts git.CreateRepoOpts) (*git.Repository, error) {
+	return nil, fmt.Errorf("the generic git provider can't create repositories, create repository %s before creating the cluster", opts.Name)
+}
+
+func (p *sshProvider) DeleteRepo(_ context.Context, opts git.DeleteRepoOpts) error {
+	return fmt.Errorf("the generic git provider can't delete repositories, delete repository %s from the git server", opts.Repository)
+}
+
+func (p *sshProvider) AddDeployKeyToRepo(_ context.Context, _ git.AddDeployKeyOpts) error {
+	return errors.New("the generic git provider can't add deploy keys, add the public key of the private key in EKSA_GIT_PRIVATE_KEY to the git server")
+}
+
+// Validate validates that the repository exists and the key of the server matches the known hosts.
+func (p *sshProvider) Validate(ctx context.Context) error {
+	_, err := p.client.ListRefs(ctx)
+	var keyErr *knownhosts.KeyError
+	switch {
+	case err == nil, errors.Is(err, transport.ErrEmptyRemoteRepository):
+		return nil
+	case errors.As(err, &keyErr) && len(keyErr.Want) > 0:
+		return fmt.Errorf("the host key of the git server for %s doesn't match the known hosts: %v", p.repositoryURL, err)
+	case errors.As(err, &keyErr):
+		return fmt.Errorf("the git server for %s isn't in the known hosts: %v", p.repositoryURL, err)
+	case errors.Is(err, transport.ErrRepositoryNotFound):
+		return fmt.Errorf("repository %s does not exist, create it before creating the cluster", p.repositoryURL)
+	default:
+		return fmt.Errorf("connecting to repository %s: %v", p.repositoryURL, err)
+	}
+}
+
+// PathExists checks if a path exists in the remote repository. If the branch doesn't exist or the
+// repository is empty, it returns false and no error.
+func (p *sshProvider) PathExists(ctx context.Context, _, _, branch, path string) (bool, error) {
+	exists, err := p.client.PathExists(ctx, branch, path)
+	if err != nil {
+		return false, fmt.Errorf("failed checking if path %s exists in remote git repository: %v", path, err)
+	}
+	return exists, nil
+}
+
+// RepositoryName returns the name of the repository in repositoryURL.
+func RepositoryName(repositoryURL string) string {
+	return path.Base(strings.TrimSuffix(repositoryURL, filepath.Ext(repositoryURL)))
+}
+
+type goGitClient struct {
+	repositoryURL string
+	auth          transport.AuthMethod
+}
+
+// NewClient returns a Client that connects to repositoryURL with go-git.
+func NewClient(repositoryURL string, auth transport.AuthMethod) Client {
+	return &goGitClient{
+		repositoryURL: repositoryURL,
+		auth:          auth,
+	}
+}
+
+func (c *goGitClient) ListRefs(ctx context.Context) ([]*plumbing.Reference, error) {
+	remote := gogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: gogit.DefaultRemoteName,
+		URLs: []string{c.repositoryURL},
+	})
+	return remote.ListContext(ctx, &gogit.ListOptions{Auth: c.auth})
+}
+
+func (c *goGitClient) PathExists(ctx context.Context, branch, filePath string) (bool, error) {
+	r, err := gogit.CloneContext(ctx, memory.NewStorage(), nil, &gogit.CloneOptions{
+		URL:           c.repositoryURL,
+		Auth:          c.auth,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+		Depth:         1,
+		NoCheckout:    true,
+	})
+	if errors.Is(err, transport.ErrEmptyRemoteRepository) || isReferenceNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return false, err
+	}
+
+	commit, err := r.CommitObject(head.Hash())
+	if err != nil {
+		return false, err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tree.FindEntry(path.Clean(filePath))
+	if errors.Is(err, object.ErrEntryNotFound) || errors.Is(err, object.ErrDirectoryNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func isReferenceNotFound(err error) bool {
+	var noMatch gogit.NoMatchingRefSpecError
+	return errors.Is(err, plumbing.ErrReferenceNotFound) || errors.As(err, &noMatch)
+}
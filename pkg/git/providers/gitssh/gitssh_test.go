@@ -0,0 +1,160 @@
+package gitssh_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitssh"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitssh/mocks"
+)
+
+const repoURL = "ssh://git@git.example.com/platform/fleet.git"
+
+func TestGetRepo(t *testing.T) {
+	tests := []struct {
+		name     string
+		listErr  error
+		wantRepo *git.Repository
+		wantErr  string
+	}{
+		{
+			name:     "repository exists",
+			wantRepo: &git.Repository{Name: "fleet", CloneUrl: repoURL},
+		},
+		{
+			name:     "repository is empty",
+			listErr:  transport.ErrEmptyRemoteRepository,
+			wantRepo: &git.Repository{Name: "fleet", CloneUrl: repoURL},
+		},
+		{
+			name:    "repository does not exist",
+			listErr: transport.ErrRepositoryNotFound,
+		},
+		{
+			name:    "connection error",
+			listErr: errors.New("connection refused"),
+			wantErr: "unexpected error when describing repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			client := mocks.NewMockClient(gomock.NewController(t))
+			client.EXPECT().ListRefs(ctx).Return(nil, tt.listErr)
+
+			repo, err := gitssh.New(client, repoURL).GetRepo(ctx)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(repo).To(Equal(tt.wantRepo))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		listErr error
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:    "empty repository",
+			listErr: transport.ErrEmptyRemoteRepository,
+		},
+		{
+			name:    "host key mismatch",
+			listErr: &knownhosts.KeyError{Want: []knownhosts.KnownKey{{Filename: "known_hosts"}}},
+			wantErr: "doesn't match the known hosts",
+		},
+		{
+			name:    "unknown host",
+			listErr: &knownhosts.KeyError{},
+			wantErr: "isn't in the known hosts",
+		},
+		{
+			name:    "repository does not exist",
+			listErr: transport.ErrRepositoryNotFound,
+			wantErr: "create it before creating the cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			client := mocks.NewMockClient(gomock.NewController(t))
+			client.EXPECT().ListRefs(ctx).Return(nil, tt.listErr)
+
+			err := gitssh.New(client, repoURL).Validate(ctx)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestCreateRepo(t *testing.T) {
+	g := NewWithT(t)
+	_, err := gitssh.New(nil, repoURL).CreateRepo(context.Background(), git.CreateRepoOpts{Name: "fleet"})
+	g.Expect(err).To(MatchError(ContainSubstring("create repository fleet before creating the cluster")))
+}
+
+func TestPathExists(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := mocks.NewMockClient(gomock.NewController(t))
+	client.EXPECT().PathExists(ctx, "main", "clusters/mgmt").Return(true, nil)
+
+	exists, err := gitssh.New(client, repoURL).PathExists(ctx, "", "fleet", "main", "clusters/mgmt")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(exists).To(BeTrue())
+}
+
+func TestClientPathExists(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	r, err := gogit.PlainInit(dir, false)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(os.MkdirAll(filepath.Join(dir, "clusters", "mgmt"), 0o755)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "clusters", "mgmt", "cluster.yaml"), []byte("kind: Cluster"), 0o644)).To(Succeed())
+	w, err := r.Worktree()
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = w.Add("clusters")
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = w.Commit("initial commit", &gogit.CommitOptions{Author: &object.Signature{Name: "test", When: time.Now()}})
+	g.Expect(err).NotTo(HaveOccurred())
+	head, err := r.Head()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	client := gitssh.NewClient(dir, nil)
+
+	refs, err := client.ListRefs(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(refs).NotTo(BeEmpty())
+
+	branch := head.Name().Short()
+	g.Expect(client.PathExists(ctx, branch, "clusters/mgmt")).To(BeTrue())
+	g.Expect(client.PathExists(ctx, branch, "clusters/mgmt/cluster.yaml")).To(BeTrue())
+	g.Expect(client.PathExists(ctx, branch, "clusters/workload")).To(BeFalse())
+	g.Expect(client.PathExists(ctx, "does-not-exist", "clusters/mgmt")).To(BeFalse())
+}
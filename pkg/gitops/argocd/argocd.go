@@ -0,0 +1,341 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/git"
+	gitFactory "github.com/aws/eks-anywhere/pkg/git/factory"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+const (
+	defaultRemote = "origin"
+
+	initialClusterconfigCommitMessage = "Initial commit of cluster configuration; generated by EKS-A CLI"
+	updateClusterconfigCommitMessage  = "Update commit of cluster configuration; generated by EKS-A CLI"
+	deleteClusterconfigCommitMessage  = "Delete commit of cluster configuration; generated by EKS-A CLI"
+)
+
+// GitOpsArgoCDClient abstracts the operations needed to install and drive Argo CD in a cluster.
+type GitOpsArgoCDClient interface {
+	Install(ctx context.Context, cluster *types.Cluster, argoCDSystemDir, namespace string) error
+	ApplyRepositorySecret(ctx context.Context, cluster *types.Cluster, argoCDConfig *v1alpha1.ArgoCDConfig, cliConfig *config.CliConfig) error
+	ApplyApplications(ctx context.Context, cluster *types.Cluster, manifest []byte) error
+	Uninstall(ctx context.Context, cluster *types.Cluster, argoCDSystemDir, namespace string) error
+	DisableApplicationReconcile(ctx context.Context, cluster *types.Cluster, application, namespace string) error
+	EnableApplicationReconcile(ctx context.Context, cluster *types.Cluster, application, namespace string) error
+	Refresh(ctx context.Context, cluster *types.Cluster, application, namespace string) error
+}
+
+// Reader reads the manifests referenced in the bundle.
+type Reader interface {
+	ReadFile(url string) ([]byte, error)
+}
+
+type GitClient interface {
+	GetRepo(ctx context.Context) (repo *git.Repository, err error)
+	CreateRepo(ctx context.Context, opts git.CreateRepoOpts) error
+	Clone(ctx context.Context) error
+	Push(ctx context.Context) error
+	Pull(ctx context.Context, branch string) error
+	PathExists(ctx context.Context, owner, repo, branch, path string) (exists bool, err error)
+	Add(filename string) error
+	Remove(filename string) error
+	Commit(message string) error
+	Branch(name string) error
+	Init() error
+}
+
+// ArgoCD is a GitOps manager that uses Argo CD to sync the cluster configuration from a git repository.
+type ArgoCD struct {
+	argoCDClient GitOpsArgoCDClient
+	gitClient    GitClient
+	writer       filewriter.FileWriter
+	reader       Reader
+	cliConfig    *config.CliConfig
+}
+
+// NewArgoCD builds an ArgoCD GitOps manager.
+func NewArgoCD(kubeClient KubeClient, gitTools *gitFactory.GitTools, reader Reader, cliConfig *config.CliConfig) *ArgoCD {
+	var w filewriter.FileWriter
+	if gitTools != nil {
+		w = gitTools.Writer
+	}
+
+	return &ArgoCD{
+		argoCDClient: newArgoCDClient(kubeClient),
+		gitClient:    newGitClient(gitTools),
+		writer:       w,
+		reader:       reader,
+		cliConfig:    cliConfig,
+	}
+}
+
+// NewArgoCDFromGitOpsArgoCDClient builds an ArgoCD GitOps manager from its already built dependencies.
+func NewArgoCDFromGitOpsArgoCDClient(argoCDClient GitOpsArgoCDClient, gitClient GitClient, writer filewriter.FileWriter, reader Reader, cliConfig *config.CliConfig) *ArgoCD {
+	return &ArgoCD{
+		argoCDClient: argoCDClient,
+		gitClient:    gitClient,
+		writer:       writer,
+		reader:       reader,
+		cliConfig:    cliConfig,
+	}
+}
+
+// InstallGitOps commits the cluster configuration to git and, for self-managed clusters, installs Argo CD.
+func (a *ArgoCD) InstallGitOps(ctx context.Context, cluster *types.Cluster, managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, bootstrap Argo CD skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, datacenterConfig, machineConfigs)
+
+	if err := ac.setupRepository(ctx); err != nil {
+		return err
+	}
+
+	if err := ac.commitArgoCDAndClusterConfigToGit(ctx, managementComponents); err != nil {
+		return err
+	}
+
+	if err := a.Bootstrap(ctx, cluster, clusterSpec); err != nil {
+		return err
+	}
+
+	logger.V(4).Info("pulling from remote after Argo CD bootstrap to ensure configuration files in local git repository are in sync",
+		"remote", defaultRemote, "branch", ac.branch())
+
+	if err := a.gitClient.Pull(ctx, ac.branch()); err != nil {
+		logger.Error(err, "error when pulling from remote repository after Argo CD bootstrap; ensure local repository is up-to-date with remote (git pull)",
+			"remote", defaultRemote, "branch", ac.branch(), "error", err)
+	}
+	return nil
+}
+
+// Bootstrap installs the Argo CD components in a self-managed cluster, registers the git repository
+// and creates the applications that sync the cluster configurations. Workload clusters are synced by the
+// Argo CD instance of their management cluster, so nothing is installed for them.
+// If any step fails, the Argo CD components are uninstalled.
+func (a *ArgoCD) Bootstrap(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if clusterSpec.Cluster.IsManaged() {
+		return nil
+	}
+
+	if err := a.bootstrap(ctx, cluster, clusterSpec); err != nil {
+		_ = a.Uninstall(ctx, cluster, clusterSpec)
+		return fmt.Errorf("installing Argo CD gitops: %v", err)
+	}
+
+	return nil
+}
+
+func (a *ArgoCD) bootstrap(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+
+	if err := a.argoCDClient.Install(ctx, cluster, a.writerPath(ac.argoCDSystemDir()), ac.namespace()); err != nil {
+		return err
+	}
+
+	if err := a.argoCDClient.ApplyRepositorySecret(ctx, cluster, clusterSpec.ArgoCDConfig, a.cliConfig); err != nil {
+		return err
+	}
+
+	apps, err := ac.applicationsManifest()
+	if err != nil {
+		return err
+	}
+
+	return a.argoCDClient.ApplyApplications(ctx, cluster, apps)
+}
+
+// Uninstall removes the Argo CD applications and components from the cluster.
+func (a *ArgoCD) Uninstall(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+	if err := a.argoCDClient.Uninstall(ctx, cluster, a.writerPath(ac.argoCDSystemDir()), ac.namespace()); err != nil {
+		logger.Info("Could not uninstall Argo CD components", "error", err)
+		return err
+	}
+	return nil
+}
+
+// UninstallGitOps removes Argo CD from a self-managed cluster before it is deleted, so it doesn't
+// re-apply the cluster objects while they are being moved out of it.
+func (a *ArgoCD) UninstallGitOps(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if a.shouldSkipArgoCD() || clusterSpec.Cluster.IsManaged() {
+		logger.V(4).Info("Argo CD not installed in cluster, uninstall skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Uninstalling Argo CD components")
+	return a.Uninstall(ctx, cluster, clusterSpec)
+}
+
+// PauseClusterResourcesReconcile stops Argo CD from syncing the cluster application.
+func (a *ArgoCD) PauseClusterResourcesReconcile(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, provider providers.Provider) error {
+	if a.shouldSkipArgoCD() {
+		logger.V(4).Info("GitOps field not specified, pause cluster resources reconcile skipped")
+		return nil
+	}
+
+	logger.V(3).Info("Pause Argo CD EKS-A resources reconcile")
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+	if err := a.argoCDClient.DisableApplicationReconcile(ctx, cluster, ac.applicationName(), ac.namespace()); err != nil {
+		return fmt.Errorf("disable application %s from Argo CD reconcile: %v", ac.applicationName(), err)
+	}
+
+	return nil
+}
+
+// ResumeClusterResourcesReconcile lets Argo CD sync the cluster application again.
+func (a *ArgoCD) ResumeClusterResourcesReconcile(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec, provider providers.Provider) error {
+	if a.shouldSkipArgoCD() {
+		logger.V(4).Info("GitOps field not specified, resume cluster resources reconcile skipped")
+		return nil
+	}
+
+	logger.V(3).Info("Resume Argo CD EKS-A resources reconcile")
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+	if err := a.argoCDClient.EnableApplicationReconcile(ctx, cluster, ac.applicationName(), ac.namespace()); err != nil {
+		return fmt.Errorf("enable application %s from Argo CD reconcile: %v", ac.applicationName(), err)
+	}
+
+	return nil
+}
+
+// ForceReconcileGitRepo requests Argo CD to refresh the cluster application from git.
+func (a *ArgoCD) ForceReconcileGitRepo(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps not configured, force reconcile Argo CD application skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+	return a.argoCDClient.Refresh(ctx, cluster, ac.applicationName(), ac.namespace())
+}
+
+// UpdateGitEksaSpec writes the cluster configuration to git and pushes it.
+func (a *ArgoCD) UpdateGitEksaSpec(ctx context.Context, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, update git repo skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, datacenterConfig, machineConfigs)
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	g := NewFileGenerator()
+	if err := g.Init(a.writer, ac.eksaSystemDir(), ac.argoCDSystemDir()); err != nil {
+		return err
+	}
+
+	if err := g.WriteEksaFiles(clusterSpec, datacenterConfig, machineConfigs); err != nil {
+		return err
+	}
+
+	path := ac.eksaSystemDir()
+	if err := a.gitClient.Add(path); err != nil {
+		return fmt.Errorf("adding %s to git: %v", path, err)
+	}
+
+	if err := a.pushToRemoteRepo(ctx, path, updateClusterconfigCommitMessage); err != nil {
+		return err
+	}
+	logger.V(3).Info("Finished pushing updated cluster config file to git", "repository", ac.repository())
+	return nil
+}
+
+// Validations returns the validations to run before installing Argo CD.
+func (a *ArgoCD) Validations(ctx context.Context, clusterSpec *cluster.Spec) []validations.Validation {
+	if a.shouldSkipArgoCD() {
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+
+	return []validations.Validation{
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "Argo CD path",
+				Remediation: "Please provide a different path or different cluster name",
+				Err:         ac.validateRemoteConfigPathDoesNotExist(ctx),
+			}
+		},
+	}
+}
+
+// CleanupGitRepo removes the cluster configuration from git.
+func (a *ArgoCD) CleanupGitRepo(ctx context.Context, clusterSpec *cluster.Spec) error {
+	if a.shouldSkipArgoCD() {
+		logger.Info("GitOps field not specified, clean up git repo skipped")
+		return nil
+	}
+
+	ac := newArgoCDForCluster(a, clusterSpec, nil, nil)
+
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	var p string
+	if clusterSpec.Cluster.IsManaged() {
+		p = ac.eksaSystemDir()
+	} else {
+		p = ac.path()
+	}
+
+	if !validations.FileExists(path.Join(a.writer.Dir(), p)) {
+		logger.V(3).Info("cluster dir does not exist in git, skip clean up")
+		return nil
+	}
+
+	if err := a.gitClient.Remove(p); err != nil {
+		return fmt.Errorf("removing %s in git: %v", p, err)
+	}
+
+	if err := a.pushToRemoteRepo(ctx, p, deleteClusterconfigCommitMessage); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Finished cleaning up cluster files in git",
+		"repository", ac.repository())
+	return nil
+}
+
+func (a *ArgoCD) pushToRemoteRepo(ctx context.Context, path, msg string) error {
+	if err := a.gitClient.Commit(msg); err != nil {
+		return fmt.Errorf("committing %s to git: %v", path, err)
+	}
+
+	if err := a.gitClient.Push(ctx); err != nil {
+		return fmt.Errorf("pushing %s to git: %v", path, err)
+	}
+	return nil
+}
+
+func (a *ArgoCD) writerPath(dir string) string {
+	return path.Join(a.writer.Dir(), dir)
+}
+
+func (a *ArgoCD) shouldSkipArgoCD() bool {
+	return a.writer == nil
+}
@@ -0,0 +1,316 @@
+package argocd_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd/mocks"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+type argoCDTest struct {
+	*WithT
+	*testing.T
+	ctx         context.Context
+	argoCD      *mocks.MockGitOpsArgoCDClient
+	git         *mocks.MockGitClient
+	reader      *mocks.MockReader
+	gitOps      *argocd.ArgoCD
+	writer      filewriter.FileWriter
+	cluster     *types.Cluster
+	clusterSpec *cluster.Spec
+}
+
+func newArgoCDTest(t *testing.T, clusterName string) *argoCDTest {
+	ctrl := gomock.NewController(t)
+	argoCDClient := mocks.NewMockGitOpsArgoCDClient(ctrl)
+	gitClient := mocks.NewMockGitClient(ctrl)
+	reader := mocks.NewMockReader(ctrl)
+	_, w := test.NewWriter(t)
+
+	return &argoCDTest{
+		WithT:       NewWithT(t),
+		T:           t,
+		ctx:         context.Background(),
+		argoCD:      argoCDClient,
+		git:         gitClient,
+		reader:      reader,
+		gitOps:      argocd.NewArgoCDFromGitOpsArgoCDClient(argoCDClient, gitClient, w, reader, nil),
+		writer:      w,
+		cluster:     &types.Cluster{Name: clusterName, KubeconfigFile: "kubeconfig"},
+		clusterSpec: newClusterSpec(t, newCluster(clusterName)),
+	}
+}
+
+func newCluster(clusterName string) *v1alpha1.Cluster {
+	c := &v1alpha1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.ClusterKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: v1alpha1.ClusterSpec{
+			KubernetesVersion: v1alpha1.Kube119,
+		},
+	}
+	c.SetSelfManaged()
+
+	return c
+}
+
+func newClusterSpec(t *testing.T, clusterConfig *v1alpha1.Cluster) *cluster.Spec {
+	t.Helper()
+
+	argoCDConfig := &v1alpha1.ArgoCDConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha1.ArgoCDConfigKind,
+			APIVersion: v1alpha1.SchemeBuilder.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-gitops",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.ArgoCDConfigSpec{
+			Branch: "testBranch",
+			Github: &v1alpha1.GithubProviderConfig{
+				Owner:      "janedoe",
+				Repository: "testRepo",
+				Personal:   true,
+			},
+		},
+	}
+
+	clusterConfig.Spec.GitOpsRef = &v1alpha1.Ref{Kind: v1alpha1.ArgoCDConfigKind, Name: "test-gitops"}
+
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster = clusterConfig
+		s.ArgoCDConfig = argoCDConfig
+	})
+	if err := cluster.SetConfigDefaults(clusterSpec.Config); err != nil {
+		t.Fatal(err)
+	}
+	return clusterSpec
+}
+
+func datacenterConfig(clusterName string) *v1alpha1.VSphereDatacenterConfig {
+	return &v1alpha1.VSphereDatacenterConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind: v1alpha1.VSphereDatacenterKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: v1alpha1.VSphereDatacenterConfigSpec{
+			Datacenter: "SDDC-Datacenter",
+		},
+	}
+}
+
+func machineConfig(clusterName string) *v1alpha1.VSphereMachineConfig {
+	return &v1alpha1.VSphereMachineConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind: v1alpha1.VSphereMachineConfigKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Spec: v1alpha1.VSphereMachineConfigSpec{
+			Template: "/SDDC-Datacenter/vm/Templates/ubuntu-2004-kube-v1.19.6",
+		},
+	}
+}
+
+const installManifestURI = "https://anywhere-assets.eks.amazonaws.com/argo-cd/manifests/v2.13.3/install.yaml"
+
+func managementComponents(clusterSpec *cluster.Spec, version string) *cluster.ManagementComponents {
+	managementComponents := cluster.ManagementComponentsFromBundles(clusterSpec.Bundles)
+	managementComponents.ArgoCD = releasev1alpha1.ArgoCDBundle{
+		Version:  version,
+		ArgoCD:   releasev1alpha1.Image{URI: "public.ecr.aws/eks-anywhere/argoproj/argocd:v2.13.3-eks-a-1"},
+		Dex:      releasev1alpha1.Image{URI: "public.ecr.aws/eks-anywhere/argoproj/dex:v2.41.1-eks-a-1"},
+		Redis:    releasev1alpha1.Image{URI: "public.ecr.aws/eks-anywhere/argoproj/redis:v7.0.15-eks-a-1"},
+		Manifest: releasev1alpha1.Manifest{URI: installManifestURI},
+	}
+	return managementComponents
+}
+
+func (tt *argoCDTest) expectReadInstallManifest() {
+	tt.reader.EXPECT().ReadFile(installManifestURI).Return([]byte("install manifest"), nil)
+}
+
+func (tt *argoCDTest) expectCloneAndPush(addPath string) {
+	tt.git.EXPECT().Clone(tt.ctx).Return(nil)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+	tt.git.EXPECT().Add(addPath).Return(nil)
+	tt.git.EXPECT().Commit(test.OfType("string")).Return(nil)
+	tt.git.EXPECT().Push(tt.ctx).Return(nil)
+}
+
+func TestInstallGitOpsManagementCluster(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	managementComponents := managementComponents(tt.clusterSpec, "v2.13.3+abcdef1")
+	argoCDSystemDir := path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd")
+
+	tt.git.EXPECT().GetRepo(tt.ctx).Return(&git.Repository{Name: "testRepo"}, nil)
+	tt.expectReadInstallManifest()
+	tt.expectCloneAndPush("clusters")
+	tt.argoCD.EXPECT().Install(tt.ctx, tt.cluster, argoCDSystemDir, "argocd").Return(nil)
+	tt.argoCD.EXPECT().ApplyRepositorySecret(tt.ctx, tt.cluster, tt.clusterSpec.ArgoCDConfig, nil).Return(nil)
+	tt.argoCD.EXPECT().ApplyApplications(tt.ctx, tt.cluster, test.OfType("[]uint8")).Return(nil)
+	tt.git.EXPECT().Pull(tt.ctx, "testBranch").Return(nil)
+
+	tt.Expect(tt.gitOps.InstallGitOps(tt.ctx, tt.cluster, managementComponents, tt.clusterSpec, datacenterConfig("management-cluster"), []providers.MachineConfig{machineConfig("management-cluster")})).To(Succeed())
+
+	eksaSystemDir := path.Join(tt.writer.Dir(), "clusters/management-cluster/management-cluster/eksa-system")
+	tt.Expect(validations.FileExists(path.Join(eksaSystemDir, "eksa-cluster.yaml"))).To(BeTrue())
+	test.AssertFilesEquals(t, path.Join(eksaSystemDir, "kustomization.yaml"), "./testdata/eksa-kustomization.yaml")
+	test.AssertFilesEquals(t, path.Join(argoCDSystemDir, "kustomization.yaml"), "./testdata/argocd-kustomization.yaml")
+	tt.Expect(os.ReadFile(path.Join(argoCDSystemDir, "install.yaml"))).To(Equal([]byte("install manifest")))
+	test.AssertFilesEquals(t, path.Join(argoCDSystemDir, "namespace.yaml"), "./testdata/argocd-namespace.yaml")
+	test.AssertFilesEquals(t, path.Join(argoCDSystemDir, "argocd-apps.yaml"), "./testdata/argocd-apps.yaml")
+}
+
+func TestInstallGitOpsWorkloadClusterSkipsBootstrap(t *testing.T) {
+	tt := newArgoCDTest(t, "workload-cluster")
+	tt.clusterSpec.Cluster.SetManagedBy("management-cluster")
+	tt.clusterSpec.ArgoCDConfig.Spec.ClusterConfigPath = "clusters/management-cluster"
+	managementComponents := cluster.ManagementComponentsFromBundles(tt.clusterSpec.Bundles)
+
+	tt.git.EXPECT().GetRepo(tt.ctx).Return(&git.Repository{Name: "testRepo"}, nil)
+	tt.expectCloneAndPush("clusters")
+	tt.git.EXPECT().Pull(tt.ctx, "testBranch").Return(nil)
+
+	tt.Expect(tt.gitOps.InstallGitOps(tt.ctx, tt.cluster, managementComponents, tt.clusterSpec, datacenterConfig("workload-cluster"), []providers.MachineConfig{machineConfig("workload-cluster")})).To(Succeed())
+
+	tt.Expect(validations.FileExists(path.Join(tt.writer.Dir(), "clusters/management-cluster/workload-cluster/eksa-system/eksa-cluster.yaml"))).To(BeTrue())
+	tt.Expect(validations.FileExists(path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd/kustomization.yaml"))).To(BeFalse())
+}
+
+func TestInstallGitOpsBootstrapErrorUninstalls(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	managementComponents := managementComponents(tt.clusterSpec, "v2.13.3+abcdef1")
+	argoCDSystemDir := path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd")
+
+	tt.git.EXPECT().GetRepo(tt.ctx).Return(&git.Repository{Name: "testRepo"}, nil)
+	tt.expectReadInstallManifest()
+	tt.expectCloneAndPush("clusters")
+	tt.argoCD.EXPECT().Install(tt.ctx, tt.cluster, argoCDSystemDir, "argocd").Return(nil)
+	tt.argoCD.EXPECT().ApplyRepositorySecret(tt.ctx, tt.cluster, tt.clusterSpec.ArgoCDConfig, nil).Return(errors.New("missing token"))
+	tt.argoCD.EXPECT().Uninstall(tt.ctx, tt.cluster, argoCDSystemDir, "argocd").Return(nil)
+
+	tt.Expect(tt.gitOps.InstallGitOps(tt.ctx, tt.cluster, managementComponents, tt.clusterSpec, nil, nil)).To(MatchError(ContainSubstring("missing token")))
+}
+
+func TestInstallGitOpsBundleWithoutArgoCD(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	managementComponents := cluster.ManagementComponentsFromBundles(tt.clusterSpec.Bundles)
+
+	tt.git.EXPECT().GetRepo(tt.ctx).Return(&git.Repository{Name: "testRepo"}, nil)
+	tt.git.EXPECT().Clone(tt.ctx).Return(nil)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+
+	tt.Expect(tt.gitOps.InstallGitOps(tt.ctx, tt.cluster, managementComponents, tt.clusterSpec, nil, nil)).To(MatchError(ContainSubstring("the EKS-A bundle doesn't include Argo CD")))
+}
+
+func TestInstallGitOpsSkippedWithoutGitOps(t *testing.T) {
+	g := NewWithT(t)
+	a := argocd.NewArgoCDFromGitOpsArgoCDClient(nil, nil, nil, nil, nil)
+	g.Expect(a.InstallGitOps(context.Background(), &types.Cluster{}, nil, nil, nil, nil)).To(Succeed())
+	g.Expect(a.UninstallGitOps(context.Background(), &types.Cluster{}, nil)).To(Succeed())
+}
+
+func TestPauseClusterResourcesReconcile(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.argoCD.EXPECT().DisableApplicationReconcile(tt.ctx, tt.cluster, "eksa-management-cluster", "argocd").Return(nil)
+
+	tt.Expect(tt.gitOps.PauseClusterResourcesReconcile(tt.ctx, tt.cluster, tt.clusterSpec, nil)).To(Succeed())
+}
+
+func TestPauseClusterResourcesReconcileError(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.argoCD.EXPECT().DisableApplicationReconcile(tt.ctx, tt.cluster, "eksa-management-cluster", "argocd").Return(errors.New("not found"))
+
+	tt.Expect(tt.gitOps.PauseClusterResourcesReconcile(tt.ctx, tt.cluster, tt.clusterSpec, nil)).To(MatchError(ContainSubstring("disable application eksa-management-cluster")))
+}
+
+func TestResumeClusterResourcesReconcile(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.argoCD.EXPECT().EnableApplicationReconcile(tt.ctx, tt.cluster, "eksa-management-cluster", "argocd").Return(nil)
+
+	tt.Expect(tt.gitOps.ResumeClusterResourcesReconcile(tt.ctx, tt.cluster, tt.clusterSpec, nil)).To(Succeed())
+}
+
+func TestForceReconcileGitRepo(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.argoCD.EXPECT().Refresh(tt.ctx, tt.cluster, "eksa-management-cluster", "argocd").Return(nil)
+
+	tt.Expect(tt.gitOps.ForceReconcileGitRepo(tt.ctx, tt.cluster, tt.clusterSpec)).To(Succeed())
+}
+
+func TestUpdateGitEksaSpec(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.expectCloneAndPush("clusters/management-cluster/management-cluster/eksa-system")
+
+	tt.Expect(tt.gitOps.UpdateGitEksaSpec(tt.ctx, tt.clusterSpec, datacenterConfig("management-cluster"), []providers.MachineConfig{machineConfig("management-cluster")})).To(Succeed())
+	tt.Expect(validations.FileExists(path.Join(tt.writer.Dir(), "clusters/management-cluster/management-cluster/eksa-system/eksa-cluster.yaml"))).To(BeTrue())
+}
+
+func TestUninstallGitOpsManagementCluster(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.git.EXPECT().Clone(tt.ctx).Return(nil)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+	tt.argoCD.EXPECT().Uninstall(tt.ctx, tt.cluster, path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd"), "argocd").Return(nil)
+
+	tt.Expect(tt.gitOps.UninstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec)).To(Succeed())
+}
+
+func TestUninstallGitOpsManagementClusterError(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.git.EXPECT().Clone(tt.ctx).Return(nil)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+	tt.argoCD.EXPECT().Uninstall(tt.ctx, tt.cluster, path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd"), "argocd").Return(errors.New("error deleting"))
+
+	tt.Expect(tt.gitOps.UninstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec)).To(MatchError(ContainSubstring("error deleting")))
+}
+
+func TestUninstallGitOpsWorkloadCluster(t *testing.T) {
+	tt := newArgoCDTest(t, "workload-cluster")
+	tt.clusterSpec.Cluster.SetManagedBy("management-cluster")
+
+	tt.Expect(tt.gitOps.UninstallGitOps(tt.ctx, tt.cluster, tt.clusterSpec)).To(Succeed())
+}
+
+func TestCleanupGitRepoSkipsMissingDir(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.git.EXPECT().Clone(tt.ctx).Return(nil)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+
+	tt.Expect(tt.gitOps.CleanupGitRepo(tt.ctx, tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidationsRemotePathExists(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	tt.git.EXPECT().PathExists(tt.ctx, "janedoe", "testRepo", "testBranch", "clusters/management-cluster").Return(true, nil)
+
+	vs := tt.gitOps.Validations(tt.ctx, tt.clusterSpec)
+	tt.Expect(vs).To(HaveLen(1))
+	tt.Expect(vs[0]().Err).To(MatchError(ContainSubstring("already exists in remote repository")))
+}
@@ -0,0 +1,237 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/executables"
+	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
+	"github.com/aws/eks-anywhere/pkg/retrier"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	maxRetries    = 5
+	backOffPeriod = 5 * time.Second
+
+	componentsTimeout = "5m"
+
+	skipReconcileAnnotation = "argocd.argoproj.io/skip-reconcile"
+	refreshAnnotation       = "argocd.argoproj.io/refresh"
+	secretTypeLabel         = "argocd.argoproj.io/secret-type"
+
+	applicationResourceType    = "applications.argoproj.io"
+	applicationSetResourceType = "applicationsets.argoproj.io"
+
+	serverDeploymentName         = "argocd-server"
+	repositorySecretName         = "eksa-repository"
+	applicationControllerSetName = "argocd-application-controller"
+)
+
+// deployments are the Argo CD deployments that need to be available before creating any application.
+var deployments = []string{serverDeploymentName, "argocd-repo-server", "argocd-applicationset-controller"}
+
+// KubeClient is an interface that abstracts the basic commands of kubectl executable.
+type KubeClient interface {
+	ApplyKustomization(ctx context.Context, cluster *types.Cluster, dir string) error
+	DeleteKustomization(ctx context.Context, cluster *types.Cluster, dir string) error
+	ApplyKubeSpecFromBytes(ctx context.Context, cluster *types.Cluster, data []byte) error
+	WaitForDeployment(ctx context.Context, cluster *types.Cluster, timeout string, condition string, target string, namespace string) error
+	WaitForResourceRolledout(ctx context.Context, cluster *types.Cluster, timeout string, target string, namespace string, resource string) error
+	Delete(ctx context.Context, resourceType, kubeconfig string, opts ...kubernetes.KubectlDeleteOption) error
+	UpdateAnnotation(ctx context.Context, resourceType, objectName string, annotations map[string]string, opts ...executables.KubectlOpt) error
+	RemoveAnnotation(ctx context.Context, resourceType, objectName string, key string, opts ...executables.KubectlOpt) error
+}
+
+type argoCDClient struct {
+	kube KubeClient
+	*retrier.Retrier
+}
+
+func newArgoCDClient(kube KubeClient) *argoCDClient {
+	return &argoCDClient{
+		kube:    kube,
+		Retrier: retrier.NewWithMaxRetries(maxRetries, backOffPeriod),
+	}
+}
+
+// Install applies the Argo CD kustomization in argoCDSystemDir and waits for its components to be ready.
+func (c *argoCDClient) Install(ctx context.Context, cluster *types.Cluster, argoCDSystemDir, namespace string) error {
+	err := c.Retry(
+		func() error {
+			return c.kube.ApplyKustomization(ctx, cluster, argoCDSystemDir)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("applying argo cd components: %v", err)
+	}
+
+	for _, d := range deployments {
+		if err := c.kube.WaitForDeployment(ctx, cluster, componentsTimeout, "Available", d, namespace); err != nil {
+			return fmt.Errorf("waiting for argo cd deployment %s: %v", d, err)
+		}
+	}
+
+	if err := c.kube.WaitForResourceRolledout(ctx, cluster, componentsTimeout, applicationControllerSetName, namespace, "statefulset"); err != nil {
+		return fmt.Errorf("waiting for argo cd application controller: %v", err)
+	}
+
+	return nil
+}
+
+// ApplyRepositorySecret registers the git repository and its credentials in Argo CD.
+func (c *argoCDClient) ApplyRepositorySecret(ctx context.Context, cluster *types.Cluster, argoCDConfig *v1alpha1.ArgoCDConfig, cliConfig *config.CliConfig) error {
+	secret, err := repositorySecret(argoCDConfig, cliConfig)
+	if err != nil {
+		return err
+	}
+
+	content, err := yaml.Marshal(secret)
+	if err != nil {
+		return fmt.Errorf("marshalling argo cd repository secret: %v", err)
+	}
+
+	return c.Retry(
+		func() error {
+			return c.kube.ApplyKubeSpecFromBytes(ctx, cluster, content)
+		},
+	)
+}
+
+// ApplyApplications applies the Argo CD applications manifest.
+func (c *argoCDClient) ApplyApplications(ctx context.Context, cluster *types.Cluster, manifest []byte) error {
+	return c.Retry(
+		func() error {
+			return c.kube.ApplyKubeSpecFromBytes(ctx, cluster, manifest)
+		},
+	)
+}
+
+// Uninstall deletes the Argo CD applications, without deleting the resources they manage, and then
+// the Argo CD components.
+func (c *argoCDClient) Uninstall(ctx context.Context, cluster *types.Cluster, argoCDSystemDir, namespace string) error {
+	apps := []struct{ resourceType, name string }{
+		{applicationSetResourceType, clustersApplicationSetName},
+		{applicationResourceType, argoCDApplicationName},
+	}
+	for _, app := range apps {
+		err := c.Retry(
+			func() error {
+				err := c.kube.Delete(ctx, app.resourceType, cluster.KubeconfigFile, &kubernetes.KubectlDeleteOptions{
+					Name:      app.name,
+					Namespace: namespace,
+				})
+				if apierrors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("deleting %s %s: %v", app.resourceType, app.name, err)
+		}
+	}
+
+	return c.Retry(
+		func() error {
+			return c.kube.DeleteKustomization(ctx, cluster, argoCDSystemDir)
+		},
+	)
+}
+
+func (c *argoCDClient) DisableApplicationReconcile(ctx context.Context, cluster *types.Cluster, application, namespace string) error {
+	annotations := map[string]string{
+		skipReconcileAnnotation: "true",
+	}
+
+	return c.Retry(
+		func() error {
+			return c.kube.UpdateAnnotation(ctx, applicationResourceType, application, annotations, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(namespace))
+		},
+	)
+}
+
+func (c *argoCDClient) EnableApplicationReconcile(ctx context.Context, cluster *types.Cluster, application, namespace string) error {
+	return c.Retry(
+		func() error {
+			return c.kube.RemoveAnnotation(ctx, applicationResourceType, application, skipReconcileAnnotation, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(namespace))
+		},
+	)
+}
+
+func (c *argoCDClient) Refresh(ctx context.Context, cluster *types.Cluster, application, namespace string) error {
+	annotations := map[string]string{
+		refreshAnnotation: "hard",
+	}
+
+	return c.Retry(
+		func() error {
+			return c.kube.UpdateAnnotation(ctx, applicationResourceType, application, annotations, executables.WithOverwrite(), executables.WithCluster(cluster), executables.WithNamespace(namespace))
+		},
+	)
+}
+
+// repositorySecret builds the Argo CD repository secret with the same credentials the CLI uses to push to the repository.
+func repositorySecret(argoCDConfig *v1alpha1.ArgoCDConfig, cliConfig *config.CliConfig) (*corev1.Secret, error) {
+	data := map[string]string{
+		"type": "git",
+		"url":  repositoryURL(argoCDConfig),
+	}
+
+	switch {
+	case argoCDConfig.Spec.Github != nil:
+		token, err := github.GetGithubAccessTokenFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		data["username"] = argoCDConfig.Spec.Github.Owner
+		data["password"] = token
+	case argoCDConfig.Spec.Gitlab != nil:
+		token, err := gitlab.GetGitlabAccessTokenFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		// GitLab accepts any username with an access token as password.
+		data["username"] = "oauth2"
+		data["password"] = token
+	case argoCDConfig.Spec.Git != nil:
+		if cliConfig == nil || cliConfig.GitPrivateKeyFile == "" {
+			return nil, errors.New("a private key file is required to register the git repository in Argo CD")
+		}
+		if cliConfig.GitSshKeyPassphrase != "" {
+			return nil, errors.New("argo cd does not support passphrase protected SSH private keys")
+		}
+		key, err := os.ReadFile(cliConfig.GitPrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading git private key file: %v", err)
+		}
+		data["sshPrivateKey"] = string(key)
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      repositorySecretName,
+			Namespace: argoCDConfig.Spec.SystemNamespace,
+			Labels: map[string]string{
+				secretTypeLabel: "repository",
+			},
+		},
+		StringData: data,
+	}, nil
+}
@@ -0,0 +1,353 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/git"
+	"github.com/aws/eks-anywhere/pkg/git/providers/github"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/manifests/bundles"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/validations"
+)
+
+// argoCDForCluster bundles the ArgoCD struct with a specific clusterSpec, so that all the git and file write
+// operations for the clusterSpec can be done in each structure method.
+type argoCDForCluster struct {
+	*ArgoCD
+	clusterSpec      *cluster.Spec
+	datacenterConfig providers.DatacenterConfig
+	machineConfigs   []providers.MachineConfig
+}
+
+func newArgoCDForCluster(argoCD *ArgoCD, clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) *argoCDForCluster {
+	return &argoCDForCluster{
+		ArgoCD:           argoCD,
+		clusterSpec:      clusterSpec,
+		datacenterConfig: datacenterConfig,
+		machineConfigs:   machineConfigs,
+	}
+}
+
+// commitArgoCDAndClusterConfigToGit commits the cluster configuration file to the Argo CD managed git repository.
+// It uses the same repository layout as Flux: the cluster configuration goes to <path>/<cluster>/eksa-system and,
+// for self-managed clusters, the Argo CD manifests go to <path>/<namespace>.
+func (ac *argoCDForCluster) commitArgoCDAndClusterConfigToGit(ctx context.Context, managementComponents *cluster.ManagementComponents) error {
+	logger.Info("Adding cluster configuration files to Git")
+
+	if err := ac.validateLocalConfigPathDoesNotExist(); err != nil {
+		return err
+	}
+
+	g := NewFileGenerator()
+	if err := g.Init(ac.writer, ac.eksaSystemDir(), ac.argoCDSystemDir()); err != nil {
+		return err
+	}
+
+	if err := g.WriteEksaFiles(ac.clusterSpec, ac.datacenterConfig, ac.machineConfigs); err != nil {
+		return fmt.Errorf("writing eks-a config files: %v", err)
+	}
+
+	if ac.clusterSpec.Cluster.IsSelfManaged() {
+		if err := ac.writeArgoCDSystemFiles(g, managementComponents); err != nil {
+			return err
+		}
+	}
+
+	p := path.Dir(ac.path())
+	if err := ac.gitClient.Add(p); err != nil {
+		return fmt.Errorf("adding %s to git: %v", p, err)
+	}
+
+	if err := ac.ArgoCD.pushToRemoteRepo(ctx, p, initialClusterconfigCommitMessage); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Finished pushing cluster config and Argo CD manifest files to git")
+	return nil
+}
+
+// writeArgoCDSystemFiles writes the Argo CD manifests for the version in managementComponents, read from
+// the bundle so they follow the EKS-A release and work with registry mirrors and air-gapped installs.
+func (ac *argoCDForCluster) writeArgoCDSystemFiles(g *FileGenerator, managementComponents *cluster.ManagementComponents) error {
+	if managementComponents.ArgoCD.Manifest.URI == "" {
+		return errors.New("the EKS-A bundle doesn't include Argo CD, use an EKS-A release that supports Argo CD")
+	}
+
+	manifest, err := bundles.ReadManifest(ac.reader, managementComponents.ArgoCD.Manifest)
+	if err != nil {
+		return fmt.Errorf("reading argo cd install manifest: %v", err)
+	}
+
+	knownHosts, err := ac.knownHosts()
+	if err != nil {
+		return err
+	}
+
+	if err := g.WriteArgoCDSystemFiles(managementComponents, ac.clusterSpec, manifest.Content, knownHosts); err != nil {
+		return fmt.Errorf("writing argo cd system files: %v", err)
+	}
+
+	return nil
+}
+
+// knownHosts returns the SSH known hosts for the generic git provider, either pinned in the
+// ArgoCDConfig or read from the known hosts file provided to the CLI.
+func (ac *argoCDForCluster) knownHosts() (string, error) {
+	gitConfig := ac.clusterSpec.ArgoCDConfig.Spec.Git
+	if gitConfig == nil {
+		return "", nil
+	}
+
+	if gitConfig.KnownHosts != "" {
+		return gitConfig.KnownHosts, nil
+	}
+
+	if ac.cliConfig == nil || ac.cliConfig.GitKnownHostsFile == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(ac.cliConfig.GitKnownHostsFile)
+	if err != nil {
+		return "", fmt.Errorf("reading git known hosts file: %v", err)
+	}
+
+	return string(content), nil
+}
+
+// applicationsManifest renders the Argo CD applications that sync Argo CD itself and the cluster configurations.
+func (ac *argoCDForCluster) applicationsManifest() ([]byte, error) {
+	return renderApplications(ac.clusterSpec.ArgoCDConfig, ac.repositoryURL())
+}
+
+func (ac *argoCDForCluster) syncGitRepo(ctx context.Context) error {
+	if !validations.FileExists(path.Join(ac.writer.Dir(), ".git")) {
+		if err := ac.clone(ctx); err != nil {
+			return fmt.Errorf("cloning git repo: %v", err)
+		}
+	} else {
+		// Make sure the local git repo is on the branch specified in config and up-to-date with the remote
+		if err := ac.gitClient.Branch(ac.branch()); err != nil {
+			return fmt.Errorf("switching to git branch %s: %v", ac.branch(), err)
+		}
+	}
+	return nil
+}
+
+func (ac *argoCDForCluster) initializeProviderRepositoryIfNotExists(ctx context.Context) (*git.Repository, error) {
+	// If git provider, the repository should be pre-initialized by the user.
+	if ac.clusterSpec.ArgoCDConfig.Spec.Git != nil {
+		return &git.Repository{}, nil
+	}
+
+	r, err := ac.gitClient.GetRepo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("describing repo: %v", err)
+	}
+
+	if r != nil {
+		return r, nil
+	}
+
+	if err = ac.createRemoteRepository(ctx); err != nil {
+		return nil, err
+	}
+
+	if err = ac.initializeLocalRepository(); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// setupRepository will set up the repository which will house the GitOps configuration for the cluster.
+// if the repository exists and is not empty, it will be cloned.
+// if the repository exists but is empty, it will be initialized locally, as a bare repository cannot be cloned.
+// if the repository does not exist, it will be created and then initialized locally.
+func (ac *argoCDForCluster) setupRepository(ctx context.Context) (err error) {
+	r, err := ac.initializeProviderRepositoryIfNotExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if r != nil {
+		err = ac.clone(ctx)
+	}
+
+	var repoEmptyErr *git.RepositoryIsEmptyError
+	if errors.As(err, &repoEmptyErr) {
+		logger.V(3).Info("remote repository is empty and can't be cloned; will initialize locally")
+		if initErr := ac.initializeLocalRepository(); initErr != nil {
+			return fmt.Errorf("initializing local repository: %v", initErr)
+		}
+		return nil
+	}
+
+	return err
+}
+
+func (ac *argoCDForCluster) clone(ctx context.Context) error {
+	logger.V(3).Info("Cloning remote repository")
+	if err := ac.gitClient.Clone(ctx); err != nil {
+		return err
+	}
+
+	logger.V(3).Info("Creating a new branch")
+	return ac.gitClient.Branch(ac.branch())
+}
+
+// createRemoteRepository will create a repository in the remote git provider with the user-provided configuration.
+func (ac *argoCDForCluster) createRemoteRepository(ctx context.Context) error {
+	logger.V(3).Info("Remote repo does not exist; will create and initialize", "repo", ac.repository(), "owner", ac.owner())
+
+	opts := git.CreateRepoOpts{
+		Name:        ac.repository(),
+		Owner:       ac.owner(),
+		Description: "EKS-A cluster configuration repository",
+		Personal:    ac.personal(),
+		Privacy:     true,
+	}
+
+	logger.V(4).Info("Creating remote repo", "options", opts)
+	if err := ac.gitClient.CreateRepo(ctx, opts); err != nil {
+		return fmt.Errorf("creating repo: %v", err)
+	}
+
+	return nil
+}
+
+// initializeLocalRepository will git init the local repository directory, initialize a git repository.
+// it will then change branches to the branch specified in the GitOps configuration.
+func (ac *argoCDForCluster) initializeLocalRepository() error {
+	if err := ac.gitClient.Init(); err != nil {
+		return fmt.Errorf("initializing repository: %v", err)
+	}
+
+	// git requires at least one commit in the repo to branch from
+	if err := ac.gitClient.Commit("initializing repository"); err != nil {
+		return fmt.Errorf("committing to repository: %v", err)
+	}
+
+	if err := ac.gitClient.Branch(ac.branch()); err != nil {
+		return fmt.Errorf("creating branch: %v", err)
+	}
+	return nil
+}
+
+// validateLocalConfigPathDoesNotExist returns an exception if the cluster configuration file exists.
+// This is done so that we avoid clobbering existing cluster configurations in the user-provided git repository.
+func (ac *argoCDForCluster) validateLocalConfigPathDoesNotExist() error {
+	if ac.clusterSpec.Cluster.IsSelfManaged() {
+		p := path.Join(ac.writer.Dir(), ac.path())
+		if validations.FileExists(p) {
+			return fmt.Errorf("a cluster configuration file already exists at path %s", p)
+		}
+	}
+	return nil
+}
+
+func (ac *argoCDForCluster) validateRemoteConfigPathDoesNotExist(ctx context.Context) error {
+	if !ac.clusterSpec.Cluster.IsSelfManaged() || ac.gitClient == nil {
+		return nil
+	}
+
+	exists, err := ac.gitClient.PathExists(ctx, ac.owner(), ac.repository(), ac.branch(), ac.path())
+	if err != nil {
+		return fmt.Errorf("failed validating remote Argo CD config path: %v", err)
+	}
+
+	if exists {
+		return fmt.Errorf("argo cd path %s already exists in remote repository", ac.path())
+	}
+
+	return nil
+}
+
+func (ac *argoCDForCluster) namespace() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.SystemNamespace
+}
+
+func (ac *argoCDForCluster) repository() string {
+	if ac.clusterSpec.ArgoCDConfig.Spec.Github != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Github.Repository
+	}
+	if ac.clusterSpec.ArgoCDConfig.Spec.Gitlab != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Gitlab.Repository
+	}
+	if ac.clusterSpec.ArgoCDConfig.Spec.Git != nil {
+		r := ac.clusterSpec.ArgoCDConfig.Spec.Git.RepositoryUrl
+		return path.Base(strings.TrimSuffix(r, filepath.Ext(r)))
+	}
+	return ""
+}
+
+func (ac *argoCDForCluster) repositoryURL() string {
+	return repositoryURL(ac.clusterSpec.ArgoCDConfig)
+}
+
+func (ac *argoCDForCluster) owner() string {
+	if ac.clusterSpec.ArgoCDConfig.Spec.Github != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Github.Owner
+	}
+	if ac.clusterSpec.ArgoCDConfig.Spec.Gitlab != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Gitlab.Owner
+	}
+	return ""
+}
+
+func (ac *argoCDForCluster) branch() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.Branch
+}
+
+func (ac *argoCDForCluster) personal() bool {
+	if ac.clusterSpec.ArgoCDConfig.Spec.Github != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Github.Personal
+	}
+	if ac.clusterSpec.ArgoCDConfig.Spec.Gitlab != nil {
+		return ac.clusterSpec.ArgoCDConfig.Spec.Gitlab.Personal
+	}
+	return false
+}
+
+func (ac *argoCDForCluster) path() string {
+	return ac.clusterSpec.ArgoCDConfig.Spec.ClusterConfigPath
+}
+
+func (ac *argoCDForCluster) eksaSystemDir() string {
+	return path.Join(ac.path(), ac.clusterSpec.Cluster.GetName(), eksaSystemDirName)
+}
+
+func (ac *argoCDForCluster) argoCDSystemDir() string {
+	return path.Join(ac.path(), ac.namespace())
+}
+
+// applicationName is the name of the Argo CD application generated for the cluster by the clusters ApplicationSet.
+func (ac *argoCDForCluster) applicationName() string {
+	return applicationName(ac.clusterSpec.Cluster.GetName())
+}
+
+func applicationName(clusterName string) string {
+	return "eksa-" + clusterName
+}
+
+// repositoryURL returns the URL Argo CD uses to pull the repository configured in the ArgoCDConfig.
+func repositoryURL(config *v1alpha1.ArgoCDConfig) string {
+	switch {
+	case config.Spec.Github != nil:
+		return github.RepoUrl(config.Spec.Github.Owner, config.Spec.Github.Repository)
+	case config.Spec.Gitlab != nil:
+		return gitlab.RepoUrl(gitlab.Hostname(config.Spec.Gitlab), config.Spec.Gitlab.Owner, config.Spec.Gitlab.Repository)
+	case config.Spec.Git != nil:
+		return config.Spec.Git.RepositoryUrl
+	}
+	return ""
+}
@@ -0,0 +1,236 @@
+package argocd
+
+import (
+	_ "embed"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/clustermarshaller"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/providers"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+const (
+	eksaSystemDirName     = "eksa-system"
+	kustomizeFileName     = "kustomization.yaml"
+	clusterConfigFileName = "eksa-cluster.yaml"
+	namespaceFileName     = "namespace.yaml"
+	knownHostsFileName    = "ssh_known_hosts"
+	applicationsFileName  = "argocd-apps.yaml"
+	installFileName       = "install.yaml"
+
+	// These are the images referenced by the Argo CD install manifest. The kustomization replaces
+	// them with the images from the bundle.
+	upstreamArgoCDImage = "quay.io/argoproj/argocd"
+	upstreamDexImage    = "ghcr.io/dexidp/dex"
+	upstreamRedisImage  = "public.ecr.aws/docker/library/redis"
+
+	argoCDApplicationName      = "eksa-argocd"
+	clustersApplicationSetName = "eksa-clusters"
+)
+
+//go:embed manifests/eksa-system/kustomization.yaml
+var eksaKustomizeContent string
+
+//go:embed manifests/argocd-system/kustomization.yaml
+var argoCDKustomizeContent string
+
+//go:embed manifests/argocd-system/namespace.yaml
+var argoCDNamespaceContent string
+
+//go:embed manifests/argocd-system/argocd-apps.yaml
+var argoCDApplicationsContent string
+
+type Templater interface {
+	WriteToFile(templateContent string, data interface{}, fileName string, f ...filewriter.FileOptionsFunc) (filePath string, err error)
+}
+
+type FileGenerator struct {
+	argoCDWriter, eksaWriter       filewriter.FileWriter
+	argoCDTemplater, eksaTemplater Templater
+}
+
+func NewFileGenerator() *FileGenerator {
+	return &FileGenerator{}
+}
+
+// NewFileGeneratorWithWriterTemplater takes argo cd and eksa writer and templater interface to build the generator.
+// This is only for testing.
+func NewFileGeneratorWithWriterTemplater(argoCDWriter, eksaWriter filewriter.FileWriter, argoCDTemplater, eksaTemplater Templater) *FileGenerator {
+	return &FileGenerator{
+		argoCDWriter:    argoCDWriter,
+		eksaWriter:      eksaWriter,
+		argoCDTemplater: argoCDTemplater,
+		eksaTemplater:   eksaTemplater,
+	}
+}
+
+func (g *FileGenerator) Init(writer filewriter.FileWriter, eksaSystemDir, argoCDSystemDir string) error {
+	eksaWriter, err := writer.WithDir(eksaSystemDir)
+	if err != nil {
+		return fmt.Errorf("initializing eks-a system writer: %v", err)
+	}
+	eksaWriter.CleanUpTemp()
+
+	argoCDWriter, err := writer.WithDir(argoCDSystemDir)
+	if err != nil {
+		return fmt.Errorf("initializing argo cd system writer: %v", err)
+	}
+	argoCDWriter.CleanUpTemp()
+
+	g.eksaWriter = eksaWriter
+	g.argoCDWriter = argoCDWriter
+	g.eksaTemplater = templater.New(eksaWriter)
+	g.argoCDTemplater = templater.New(argoCDWriter)
+
+	return nil
+}
+
+func (g *FileGenerator) WriteEksaFiles(clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	if datacenterConfig == nil && machineConfigs == nil {
+		return nil
+	}
+
+	if err := g.WriteClusterConfig(clusterSpec, datacenterConfig, machineConfigs); err != nil {
+		return err
+	}
+
+	if err := g.WriteEksaKustomization(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *FileGenerator) WriteClusterConfig(clusterSpec *cluster.Spec, datacenterConfig providers.DatacenterConfig, machineConfigs []providers.MachineConfig) error {
+	specs, err := clustermarshaller.MarshalClusterSpec(clusterSpec, datacenterConfig, machineConfigs)
+	if err != nil {
+		return err
+	}
+	if filePath, err := g.eksaWriter.Write(clusterConfigFileName, specs, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing eks-a cluster config file into %s: %v", filePath, err)
+	}
+
+	return nil
+}
+
+func (g *FileGenerator) WriteEksaKustomization() error {
+	values := map[string]string{
+		"ConfigFileName": clusterConfigFileName,
+	}
+
+	if path, err := g.eksaTemplater.WriteToFile(eksaKustomizeContent, values, kustomizeFileName, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing eks-a kustomization manifest file into %s: %v", path, err)
+	}
+	return nil
+}
+
+// WriteArgoCDSystemFiles writes the Argo CD install manifest from the bundle, the kustomization that
+// installs it with the bundle images, and the applications that sync the cluster configurations into
+// the argo cd system git directory.
+func (g *FileGenerator) WriteArgoCDSystemFiles(managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, installManifest []byte, knownHosts string) error {
+	if err := g.WriteArgoCDNamespace(clusterSpec); err != nil {
+		return err
+	}
+
+	if path, err := g.argoCDWriter.Write(installFileName, installManifest, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing argo cd install manifest file into %s: %v", path, err)
+	}
+
+	if knownHosts != "" {
+		if path, err := g.argoCDWriter.Write(knownHostsFileName, []byte(knownHosts), filewriter.PersistentFile); err != nil {
+			return fmt.Errorf("writing argo cd known hosts file into %s: %v", path, err)
+		}
+	}
+
+	if err := g.WriteArgoCDKustomization(managementComponents, clusterSpec, knownHosts != ""); err != nil {
+		return err
+	}
+
+	if err := g.WriteArgoCDApplications(clusterSpec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteArgoCDNamespace writes the namespace manifest for the Argo CD components.
+func (g *FileGenerator) WriteArgoCDNamespace(clusterSpec *cluster.Spec) error {
+	values := map[string]string{
+		"Namespace": clusterSpec.ArgoCDConfig.Spec.SystemNamespace,
+	}
+
+	if path, err := g.argoCDTemplater.WriteToFile(argoCDNamespaceContent, values, namespaceFileName, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing argo cd namespace manifest file into %s: %v", path, err)
+	}
+	return nil
+}
+
+// WriteArgoCDKustomization writes the kustomization that installs Argo CD with the images from the bundle.
+func (g *FileGenerator) WriteArgoCDKustomization(managementComponents *cluster.ManagementComponents, clusterSpec *cluster.Spec, withKnownHosts bool) error {
+	argoCD := managementComponents.ArgoCD
+	values := map[string]string{
+		"Namespace":           clusterSpec.ArgoCDConfig.Spec.SystemNamespace,
+		"InstallFileName":     installFileName,
+		"UpstreamArgoCDImage": upstreamArgoCDImage,
+		"ArgoCDImage":         argoCD.ArgoCD.Image(),
+		"ArgoCDTag":           argoCD.ArgoCD.Tag(),
+		"UpstreamDexImage":    upstreamDexImage,
+		"DexImage":            argoCD.Dex.Image(),
+		"DexTag":              argoCD.Dex.Tag(),
+		"UpstreamRedisImage":  upstreamRedisImage,
+		"RedisImage":          argoCD.Redis.Image(),
+		"RedisTag":            argoCD.Redis.Tag(),
+	}
+	if withKnownHosts {
+		values["KnownHostsFileName"] = knownHostsFileName
+	}
+
+	if path, err := g.argoCDTemplater.WriteToFile(argoCDKustomizeContent, values, kustomizeFileName, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing argo cd kustomization manifest file into %s: %v", path, err)
+	}
+	return nil
+}
+
+// WriteArgoCDApplications writes the applications that sync Argo CD and the cluster configurations.
+func (g *FileGenerator) WriteArgoCDApplications(clusterSpec *cluster.Spec) error {
+	content, err := renderApplications(clusterSpec.ArgoCDConfig, repositoryURL(clusterSpec.ArgoCDConfig))
+	if err != nil {
+		return err
+	}
+
+	if path, err := g.argoCDWriter.Write(applicationsFileName, content, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("writing argo cd applications manifest file into %s: %v", path, err)
+	}
+	return nil
+}
+
+// renderApplications renders the Argo CD Application that syncs the Argo CD installation and the ApplicationSet
+// that generates one Application per cluster directory in the repository.
+func renderApplications(config *v1alpha1.ArgoCDConfig, repositoryURL string) ([]byte, error) {
+	clustersPath := path.Clean(config.Spec.ClusterConfigPath)
+	values := map[string]string{
+		"Namespace":                  config.Spec.SystemNamespace,
+		"RepositoryURL":              repositoryURL,
+		"Branch":                     config.Spec.Branch,
+		"ArgoCDApplicationName":      argoCDApplicationName,
+		"ArgoCDSystemPath":           path.Join(clustersPath, config.Spec.SystemNamespace),
+		"ClustersApplicationSetName": clustersApplicationSetName,
+		"ClustersPath":               clustersPath,
+		// These are ApplicationSet parameters, resolved by Argo CD for each generated application.
+		// The cluster name is the path segment right after the cluster config path.
+		"ClusterNameParameter": fmt.Sprintf("{{path[%d]}}", len(strings.Split(clustersPath, "/"))),
+		"PathParameter":        "{{path}}",
+	}
+
+	content, err := templater.Execute(argoCDApplicationsContent, values)
+	if err != nil {
+		return nil, fmt.Errorf("generating argo cd applications manifest: %v", err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,550 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/aws/eks-anywhere/pkg/gitops/argocd (interfaces: KubeClient,GitOpsArgoCDClient,GitClient,Templater,Reader)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1alpha1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	kubernetes "github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	config "github.com/aws/eks-anywhere/pkg/config"
+	executables "github.com/aws/eks-anywhere/pkg/executables"
+	filewriter "github.com/aws/eks-anywhere/pkg/filewriter"
+	git "github.com/aws/eks-anywhere/pkg/git"
+	types "github.com/aws/eks-anywhere/pkg/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKubeClient is a mock of KubeClient interface.
+type MockKubeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubeClientMockRecorder
+}
+
+// MockKubeClientMockRecorder is the mock recorder for MockKubeClient.
+type MockKubeClientMockRecorder struct {
+	mock *MockKubeClient
+}
+
+// NewMockKubeClient creates a new mock instance.
+func NewMockKubeClient(ctrl *gomock.Controller) *MockKubeClient {
+	mock := &MockKubeClient{ctrl: ctrl}
+	mock.recorder = &MockKubeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubeClient) EXPECT() *MockKubeClientMockRecorder {
+	return m.recorder
+}
+
+// ApplyKubeSpecFromBytes mocks base method.
+func (m *MockKubeClient) ApplyKubeSpecFromBytes(arg0 context.Context, arg1 *types.Cluster, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyKubeSpecFromBytes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyKubeSpecFromBytes indicates an expected call of ApplyKubeSpecFromBytes.
+func (mr *MockKubeClientMockRecorder) ApplyKubeSpecFromBytes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyKubeSpecFromBytes", reflect.TypeOf((*MockKubeClient)(nil).ApplyKubeSpecFromBytes), arg0, arg1, arg2)
+}
+
+// ApplyKustomization mocks base method.
+func (m *MockKubeClient) ApplyKustomization(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyKustomization", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyKustomization indicates an expected call of ApplyKustomization.
+func (mr *MockKubeClientMockRecorder) ApplyKustomization(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyKustomization", reflect.TypeOf((*MockKubeClient)(nil).ApplyKustomization), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockKubeClient) Delete(arg0 context.Context, arg1, arg2 string, arg3 ...kubernetes.KubectlDeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKubeClientMockRecorder) Delete(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKubeClient)(nil).Delete), varargs...)
+}
+
+// DeleteKustomization mocks base method.
+func (m *MockKubeClient) DeleteKustomization(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKustomization", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKustomization indicates an expected call of DeleteKustomization.
+func (mr *MockKubeClientMockRecorder) DeleteKustomization(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKustomization", reflect.TypeOf((*MockKubeClient)(nil).DeleteKustomization), arg0, arg1, arg2)
+}
+
+// RemoveAnnotation mocks base method.
+func (m *MockKubeClient) RemoveAnnotation(arg0 context.Context, arg1, arg2, arg3 string, arg4 ...executables.KubectlOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveAnnotation", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAnnotation indicates an expected call of RemoveAnnotation.
+func (mr *MockKubeClientMockRecorder) RemoveAnnotation(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotation", reflect.TypeOf((*MockKubeClient)(nil).RemoveAnnotation), varargs...)
+}
+
+// UpdateAnnotation mocks base method.
+func (m *MockKubeClient) UpdateAnnotation(arg0 context.Context, arg1, arg2 string, arg3 map[string]string, arg4 ...executables.KubectlOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAnnotation", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnnotation indicates an expected call of UpdateAnnotation.
+func (mr *MockKubeClientMockRecorder) UpdateAnnotation(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotation", reflect.TypeOf((*MockKubeClient)(nil).UpdateAnnotation), varargs...)
+}
+
+// WaitForDeployment mocks base method.
+func (m *MockKubeClient) WaitForDeployment(arg0 context.Context, arg1 *types.Cluster, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForDeployment", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForDeployment indicates an expected call of WaitForDeployment.
+func (mr *MockKubeClientMockRecorder) WaitForDeployment(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForDeployment", reflect.TypeOf((*MockKubeClient)(nil).WaitForDeployment), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// WaitForResourceRolledout mocks base method.
+func (m *MockKubeClient) WaitForResourceRolledout(arg0 context.Context, arg1 *types.Cluster, arg2, arg3, arg4, arg5 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForResourceRolledout", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForResourceRolledout indicates an expected call of WaitForResourceRolledout.
+func (mr *MockKubeClientMockRecorder) WaitForResourceRolledout(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForResourceRolledout", reflect.TypeOf((*MockKubeClient)(nil).WaitForResourceRolledout), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// MockGitOpsArgoCDClient is a mock of GitOpsArgoCDClient interface.
+type MockGitOpsArgoCDClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitOpsArgoCDClientMockRecorder
+}
+
+// MockGitOpsArgoCDClientMockRecorder is the mock recorder for MockGitOpsArgoCDClient.
+type MockGitOpsArgoCDClientMockRecorder struct {
+	mock *MockGitOpsArgoCDClient
+}
+
+// NewMockGitOpsArgoCDClient creates a new mock instance.
+func NewMockGitOpsArgoCDClient(ctrl *gomock.Controller) *MockGitOpsArgoCDClient {
+	mock := &MockGitOpsArgoCDClient{ctrl: ctrl}
+	mock.recorder = &MockGitOpsArgoCDClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitOpsArgoCDClient) EXPECT() *MockGitOpsArgoCDClientMockRecorder {
+	return m.recorder
+}
+
+// ApplyApplications mocks base method.
+func (m *MockGitOpsArgoCDClient) ApplyApplications(arg0 context.Context, arg1 *types.Cluster, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyApplications", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyApplications indicates an expected call of ApplyApplications.
+func (mr *MockGitOpsArgoCDClientMockRecorder) ApplyApplications(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyApplications", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).ApplyApplications), arg0, arg1, arg2)
+}
+
+// ApplyRepositorySecret mocks base method.
+func (m *MockGitOpsArgoCDClient) ApplyRepositorySecret(arg0 context.Context, arg1 *types.Cluster, arg2 *v1alpha1.ArgoCDConfig, arg3 *config.CliConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyRepositorySecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyRepositorySecret indicates an expected call of ApplyRepositorySecret.
+func (mr *MockGitOpsArgoCDClientMockRecorder) ApplyRepositorySecret(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRepositorySecret", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).ApplyRepositorySecret), arg0, arg1, arg2, arg3)
+}
+
+// DisableApplicationReconcile mocks base method.
+func (m *MockGitOpsArgoCDClient) DisableApplicationReconcile(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableApplicationReconcile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableApplicationReconcile indicates an expected call of DisableApplicationReconcile.
+func (mr *MockGitOpsArgoCDClientMockRecorder) DisableApplicationReconcile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableApplicationReconcile", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).DisableApplicationReconcile), arg0, arg1, arg2, arg3)
+}
+
+// EnableApplicationReconcile mocks base method.
+func (m *MockGitOpsArgoCDClient) EnableApplicationReconcile(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableApplicationReconcile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableApplicationReconcile indicates an expected call of EnableApplicationReconcile.
+func (mr *MockGitOpsArgoCDClientMockRecorder) EnableApplicationReconcile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableApplicationReconcile", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).EnableApplicationReconcile), arg0, arg1, arg2, arg3)
+}
+
+// Install mocks base method.
+func (m *MockGitOpsArgoCDClient) Install(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Install", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Install indicates an expected call of Install.
+func (mr *MockGitOpsArgoCDClientMockRecorder) Install(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).Install), arg0, arg1, arg2, arg3)
+}
+
+// Refresh mocks base method.
+func (m *MockGitOpsArgoCDClient) Refresh(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockGitOpsArgoCDClientMockRecorder) Refresh(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).Refresh), arg0, arg1, arg2, arg3)
+}
+
+// Uninstall mocks base method.
+func (m *MockGitOpsArgoCDClient) Uninstall(arg0 context.Context, arg1 *types.Cluster, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uninstall", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uninstall indicates an expected call of Uninstall.
+func (mr *MockGitOpsArgoCDClientMockRecorder) Uninstall(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uninstall", reflect.TypeOf((*MockGitOpsArgoCDClient)(nil).Uninstall), arg0, arg1, arg2, arg3)
+}
+
+// MockGitClient is a mock of GitClient interface.
+type MockGitClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitClientMockRecorder
+}
+
+// MockGitClientMockRecorder is the mock recorder for MockGitClient.
+type MockGitClientMockRecorder struct {
+	mock *MockGitClient
+}
+
+// NewMockGitClient creates a new mock instance.
+func NewMockGitClient(ctrl *gomock.Controller) *MockGitClient {
+	mock := &MockGitClient{ctrl: ctrl}
+	mock.recorder = &MockGitClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitClient) EXPECT() *MockGitClientMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockGitClient) Add(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockGitClientMockRecorder) Add(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockGitClient)(nil).Add), arg0)
+}
+
+// Branch mocks base method.
+func (m *MockGitClient) Branch(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Branch", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Branch indicates an expected call of Branch.
+func (mr *MockGitClientMockRecorder) Branch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Branch", reflect.TypeOf((*MockGitClient)(nil).Branch), arg0)
+}
+
+// Clone mocks base method.
+func (m *MockGitClient) Clone(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockGitClientMockRecorder) Clone(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGitClient)(nil).Clone), arg0)
+}
+
+// Commit mocks base method.
+func (m *MockGitClient) Commit(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockGitClientMockRecorder) Commit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockGitClient)(nil).Commit), arg0)
+}
+
+// CreateRepo mocks base method.
+func (m *MockGitClient) CreateRepo(arg0 context.Context, arg1 git.CreateRepoOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRepo indicates an expected call of CreateRepo.
+func (mr *MockGitClientMockRecorder) CreateRepo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepo", reflect.TypeOf((*MockGitClient)(nil).CreateRepo), arg0, arg1)
+}
+
+// GetRepo mocks base method.
+func (m *MockGitClient) GetRepo(arg0 context.Context) (*git.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepo", arg0)
+	ret0, _ := ret[0].(*git.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepo indicates an expected call of GetRepo.
+func (mr *MockGitClientMockRecorder) GetRepo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepo", reflect.TypeOf((*MockGitClient)(nil).GetRepo), arg0)
+}
+
+// Init mocks base method.
+func (m *MockGitClient) Init() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockGitClientMockRecorder) Init() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockGitClient)(nil).Init))
+}
+
+// PathExists mocks base method.
+func (m *MockGitClient) PathExists(arg0 context.Context, arg1, arg2, arg3, arg4 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PathExists", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PathExists indicates an expected call of PathExists.
+func (mr *MockGitClientMockRecorder) PathExists(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PathExists", reflect.TypeOf((*MockGitClient)(nil).PathExists), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Pull mocks base method.
+func (m *MockGitClient) Pull(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pull", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pull indicates an expected call of Pull.
+func (mr *MockGitClientMockRecorder) Pull(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pull", reflect.TypeOf((*MockGitClient)(nil).Pull), arg0, arg1)
+}
+
+// Push mocks base method.
+func (m *MockGitClient) Push(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Push", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Push indicates an expected call of Push.
+func (mr *MockGitClientMockRecorder) Push(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockGitClient)(nil).Push), arg0)
+}
+
+// Remove mocks base method.
+func (m *MockGitClient) Remove(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockGitClientMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockGitClient)(nil).Remove), arg0)
+}
+
+// MockTemplater is a mock of Templater interface.
+type MockTemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplaterMockRecorder
+}
+
+// MockTemplaterMockRecorder is the mock recorder for MockTemplater.
+type MockTemplaterMockRecorder struct {
+	mock *MockTemplater
+}
+
+// NewMockTemplater creates a new mock instance.
+func NewMockTemplater(ctrl *gomock.Controller) *MockTemplater {
+	mock := &MockTemplater{ctrl: ctrl}
+	mock.recorder = &MockTemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplater) EXPECT() *MockTemplaterMockRecorder {
+	return m.recorder
+}
+
+// WriteToFile mocks base method.
+func (m *MockTemplater) WriteToFile(arg0 string, arg1 interface{}, arg2 string, arg3 ...filewriter.FileOptionsFunc) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteToFile", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteToFile indicates an expected call of WriteToFile.
+func (mr *MockTemplaterMockRecorder) WriteToFile(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteToFile", reflect.TypeOf((*MockTemplater)(nil).WriteToFile), varargs...)
+}
+
+// MockReader is a mock of Reader interface.
+type MockReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockReaderMockRecorder
+}
+
+// MockReaderMockRecorder is the mock recorder for MockReader.
+type MockReaderMockRecorder struct {
+	mock *MockReader
+}
+
+// NewMockReader creates a new mock instance.
+func NewMockReader(ctrl *gomock.Controller) *MockReader {
+	mock := &MockReader{ctrl: ctrl}
+	mock.recorder = &MockReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReader) EXPECT() *MockReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadFile mocks base method.
+func (m *MockReader) ReadFile(arg0 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockReaderMockRecorder) ReadFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockReader)(nil).ReadFile), arg0)
+}
@@ -0,0 +1,113 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const upgradeArgoCDConfigCommitMessage = "Upgrade commit of Argo CD configuration; generated by EKS-A CLI"
+
+// Upgrade checks whether upgrading the Argo CD components is necessary and, if so, upgrades them to the
+// version in the new management components bundle.
+func (a *ArgoCD) Upgrade(ctx context.Context, managementCluster *types.Cluster, currentManagementComponents, newManagementComponents *cluster.ManagementComponents, currentSpec *cluster.Spec, newSpec *cluster.Spec) (*types.ChangeDiff, error) {
+	logger.V(1).Info("Checking for Argo CD upgrades")
+
+	if a.shouldSkipArgoCD() || !shouldUpgrade(currentSpec, newSpec) {
+		return nil, nil
+	}
+
+	oldVersion := currentManagementComponents.ArgoCD.Version
+	newVersion := newManagementComponents.ArgoCD.Version
+	changeDiff := ChangeDiff(oldVersion, newVersion)
+	if changeDiff == nil {
+		logger.V(1).Info("Nothing to upgrade for Argo CD")
+		return nil, nil
+	}
+
+	logger.V(1).Info("Starting Argo CD upgrades")
+	ac := newArgoCDForCluster(a, newSpec, nil, nil)
+	if err := ac.upgradeFilesAndCommit(ctx, newManagementComponents); err != nil {
+		return nil, fmt.Errorf("upgrading Argo CD from version %s to %s: %v", oldVersion, newVersion, err)
+	}
+
+	if err := a.bootstrap(ctx, managementCluster, newSpec); err != nil {
+		return nil, fmt.Errorf("upgrading Argo CD components: %v", err)
+	}
+
+	return changeDiff, nil
+}
+
+// ChangeDiff generates a version change diff for the Argo CD components.
+func ChangeDiff(oldVersion, newVersion string) *types.ChangeDiff {
+	if oldVersion == newVersion {
+		return nil
+	}
+
+	logger.V(1).Info("Argo CD change diff ", "oldVersion ", oldVersion, "newVersion ", newVersion)
+	return &types.ChangeDiff{
+		ComponentReports: []types.ComponentChangeDiff{
+			{
+				ComponentName: "Argo CD",
+				NewVersion:    newVersion,
+				OldVersion:    oldVersion,
+			},
+		},
+	}
+}
+
+func shouldUpgrade(currentSpec, newSpec *cluster.Spec) bool {
+	if !newSpec.Cluster.IsSelfManaged() {
+		logger.V(1).Info("Skipping Argo CD upgrades, not a self-managed cluster")
+		return false
+	}
+	if currentSpec.Cluster.Spec.GitOpsRef == nil {
+		logger.V(1).Info("Skipping Argo CD upgrades, no previous Argo CD installed in the cluster")
+		return false
+	}
+	if currentSpec.Cluster.Spec.GitOpsRef.Kind != v1alpha1.ArgoCDConfigKind {
+		logger.V(1).Info("Skipping Argo CD upgrades, cluster is not managed by Argo CD")
+		return false
+	}
+	return true
+}
+
+// Install installs the Argo CD components when GitOps is enabled in an existing cluster.
+func (a *ArgoCD) Install(ctx context.Context, cluster *types.Cluster, managementComponents *cluster.ManagementComponents, oldSpec, newSpec *cluster.Spec) error {
+	if oldSpec.Cluster.Spec.GitOpsRef == nil && newSpec.Cluster.Spec.GitOpsRef != nil {
+		return a.InstallGitOps(ctx, cluster, managementComponents, newSpec, nil, nil)
+	}
+	return nil
+}
+
+func (ac *argoCDForCluster) upgradeFilesAndCommit(ctx context.Context, managementComponents *cluster.ManagementComponents) error {
+	if err := ac.syncGitRepo(ctx); err != nil {
+		return err
+	}
+
+	logger.Info("Adding Argo CD configuration files to Git")
+
+	g := NewFileGenerator()
+	if err := g.Init(ac.writer, ac.eksaSystemDir(), ac.argoCDSystemDir()); err != nil {
+		return err
+	}
+
+	if err := ac.writeArgoCDSystemFiles(g, managementComponents); err != nil {
+		return err
+	}
+
+	if err := ac.gitClient.Add(ac.path()); err != nil {
+		return fmt.Errorf("adding %s to git: %v", ac.path(), err)
+	}
+
+	if err := ac.ArgoCD.pushToRemoteRepo(ctx, ac.path(), upgradeArgoCDConfigCommitMessage); err != nil {
+		return err
+	}
+	logger.V(3).Info("Finished pushing Argo CD manifest files to git",
+		"repository", ac.repository())
+	return nil
+}
@@ -0,0 +1,89 @@
+package argocd_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/gitops/argocd"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+func TestArgoCDUpgradeNoChanges(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	currentComponents := managementComponents(tt.clusterSpec, "v2.13.3+abcdef1")
+	newComponents := managementComponents(tt.clusterSpec, "v2.13.3+abcdef1")
+
+	tt.Expect(tt.gitOps.Upgrade(tt.ctx, tt.cluster, currentComponents, newComponents, tt.clusterSpec, tt.clusterSpec)).To(BeNil())
+}
+
+func TestArgoCDUpgradeSuccess(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	argoCDSystemDir := path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd")
+	currentComponents := managementComponents(tt.clusterSpec, "v2.12.0+abcdef1")
+	newComponents := managementComponents(tt.clusterSpec, "v2.13.3+abcdef1")
+
+	tt.expectCloneAndPush("clusters/management-cluster")
+	tt.expectReadInstallManifest()
+	tt.argoCD.EXPECT().Install(tt.ctx, tt.cluster, argoCDSystemDir, "argocd").Return(nil)
+	tt.argoCD.EXPECT().ApplyRepositorySecret(tt.ctx, tt.cluster, tt.clusterSpec.ArgoCDConfig, nil).Return(nil)
+	tt.argoCD.EXPECT().ApplyApplications(tt.ctx, tt.cluster, test.OfType("[]uint8")).Return(nil)
+
+	wantDiff := &types.ChangeDiff{
+		ComponentReports: []types.ComponentChangeDiff{
+			{
+				ComponentName: "Argo CD",
+				OldVersion:    "v2.12.0+abcdef1",
+				NewVersion:    "v2.13.3+abcdef1",
+			},
+		},
+	}
+	tt.Expect(tt.gitOps.Upgrade(tt.ctx, tt.cluster, currentComponents, newComponents, tt.clusterSpec, tt.clusterSpec)).To(Equal(wantDiff))
+	tt.Expect(path.Join(argoCDSystemDir, "kustomization.yaml")).To(BeAnExistingFile())
+}
+
+func TestArgoCDUpgradeSkipWorkloadCluster(t *testing.T) {
+	tt := newArgoCDTest(t, "workload-cluster")
+	tt.clusterSpec.Cluster.SetManagedBy("management-cluster")
+
+	tt.Expect(tt.gitOps.Upgrade(tt.ctx, tt.cluster, nil, nil, tt.clusterSpec, tt.clusterSpec)).To(BeNil())
+}
+
+func TestArgoCDUpgradeSkipWithoutPreviousGitOps(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	currentSpec := tt.clusterSpec.DeepCopy()
+	currentSpec.Cluster.Spec.GitOpsRef = nil
+
+	tt.Expect(tt.gitOps.Upgrade(tt.ctx, tt.cluster, nil, nil, currentSpec, tt.clusterSpec)).To(BeNil())
+}
+
+func TestArgoCDInstallWhenEnablingGitOps(t *testing.T) {
+	tt := newArgoCDTest(t, "management-cluster")
+	currentSpec := tt.clusterSpec.DeepCopy()
+	currentSpec.Cluster.Spec.GitOpsRef = nil
+	argoCDSystemDir := path.Join(tt.writer.Dir(), "clusters/management-cluster/argocd")
+
+	tt.git.EXPECT().GetRepo(tt.ctx).Return(nil, nil)
+	tt.git.EXPECT().CreateRepo(tt.ctx, gomock.Any()).Return(nil)
+	tt.git.EXPECT().Init().Return(nil)
+	tt.git.EXPECT().Commit(gomock.Any()).Return(nil).Times(2)
+	tt.git.EXPECT().Branch("testBranch").Return(nil)
+	tt.git.EXPECT().Add("clusters").Return(nil)
+	tt.git.EXPECT().Push(tt.ctx).Return(nil)
+	tt.argoCD.EXPECT().Install(tt.ctx, tt.cluster, argoCDSystemDir, "argocd").Return(nil)
+	tt.argoCD.EXPECT().ApplyRepositorySecret(tt.ctx, tt.cluster, tt.clusterSpec.ArgoCDConfig, nil).Return(nil)
+	tt.argoCD.EXPECT().ApplyApplications(tt.ctx, tt.cluster, test.OfType("[]uint8")).Return(nil)
+	tt.git.EXPECT().Pull(tt.ctx, "testBranch").Return(nil)
+	tt.expectReadInstallManifest()
+
+	tt.Expect(tt.gitOps.Install(tt.ctx, tt.cluster, managementComponents(tt.clusterSpec, "v2.13.3+abcdef1"), currentSpec, tt.clusterSpec)).To(Succeed())
+}
+
+func TestChangeDiff(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(argocd.ChangeDiff("v2.13.3", "v2.13.3")).To(BeNil())
+	g.Expect(argocd.ChangeDiff("v2.12.0", "v2.13.3").ComponentReports).To(HaveLen(1))
+}
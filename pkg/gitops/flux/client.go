@@ -22,6 +22,7 @@ const (
 // FluxClient is an interface that abstracts the basic commands of flux executable.
 type FluxClient interface {
 	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
+	BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	Reconcile(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
@@ -57,6 +58,14 @@ func (c *fluxClient) BootstrapGithub(ctx context.Context, cluster *types.Cluster
 	)
 }
 
+func (c *fluxClient) BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error {
+	return c.Retry(
+		func() error {
+			return c.flux.BootstrapGitlab(ctx, cluster, fluxConfig)
+		},
+	)
+}
+
 func (c *fluxClient) BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error {
 	return c.Retry(
 		func() error {
@@ -27,6 +27,7 @@ const (
 
 type GitOpsFluxClient interface {
 	BootstrapGithub(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
+	BootstrapGitlab(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	BootstrapGit(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig, cliConfig *config.CliConfig) error
 	Uninstall(ctx context.Context, cluster *types.Cluster, fluxConfig *v1alpha1.FluxConfig) error
 	GetCluster(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) (eksaCluster *v1alpha1.Cluster, err error)
@@ -118,6 +119,11 @@ func (f *Flux) Bootstrap(ctx context.Context, cluster *types.Cluster, clusterSpe
 		return fmt.Errorf("installing GitHub gitops: %v", err)
 	}
 
+	if err := f.BootstrapGitlab(ctx, cluster, clusterSpec); err != nil {
+		_ = f.Uninstall(ctx, cluster, clusterSpec)
+		return fmt.Errorf("installing GitLab gitops: %v", err)
+	}
+
 	if err := f.BootstrapGit(ctx, cluster, clusterSpec); err != nil {
 		_ = f.Uninstall(ctx, cluster, clusterSpec)
 		return fmt.Errorf("installing generic git gitops: %v", err)
@@ -134,6 +140,14 @@ func (f *Flux) BootstrapGithub(ctx context.Context, cluster *types.Cluster, clus
 	return f.fluxClient.BootstrapGithub(ctx, cluster, clusterSpec.FluxConfig)
 }
 
+func (f *Flux) BootstrapGitlab(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	if clusterSpec.Cluster.IsManaged() || clusterSpec.FluxConfig.Spec.Gitlab == nil {
+		return nil
+	}
+
+	return f.fluxClient.BootstrapGitlab(ctx, cluster, clusterSpec.FluxConfig)
+}
+
 func (f *Flux) BootstrapGit(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
 	if clusterSpec.Cluster.IsManaged() || clusterSpec.FluxConfig.Spec.Git == nil {
 		return nil
@@ -301,6 +315,12 @@ func (f *Flux) CleanupGitRepo(ctx context.Context, clusterSpec *cluster.Spec) er
 	return nil
 }
 
+// UninstallGitOps is a no-op for Flux. The Flux components are left running until the cluster is torn down,
+// as they are paused through the EKS-A resources reconcile annotations during delete.
+func (f *Flux) UninstallGitOps(ctx context.Context, cluster *types.Cluster, clusterSpec *cluster.Spec) error {
+	return nil
+}
+
 func (f *Flux) pushToRemoteRepo(ctx context.Context, path, msg string) error {
 	if err := f.gitClient.Commit(msg); err != nil {
 		return fmt.Errorf("committing %s to git: %v", path, err)
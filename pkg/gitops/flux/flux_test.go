@@ -1097,6 +1097,13 @@ func TestUninstallError(t *testing.T) {
 	g.Expect(g.gitOpsFlux.Uninstall(g.ctx, c, g.clusterSpec)).To(MatchError(ContainSubstring("error in uninstall")))
 }
 
+func TestUninstallGitOpsNoop(t *testing.T) {
+	g := newFluxTest(t)
+	g.setupFlux()
+
+	g.Expect(g.gitOpsFlux.UninstallGitOps(g.ctx, &types.Cluster{}, g.clusterSpec)).To(Succeed())
+}
+
 func TestFluxBootstrapGithub(t *testing.T) {
 	c := &types.Cluster{}
 	ctx := context.Background()
@@ -1204,3 +1211,71 @@ func TestFluxBootstrapGit(t *testing.T) {
 		})
 	}
 }
+
+func TestBootstrapGitlabError(t *testing.T) {
+	g := newFluxTest(t)
+	c := &types.Cluster{}
+	clusterConfig := NewCluster("management-cluster")
+	clusterSpec := newClusterSpec(t, clusterConfig, "")
+	clusterSpec.FluxConfig.Spec.Github = nil
+	clusterSpec.FluxConfig.Spec.Gitlab = &v1alpha1.GitlabProviderConfig{Owner: "platform", Repository: "testRepo"}
+
+	g.flux.EXPECT().BootstrapGitlab(g.ctx, c, clusterSpec.FluxConfig).Return(errors.New("error in bootstrap gitlab"))
+	g.flux.EXPECT().Uninstall(g.ctx, c, clusterSpec.FluxConfig).Return(nil)
+
+	g.Expect(g.gitOpsFlux.Bootstrap(g.ctx, c, clusterSpec)).To(MatchError(ContainSubstring("error in bootstrap gitlab")))
+}
+
+func TestFluxBootstrapGitlab(t *testing.T) {
+	c := &types.Cluster{}
+	ctx := context.Background()
+	testCases := []struct {
+		name                string
+		spec                *cluster.Spec
+		needBootstrapGitlab bool
+	}{
+		{
+			name: "management cluster",
+			spec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = "management-cluster"
+				s.Cluster.SetSelfManaged()
+				s.FluxConfig = &anywherev1.FluxConfig{
+					Spec: anywherev1.FluxConfigSpec{
+						Gitlab: &anywherev1.GitlabProviderConfig{},
+					},
+				}
+			}),
+			needBootstrapGitlab: true,
+		},
+		{
+			name: "workload cluster",
+			spec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = "workload-cluster"
+				s.Cluster.SetManagedBy("management-cluster")
+			}),
+			needBootstrapGitlab: false,
+		},
+		{
+			name: "management cluster not gitlab configured",
+			spec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = "management-cluster"
+				s.Cluster.SetSelfManaged()
+				s.FluxConfig = &anywherev1.FluxConfig{}
+			}),
+			needBootstrapGitlab: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctrl := gomock.NewController(t)
+			mockFluxClient := mocks.NewMockFluxClient(ctrl)
+			if tc.needBootstrapGitlab {
+				mockFluxClient.EXPECT().BootstrapGitlab(ctx, c, tc.spec.FluxConfig).Return(nil)
+			}
+
+			f := flux.NewFlux(mockFluxClient, nil, nil, nil)
+			g.Expect(f.BootstrapGitlab(ctx, c, tc.spec)).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -69,6 +69,20 @@ func (mr *MockFluxClientMockRecorder) BootstrapGithub(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapGithub", reflect.TypeOf((*MockFluxClient)(nil).BootstrapGithub), arg0, arg1, arg2)
 }
 
+// BootstrapGitlab mocks base method.
+func (m *MockFluxClient) BootstrapGitlab(arg0 context.Context, arg1 *types.Cluster, arg2 *v1alpha1.FluxConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapGitlab", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootstrapGitlab indicates an expected call of BootstrapGitlab.
+func (mr *MockFluxClientMockRecorder) BootstrapGitlab(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapGitlab", reflect.TypeOf((*MockFluxClient)(nil).BootstrapGitlab), arg0, arg1, arg2)
+}
+
 // Reconcile mocks base method.
 func (m *MockFluxClient) Reconcile(arg0 context.Context, arg1 *types.Cluster, arg2 *v1alpha1.FluxConfig) error {
 	m.ctrl.T.Helper()
@@ -238,6 +252,20 @@ func (mr *MockGitOpsFluxClientMockRecorder) BootstrapGithub(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapGithub", reflect.TypeOf((*MockGitOpsFluxClient)(nil).BootstrapGithub), arg0, arg1, arg2)
 }
 
+// BootstrapGitlab mocks base method.
+func (m *MockGitOpsFluxClient) BootstrapGitlab(arg0 context.Context, arg1 *types.Cluster, arg2 *v1alpha1.FluxConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapGitlab", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootstrapGitlab indicates an expected call of BootstrapGitlab.
+func (mr *MockGitOpsFluxClientMockRecorder) BootstrapGitlab(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapGitlab", reflect.TypeOf((*MockGitOpsFluxClient)(nil).BootstrapGitlab), arg0, arg1, arg2)
+}
+
 // DeleteSystemSecret mocks base method.
 func (m *MockGitOpsFluxClient) DeleteSystemSecret(arg0 context.Context, arg1 *types.Cluster, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -43,3 +43,20 @@ func TestInit(t *testing.T) {
 		t.Fatalf("Log file does not contain expected message: %s", message)
 	}
 }
+
+func TestInitConsoleOutput(t *testing.T) {
+	console := &bytes.Buffer{}
+	err := logger.Init(logger.Options{
+		ConsoleOutput: console,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := "log me to the console"
+	logger.Info(message)
+
+	if !bytes.Contains(console.Bytes(), []byte(message)) {
+		t.Fatalf("Console output does not contain expected message: %s", message)
+	}
+}
@@ -0,0 +1,71 @@
+package multihop
+
+import (
+	"fmt"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+)
+
+// validateMachineImages checks that the machine configs of target can be used by the intermediate
+// hops. Machine images are built for a single Kubernetes version, so the machine configs can't set
+// them: each hop then uses the default image from the bundle for its Kubernetes version.
+// CloudStack and Nutanix machine configs always require an image, so multi-hop upgrades
+// are not supported for them.
+func validateMachineImages(target *cluster.Config) error {
+	switch kind := target.Cluster.Spec.DatacenterRef.Kind; kind {
+	case anywherev1.CloudStackDatacenterKind, anywherev1.NutanixDatacenterKind:
+		return fmt.Errorf("multi-hop upgrades are not supported for %s clusters, their machine configs require an image for each kubernetes version: upgrade one kubernetes minor version at a time", kind)
+	}
+
+	for _, m := range target.VSphereMachineConfigs {
+		if m.Spec.Template != "" {
+			return imagePinnedError(anywherev1.VSphereMachineConfigKind, m.Name, "template", m.Spec.Template)
+		}
+	}
+	for _, m := range target.SnowMachineConfigs {
+		if m.Spec.AMIID != "" {
+			return imagePinnedError(anywherev1.SnowMachineConfigKind, m.Name, "amiID", m.Spec.AMIID)
+		}
+	}
+	if target.TinkerbellDatacenter != nil && target.TinkerbellDatacenter.Spec.OSImageURL != "" {
+		return imagePinnedError(anywherev1.TinkerbellDatacenterKind, target.TinkerbellDatacenter.Name, "osImageURL", target.TinkerbellDatacenter.Spec.OSImageURL)
+	}
+	for _, m := range target.TinkerbellMachineConfigs {
+		if m.Spec.OSImageURL != "" {
+			return imagePinnedError(anywherev1.TinkerbellMachineConfigKind, m.Name, "osImageURL", m.Spec.OSImageURL)
+		}
+	}
+
+	return nil
+}
+
+func imagePinnedError(kind, name, field, image string) error {
+	return fmt.Errorf("%s %s sets %s %s, which is only valid for one kubernetes version: remove it to use the default image of each hop or upgrade one kubernetes minor version at a time", kind, name, field, image)
+}
+
+// hasIntermediateHops returns whether any of the hops runs nodes at Kubernetes versions
+// other than the ones in target.
+func hasIntermediateHops(hops []*anywherev1.Cluster, target *anywherev1.Cluster) bool {
+	for _, hop := range hops {
+		if hop.Spec.KubernetesVersion != target.Spec.KubernetesVersion {
+			return true
+		}
+		for _, w := range hop.Spec.WorkerNodeGroupConfigurations {
+			targetWorker := targetWorkerGroup(target, w.Name)
+			if targetWorker != nil && workerKubernetesVersion(hop, w) != workerKubernetesVersion(target, *targetWorker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func targetWorkerGroup(target *anywherev1.Cluster, name string) *anywherev1.WorkerNodeGroupConfiguration {
+	for i := range target.Spec.WorkerNodeGroupConfigurations {
+		if target.Spec.WorkerNodeGroupConfigurations[i].Name == name {
+			return &target.Spec.WorkerNodeGroupConfigurations[i]
+		}
+	}
+	return nil
+}
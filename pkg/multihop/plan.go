@@ -0,0 +1,154 @@
+package multihop
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/util/version"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+// Plan returns the sequence of Cluster objects to apply, in order, to upgrade current to target
+// when target is more than one Kubernetes minor version ahead.
+//
+// Each hop upgrades the control plane one minor version. Worker node groups are upgraded as late
+// as possible: they stay at their version while they are within the allowed skew of the control
+// plane and they can still reach their target version, one minor version per hop, by the last hop.
+// Worker node groups that only exist in target are created in the last hop. The last element is
+// always target.
+func Plan(current, target *anywherev1.Cluster) ([]*anywherev1.Cluster, error) {
+	currentVersion, err := version.ParseGeneric(string(current.Spec.KubernetesVersion))
+	if err != nil {
+		return nil, fmt.Errorf("parsing current kubernetes version: %v", err)
+	}
+	targetVersion, err := version.ParseGeneric(string(target.Spec.KubernetesVersion))
+	if err != nil {
+		return nil, fmt.Errorf("parsing target kubernetes version: %v", err)
+	}
+	if currentVersion.Major() != targetVersion.Major() {
+		return nil, fmt.Errorf("major versions are not the same: %s and %s", currentVersion, targetVersion)
+	}
+	if targetVersion.Minor() < currentVersion.Minor() {
+		return nil, fmt.Errorf("kubernetes version downgrade is not supported (%s) -> (%s)", currentVersion, targetVersion)
+	}
+
+	workers, err := currentWorkers(current)
+	if err != nil {
+		return nil, err
+	}
+
+	major := int(targetVersion.Major())
+	lastMinor := int(targetVersion.Minor())
+	var hops []*anywherev1.Cluster
+	for minor := int(currentVersion.Minor()) + 1; minor <= lastMinor; minor++ {
+		hop, err := planHop(target, workers, major, minor, lastMinor)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+
+	// Worker node groups can't stop pinning their Kubernetes version in the same change
+	// that upgrades the control plane, so that happens in a final step without rollouts.
+	if len(hops) == 0 || !reflect.DeepEqual(hops[len(hops)-1].Spec, target.Spec) {
+		hops = append(hops, target.DeepCopy())
+	}
+
+	previous := current
+	for _, hop := range hops {
+		if err := validateHop(previous, hop); err != nil {
+			return nil, err
+		}
+		previous = hop
+	}
+
+	return hops, nil
+}
+
+type workerVersion struct {
+	minor    int
+	explicit bool
+}
+
+func currentWorkers(current *anywherev1.Cluster) (map[string]*workerVersion, error) {
+	workers := make(map[string]*workerVersion, len(current.Spec.WorkerNodeGroupConfigurations))
+	for _, w := range current.Spec.WorkerNodeGroupConfigurations {
+		minor, err := minorVersion(workerKubernetesVersion(current, w))
+		if err != nil {
+			return nil, fmt.Errorf("parsing kubernetes version of worker node group %s: %v", w.Name, err)
+		}
+		workers[w.Name] = &workerVersion{minor: minor, explicit: w.KubernetesVersion != nil}
+	}
+	return workers, nil
+}
+
+// planHop builds the Cluster for the hop that upgrades the control plane to major.minor
+// and updates workers with the worker node group versions for that hop.
+func planHop(target *anywherev1.Cluster, workers map[string]*workerVersion, major, minor, lastMinor int) (*anywherev1.Cluster, error) {
+	hop := target.DeepCopy()
+	hop.Spec.KubernetesVersion = kubernetesVersion(major, minor)
+
+	maxWorkerSkew, err := anywherev1.MaxWorkerKubernetesVersionSkew(hop.Spec.KubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	workerGroups := make([]anywherev1.WorkerNodeGroupConfiguration, 0, len(hop.Spec.WorkerNodeGroupConfigurations))
+	for _, w := range hop.Spec.WorkerNodeGroupConfigurations {
+		current, ok := workers[w.Name]
+		if !ok {
+			if minor == lastMinor {
+				workerGroups = append(workerGroups, w)
+			}
+			continue
+		}
+
+		targetMinor, err := minorVersion(workerKubernetesVersion(target, w))
+		if err != nil {
+			return nil, fmt.Errorf("parsing kubernetes version of worker node group %s: %v", w.Name, err)
+		}
+
+		if minor-current.minor > maxWorkerSkew || targetMinor-current.minor > lastMinor-minor {
+			current.minor++
+		}
+		current.explicit = current.explicit || w.KubernetesVersion != nil || current.minor != minor
+		if current.explicit {
+			v := kubernetesVersion(major, current.minor)
+			w.KubernetesVersion = &v
+		}
+
+		workerGroups = append(workerGroups, w)
+	}
+	hop.Spec.WorkerNodeGroupConfigurations = workerGroups
+
+	return hop, nil
+}
+
+func validateHop(previous, hop *anywherev1.Cluster) error {
+	allErrs := anywherev1.ValidateKubernetesVersionSkew(hop, previous)
+	allErrs = append(allErrs, anywherev1.ValidateWorkerKubernetesVersionSkew(hop, previous)...)
+	if len(allErrs) != 0 {
+		return fmt.Errorf("upgrading from kubernetes version %s to %s: %v", previous.Spec.KubernetesVersion, hop.Spec.KubernetesVersion, allErrs.ToAggregate())
+	}
+	return nil
+}
+
+func workerKubernetesVersion(c *anywherev1.Cluster, w anywherev1.WorkerNodeGroupConfiguration) anywherev1.KubernetesVersion {
+	if w.KubernetesVersion != nil {
+		return *w.KubernetesVersion
+	}
+	return c.Spec.KubernetesVersion
+}
+
+func minorVersion(v anywherev1.KubernetesVersion) (int, error) {
+	parsed, err := version.ParseGeneric(string(v))
+	if err != nil {
+		return 0, err
+	}
+	return int(parsed.Minor()), nil
+}
+
+func kubernetesVersion(major, minor int) anywherev1.KubernetesVersion {
+	return anywherev1.KubernetesVersion(fmt.Sprintf("%d.%d", major, minor))
+}
@@ -0,0 +1,183 @@
+package multihop_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/multihop"
+)
+
+func planCluster(version anywherev1.KubernetesVersion, workers ...anywherev1.WorkerNodeGroupConfiguration) *anywherev1.Cluster {
+	c := &anywherev1.Cluster{}
+	c.Name = "my-cluster"
+	c.Spec.KubernetesVersion = version
+	c.Spec.WorkerNodeGroupConfigurations = workers
+	return c
+}
+
+func worker(name string, version *anywherev1.KubernetesVersion) anywherev1.WorkerNodeGroupConfiguration {
+	return anywherev1.WorkerNodeGroupConfiguration{Name: name, KubernetesVersion: version}
+}
+
+func kubeVersion(v anywherev1.KubernetesVersion) *anywherev1.KubernetesVersion {
+	return &v
+}
+
+type hopVersions struct {
+	controlPlane anywherev1.KubernetesVersion
+	workers      map[string]*anywherev1.KubernetesVersion
+}
+
+func versionsOf(hops []*anywherev1.Cluster) []hopVersions {
+	versions := make([]hopVersions, 0, len(hops))
+	for _, hop := range hops {
+		v := hopVersions{controlPlane: hop.Spec.KubernetesVersion, workers: map[string]*anywherev1.KubernetesVersion{}}
+		for _, w := range hop.Spec.WorkerNodeGroupConfigurations {
+			v.workers[w.Name] = w.KubernetesVersion
+		}
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+func TestPlanSingleHop(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube128, worker("md-0", nil))
+	target := planCluster(anywherev1.Kube129, worker("md-0", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hops).To(Equal([]*anywherev1.Cluster{target}))
+}
+
+func TestPlanSameVersion(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube128, worker("md-0", nil))
+	target := planCluster(anywherev1.Kube128, worker("md-0", nil), worker("md-1", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hops).To(Equal([]*anywherev1.Cluster{target}))
+}
+
+func TestPlanWorkersFollowControlPlane(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube126, worker("md-0", nil))
+	target := planCluster(anywherev1.Kube129, worker("md-0", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versionsOf(hops)).To(Equal([]hopVersions{
+		{controlPlane: anywherev1.Kube127, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil}},
+		{controlPlane: anywherev1.Kube128, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil}},
+	}))
+	g.Expect(hops[2]).To(Equal(target))
+}
+
+func TestPlanWorkersLagWithinSkew(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube126, worker("md-0", nil), worker("md-1", nil))
+	target := planCluster(anywherev1.Kube129, worker("md-0", kubeVersion(anywherev1.Kube127)), worker("md-1", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versionsOf(hops)).To(Equal([]hopVersions{
+		{controlPlane: anywherev1.Kube127, workers: map[string]*anywherev1.KubernetesVersion{
+			"md-0": kubeVersion(anywherev1.Kube126),
+			"md-1": nil,
+		}},
+		{controlPlane: anywherev1.Kube128, workers: map[string]*anywherev1.KubernetesVersion{
+			"md-0": kubeVersion(anywherev1.Kube126),
+			"md-1": nil,
+		}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{
+			"md-0": kubeVersion(anywherev1.Kube127),
+			"md-1": nil,
+		}},
+	}))
+}
+
+func TestPlanWorkersLagThreeVersionsAsOfKube128(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube126, worker("md-0", nil))
+	target := planCluster(anywherev1.Kube129, worker("md-0", kubeVersion(anywherev1.Kube126)))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versionsOf(hops)).To(Equal([]hopVersions{
+		{controlPlane: anywherev1.Kube127, workers: map[string]*anywherev1.KubernetesVersion{"md-0": kubeVersion(anywherev1.Kube126)}},
+		{controlPlane: anywherev1.Kube128, workers: map[string]*anywherev1.KubernetesVersion{"md-0": kubeVersion(anywherev1.Kube126)}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{"md-0": kubeVersion(anywherev1.Kube126)}},
+	}))
+}
+
+func TestPlanPinnedWorkersUnpinnedInFinalStep(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube127, worker("md-0", kubeVersion(anywherev1.Kube127)))
+	target := planCluster(anywherev1.Kube129, worker("md-0", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versionsOf(hops)).To(Equal([]hopVersions{
+		{controlPlane: anywherev1.Kube128, workers: map[string]*anywherev1.KubernetesVersion{"md-0": kubeVersion(anywherev1.Kube128)}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{"md-0": kubeVersion(anywherev1.Kube129)}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil}},
+	}))
+}
+
+func TestPlanWorkerGroupsAddedAndRemoved(t *testing.T) {
+	g := NewWithT(t)
+	current := planCluster(anywherev1.Kube127, worker("md-0", nil), worker("md-1", nil))
+	target := planCluster(anywherev1.Kube129, worker("md-0", nil), worker("md-2", nil))
+
+	hops, err := multihop.Plan(current, target)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versionsOf(hops)).To(Equal([]hopVersions{
+		{controlPlane: anywherev1.Kube128, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil}},
+		{controlPlane: anywherev1.Kube129, workers: map[string]*anywherev1.KubernetesVersion{"md-0": nil, "md-2": nil}},
+	}))
+}
+
+func TestPlanErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		current *anywherev1.Cluster
+		target  *anywherev1.Cluster
+		wantErr string
+	}{
+		{
+			name:    "downgrade",
+			current: planCluster(anywherev1.Kube128),
+			target:  planCluster(anywherev1.Kube126),
+			wantErr: "kubernetes version downgrade is not supported",
+		},
+		{
+			name:    "invalid version",
+			current: planCluster("invalid"),
+			target:  planCluster(anywherev1.Kube126),
+			wantErr: "parsing current kubernetes version",
+		},
+		{
+			name:    "worker target out of skew",
+			current: planCluster(anywherev1.Kube124, worker("md-0", nil)),
+			target:  planCluster(anywherev1.Kube127, worker("md-0", kubeVersion(anywherev1.Kube124))),
+			wantErr: "cluster level minor version must be within 2 versions greater than worker node group version",
+		},
+		{
+			name:    "worker target out of skew as of 1.28",
+			current: planCluster(anywherev1.Kube126, worker("md-0", nil)),
+			target:  planCluster(anywherev1.Kube130, worker("md-0", kubeVersion(anywherev1.Kube126))),
+			wantErr: "cluster level minor version must be within 3 versions greater than worker node group version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := multihop.Plan(tt.current, tt.target)
+			g.Expect(err).To(MatchError(ContainSubstring(tt.wantErr)))
+		})
+	}
+}
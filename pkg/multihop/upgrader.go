@@ -0,0 +1,225 @@
+package multihop
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/logger"
+	"github.com/aws/eks-anywhere/pkg/templater"
+)
+
+// OutputDir is the folder, inside the cluster folder, where the cluster config of each hop
+// and the checkpoint are written.
+const OutputDir = "multi-hop"
+
+const checkpointFileName = "checkpoint.yaml"
+
+// UpgradeFunc upgrades the cluster to the cluster config in configFile. When resume is true,
+// the upgrade resumes from the checkpoint saved by a previous failed upgrade to the same config.
+type UpgradeFunc func(ctx context.Context, configFile string, resume bool) error
+
+// Checkpoint tracks the progress of a multi-hop upgrade so a failed upgrade can continue
+// from the hop that failed instead of planning again from the current state of the cluster.
+type Checkpoint struct {
+	ClusterName string `json:"clusterName"`
+	// TargetHash is the sha256 of the spec of the target Cluster.
+	TargetHash string `json:"targetHash"`
+	Hops       []Hop  `json:"hops"`
+}
+
+// Hop is one of the upgrades that make a multi-hop upgrade.
+type Hop struct {
+	KubernetesVersion anywherev1.KubernetesVersion `json:"kubernetesVersion"`
+	ConfigFile        string                       `json:"configFile"`
+	Completed         bool                         `json:"completed"`
+}
+
+// Upgrader upgrades a cluster several Kubernetes minor versions, one hop at a time.
+type Upgrader struct {
+	writer  filewriter.FileWriter
+	upgrade UpgradeFunc
+}
+
+// NewUpgrader builds an Upgrader that writes the hop configs and checkpoint with writer
+// and runs each hop with upgrade.
+func NewUpgrader(writer filewriter.FileWriter, upgrade UpgradeFunc) (*Upgrader, error) {
+	w, err := writer.WithDir(OutputDir)
+	if err != nil {
+		return nil, fmt.Errorf("creating multi-hop upgrade folder: %v", err)
+	}
+
+	return &Upgrader{
+		writer:  w,
+		upgrade: upgrade,
+	}, nil
+}
+
+// Run upgrades the cluster from current to target. If a checkpoint saved by a previous run for
+// the same target exists, it continues from the first hop that didn't complete, resuming it
+// from its own checkpoint when resume is true. Otherwise, it plans the hops from current.
+func (u *Upgrader) Run(ctx context.Context, current *anywherev1.Cluster, target *cluster.Config, resume bool) error {
+	checkpoint, err := u.readCheckpoint(target.Cluster)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		if resume {
+			return fmt.Errorf("no multi-hop checkpoint to resume from found in %s", u.checkpointPath())
+		}
+		if checkpoint, err = u.plan(current, target); err != nil {
+			return err
+		}
+	} else {
+		logger.Info("Continuing multi-hop upgrade from checkpoint", "checkpoint", u.checkpointPath())
+	}
+
+	versions := make([]anywherev1.KubernetesVersion, 0, len(checkpoint.Hops))
+	for _, hop := range checkpoint.Hops {
+		versions = append(versions, hop.KubernetesVersion)
+	}
+	logger.Info("Upgrading cluster in multiple hops", "cluster", target.Cluster.Name, "hops", versions)
+
+	for i := range checkpoint.Hops {
+		hop := &checkpoint.Hops[i]
+		if hop.Completed {
+			logger.V(3).Info("Skipping completed hop", "hop", i+1, "kubernetesVersion", hop.KubernetesVersion)
+			continue
+		}
+
+		logger.Info(fmt.Sprintf("Upgrading cluster, hop %d of %d", i+1, len(checkpoint.Hops)), "kubernetesVersion", hop.KubernetesVersion, "config", hop.ConfigFile)
+		if err := u.upgrade(ctx, hop.ConfigFile, resume); err != nil {
+			return fmt.Errorf("upgrading to kubernetes version %s, hop %d of %d: %v", hop.KubernetesVersion, i+1, len(checkpoint.Hops), err)
+		}
+		// Only the hop that failed in the previous run can be resumed.
+		resume = false
+
+		hop.Completed = true
+		if err := u.saveCheckpoint(checkpoint); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(u.checkpointPath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing multi-hop checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+// plan writes the cluster config for each hop and saves a checkpoint with no completed hops.
+func (u *Upgrader) plan(current *anywherev1.Cluster, target *cluster.Config) (*Checkpoint, error) {
+	hops, err := Plan(current, target.Cluster)
+	if err != nil {
+		return nil, fmt.Errorf("planning multi-hop upgrade: %v", err)
+	}
+	if hasIntermediateHops(hops, target.Cluster) {
+		if err := validateMachineImages(target); err != nil {
+			return nil, fmt.Errorf("planning multi-hop upgrade: %v", err)
+		}
+	}
+
+	checkpoint := &Checkpoint{
+		ClusterName: target.Cluster.Name,
+		TargetHash:  specHash(target.Cluster),
+		Hops:        make([]Hop, 0, len(hops)),
+	}
+
+	for i, hop := range hops {
+		config := target.DeepCopy()
+		config.Cluster = hop
+		content, err := marshalConfig(config)
+		if err != nil {
+			return nil, err
+		}
+
+		path, err := u.writer.Write(fmt.Sprintf("%s-hop-%d-eks-a-cluster.yaml", hop.Name, i+1), content, filewriter.PersistentFile)
+		if err != nil {
+			return nil, fmt.Errorf("writing cluster config for hop %d: %v", i+1, err)
+		}
+
+		checkpoint.Hops = append(checkpoint.Hops, Hop{
+			KubernetesVersion: hop.Spec.KubernetesVersion,
+			ConfigFile:        path,
+		})
+	}
+
+	if err := u.saveCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// readCheckpoint returns the saved checkpoint or nil if there isn't one or it was saved
+// for a different target.
+func (u *Upgrader) readCheckpoint(target *anywherev1.Cluster) (*Checkpoint, error) {
+	content, err := os.ReadFile(u.checkpointPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading multi-hop checkpoint: %v", err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := yaml.Unmarshal(content, checkpoint); err != nil {
+		return nil, fmt.Errorf("parsing multi-hop checkpoint %s: %v", u.checkpointPath(), err)
+	}
+
+	if checkpoint.ClusterName != target.Name || checkpoint.TargetHash != specHash(target) {
+		logger.V(3).Info("Ignoring multi-hop checkpoint saved for a different cluster config", "checkpoint", u.checkpointPath())
+		return nil, nil
+	}
+
+	return checkpoint, nil
+}
+
+func (u *Upgrader) saveCheckpoint(checkpoint *Checkpoint) error {
+	content, err := yaml.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("saving multi-hop checkpoint: %v", err)
+	}
+
+	if _, err := u.writer.Write(checkpointFileName, content, filewriter.PersistentFile); err != nil {
+		return fmt.Errorf("saving multi-hop checkpoint: %v", err)
+	}
+
+	return nil
+}
+
+func (u *Upgrader) checkpointPath() string {
+	return filepath.Join(u.writer.Dir(), checkpointFileName)
+}
+
+func marshalConfig(config *cluster.Config) ([]byte, error) {
+	objs := config.ClusterAndChildren()
+	resources := make([][]byte, 0, len(objs))
+	for _, obj := range objs {
+		content, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling %s %s: %v", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		resources = append(resources, content)
+	}
+
+	return templater.AppendYamlResources(resources...), nil
+}
+
+func specHash(c *anywherev1.Cluster) string {
+	// Cluster specs always marshal.
+	content, _ := json.Marshal(c.Spec)
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
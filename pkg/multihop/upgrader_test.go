@@ -0,0 +1,227 @@
+package multihop_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/multihop"
+)
+
+type upgraderTest struct {
+	*WithT
+	ctx     context.Context
+	dir     string
+	writer  filewriter.FileWriter
+	current *anywherev1.Cluster
+	target  *cluster.Config
+	calls   []upgradeCall
+	failOn  int
+}
+
+type upgradeCall struct {
+	version anywherev1.KubernetesVersion
+	resume  bool
+}
+
+func newUpgraderTest(t *testing.T) *upgraderTest {
+	current := planCluster(anywherev1.Kube126, worker("md-0", nil))
+	current.TypeMeta = metav1.TypeMeta{Kind: anywherev1.ClusterKind, APIVersion: anywherev1.GroupVersion.String()}
+	target := current.DeepCopy()
+	target.Spec.KubernetesVersion = anywherev1.Kube129
+
+	dir, writer := test.NewWriter(t)
+	return &upgraderTest{
+		WithT:   NewWithT(t),
+		ctx:     context.Background(),
+		dir:     dir,
+		writer:  writer,
+		current: current,
+		target:  &cluster.Config{Cluster: target},
+		failOn:  -1,
+	}
+}
+
+func (tt *upgraderTest) upgrade(_ context.Context, configFile string, resume bool) error {
+	config, err := cluster.ParseConfigFromFile(configFile)
+	if err != nil {
+		return err
+	}
+	tt.calls = append(tt.calls, upgradeCall{version: config.Cluster.Spec.KubernetesVersion, resume: resume})
+	if len(tt.calls)-1 == tt.failOn {
+		return errors.New("upgrade failed")
+	}
+	return nil
+}
+
+func (tt *upgraderTest) run(resume bool) error {
+	u, err := multihop.NewUpgrader(tt.writer, tt.upgrade)
+	tt.Expect(err).NotTo(HaveOccurred())
+	return u.Run(tt.ctx, tt.current, tt.target, resume)
+}
+
+func (tt *upgraderTest) checkpointPath() string {
+	return filepath.Join(tt.dir, multihop.OutputDir, "checkpoint.yaml")
+}
+
+func TestUpgraderRunSuccess(t *testing.T) {
+	tt := newUpgraderTest(t)
+
+	tt.Expect(tt.run(false)).To(Succeed())
+	tt.Expect(tt.calls).To(Equal([]upgradeCall{
+		{version: anywherev1.Kube127},
+		{version: anywherev1.Kube128},
+		{version: anywherev1.Kube129},
+	}))
+	tt.Expect(filepath.Join(tt.dir, multihop.OutputDir, "my-cluster-hop-1-eks-a-cluster.yaml")).To(BeAnExistingFile())
+	tt.Expect(tt.checkpointPath()).NotTo(BeAnExistingFile())
+}
+
+func TestUpgraderRunContinuesFromCheckpoint(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.failOn = 1
+
+	tt.Expect(tt.run(false)).To(MatchError(ContainSubstring("upgrading to kubernetes version 1.28, hop 2 of 3: upgrade failed")))
+	tt.Expect(tt.checkpointPath()).To(BeAnExistingFile())
+
+	// The cluster object was already updated by the failed hop, the checkpoint makes it run again.
+	tt.current.Spec.KubernetesVersion = anywherev1.Kube128
+	tt.calls = nil
+	tt.failOn = -1
+	tt.Expect(tt.run(true)).To(Succeed())
+	tt.Expect(tt.calls).To(Equal([]upgradeCall{
+		{version: anywherev1.Kube128, resume: true},
+		{version: anywherev1.Kube129},
+	}))
+	tt.Expect(tt.checkpointPath()).NotTo(BeAnExistingFile())
+}
+
+func TestUpgraderRunIgnoresCheckpointForOtherTarget(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.failOn = 0
+	tt.Expect(tt.run(false)).NotTo(Succeed())
+
+	tt.target.Cluster.Spec.KubernetesVersion = anywherev1.Kube128
+	tt.calls = nil
+	tt.failOn = -1
+	tt.Expect(tt.run(false)).To(Succeed())
+	tt.Expect(tt.calls).To(Equal([]upgradeCall{
+		{version: anywherev1.Kube127},
+		{version: anywherev1.Kube128},
+	}))
+}
+
+func TestUpgraderRunResumeWithoutCheckpoint(t *testing.T) {
+	tt := newUpgraderTest(t)
+
+	tt.Expect(tt.run(true)).To(MatchError(ContainSubstring("no multi-hop checkpoint to resume from found")))
+	tt.Expect(tt.calls).To(BeEmpty())
+}
+
+func TestUpgraderRunPlanError(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.target.Cluster.Spec.KubernetesVersion = anywherev1.Kube125
+
+	tt.Expect(tt.run(false)).To(MatchError(ContainSubstring("planning multi-hop upgrade")))
+	_, err := os.Stat(tt.checkpointPath())
+	tt.Expect(os.IsNotExist(err)).To(BeTrue())
+}
+
+func TestUpgraderRunMachineImageErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  func(*cluster.Config)
+		wantErr string
+	}{
+		{
+			name: "cloudstack",
+			config: func(c *cluster.Config) {
+				c.Cluster.Spec.DatacenterRef.Kind = anywherev1.CloudStackDatacenterKind
+			},
+			wantErr: "multi-hop upgrades are not supported for CloudStackDatacenterConfig clusters",
+		},
+		{
+			name: "nutanix",
+			config: func(c *cluster.Config) {
+				c.Cluster.Spec.DatacenterRef.Kind = anywherev1.NutanixDatacenterKind
+			},
+			wantErr: "multi-hop upgrades are not supported for NutanixDatacenterConfig clusters",
+		},
+		{
+			name: "vsphere template",
+			config: func(c *cluster.Config) {
+				m := &anywherev1.VSphereMachineConfig{}
+				m.Name = "cp"
+				m.Spec.Template = "/SDDC-Datacenter/vm/Templates/ubuntu-kube-v1-29"
+				c.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{m.Name: m}
+			},
+			wantErr: "VSphereMachineConfig cp sets template /SDDC-Datacenter/vm/Templates/ubuntu-kube-v1-29, which is only valid for one kubernetes version",
+		},
+		{
+			name: "snow ami",
+			config: func(c *cluster.Config) {
+				m := &anywherev1.SnowMachineConfig{}
+				m.Name = "cp"
+				m.Spec.AMIID = "ami-1"
+				c.SnowMachineConfigs = map[string]*anywherev1.SnowMachineConfig{m.Name: m}
+			},
+			wantErr: "SnowMachineConfig cp sets amiID ami-1",
+		},
+		{
+			name: "tinkerbell datacenter os image",
+			config: func(c *cluster.Config) {
+				c.TinkerbellDatacenter = &anywherev1.TinkerbellDatacenterConfig{}
+				c.TinkerbellDatacenter.Name = "dc"
+				c.TinkerbellDatacenter.Spec.OSImageURL = "https://images/ubuntu-1-29.gz"
+			},
+			wantErr: "TinkerbellDatacenterConfig dc sets osImageURL https://images/ubuntu-1-29.gz",
+		},
+		{
+			name: "tinkerbell machine os image",
+			config: func(c *cluster.Config) {
+				m := &anywherev1.TinkerbellMachineConfig{}
+				m.Name = "cp"
+				m.Spec.OSImageURL = "https://images/ubuntu-1-29.gz"
+				c.TinkerbellMachineConfigs = map[string]*anywherev1.TinkerbellMachineConfig{m.Name: m}
+			},
+			wantErr: "TinkerbellMachineConfig cp sets osImageURL https://images/ubuntu-1-29.gz",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newUpgraderTest(t)
+			tc.config(tt.target)
+
+			tt.Expect(tt.run(false)).To(MatchError(ContainSubstring(tc.wantErr)))
+			tt.Expect(tt.calls).To(BeEmpty())
+			tt.Expect(tt.checkpointPath()).NotTo(BeAnExistingFile())
+		})
+	}
+}
+
+func TestUpgraderRunMachineImageWithoutIntermediateHops(t *testing.T) {
+	tt := newUpgraderTest(t)
+	tt.current.Spec.WorkerNodeGroupConfigurations[0].KubernetesVersion = kubeVersion(anywherev1.Kube126)
+	tt.target.Cluster.Spec.KubernetesVersion = anywherev1.Kube127
+	m := &anywherev1.VSphereMachineConfig{
+		TypeMeta: metav1.TypeMeta{Kind: anywherev1.VSphereMachineConfigKind, APIVersion: anywherev1.GroupVersion.String()},
+	}
+	m.Name = "cp"
+	m.Spec.Template = "/SDDC-Datacenter/vm/Templates/ubuntu-kube-v1-27"
+	tt.target.VSphereMachineConfigs = map[string]*anywherev1.VSphereMachineConfig{m.Name: m}
+
+	tt.Expect(tt.run(false)).To(Succeed())
+	tt.Expect(tt.calls).To(Equal([]upgradeCall{
+		{version: anywherev1.Kube127},
+		{version: anywherev1.Kube127},
+	}))
+}
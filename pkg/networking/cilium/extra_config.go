@@ -0,0 +1,173 @@
+package cilium
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	releasev1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
+)
+
+// ExtraConfigComponentName is the ConfigComponentUpdatePlan name for the Cilium extra config:
+// Hubble, BGP control plane, kube-proxy replacement and extra Helm values.
+const ExtraConfigComponentName = "ExtraConfig"
+
+// extraConfigHash returns a hash that identifies the extra config for a Cilium installation.
+// It returns an empty string if there is no extra config.
+func extraConfigHash(cfg *anywherev1.CiliumConfig) string {
+	if cfg == nil || !cfg.HasExtraConfig() {
+		return ""
+	}
+
+	extraConfig := struct {
+		Hubble               *anywherev1.CiliumHubbleConfig          `json:"hubble,omitempty"`
+		BGPControlPlane      *anywherev1.CiliumBGPControlPlaneConfig `json:"bgpControlPlane,omitempty"`
+		KubeProxyReplacement bool                                    `json:"kubeProxyReplacement,omitempty"`
+		HelmValues           map[string]string                       `json:"helmValues,omitempty"`
+	}{
+		Hubble:               cfg.Hubble,
+		BGPControlPlane:      cfg.BGPControlPlane,
+		KubeProxyReplacement: cfg.KubeProxyReplacement,
+		HelmValues:           cfg.HelmValues,
+	}
+
+	// json sorts map keys, so the hash is stable. The config only contains
+	// bools and strings, which always marshal.
+	b, _ := json.Marshal(extraConfig)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// setExtraConfigValues adds the Helm values for the Cilium extra config. Extra Helm values are
+// applied last, in lexical key order, so the result doesn't depend on map iteration order.
+// Validation guarantees they don't overlap with each other nor with values set by EKS Anywhere.
+func setExtraConfigValues(val values, spec *cluster.Spec, versionsBundle *cluster.VersionsBundle) error {
+	cfg := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium
+	if !cfg.HasExtraConfig() {
+		return nil
+	}
+
+	if cfg.Hubble != nil {
+		val.set(true, "hubble", "enabled")
+		val.set(cfg.Hubble.Relay, "hubble", "relay", "enabled")
+		val.set(cfg.Hubble.UI, "hubble", "ui", "enabled")
+
+		if cfg.Hubble.Relay {
+			if err := setImageValues(val, versionsBundle.Cilium.HubbleRelay, "hubble", "relay", "image"); err != nil {
+				return err
+			}
+		}
+
+		if cfg.Hubble.UI {
+			if err := setImageValues(val, versionsBundle.Cilium.HubbleUI, "hubble", "ui", "frontend", "image"); err != nil {
+				return err
+			}
+			if err := setImageValues(val, versionsBundle.Cilium.HubbleUIBackend, "hubble", "ui", "backend", "image"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfg.BGPControlPlane != nil {
+		val.set(true, "bgpControlPlane", "enabled")
+	}
+
+	if cfg.KubeProxyReplacement {
+		val.set("true", "kubeProxyReplacement")
+		// Cilium can't rely on kube-proxy to reach the API server through
+		// the kubernetes Service, so it needs the control plane endpoint.
+		if host, port := controlPlaneEndpoint(spec.Cluster); host != "" {
+			val.set(host, "k8sServiceHost")
+			val.set(parseHelmValue(port), "k8sServicePort")
+		}
+	}
+
+	keys := make([]string, 0, len(cfg.HelmValues))
+	for k := range cfg.HelmValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		val.set(parseHelmValue(cfg.HelmValues[k]), strings.Split(k, ".")...)
+	}
+
+	val.set(extraConfigHash(cfg), "podAnnotations", anywherev1.CiliumExtraConfigHashAnnotation)
+
+	return nil
+}
+
+// setImageValues sets the chart image values for a bundle image. The chart defaults pin
+// upstream digests, so digests are disabled to use the bundle tag.
+func setImageValues(val values, image releasev1.Image, path ...string) error {
+	if image.URI == "" {
+		return fmt.Errorf("image %s is not available in the bundle for this cluster version", strings.Join(path, "."))
+	}
+
+	val.set(values{
+		"repository": image.Image(),
+		"tag":        image.Tag(),
+		"useDigest":  false,
+	}, path...)
+
+	return nil
+}
+
+// parseHelmValue converts a value to a bool or an integer when possible, like helm --set does.
+// Values wrapped in single or double quotes are always kept as strings, without the quotes.
+func parseHelmValue(v string) interface{} {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+
+	switch v {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return i
+	}
+	return v
+}
+
+func controlPlaneEndpoint(c *anywherev1.Cluster) (host, port string) {
+	endpoint := c.Spec.ControlPlaneConfiguration.Endpoint
+	if endpoint == nil || endpoint.Host == "" {
+		return "", ""
+	}
+
+	if h, p, err := net.SplitHostPort(endpoint.Host); err == nil {
+		return h, p
+	}
+
+	return endpoint.Host, anywherev1.ControlEndpointDefaultPort
+}
+
+// extraConfigUpdatePlan compares the extra config hash in the Cilium DaemonSet pods
+// with the one for the desired cluster Spec.
+func extraConfigUpdatePlan(ds *appsv1.DaemonSet, clusterSpec *cluster.Spec) ConfigComponentUpdatePlan {
+	update := ConfigComponentUpdatePlan{
+		Name:     ExtraConfigComponentName,
+		NewValue: extraConfigHash(clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium),
+	}
+
+	if ds != nil {
+		update.OldValue = ds.Spec.Template.Annotations[anywherev1.CiliumExtraConfigHashAnnotation]
+	}
+
+	if update.OldValue != update.NewValue {
+		update.UpdateReason = "Cilium extra config changed"
+	}
+
+	return update
+}
@@ -0,0 +1,65 @@
+package cilium
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+)
+
+const (
+	kubeProxyName          = "kube-proxy"
+	hubbleRelayName        = "hubble-relay"
+	hubbleRelayConfigName  = "hubble-relay-config"
+	hubbleRelayCertsName   = "hubble-relay-client-certs"
+	hubbleUIName           = "hubble-ui"
+	hubbleUINginxConfigMap = "hubble-ui-nginx"
+)
+
+// KubeProxyObjects returns the kube-proxy objects installed by kubeadm. They are removed
+// once Cilium replaces kube-proxy.
+func KubeProxyObjects() []client.Object {
+	return []client.Object{
+		&appsv1.DaemonSet{ObjectMeta: kubeSystemObjectMeta(kubeProxyName)},
+		&corev1.ConfigMap{ObjectMeta: kubeSystemObjectMeta(kubeProxyName)},
+	}
+}
+
+// DisabledHubbleObjects returns the objects the Cilium chart creates for Hubble Relay and the
+// Hubble UI when they are disabled in the cluster Spec. Applying the Cilium manifest doesn't
+// remove them once they have been created, so they need to be deleted.
+func DisabledHubbleObjects(spec *cluster.Spec) []client.Object {
+	hubble := spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.Hubble
+
+	var objs []client.Object
+	if hubble == nil || !hubble.Relay {
+		objs = append(objs,
+			&appsv1.Deployment{ObjectMeta: kubeSystemObjectMeta(hubbleRelayName)},
+			&corev1.Service{ObjectMeta: kubeSystemObjectMeta(hubbleRelayName)},
+			&corev1.ServiceAccount{ObjectMeta: kubeSystemObjectMeta(hubbleRelayName)},
+			&corev1.ConfigMap{ObjectMeta: kubeSystemObjectMeta(hubbleRelayConfigName)},
+			&corev1.Secret{ObjectMeta: kubeSystemObjectMeta(hubbleRelayCertsName)},
+		)
+	}
+
+	if hubble == nil || !hubble.UI {
+		objs = append(objs,
+			&appsv1.Deployment{ObjectMeta: kubeSystemObjectMeta(hubbleUIName)},
+			&corev1.Service{ObjectMeta: kubeSystemObjectMeta(hubbleUIName)},
+			&corev1.ServiceAccount{ObjectMeta: kubeSystemObjectMeta(hubbleUIName)},
+			&corev1.ConfigMap{ObjectMeta: kubeSystemObjectMeta(hubbleUINginxConfigMap)},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: hubbleUIName}},
+			&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: hubbleUIName}},
+		)
+	}
+
+	return objs
+}
+
+func kubeSystemObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: name, Namespace: constants.KubeSystemNamespace}
+}
@@ -0,0 +1,49 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Provider is a networking.Provider for the EKS Anywhere Cilium CNI.
+type Provider struct {
+	reconciler *Reconciler
+}
+
+var _ networking.Provider = &Provider{}
+
+// NewProvider returns a new Cilium Provider that installs and upgrades Cilium with the given Reconciler.
+func NewProvider(reconciler *Reconciler) *Provider {
+	return &Provider{
+		reconciler: reconciler,
+	}
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return networking.CiliumProviderName
+}
+
+// Install installs or upgrades Cilium in the cluster.
+func (p *Provider) Install(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
+	return p.reconciler.Reconcile(ctx, logger, client, spec)
+}
+
+// UpgradePlan returns the Cilium component changes between the current and the new cluster Spec.
+func (p *Provider) UpgradePlan(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff {
+	return cilium.ChangeDiff(currentSpec, newSpec)
+}
+
+// Ready always succeeds. The Cilium Reconciler already reports the state of the Cilium
+// installation through the DefaultCNIConfigured condition.
+func (p *Provider) Ready(_ context.Context, _ client.Client, _ *cluster.Spec) error {
+	return nil
+}
@@ -0,0 +1,38 @@
+package reconciler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler"
+	"github.com/aws/eks-anywhere/pkg/networking/cilium/reconciler/mocks"
+)
+
+func newCiliumProvider(t *testing.T) *reconciler.Provider {
+	ctrl := gomock.NewController(t)
+	return reconciler.NewProvider(reconciler.New(mocks.NewMockTemplater(ctrl), providerNamespaces))
+}
+
+func TestProviderName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(newCiliumProvider(t).Name()).To(Equal(networking.CiliumProviderName))
+}
+
+func TestProviderReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{Cilium: &anywherev1.CiliumConfig{}}
+	})
+
+	g.Expect(newCiliumProvider(t).Ready(ctx, client, spec)).To(Succeed())
+}
@@ -140,6 +140,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client c
 	// Upgrade process has run its course, and so we can now mark that the default cni has been configured.
 	conditions.MarkTrue(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition)
 
+	if result, err := r.pruneDisabledFeatures(ctx, logger, client, spec); err != nil || result.Return() {
+		return result, err
+	}
+
 	return r.deletePreflightIfExists(ctx, client, spec)
 }
 
@@ -271,6 +275,51 @@ func (r *Reconciler) deletePreflightIfExists(ctx context.Context, client client.
 	return controller.Result{}, nil
 }
 
+// pruneDisabledFeatures deletes the objects for features that are disabled or replaced by Cilium,
+// since applying the Cilium manifest doesn't remove them. kube-proxy is only removed once Cilium
+// is ready to take over its job.
+func (r *Reconciler) pruneDisabledFeatures(ctx context.Context, logger logr.Logger, c client.Client, spec *cluster.Spec) (controller.Result, error) {
+	if err := deleteObjectsIfExist(ctx, c, cilium.DisabledHubbleObjects(spec)); err != nil {
+		return controller.Result{}, errors.Wrap(err, "deleting disabled Hubble components")
+	}
+
+	if !spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement {
+		return controller.Result{}, nil
+	}
+
+	ds, err := getDaemonSet(ctx, c, cilium.DaemonSetName)
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	if ds == nil {
+		return controller.Result{}, errors.New("cilium DS not found, can't remove kube-proxy")
+	}
+
+	if err := cilium.CheckDaemonSetReady(ds); err != nil {
+		logger.Info("Cilium DS is not ready to replace kube-proxy, requeueing", "reason", err.Error())
+		return controller.Result{Result: &ctrl.Result{
+			RequeueAfter: defaultRequeueTime,
+		}}, nil
+	}
+
+	if err := deleteObjectsIfExist(ctx, c, cilium.KubeProxyObjects()); err != nil {
+		return controller.Result{}, errors.Wrap(err, "deleting kube-proxy")
+	}
+
+	return controller.Result{}, nil
+}
+
+func deleteObjectsIfExist(ctx context.Context, c client.Client, objs []client.Object) error {
+	for _, o := range objs {
+		if err := c.Delete(ctx, o); client.IgnoreNotFound(err) != nil {
+			return errors.Wrapf(err, "deleting object %s", client.ObjectKeyFromObject(o))
+		}
+	}
+
+	return nil
+}
+
 func (r *Reconciler) installPreflight(ctx context.Context, client client.Client, spec *cluster.Spec) error {
 	preflight, err := r.templater.GenerateUpgradePreflightManifest(ctx, spec)
 	if err != nil {
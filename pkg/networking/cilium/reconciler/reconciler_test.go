@@ -437,6 +437,53 @@ func TestReconcilerReconcileUpdateConfigConfigMapEnablePolicyChange(t *testing.T
 	tt.expectDefaultCNIConfigured(defaultCNIConfiguredCondition("True", "", "", ""))
 }
 
+func TestReconcilerReconcileDeletesDisabledHubbleComponents(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	relay := simpleDeployment("hubble-relay", "hubble-relay:1.10.1-eksa-1")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, relay)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.Result{}),
+	)
+	tt.expectDeploymentToNotExist(relay.Name, relay.Namespace)
+	tt.expectDefaultCNIConfigured(defaultCNIConfiguredCondition("True", "", "", ""))
+}
+
+func TestReconcilerReconcileKubeProxyReplacementDeletesKubeProxy(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	kubeProxy := simpleDaemonSet("kube-proxy", "kube-proxy:v1.19.8")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, kubeProxy)
+	tt.makeCiliumDaemonSetReady()
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = true
+
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Not(gomock.Nil())).Return(tt.buildManifest(ds, operator, cm), nil)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.Result{}),
+	)
+	tt.expectDSToNotExist(kubeProxy.Name, kubeProxy.Namespace)
+}
+
+func TestReconcilerReconcileKubeProxyReplacementCiliumNotReady(t *testing.T) {
+	ds := ciliumDaemonSet()
+	operator := ciliumOperator()
+	cm := ciliumConfigMap()
+	kubeProxy := simpleDaemonSet("kube-proxy", "kube-proxy:v1.19.8")
+	tt := newReconcileTest(t).withObjects(ds, operator, cm, kubeProxy)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement = true
+
+	tt.templater.EXPECT().GenerateManifest(tt.ctx, tt.spec, gomock.Not(gomock.Nil())).Return(tt.buildManifest(ds, operator, cm), nil)
+
+	tt.Expect(tt.reconciler.Reconcile(tt.ctx, test.NewNullLogger(), tt.client, tt.spec)).To(
+		Equal(controller.ResultWithRequeue(10 * time.Second)),
+	)
+	tt.Expect(tt.getDaemonSet(kubeProxy.Name, kubeProxy.Namespace)).NotTo(BeNil())
+}
+
 func TestReconcilerReconcileSkipUpgradeWithoutCiliumInstalled(t *testing.T) {
 	ds := ciliumDaemonSet()
 	operator := ciliumOperator()
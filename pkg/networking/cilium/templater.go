@@ -130,6 +130,11 @@ func (t *Templater) GenerateManifest(ctx context.Context, spec *cluster.Spec, op
 		kubeVersion: kubeVersion,
 		retrier:     retrier.NewWithMaxRetries(maxRetries, defaultBackOffPeriod),
 	}
+	// The extra config is left out of the upgrade preflight manifest since all the objects
+	// in it are deleted once the upgrade is done.
+	if err := setExtraConfigValues(c.values, spec, versionsBundle); err != nil {
+		return nil, err
+	}
 	for _, o := range opts {
 		o(c)
 	}
@@ -251,6 +256,21 @@ func templateValues(spec *cluster.Spec, versionsBundle *cluster.VersionsBundle)
 
 	}
 
+	clusterNetwork := spec.Cluster.Spec.ClusterNetwork
+	if clusterNetwork.HasIPFamily(anywherev1.IPv6Family) {
+		ipv4 := clusterNetwork.HasIPFamily(anywherev1.IPv4Family)
+		val["ipv4"] = values{
+			"enabled": ipv4,
+		}
+		val["ipv6"] = values{
+			"enabled": true,
+		}
+		// Without IPv4 addresses in the nodes, the tunnel needs to run over IPv6.
+		if !ipv4 && val["routingMode"] == "tunnel" {
+			val["underlayProtocol"] = "ipv6"
+		}
+	}
+
 	return val
 }
 
@@ -381,6 +381,209 @@ func TestTemplaterGenerateManifestDirectModeManualIPCIDRSuccess(t *testing.T) {
 	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
 }
 
+func TestTemplaterGenerateManifestDualStackSuccess(t *testing.T) {
+	wantValues := map[string]interface{}{
+		"cni": map[string]interface{}{
+			"chainingMode": "portmap",
+		},
+		"ipam": map[string]interface{}{
+			"mode": "kubernetes",
+		},
+		"identityAllocationMode": "crd",
+		"prometheus": map[string]interface{}{
+			"enabled": true,
+		},
+		"rollOutCiliumPods": true,
+		"routingMode":       "tunnel",
+		"tunnelProtocol":    "geneve",
+		"ipv4": map[string]interface{}{
+			"enabled": true,
+		},
+		"ipv6": map[string]interface{}{
+			"enabled": true,
+		},
+		"image": map[string]interface{}{
+			"repository": "public.ecr.aws/isovalent/cilium",
+			"tag":        "v1.9.11-eksa.1",
+		},
+		"operator": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/isovalent/operator",
+				"tag":        "v1.9.11-eksa.1",
+			},
+			"prometheus": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"192.168.0.0/16", "fd00:10:1::/56"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"10.96.0.0/12", "fd00:10:96::/112"}
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+}
+
+func TestTemplaterGenerateManifestIPv6Success(t *testing.T) {
+	wantValues := map[string]interface{}{
+		"cni": map[string]interface{}{
+			"chainingMode": "portmap",
+		},
+		"ipam": map[string]interface{}{
+			"mode": "kubernetes",
+		},
+		"identityAllocationMode": "crd",
+		"prometheus": map[string]interface{}{
+			"enabled": true,
+		},
+		"rollOutCiliumPods": true,
+		"routingMode":       "tunnel",
+		"tunnelProtocol":    "geneve",
+		"underlayProtocol":  "ipv6",
+		"ipv4": map[string]interface{}{
+			"enabled": false,
+		},
+		"ipv6": map[string]interface{}{
+			"enabled": true,
+		},
+		"image": map[string]interface{}{
+			"repository": "public.ecr.aws/isovalent/cilium",
+			"tag":        "v1.9.11-eksa.1",
+		},
+		"operator": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/isovalent/operator",
+				"tag":        "v1.9.11-eksa.1",
+			},
+			"prometheus": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:10:1::/56"}
+	tt.spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:10:96::/112"}
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+}
+
+func TestTemplaterGenerateManifestExtraConfigSuccess(t *testing.T) {
+	wantValues := map[string]interface{}{
+		"cni": map[string]interface{}{
+			"chainingMode": "portmap",
+		},
+		"ipam": map[string]interface{}{
+			"mode": "kubernetes",
+		},
+		"identityAllocationMode": "crd",
+		"prometheus": map[string]interface{}{
+			"enabled": true,
+		},
+		"rollOutCiliumPods": true,
+		"routingMode":       "tunnel",
+		"tunnelProtocol":    "geneve",
+		"image": map[string]interface{}{
+			"repository": "public.ecr.aws/isovalent/cilium",
+			"tag":        "v1.9.11-eksa.1",
+			"pullPolicy": "Always",
+		},
+		"operator": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": "public.ecr.aws/isovalent/operator",
+				"tag":        "v1.9.11-eksa.1",
+			},
+			"prometheus": map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"hubble": map[string]interface{}{
+			"enabled": true,
+			"relay": map[string]interface{}{
+				"enabled": true,
+				"image": map[string]interface{}{
+					"repository": "public.ecr.aws/isovalent/hubble-relay",
+					"tag":        "v1.9.11-eksa.1",
+					"useDigest":  false,
+				},
+			},
+			"ui": map[string]interface{}{
+				"enabled": true,
+				"frontend": map[string]interface{}{
+					"image": map[string]interface{}{
+						"repository": "public.ecr.aws/isovalent/hubble-ui",
+						"tag":        "v0.13.1-eksa.1",
+						"useDigest":  false,
+					},
+				},
+				"backend": map[string]interface{}{
+					"image": map[string]interface{}{
+						"repository": "public.ecr.aws/isovalent/hubble-ui-backend",
+						"tag":        "v0.13.1-eksa.1",
+						"useDigest":  false,
+					},
+				},
+			},
+			"metrics": map[string]interface{}{
+				"enableOpenMetrics": true,
+			},
+		},
+		"bgpControlPlane": map[string]interface{}{
+			"enabled": true,
+		},
+		"kubeProxyReplacement": "true",
+		"k8sServiceHost":       "1.2.3.4",
+		"k8sServicePort":       float64(6443),
+		"bpf": map[string]interface{}{
+			"lbExternalClusterIP": true,
+			"mapDynamicSizeRatio": float64(3),
+		},
+		"cluster": map[string]interface{}{
+			"name": "123",
+		},
+		"podAnnotations": map[string]interface{}{
+			v1alpha1.CiliumExtraConfigHashAnnotation: "7eeceefb3350977e20e2c5f3c6bbdc803c38281e13f269a80880430140b8f3d4",
+		},
+	}
+
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint = &v1alpha1.Endpoint{Host: "1.2.3.4"}
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium = &v1alpha1.CiliumConfig{
+		Hubble:               &v1alpha1.CiliumHubbleConfig{Relay: true, UI: true},
+		BGPControlPlane:      &v1alpha1.CiliumBGPControlPlaneConfig{},
+		KubeProxyReplacement: true,
+		HelmValues: map[string]string{
+			"bpf.mapDynamicSizeRatio":          "3",
+			"bpf.lbExternalClusterIP":          "true",
+			"cluster.name":                     "'123'",
+			"hubble.metrics.enableOpenMetrics": "true",
+			"image.pullPolicy":                 "Always",
+		},
+	}
+	vb := tt.spec.VersionsBundles["1.22"]
+	vb.Cilium.HubbleRelay.URI = "public.ecr.aws/isovalent/hubble-relay:v1.9.11-eksa.1"
+	vb.Cilium.HubbleUI.URI = "public.ecr.aws/isovalent/hubble-ui:v0.13.1-eksa.1"
+	vb.Cilium.HubbleUIBackend.URI = "public.ecr.aws/isovalent/hubble-ui-backend:v0.13.1-eksa.1"
+	tt.expectHelmClientFactoryGet("", "")
+	tt.expectHelmTemplateWith(eqMap(wantValues), "1.22").Return(tt.manifest, nil)
+
+	tt.Expect(tt.t.GenerateManifest(tt.ctx, tt.spec)).To(Equal(tt.manifest), "templater.GenerateManifest() should return right manifest")
+}
+
+func TestTemplaterGenerateManifestHubbleImageMissingFromBundle(t *testing.T) {
+	tt := newtemplaterTest(t)
+	tt.spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium = &v1alpha1.CiliumConfig{
+		Hubble: &v1alpha1.CiliumHubbleConfig{Relay: true},
+	}
+
+	_, err := tt.t.GenerateManifest(tt.ctx, tt.spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("image hubble.relay.image is not available in the bundle")))
+}
+
 func TestTemplaterGenerateManifestError(t *testing.T) {
 	expectedAttempts := 2
 	tt := newtemplaterTest(t)
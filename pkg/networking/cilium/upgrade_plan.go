@@ -138,7 +138,7 @@ func BuildUpgradePlan(installation *Installation, clusterSpec *cluster.Spec) Upg
 	return UpgradePlan{
 		DaemonSet: daemonSetUpgradePlan(installation.DaemonSet, clusterSpec),
 		Operator:  operatorUpgradePlan(installation.Operator, clusterSpec),
-		ConfigMap: configMapUpgradePlan(installation.ConfigMap, clusterSpec),
+		ConfigMap: configMapUpgradePlan(installation.ConfigMap, installation.DaemonSet, clusterSpec),
 	}
 }
 
@@ -199,7 +199,7 @@ func operatorUpgradePlan(operator *appsv1.Deployment, clusterSpec *cluster.Spec)
 	return info
 }
 
-func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec) ConfigUpdatePlan {
+func configMapUpgradePlan(configMap *corev1.ConfigMap, ds *appsv1.DaemonSet, clusterSpec *cluster.Spec) ConfigUpdatePlan {
 	updatePlan := &ConfigUpdatePlan{}
 
 	var newEnforcementPolicy string
@@ -246,6 +246,7 @@ func configMapUpgradePlan(configMap *corev1.ConfigMap, clusterSpec *cluster.Spec
 	}
 
 	updatePlan.Components = append(updatePlan.Components, egressMasqueradeUpdate)
+	updatePlan.Components = append(updatePlan.Components, extraConfigUpdatePlan(ds, clusterSpec))
 
 	updatePlan.generateUpdateReasonFromComponents()
 
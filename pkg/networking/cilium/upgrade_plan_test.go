@@ -57,6 +57,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -93,6 +96,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -131,6 +137,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -176,6 +185,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -212,6 +224,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -251,6 +266,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -289,6 +307,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -325,6 +346,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -366,6 +390,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -409,6 +436,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 						{
 							Name: "EgressMasqueradeInterfaces",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -452,6 +482,9 @@ func TestBuildUpgradePlan(t *testing.T) {
 							NewValue:     "new",
 							UpdateReason: "Egress masquerade interfaces changed: [old] -> [new]",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
 					},
 				},
 			},
@@ -498,6 +531,101 @@ func TestBuildUpgradePlan(t *testing.T) {
 							NewValue:     "new",
 							UpdateReason: "Egress masquerade interfaces field is not present in config but is configured in cluster spec",
 						},
+						{
+							Name: cilium.ExtraConfigComponentName,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "extra config changed",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0"),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", ""),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundles["1.19"].Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundles["1.19"].Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{
+						HelmValues: map[string]string{"envoy.enabled": "true"},
+					},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Cilium extra config changed",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name: cilium.EgressMasqueradeInterfacesComponentName,
+						},
+						{
+							Name:         cilium.ExtraConfigComponentName,
+							NewValue:     "7db4540200355fdd03e50b41041450893401cf30e42a6eb6c38cb96b328ff388",
+							UpdateReason: "Cilium extra config changed",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "extra config removed",
+			installation: &cilium.Installation{
+				DaemonSet: daemonSet("cilium:v1.0.0", func(ds *appsv1.DaemonSet) {
+					ds.Spec.Template.Annotations = map[string]string{
+						anywherev1.CiliumExtraConfigHashAnnotation: "old-hash",
+					}
+				}),
+				Operator:  deployment("cilium-operator:v1.0.0"),
+				ConfigMap: ciliumConfigMap("default", ""),
+			},
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.VersionsBundles["1.19"].Cilium.Cilium.URI = "cilium:v1.0.0"
+				s.VersionsBundles["1.19"].Cilium.Operator.URI = "cilium-operator:v1.0.0"
+				s.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+					Cilium: &anywherev1.CiliumConfig{},
+				}
+			}),
+			want: cilium.UpgradePlan{
+				DaemonSet: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium:v1.0.0",
+					NewImage: "cilium:v1.0.0",
+				},
+				Operator: cilium.VersionedComponentUpgradePlan{
+					OldImage: "cilium-operator:v1.0.0",
+					NewImage: "cilium-operator:v1.0.0",
+				},
+				ConfigMap: cilium.ConfigUpdatePlan{
+					UpdateReason: "Cilium extra config changed",
+					Components: []cilium.ConfigComponentUpdatePlan{
+						{
+							Name:     cilium.PolicyEnforcementComponentName,
+							OldValue: "default",
+							NewValue: "default",
+						},
+						{
+							Name: cilium.EgressMasqueradeInterfacesComponentName,
+						},
+						{
+							Name:         cilium.ExtraConfigComponentName,
+							OldValue:     "old-hash",
+							UpdateReason: "Cilium extra config changed",
+						},
 					},
 				},
 			},
@@ -0,0 +1,75 @@
+package custom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// Provider is a networking.Provider for a CNI installed and managed by the user.
+// It never installs nor upgrades anything in the cluster, it only waits for the
+// nodes to become ready once the user has installed their CNI.
+type Provider struct{}
+
+var _ networking.Provider = &Provider{}
+
+// NewProvider returns a new custom CNI Provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return networking.CustomProviderName
+}
+
+// Install doesn't install anything, the CNI is managed by the user. It only records
+// in the cluster status that the CNI is not managed by EKS Anywhere.
+func (p *Provider) Install(_ context.Context, logger logr.Logger, _ client.Client, spec *cluster.Spec) (controller.Result, error) {
+	logger.Info("Cluster configured with a custom CNI, skipping CNI installation")
+	conditions.MarkFalse(spec.Cluster, anywherev1.DefaultCNIConfiguredCondition, anywherev1.CustomCNIConfiguredReason, clusterv1.ConditionSeverityInfo, "Configured to use a custom CNI")
+	return controller.Result{}, nil
+}
+
+// UpgradePlan always returns nil since EKS Anywhere doesn't upgrade a custom CNI.
+func (p *Provider) UpgradePlan(_, _ *cluster.Spec) *types.ChangeDiff {
+	return nil
+}
+
+// Ready returns an error until all the nodes in the cluster are ready, which
+// requires the user to have installed a working CNI.
+func (p *Provider) Ready(ctx context.Context, client client.Client, _ *cluster.Spec) error {
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return fmt.Errorf("listing nodes: %v", err)
+	}
+
+	for _, node := range nodes.Items {
+		if !nodeReady(node) {
+			return fmt.Errorf("node %s is not ready, check the custom CNI has been installed", node.Name)
+		}
+	}
+
+	return nil
+}
+
+func nodeReady(node corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package custom_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/networking/custom"
+)
+
+func customCNISpec() *cluster.Spec {
+	return test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Custom: &v1alpha1.CustomCNIConfig{},
+		}
+	})
+}
+
+func node(name string, status corev1.ConditionStatus) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: status},
+			},
+		},
+	}
+}
+
+func TestProviderName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(custom.NewProvider().Name()).To(Equal(networking.CustomProviderName))
+}
+
+func TestProviderInstall(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	spec := customCNISpec()
+	client := fake.NewClientBuilder().Build()
+
+	result, err := custom.NewProvider().Install(ctx, test.NewNullLogger(), client, spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(controller.Result{}))
+
+	condition := conditions.Get(spec.Cluster, v1alpha1.DefaultCNIConfiguredCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(v1alpha1.CustomCNIConfiguredReason))
+}
+
+func TestProviderUpgradePlan(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(custom.NewProvider().UpgradePlan(customCNISpec(), customCNISpec())).To(BeNil())
+}
+
+func TestProviderReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(
+		node("cp-1", corev1.ConditionTrue),
+		node("md-1", corev1.ConditionTrue),
+	).Build()
+
+	g.Expect(custom.NewProvider().Ready(ctx, client, customCNISpec())).To(Succeed())
+}
+
+func TestProviderReadyNodeNotReady(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(
+		node("cp-1", corev1.ConditionTrue),
+		node("md-1", corev1.ConditionFalse),
+	).Build()
+
+	g.Expect(custom.NewProvider().Ready(ctx, client, customCNISpec())).To(
+		MatchError("node md-1 is not ready, check the custom CNI has been installed"),
+	)
+}
+
+func TestProviderReadyNodeWithoutReadyCondition(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cp-1"}},
+	).Build()
+
+	g.Expect(custom.NewProvider().Ready(ctx, client, customCNISpec())).NotTo(Succeed())
+}
@@ -0,0 +1,85 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+const (
+	// CiliumProviderName is the name of the provider for the EKS Anywhere Cilium CNI.
+	CiliumProviderName = "cilium"
+
+	// CustomProviderName is the name of the provider for a CNI installed and managed by the user.
+	CustomProviderName = "custom"
+)
+
+// Provider manages the lifecycle of a CNI in a cluster.
+type Provider interface {
+	// Name returns the name of the CNI provider.
+	Name() string
+
+	// Install takes the CNI in a cluster to the desired state defined in a cluster Spec, installing
+	// or upgrading it as needed. client is connected to the target Kubernetes cluster, not the
+	// management cluster. It uses a controller.Result to indicate when requeues are needed.
+	Install(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
+
+	// UpgradePlan returns the CNI component changes between the current and the new cluster Spec.
+	// It returns nil if there are no changes to report.
+	UpgradePlan(currentSpec, newSpec *cluster.Spec) *types.ChangeDiff
+
+	// Ready returns an error if the CNI in the cluster is not ready yet.
+	Ready(ctx context.Context, client client.Client, spec *cluster.Spec) error
+}
+
+// Registry holds the available CNI providers and selects one based on a CNI configuration.
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry builds a Registry with the given providers.
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{
+		providers: make(map[string]Provider, len(providers)),
+	}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+
+	return r
+}
+
+// Get returns the Provider for the CNI configured in cni.
+func (r *Registry) Get(cni *anywherev1.CNIConfig) (Provider, error) {
+	name := providerName(cni)
+	if name == "" {
+		return nil, fmt.Errorf("unsupported CNI, only Cilium and custom CNIs are supported at this time")
+	}
+
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("no provider registered for CNI %s", name)
+	}
+
+	return p, nil
+}
+
+func providerName(cni *anywherev1.CNIConfig) string {
+	switch {
+	case cni == nil:
+		return ""
+	case cni.Cilium != nil:
+		return CiliumProviderName
+	case cni.Custom != nil:
+		return CustomProviderName
+	default:
+		return ""
+	}
+}
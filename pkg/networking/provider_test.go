@@ -0,0 +1,50 @@
+package networking_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/networking/mocks"
+)
+
+func TestRegistryGet(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	ciliumProvider := mocks.NewMockProvider(ctrl)
+	ciliumProvider.EXPECT().Name().Return(networking.CiliumProviderName)
+	customProvider := mocks.NewMockProvider(ctrl)
+	customProvider.EXPECT().Name().Return(networking.CustomProviderName)
+
+	r := networking.NewRegistry(ciliumProvider, customProvider)
+
+	p, err := r.Get(&v1alpha1.CNIConfig{Cilium: &v1alpha1.CiliumConfig{}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(BeIdenticalTo(ciliumProvider))
+
+	p, err = r.Get(&v1alpha1.CNIConfig{Custom: &v1alpha1.CustomCNIConfig{}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p).To(BeIdenticalTo(customProvider))
+}
+
+func TestRegistryGetUnsupportedCNI(t *testing.T) {
+	g := NewWithT(t)
+	r := networking.NewRegistry()
+
+	_, err := r.Get(&v1alpha1.CNIConfig{Kindnetd: &v1alpha1.KindnetdConfig{}})
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI")))
+
+	_, err = r.Get(nil)
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI")))
+}
+
+func TestRegistryGetProviderNotRegistered(t *testing.T) {
+	g := NewWithT(t)
+	r := networking.NewRegistry()
+
+	_, err := r.Get(&v1alpha1.CNIConfig{Custom: &v1alpha1.CustomCNIConfig{}})
+	g.Expect(err).To(MatchError("no provider registered for CNI custom"))
+}
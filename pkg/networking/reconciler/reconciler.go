@@ -4,35 +4,57 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
-	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	anywherev1 "github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking"
 )
 
-type CiliumReconciler interface {
-	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
-}
-
+// Reconciler reconciles the CNI of a cluster using the networking.Provider
+// that corresponds to the CNI configured in the cluster spec.
 type Reconciler struct {
-	ciliumReconciler CiliumReconciler
+	providers *networking.Registry
 }
 
-func New(ciliumReconciler CiliumReconciler) *Reconciler {
+// New builds a Reconciler that selects CNI providers from the given registry.
+func New(providers *networking.Registry) *Reconciler {
 	return &Reconciler{
-		ciliumReconciler: ciliumReconciler,
+		providers: providers,
 	}
 }
 
 // Reconcile takes the specified CNI in a cluster to the desired state defined in a cluster Spec
 // It uses a controller.Result to indicate when requeues are needed
-// Intended to be used in a kubernetes controller
-// Only Cilium CNI is supported for now.
+// Intended to be used in a kubernetes controller.
 func (r *Reconciler) Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error) {
-	if spec.Cluster.Spec.ClusterNetwork.CNIConfig.Cilium != nil {
-		return r.ciliumReconciler.Reconcile(ctx, logger, client, spec)
-	} else {
-		return controller.Result{}, errors.New("unsupported CNI, only Cilium is supported at this time")
+	provider, err := r.providers.Get(spec.Cluster.Spec.ClusterNetwork.CNIConfig)
+	if err != nil {
+		return controller.Result{}, err
 	}
+
+	return provider.Install(ctx, logger, client, spec)
+}
+
+// ReportReady checks if the CNI in the cluster is ready and records it in the CNIReady condition of the cluster.
+// It doesn't requeue when the CNI is not ready: the condition is included in the cluster Ready summary,
+// which already requeues the cluster until it's ready.
+// It's meant to run once the worker nodes have been reconciled, so the readiness check covers all the nodes.
+func (r *Reconciler) ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) error {
+	provider, err := r.providers.Get(spec.Cluster.Spec.ClusterNetwork.CNIConfig)
+	if err != nil {
+		return err
+	}
+
+	if err := provider.Ready(ctx, client, spec); err != nil {
+		logger.Info("CNI is not ready", "cni", provider.Name(), "reason", err.Error())
+		conditions.MarkFalse(spec.Cluster, anywherev1.CNIReadyCondition, anywherev1.CNINotReadyReason, clusterv1.ConditionSeverityInfo, err.Error())
+		return nil
+	}
+
+	conditions.MarkTrue(spec.Cluster, anywherev1.CNIReadyCondition)
+	return nil
 }
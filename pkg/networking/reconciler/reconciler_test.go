@@ -2,22 +2,47 @@ package reconciler_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/aws/eks-anywhere/internal/test"
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/controller"
+	"github.com/aws/eks-anywhere/pkg/networking"
+	"github.com/aws/eks-anywhere/pkg/networking/mocks"
 	"github.com/aws/eks-anywhere/pkg/networking/reconciler"
-	"github.com/aws/eks-anywhere/pkg/networking/reconciler/mocks"
 )
 
+type reconcilerTest struct {
+	*WithT
+	ctx      context.Context
+	provider *mocks.MockProvider
+	r        *reconciler.Reconciler
+}
+
+func newReconcilerTest(t *testing.T, name string) *reconcilerTest {
+	ctrl := gomock.NewController(t)
+	provider := mocks.NewMockProvider(ctrl)
+	provider.EXPECT().Name().Return(name).AnyTimes()
+
+	return &reconcilerTest{
+		WithT:    NewWithT(t),
+		ctx:      context.Background(),
+		provider: provider,
+		r:        reconciler.New(networking.NewRegistry(provider)),
+	}
+}
+
 func TestReconcilerReconcileCilium(t *testing.T) {
-	ctx := context.Background()
+	tt := newReconcilerTest(t, networking.CiliumProviderName)
 	logger := test.NewNullLogger()
 	client := fake.NewClientBuilder().Build()
 	spec := test.NewClusterSpec(func(s *cluster.Spec) {
@@ -26,30 +51,118 @@ func TestReconcilerReconcileCilium(t *testing.T) {
 		}
 	})
 
-	g := NewWithT(t)
-	ctrl := gomock.NewController(t)
-	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
-	ciliumReconciler.EXPECT().Reconcile(ctx, logger, client, spec)
+	tt.provider.EXPECT().Install(tt.ctx, logger, client, spec)
 
-	r := reconciler.New(ciliumReconciler)
-	result, err := r.Reconcile(ctx, logger, client, spec)
-	g.Expect(result).To(Equal(controller.Result{}))
-	g.Expect(err).NotTo(HaveOccurred())
+	result, err := tt.r.Reconcile(tt.ctx, logger, client, spec)
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(err).NotTo(HaveOccurred())
 }
 
-func TestReconcilerReconcileUnsupportedCNI(t *testing.T) {
-	ctx := context.Background()
+func TestReconcilerReconcileCustom(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CustomProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Custom: &v1alpha1.CustomCNIConfig{},
+		}
+	})
+
+	tt.provider.EXPECT().Install(tt.ctx, logger, client, spec)
+
+	result, err := tt.r.Reconcile(tt.ctx, logger, client, spec)
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestReconcilerReconcileInstallError(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CiliumProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Cilium: &v1alpha1.CiliumConfig{},
+		}
+	})
+
+	tt.provider.EXPECT().Install(tt.ctx, logger, client, spec).Return(controller.Result{}, errors.New("installing"))
+
+	_, err := tt.r.Reconcile(tt.ctx, logger, client, spec)
+	tt.Expect(err).To(MatchError("installing"))
+}
+
+func TestReconcilerReconcileInstallRequeue(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CiliumProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Cilium: &v1alpha1.CiliumConfig{},
+		}
+	})
+
+	tt.provider.EXPECT().Install(tt.ctx, logger, client, spec).Return(controller.ResultWithRequeue(5*time.Second), nil)
+
+	result, err := tt.r.Reconcile(tt.ctx, logger, client, spec)
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(result).To(Equal(controller.ResultWithRequeue(5 * time.Second)))
+}
+
+func TestReconcilerReportReady(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CustomProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Custom: &v1alpha1.CustomCNIConfig{},
+		}
+	})
+
+	tt.provider.EXPECT().Ready(tt.ctx, client, spec)
+
+	tt.Expect(tt.r.ReportReady(tt.ctx, logger, client, spec)).To(Succeed())
+	tt.Expect(conditions.IsTrue(spec.Cluster, v1alpha1.CNIReadyCondition)).To(BeTrue())
+}
+
+func TestReconcilerReportReadyNotReady(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CustomProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+			Custom: &v1alpha1.CustomCNIConfig{},
+		}
+	})
+
+	tt.provider.EXPECT().Ready(tt.ctx, client, spec).Return(errors.New("node not ready"))
+
+	tt.Expect(tt.r.ReportReady(tt.ctx, logger, client, spec)).To(Succeed())
+	condition := conditions.Get(spec.Cluster, v1alpha1.CNIReadyCondition)
+	tt.Expect(condition).NotTo(BeNil())
+	tt.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	tt.Expect(condition.Reason).To(Equal(v1alpha1.CNINotReadyReason))
+	tt.Expect(condition.Message).To(Equal("node not ready"))
+}
+
+func TestReconcilerReportReadyUnsupportedCNI(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CiliumProviderName)
 	logger := test.NewNullLogger()
 	client := fake.NewClientBuilder().Build()
 	spec := test.NewClusterSpec(func(s *cluster.Spec) {
 		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{}
 	})
 
-	g := NewWithT(t)
-	ctrl := gomock.NewController(t)
-	ciliumReconciler := mocks.NewMockCiliumReconciler(ctrl)
+	tt.Expect(tt.r.ReportReady(tt.ctx, logger, client, spec)).To(MatchError(ContainSubstring("unsupported CNI")))
+}
+
+func TestReconcilerReconcileUnsupportedCNI(t *testing.T) {
+	tt := newReconcilerTest(t, networking.CiliumProviderName)
+	logger := test.NewNullLogger()
+	client := fake.NewClientBuilder().Build()
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{}
+	})
 
-	r := reconciler.New(ciliumReconciler)
-	_, err := r.Reconcile(ctx, logger, client, spec)
-	g.Expect(err).To(MatchError(ContainSubstring("unsupported CNI, only Cilium is supported at this time")))
+	_, err := tt.r.Reconcile(tt.ctx, logger, client, spec)
+	tt.Expect(err).To(MatchError(ContainSubstring("unsupported CNI, only Cilium and custom CNIs are supported at this time")))
 }
@@ -25,6 +25,7 @@ type IPValidator interface {
 // CNIReconciler is an interface for reconciling CNI in the CloudStack cluster reconciler.
 type CNIReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *c.Spec) (controller.Result, error)
+	ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *c.Spec) error
 }
 
 // RemoteClientRegistry is an interface that defines methods for remote clients.
@@ -69,6 +70,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *an
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReportCNIReady,
 	).Run(ctx, log, clusterSpec)
 }
 
@@ -125,7 +127,7 @@ func (r *Reconciler) ReconcileControlPlane(ctx context.Context, log logr.Logger,
 		return controller.Result{}, err
 	}
 
-	return clusters.ReconcileControlPlane(ctx, log, r.client, &clusters.ControlPlane{
+	return clusters.ReconcileControlPlaneForEKSA(ctx, log, r.client, spec.Cluster, &clusters.ControlPlane{
 		Cluster:                     cp.Cluster,
 		ProviderCluster:             cp.ProviderCluster,
 		KubeadmControlPlane:         cp.KubeadmControlPlane,
@@ -152,7 +154,7 @@ func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, clus
 		return controller.Result{}, errors.Wrap(err, "Generate worker node CAPI spec")
 	}
 
-	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, clusterSpec.Cluster, clusters.ToWorkers(w))
+	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, r.remoteClientRegistry, clusterSpec.Cluster, clusters.ToWorkers(w))
 }
 
 // ReconcileCNI reconciles the CNI to the desired state.
@@ -165,3 +167,15 @@ func (r *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, clusterS
 
 	return r.cniReconciler.Reconcile(ctx, log, client, clusterSpec)
 }
+
+// ReportCNIReady records in the cluster status whether the CNI is ready.
+// It runs after the workers have been reconciled so the check covers all the nodes.
+func (r *Reconciler) ReportCNIReady(ctx context.Context, log logr.Logger, clusterSpec *c.Spec) (controller.Result, error) {
+	log = log.WithValues("phase", "reportCNIReady")
+	client, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(clusterSpec.Cluster))
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, r.cniReconciler.ReportReady(ctx, log, client, clusterSpec)
+}
@@ -59,9 +59,10 @@ func TestReconcilerReconcileSuccess(t *testing.T) {
 	tt.ipValidator.EXPECT().ValidateControlPlaneIP(tt.ctx, logger, tt.buildSpec()).Return(controller.Result{}, nil)
 	tt.remoteClientRegistry.EXPECT().GetClient(
 		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: constants.EksaSystemNamespace},
-	).Return(remoteClient, nil).Times(1)
+	).Return(remoteClient, nil).Times(2)
 
 	tt.cniReconciler.EXPECT().Reconcile(tt.ctx, logger, remoteClient, spec)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, gomock.Any())
 	ctrl := gomock.NewController(t)
 	validator := cloudstack.NewMockProviderValidator(ctrl)
 	tt.validatorRegistry.EXPECT().Get(tt.execConfig).Return(validator, nil).Times(1)
@@ -351,6 +352,28 @@ func TestReconcileCNISuccess(t *testing.T) {
 	tt.Expect(result).To(Equal(controller.Result{}))
 }
 
+func TestReportCNIReadySuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.withFakeClient()
+
+	logger := test.NewNullLogger()
+	remoteClient := fake.NewClientBuilder().Build()
+	tt.eksaSupportObjs = append(tt.eksaSupportObjs, tt.secret)
+	spec := tt.buildSpec()
+
+	tt.remoteClientRegistry.EXPECT().GetClient(
+		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: "eksa-system"},
+	).Return(remoteClient, nil)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, spec)
+
+	result, err := tt.reconciler().ReportCNIReady(tt.ctx, logger, spec)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
+	tt.Expect(tt.cluster.Status.FailureReason).To(BeZero())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
 func TestReconcileCNIErrorClientRegistry(t *testing.T) {
 	tt := newReconcilerTest(t)
 	tt.withFakeClient()
@@ -79,6 +79,9 @@ func (cs *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, wo
 		values["workloadTemplateName"] = workloadTemplateNames[workerNodeGroupConfiguration.Name]
 		values["workloadkubeadmconfigTemplateName"] = kubeadmconfigTemplateNames[workerNodeGroupConfiguration.Name]
 		values["autoscalingConfig"] = workerNodeGroupConfiguration.AutoScalingConfiguration
+		// The node resources are defined by the CloudStack compute offering, which is only referenced by name,
+		// so the node capacity isn't published and node groups can't scale from zero.
+		values["autoscalingAnnotations"] = clusterapi.AutoscalingAnnotations(workerNodeGroupConfiguration, nil)
 
 		if workerNodeGroupConfiguration.UpgradeRolloutStrategy != nil {
 			values["upgradeRolloutStrategy"] = true
@@ -219,6 +222,10 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 		if len(registryMirror.CACertContent) > 0 {
 			values["registryCACert"] = registryMirror.CACertContent
 		}
+
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return nil, err
+		}
 	}
 
 	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
@@ -253,6 +260,12 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 			return nil, err
 		}
 		values["encryptionProviderConfig"] = conf
+
+		kmsPluginFiles, err := common.GenerateKMSPluginFiles(clusterSpec.Cluster.Spec.EtcdEncryption, "/var/lib/kubeadm/kms-plugin")
+		if err != nil {
+			return nil, err
+		}
+		values["kmsPluginFiles"] = kmsPluginFiles
 	}
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil {
@@ -401,6 +414,10 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 		if len(registryMirror.CACertContent) > 0 {
 			values["registryCACert"] = registryMirror.CACertContent
 		}
+
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return nil, err
+		}
 	}
 
 	if clusterSpec.Cluster.Spec.ProxyConfiguration != nil {
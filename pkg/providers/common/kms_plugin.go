@@ -0,0 +1,153 @@
+package common
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+)
+
+const (
+	kmsPluginNamePrefix      = "kms-plugin-"
+	kmsPluginConfigMountPath = "/etc/kms-plugin/config"
+	staticPodManifestsDir    = "/etc/kubernetes/manifests"
+)
+
+// KMSPluginFile is a file that needs to be written in the control plane nodes
+// to run a KMS plugin managed by EKS Anywhere.
+type KMSPluginFile struct {
+	Path    string
+	Content string
+}
+
+// GenerateKMSPluginFiles takes a list of EtcdEncryption configs and generates the files to run,
+// as static pods, the KMS plugins that EKS Anywhere manages: one pod manifest per plugin plus its
+// config file when one is provided. Config files are placed under configDir, which must be a
+// directory writable by the node bootstrapper in the control plane nodes.
+func GenerateKMSPluginFiles(confs *[]v1alpha1.EtcdEncryption, configDir string) ([]KMSPluginFile, error) {
+	if confs == nil {
+		return nil, nil
+	}
+
+	var files []KMSPluginFile
+	for _, conf := range *confs {
+		for _, provider := range conf.Providers {
+			if provider.KMS == nil || provider.KMS.Plugin == nil {
+				continue
+			}
+
+			configPath := ""
+			if provider.KMS.Plugin.Config != "" {
+				configPath = filepath.Join(configDir, provider.KMS.Name, "config")
+				files = append(files, KMSPluginFile{
+					Path:    configPath,
+					Content: strings.Trim(provider.KMS.Plugin.Config, "\n"),
+				})
+			}
+
+			pod, err := kmsPluginStaticPod(provider.KMS, configPath)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, KMSPluginFile{
+				Path:    filepath.Join(staticPodManifestsDir, kmsPluginNamePrefix+provider.KMS.Name+".yaml"),
+				Content: pod,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+func kmsPluginStaticPod(kms *v1alpha1.KMS, configPath string) (string, error) {
+	name := kmsPluginNamePrefix + kms.Name
+	hostPathDirectoryOrCreate := corev1.HostPathDirectoryOrCreate
+	hostPathFile := corev1.HostPathFile
+
+	container := corev1.Container{
+		Name:            "kms-plugin",
+		Image:           kms.Plugin.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Args:            kms.Plugin.Args,
+		Env:             kmsPluginEnv(kms.Plugin.Env),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "kms-plugin-socket",
+				MountPath: v1alpha1.KMSPluginSocketDir,
+			},
+		},
+	}
+	volumes := []corev1.Volume{
+		{
+			Name: "kms-plugin-socket",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: v1alpha1.KMSPluginSocketDir,
+					Type: &hostPathDirectoryOrCreate,
+				},
+			},
+		},
+	}
+
+	if configPath != "" {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      "kms-plugin-config",
+			MountPath: kmsPluginConfigMountPath,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "kms-plugin-config",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: configPath,
+					Type: &hostPathFile,
+				},
+			},
+		})
+	}
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kube-system",
+			Labels: map[string]string{
+				"component": name,
+				"tier":      "control-plane",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers:        []corev1.Container{container},
+			HostNetwork:       true,
+			PriorityClassName: "system-node-critical",
+			Volumes:           volumes,
+		},
+	}
+
+	marshaledPod, err := yaml.Marshal(pod)
+	if err != nil {
+		return "", fmt.Errorf("marshaling kms plugin static pod %s: %v", name, err)
+	}
+	return strings.Trim(string(marshaledPod), "\n"), nil
+}
+
+func kmsPluginEnv(env map[string]string) []corev1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+	vars := make([]corev1.EnvVar, 0, len(env))
+	for name, value := range env {
+		vars = append(vars, corev1.EnvVar{Name: name, Value: value})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars
+}
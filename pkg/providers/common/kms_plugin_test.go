@@ -0,0 +1,119 @@
+package common_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	. "github.com/aws/eks-anywhere/pkg/providers/common"
+)
+
+func TestGenerateKMSPluginFilesNoPlugins(t *testing.T) {
+	g := NewWithT(t)
+	tests := []struct {
+		name   string
+		config *[]v1alpha1.EtcdEncryption
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+		},
+		{
+			name: "externally managed plugin",
+			config: &[]v1alpha1.EtcdEncryption{
+				{
+					Providers: []v1alpha1.EtcdEncryptionProvider{
+						{
+							KMS: &v1alpha1.KMS{
+								Name:                "config1",
+								SocketListenAddress: "unix:///var/run/kmsplugin/socket1.sock",
+							},
+						},
+					},
+					Resources: []string{"secrets"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(*testing.T) {
+			got, err := GenerateKMSPluginFiles(tt.config, "/var/lib/kubeadm/kms-plugin")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(BeEmpty())
+		})
+	}
+}
+
+func TestGenerateKMSPluginFiles(t *testing.T) {
+	g := NewWithT(t)
+	encryptionConf := &[]v1alpha1.EtcdEncryption{
+		{
+			Providers: []v1alpha1.EtcdEncryptionProvider{
+				{
+					KMS: &v1alpha1.KMS{
+						Name:                "new-key",
+						SocketListenAddress: "unix:///var/run/kmsplugin/new-key.sock",
+						Plugin: &v1alpha1.KMSPlugin{
+							Image: "public.ecr.aws/kms-plugin:v1",
+							Args: []string{
+								"--listen=/var/run/kmsplugin/new-key.sock",
+								"--config=/etc/kms-plugin/config",
+							},
+							Env: map[string]string{
+								"REGION":   "us-west-2",
+								"LOGLEVEL": "info",
+							},
+							Config: "keyId: new\n",
+						},
+					},
+				},
+				{
+					KMS: &v1alpha1.KMS{
+						Name:                "old-key",
+						SocketListenAddress: "unix:///var/run/kmsplugin/old-key.sock",
+					},
+				},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+
+	got, err := GenerateKMSPluginFiles(encryptionConf, "/var/lib/kubeadm/kms-plugin")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(HaveLen(2))
+	g.Expect(got[0]).To(Equal(KMSPluginFile{
+		Path:    "/var/lib/kubeadm/kms-plugin/new-key/config",
+		Content: "keyId: new",
+	}))
+	g.Expect(got[1].Path).To(Equal("/etc/kubernetes/manifests/kms-plugin-new-key.yaml"))
+	test.AssertContentToFile(t, got[1].Content, "testdata/expected_kms_plugin_pod.yaml")
+}
+
+func TestGenerateKMSPluginFilesNoConfig(t *testing.T) {
+	g := NewWithT(t)
+	encryptionConf := &[]v1alpha1.EtcdEncryption{
+		{
+			Providers: []v1alpha1.EtcdEncryptionProvider{
+				{
+					KMS: &v1alpha1.KMS{
+						Name:                "key",
+						SocketListenAddress: "unix:///var/run/kmsplugin/key.sock",
+						Plugin: &v1alpha1.KMSPlugin{
+							Image: "public.ecr.aws/kms-plugin:v1",
+						},
+					},
+				},
+			},
+			Resources: []string{"secrets"},
+		},
+	}
+
+	got, err := GenerateKMSPluginFiles(encryptionConf, "/var/lib/kubeadm/kms-plugin")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(HaveLen(1))
+	g.Expect(got[0].Path).To(Equal("/etc/kubernetes/manifests/kms-plugin-key.yaml"))
+	g.Expect(got[0].Content).ToNot(ContainSubstring("kms-plugin-config"))
+}
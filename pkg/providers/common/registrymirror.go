@@ -0,0 +1,40 @@
+package common
+
+import (
+	"github.com/aws/eks-anywhere/pkg/config"
+	"github.com/aws/eks-anywhere/pkg/registrymirror"
+	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
+)
+
+// SetRegistryMirrorFallbackValues sets the template values to configure the fallback endpoints of a registry mirror:
+// the ordered list of fallback mirrors for each registry and the tls and auth settings of each endpoint.
+func SetRegistryMirrorFallbackValues(values map[string]interface{}, registryMirror *registrymirror.RegistryMirror) error {
+	if registryMirror == nil || len(registryMirror.Fallbacks) == 0 {
+		return nil
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(registryMirror.Fallbacks))
+	for i, fallback := range registryMirror.Fallbacks {
+		endpoint := map[string]interface{}{
+			"base":         fallback.BaseRegistry,
+			"position":     i + 1,
+			"caCert":       fallback.CACertContent,
+			"insecureSkip": fallback.InsecureSkipVerify,
+		}
+		if fallback.Auth {
+			username, password, err := config.ReadFallbackCredentials(i)
+			if err != nil {
+				return err
+			}
+			endpoint["auth"] = true
+			endpoint["username"] = username
+			endpoint["password"] = password
+			values["registryFallbackAuth"] = true
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	values["registryMirrorFallbacks"] = containerd.ToAPIEndpointLists(registryMirror.FallbackRegistryMap())
+	values["registryMirrorFallbackEndpoints"] = endpoints
+	return nil
+}
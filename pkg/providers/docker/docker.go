@@ -326,6 +326,9 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 	if clusterSpec.AWSIamConfig != nil {
 		values["awsIamAuth"] = true
 	}
+	if clusterSpec.Cluster.Spec.ClusterNetwork.IsIPv6Primary() {
+		values["ipv6Primary"] = true
+	}
 
 	values["controlPlaneTaints"] = clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Taints
 
@@ -367,7 +370,8 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 
 	} else {
 		kubeletExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
-			Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf))
+			Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf)).
+			Append(clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
 
 		cgroupDriverArgs, err := kubeletCgroupDriverExtraArgs(clusterSpec.Cluster.Spec.KubernetesVersion)
 		if err != nil {
@@ -391,15 +395,18 @@ func buildTemplateMapCP(clusterSpec *cluster.Spec) (map[string]interface{}, erro
 func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration v1alpha1.WorkerNodeGroupConfiguration) (map[string]interface{}, error) {
 	versionsBundle := clusterSpec.WorkerNodeGroupVersionsBundle(workerNodeGroupConfiguration)
 
+	// Docker nodes share the resources of the host, so the node capacity isn't published
+	// in the autoscaling annotations and node groups can't scale from zero.
 	values := map[string]interface{}{
-		"clusterName":           clusterSpec.Cluster.Name,
-		"kubernetesVersion":     versionsBundle.KubeDistro.Kubernetes.Tag,
-		"kindNodeImage":         versionsBundle.EksD.KindNode.VersionedImage(),
-		"eksaSystemNamespace":   constants.EksaSystemNamespace,
-		"workerReplicas":        *workerNodeGroupConfiguration.Count,
-		"workerNodeGroupName":   fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
-		"workerNodeGroupTaints": workerNodeGroupConfiguration.Taints,
-		"autoscalingConfig":     workerNodeGroupConfiguration.AutoScalingConfiguration,
+		"clusterName":            clusterSpec.Cluster.Name,
+		"kubernetesVersion":      versionsBundle.KubeDistro.Kubernetes.Tag,
+		"kindNodeImage":          versionsBundle.EksD.KindNode.VersionedImage(),
+		"eksaSystemNamespace":    constants.EksaSystemNamespace,
+		"workerReplicas":         *workerNodeGroupConfiguration.Count,
+		"workerNodeGroupName":    fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
+		"workerNodeGroupTaints":  workerNodeGroupConfiguration.Taints,
+		"autoscalingConfig":      workerNodeGroupConfiguration.AutoScalingConfiguration,
+		"autoscalingAnnotations": clusterapi.AutoscalingAnnotations(workerNodeGroupConfiguration, nil),
 	}
 
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
@@ -432,7 +439,8 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupConfiguration
 			kubeVersion = *workerNodeGroupConfiguration.KubernetesVersion
 		}
 		kubeletExtraArgs := clusterapi.SecureTlsCipherSuitesExtraArgs().
-			Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf))
+			Append(clusterapi.ResolvConfExtraArgs(clusterSpec.Cluster.Spec.ClusterNetwork.DNS.ResolvConf)).
+			Append(clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork))
 
 		cgroupDriverArgs, err := kubeletCgroupDriverExtraArgs(kubeVersion)
 		if err != nil {
@@ -811,6 +819,10 @@ func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]i
 		values["registryCACert"] = registryMirror.CACertContent
 	}
 
+	if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+		return values, err
+	}
+
 	if registryMirror.Auth {
 		values["registryAuth"] = registryMirror.Auth
 		username, password, err := config.ReadCredentials()
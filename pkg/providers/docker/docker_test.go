@@ -270,6 +270,22 @@ func TestProviderGenerateDeploymentFileSuccessUpdateMachineTemplate(t *testing.T
 			wantCPFile: "testdata/valid_deployment_custom_cidrs_cp_expected.yaml",
 			wantMDFile: "testdata/valid_deployment_custom_cidrs_md_expected.yaml",
 		},
+		{
+			testName: "valid config with dual-stack ipv6 primary",
+			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
+				s.Cluster.Name = "test-cluster"
+				s.Cluster.Spec.KubernetesVersion = "1.19"
+				s.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:10:1::/56", "192.168.0.0/16"}
+				s.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:10:96::/112", "10.128.0.0/12"}
+				s.Cluster.Spec.ClusterNetwork.Nodes = &v1alpha1.Nodes{CIDRMaskSizeIPv6: ptr.Int(72)}
+				s.Cluster.Spec.ControlPlaneConfiguration.Count = 3
+				s.VersionsBundles["1.19"] = versionsBundle
+				s.Cluster.Spec.ExternalEtcdConfiguration = &v1alpha1.ExternalEtcdConfiguration{Count: 3}
+				s.Cluster.Spec.WorkerNodeGroupConfigurations = []v1alpha1.WorkerNodeGroupConfiguration{{Count: ptr.Int(3), MachineGroupRef: &v1alpha1.Ref{Name: "test-cluster"}, Name: "md-0"}}
+			}),
+			wantCPFile: "testdata/valid_deployment_dual_stack_cp_expected.yaml",
+			wantMDFile: "testdata/valid_deployment_dual_stack_md_expected.yaml",
+		},
 		{
 			testName: "with minimal oidc",
 			clusterSpec: test.NewClusterSpec(func(s *cluster.Spec) {
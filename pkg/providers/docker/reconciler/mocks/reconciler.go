@@ -53,6 +53,20 @@ func (mr *MockCNIReconcilerMockRecorder) Reconcile(ctx, logger, client, spec int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockCNIReconciler)(nil).Reconcile), ctx, logger, client, spec)
 }
 
+// ReportReady mocks base method.
+func (m *MockCNIReconciler) ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportReady", ctx, logger, client, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportReady indicates an expected call of ReportReady.
+func (mr *MockCNIReconcilerMockRecorder) ReportReady(ctx, logger, client, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportReady", reflect.TypeOf((*MockCNIReconciler)(nil).ReportReady), ctx, logger, client, spec)
+}
+
 // MockRemoteClientRegistry is a mock of RemoteClientRegistry interface.
 type MockRemoteClientRegistry struct {
 	ctrl     *gomock.Controller
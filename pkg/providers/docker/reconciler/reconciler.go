@@ -27,6 +27,7 @@ type Reconciler struct {
 // CNIReconciler is an interface for reconciling CNI in the Docker cluster reconciler.
 type CNIReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
+	ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) error
 }
 
 // RemoteClientRegistry is an interface that defines methods for remote clients.
@@ -58,6 +59,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, c *anywhere
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReportCNIReady,
 	).Run(ctx, log, clusterSpec)
 }
 
@@ -79,6 +81,18 @@ func (r *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, clusterS
 	return r.cniReconciler.Reconcile(ctx, log, client, clusterSpec)
 }
 
+// ReportCNIReady records in the cluster status whether the CNI is ready.
+// It runs after the workers have been reconciled so the check covers all the nodes.
+func (r *Reconciler) ReportCNIReady(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
+	log = log.WithValues("phase", "reportCNIReady")
+	client, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(clusterSpec.Cluster))
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, r.cniReconciler.ReportReady(ctx, log, client, clusterSpec)
+}
+
 // ReconcileWorkers applies the worker CAPI objects to the cluster.
 func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, spec *cluster.Spec) (controller.Result, error) {
 	log = log.WithValues("phase", "reconcileWorkers")
@@ -88,7 +102,7 @@ func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, spec
 		return controller.Result{}, errors.Wrap(err, "generating workers spec")
 	}
 
-	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, spec.Cluster, clusters.ToWorkers(w))
+	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, r.remoteClientRegistry, spec.Cluster, clusters.ToWorkers(w))
 }
 
 // ReconcileControlPlane applies the control plane CAPI objects to the cluster.
@@ -99,7 +113,7 @@ func (r *Reconciler) ReconcileControlPlane(ctx context.Context, log logr.Logger,
 	if err != nil {
 		return controller.Result{}, err
 	}
-	return clusters.ReconcileControlPlane(ctx, log, r.client, &clusters.ControlPlane{
+	return clusters.ReconcileControlPlaneForEKSA(ctx, log, r.client, spec.Cluster, &clusters.ControlPlane{
 		Cluster:                     cp.Cluster,
 		ProviderCluster:             cp.ProviderCluster,
 		KubeadmControlPlane:         cp.KubeadmControlPlane,
@@ -50,8 +50,9 @@ func TestReconcilerReconcileSuccess(t *testing.T) {
 	remoteClient := fake.NewClientBuilder().Build()
 	tt.remoteClientRegistry.EXPECT().GetClient(
 		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: constants.EksaSystemNamespace},
-	).Return(remoteClient, nil)
+	).Return(remoteClient, nil).Times(2)
 	tt.cniReconciler.EXPECT().Reconcile(tt.ctx, logger, remoteClient, tt.buildSpec())
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, gomock.Any())
 
 	tt.Expect(tt.reconciler().Reconcile(tt.ctx, logger, tt.cluster)).To(Equal(controller.Result{}))
 	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
@@ -148,6 +149,27 @@ func TestReconcileCNISuccess(t *testing.T) {
 	tt.Expect(result).To(Equal(controller.Result{}))
 }
 
+func TestReportCNIReadySuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.withFakeClient()
+
+	logger := test.NewNullLogger()
+	remoteClient := fake.NewClientBuilder().Build()
+	spec := tt.buildSpec()
+
+	tt.remoteClientRegistry.EXPECT().GetClient(
+		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: "eksa-system"},
+	).Return(remoteClient, nil)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, spec)
+
+	result, err := tt.reconciler().ReportCNIReady(tt.ctx, logger, spec)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
+	tt.Expect(tt.cluster.Status.FailureReason).To(BeZero())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
 func TestReconcileCNIErrorClientRegistry(t *testing.T) {
 	tt := newReconcilerTest(t)
 	tt.withFakeClient()
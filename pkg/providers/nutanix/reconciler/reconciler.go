@@ -25,6 +25,7 @@ import (
 // CNIReconciler is an interface for reconciling CNI in the Tinkerbell cluster reconciler.
 type CNIReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
+	ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) error
 }
 
 // RemoteClientRegistry is an interface that defines methods for remote clients.
@@ -153,6 +154,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, c *anywhere
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReportCNIReady,
 	).Run(ctx, log, clusterSpec)
 }
 
@@ -168,6 +170,18 @@ func (r *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, clusterS
 	return r.cniReconciler.Reconcile(ctx, log, c, clusterSpec)
 }
 
+// ReportCNIReady records in the cluster status whether the CNI is ready.
+// It runs after the workers have been reconciled so the check covers all the nodes.
+func (r *Reconciler) ReportCNIReady(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
+	log = log.WithValues("phase", "reportCNIReady")
+	client, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(clusterSpec.Cluster))
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, r.cniReconciler.ReportReady(ctx, log, client, clusterSpec)
+}
+
 // ValidateClusterSpec performs additional, context-aware validations on the cluster spec.
 func (r *Reconciler) ValidateClusterSpec(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
 	log = log.WithValues("phase", "validateClusterSpec")
@@ -199,7 +213,7 @@ func (r *Reconciler) ReconcileControlPlane(ctx context.Context, log logr.Logger,
 		return controller.Result{}, err
 	}
 
-	return clusters.ReconcileControlPlane(ctx, log, r.client, toClientControlPlane(cp))
+	return clusters.ReconcileControlPlaneForEKSA(ctx, log, r.client, clusterSpec.Cluster, toClientControlPlane(cp))
 }
 
 func toClientControlPlane(cp *nutanix.ControlPlane) *clusters.ControlPlane {
@@ -237,7 +251,7 @@ func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, spec
 		return controller.Result{}, err
 	}
 
-	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, spec.Cluster, clusters.ToWorkers(w))
+	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, r.remoteClientRegistry, spec.Cluster, clusters.ToWorkers(w))
 }
 
 // CheckControlPlaneReady checks whether the control plane for an eks-a cluster is ready or not.
@@ -9,6 +9,7 @@ import (
 
 	capxv1beta1 "github.com/nutanix-cloud-native/cluster-api-provider-nutanix/api/v1beta1"
 	"github.com/nutanix-cloud-native/prism-go-client/environment/credentials"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
@@ -89,6 +90,7 @@ func (ntb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, w
 		values["workloadTemplateName"] = workloadTemplateNames[workerNodeGroupConfiguration.Name]
 		values["workloadkubeadmconfigTemplateName"] = kubeadmconfigTemplateNames[workerNodeGroupConfiguration.Name]
 		values["autoscalingConfig"] = workerNodeGroupConfiguration.AutoScalingConfiguration
+		values["autoscalingAnnotations"] = clusterapi.AutoscalingAnnotations(workerNodeGroupConfiguration, nodeCapacity(ntb.workerNodeGroupMachineSpecs[workerNodeGroupConfiguration.MachineGroupRef.Name]))
 
 		if workerNodeGroupConfiguration.UpgradeRolloutStrategy != nil {
 			values["upgradeRolloutStrategy"] = true
@@ -249,6 +251,10 @@ func buildTemplateMapCP(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return values, err
+		}
+
 		if registryMirror.Auth {
 			values["registryAuth"] = registryMirror.Auth
 			username, password, err := config.ReadCredentials()
@@ -317,6 +323,12 @@ func buildTemplateMapCP(
 		}
 
 		values["encryptionProviderConfig"] = conf
+
+		kmsPluginFiles, err := common.GenerateKMSPluginFiles(clusterSpec.Cluster.Spec.EtcdEncryption, "/etc/kubernetes/kms-plugin")
+		if err != nil {
+			return nil, err
+		}
+		values["kmsPluginFiles"] = kmsPluginFiles
 	}
 
 	if clusterSpec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration != nil {
@@ -435,6 +447,10 @@ func buildTemplateMapMD(clusterSpec *cluster.Spec, workerNodeGroupMachineSpec v1
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return values, err
+		}
+
 		if registryMirror.Auth {
 			values["registryAuth"] = registryMirror.Auth
 			username, password, err := config.ReadCredentials()
@@ -658,3 +674,13 @@ func generateCcmIgnoredNodeIPsList(clusterSpec *cluster.Spec) []string {
 
 	return result
 }
+
+// nodeCapacity returns the resources of the nodes created from a machine config,
+// published to the cluster autoscaler so it can scale a worker node group from zero.
+func nodeCapacity(machineSpec v1alpha1.NutanixMachineConfigSpec) *clusterapi.NodeCapacity {
+	return &clusterapi.NodeCapacity{
+		CPU:           *resource.NewQuantity(int64(machineSpec.VCPUsPerSocket)*int64(machineSpec.VCPUSockets), resource.DecimalSI),
+		Memory:        machineSpec.MemorySize,
+		EphemeralDisk: machineSpec.SystemDiskSize,
+	}
+}
@@ -652,6 +652,10 @@ func TestTemplateBuilderEtcdEncryption(t *testing.T) {
 			Input:  "testdata/cluster_nutanix_etcd_encryption.yaml",
 			Output: "testdata/expected_results_etcd_encryption.yaml",
 		},
+		{
+			Input:  "testdata/cluster_nutanix_etcd_encryption_kms_plugin.yaml",
+			Output: "testdata/expected_results_etcd_encryption_kms_plugin.yaml",
+		},
 	} {
 		clusterSpec := test.NewFullClusterSpec(t, tc.Input)
 
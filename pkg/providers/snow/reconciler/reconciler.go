@@ -20,6 +20,7 @@ import (
 
 type CNIReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) (controller.Result, error)
+	ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *cluster.Spec) error
 }
 
 type RemoteClientRegistry interface {
@@ -65,6 +66,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, c *anywhere
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReportCNIReady,
 	).Run(ctx, log, clusterSpec)
 }
 
@@ -96,7 +98,7 @@ func (s *Reconciler) ReconcileControlPlane(ctx context.Context, log logr.Logger,
 		return controller.Result{}, err
 	}
 
-	return clusters.ReconcileControlPlane(ctx, log, s.client, toClientControlPlane(cp))
+	return clusters.ReconcileControlPlaneForEKSA(ctx, log, s.client, clusterSpec.Cluster, toClientControlPlane(cp))
 }
 
 func (r *Reconciler) CheckControlPlaneReady(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
@@ -115,6 +117,18 @@ func (s *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, clusterS
 	return s.cniReconciler.Reconcile(ctx, log, client, clusterSpec)
 }
 
+// ReportCNIReady records in the cluster status whether the CNI is ready.
+// It runs after the workers have been reconciled so the check covers all the nodes.
+func (s *Reconciler) ReportCNIReady(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
+	log = log.WithValues("phase", "reportCNIReady")
+	client, err := s.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(clusterSpec.Cluster))
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, s.cniReconciler.ReportReady(ctx, log, client, clusterSpec)
+}
+
 func (s *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, clusterSpec *cluster.Spec) (controller.Result, error) {
 	log = log.WithValues("phase", "reconcileWorkers")
 	log.Info("Applying worker CAPI objects")
@@ -124,7 +138,7 @@ func (s *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, clus
 		return controller.Result{}, err
 	}
 
-	return clusters.ReconcileWorkersForEKSA(ctx, log, s.client, clusterSpec.Cluster, toClientWorkers(w))
+	return clusters.ReconcileWorkersForEKSA(ctx, log, s.client, s.remoteClientRegistry, clusterSpec.Cluster, toClientWorkers(w))
 }
 
 func toClientControlPlane(cp *snow.ControlPlane) *clusters.ControlPlane {
@@ -55,8 +55,9 @@ func TestReconcilerReconcileSuccess(t *testing.T) {
 
 	tt.remoteClientRegistry.EXPECT().GetClient(
 		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: "eksa-system"},
-	).Return(remoteClient, nil)
+	).Return(remoteClient, nil).Times(2)
 	tt.cniReconciler.EXPECT().Reconcile(tt.ctx, logger, remoteClient, tt.buildSpec())
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, gomock.Any())
 
 	result, err := tt.reconciler().Reconcile(tt.ctx, logger, tt.cluster)
 
@@ -220,6 +221,27 @@ func TestReconcilerReconcileCNISuccess(t *testing.T) {
 	tt.Expect(result).To(Equal(controller.Result{}))
 }
 
+func TestReconcilerReportCNIReadySuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.withFakeClient()
+
+	logger := test.NewNullLogger()
+	remoteClient := fake.NewClientBuilder().Build()
+	spec := tt.buildSpec()
+
+	tt.remoteClientRegistry.EXPECT().GetClient(
+		tt.ctx, client.ObjectKey{Name: tt.cluster.Name, Namespace: "eksa-system"},
+	).Return(remoteClient, nil)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, spec)
+
+	result, err := tt.reconciler().ReportCNIReady(tt.ctx, logger, spec)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
+	tt.Expect(tt.cluster.Status.FailureReason).To(BeZero())
+	tt.Expect(result).To(Equal(controller.Result{}))
+}
+
 func TestReconcilerReconcileCNIErrorClientRegistry(t *testing.T) {
 	tt := newReconcilerTest(t)
 	tt.withFakeClient()
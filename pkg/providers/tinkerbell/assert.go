@@ -508,6 +508,14 @@ func ensureWorkerHardwareAvailability(spec *ClusterSpec, current ValidatableClus
 			if nodeGroup.UpgradeRolloutStrategy != nil && nodeGroup.UpgradeRolloutStrategy.Type == "RollingUpdate" {
 				maxSurge = nodeGroup.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
 			}
+			// Canary nodes are created before removing any node and the rest of the group is rolled
+			// before removing them, so there are never more extra nodes than canary nodes or maxSurge.
+			if nodeGroup.UpgradeRolloutStrategy != nil && nodeGroup.UpgradeRolloutStrategy.Type == v1alpha1.CanaryStrategyType {
+				maxSurge = nodeGroup.UpgradeRolloutStrategy.RollingUpdate.MaxSurge
+				if canaryNodes := nodeGroup.UpgradeRolloutStrategy.Canary.NodeCount(*nodeGroup.Count); canaryNodes > maxSurge {
+					maxSurge = canaryNodes
+				}
+			}
 			err := hwReq.Add(
 				spec.WorkerNodeGroupMachineConfig(nodeGroup).Spec.HardwareSelector,
 				maxSurge,
@@ -1104,6 +1104,18 @@ func TestAssertUpgradeRolloutStrategyValid_UpgradeStrategyNotEqual(t *testing.T)
 	g.Expect(tinkerbell.AssertUpgradeRolloutStrategyValid(clusterSpec)).ToNot(gomega.Succeed())
 }
 
+func TestAssertUpgradeRolloutStrategyValid_CanaryWorkersWithRollingUpdateControlPlane(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+	clusterSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &eksav1alpha1.WorkerNodesUpgradeRolloutStrategy{
+		Type:          "Canary",
+		RollingUpdate: &eksav1alpha1.WorkerNodesRollingUpdateParams{MaxSurge: 1},
+	}
+
+	g.Expect(tinkerbell.AssertUpgradeRolloutStrategyValid(clusterSpec)).To(gomega.Succeed())
+}
+
 func TestAssertAutoScalerDisabledForInPlace_Success(t *testing.T) {
 	g := gomega.NewWithT(t)
 	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
@@ -35,7 +35,12 @@ func (p ControlPlane) Objects() []kubernetes.Object {
 
 // ControlPlaneSpec builds a Tinkerbell ControlPlane definition based on an eks-a cluster spec.
 func ControlPlaneSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, clusterSpec *cluster.Spec) (*ControlPlane, error) {
-	templateBuilder, err := generateTemplateBuilder(clusterSpec)
+	layouts, err := networkLayoutsFromCluster(ctx, client, clusterSpec)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading hardware network layouts")
+	}
+
+	templateBuilder, err := generateTemplateBuilder(clusterSpec, WithNetworkLayouts(layouts))
 	if err != nil {
 		return nil, errors.Wrap(err, "generating tinkerbell template builder")
 	}
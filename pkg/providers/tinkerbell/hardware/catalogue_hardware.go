@@ -155,13 +155,14 @@ func hardwareFromMachine(m Machine) *tinkv1alpha1.Hardware {
 	return &tinkv1alpha1.Hardware{
 		TypeMeta: newHardwareTypeMeta(),
 		ObjectMeta: v1.ObjectMeta{
-			Name:      m.Hostname,
-			Namespace: constants.EksaSystemNamespace,
-			Labels:    m.Labels,
+			Name:        m.Hostname,
+			Namespace:   constants.EksaSystemNamespace,
+			Labels:      m.Labels,
+			Annotations: networkLayoutAnnotations(m),
 		},
 		Spec: tinkv1alpha1.HardwareSpec{
 			BMCRef: newBMCRefFromMachine(m),
-			Disks:  []tinkv1alpha1.Disk{{Device: m.Disk}},
+			Disks:  disksFromMachine(m),
 			Metadata: &tinkv1alpha1.HardwareMetadata{
 				Facility: &tinkv1alpha1.MetadataFacility{
 					FacilityCode: "onprem",
@@ -190,7 +191,7 @@ func hardwareFromMachine(m Machine) *tinkv1alpha1.Hardware {
 					AlwaysPxe:       true,
 				},
 			},
-			Interfaces: []tinkv1alpha1.Interface{
+			Interfaces: append([]tinkv1alpha1.Interface{
 				{
 					Netboot: &tinkv1alpha1.Netboot{
 						AllowPXE:      &allow,
@@ -215,11 +216,51 @@ func hardwareFromMachine(m Machine) *tinkv1alpha1.Hardware {
 						VLANID:      m.VLANID,
 					},
 				},
-			},
+			}, additionalInterfacesFromMachine(m)...),
 		},
 	}
 }
 
+func disksFromMachine(m Machine) []tinkv1alpha1.Disk {
+	disks := []tinkv1alpha1.Disk{{Device: m.Disk}}
+	for _, d := range m.AdditionalDisks {
+		disks = append(disks, tinkv1alpha1.Disk{Device: d})
+	}
+	return disks
+}
+
+// additionalInterfacesFromMachine returns the Hardware interfaces for m's additional NICs. They
+// aren't allowed to netboot so Tinkerbell only serves the primary interface.
+func additionalInterfacesFromMachine(m Machine) []tinkv1alpha1.Interface {
+	var interfaces []tinkv1alpha1.Interface
+	for _, nic := range m.AdditionalInterfaces {
+		disallow := false
+		iface := tinkv1alpha1.Interface{
+			Netboot: &tinkv1alpha1.Netboot{
+				AllowPXE:      &disallow,
+				AllowWorkflow: &disallow,
+			},
+			DHCP: &tinkv1alpha1.DHCP{
+				Arch:     "x86_64",
+				MAC:      nic.MACAddress,
+				Hostname: m.Hostname,
+				UEFI:     true,
+				VLANID:   nic.VLANID,
+			},
+		}
+		if nic.IPAddress != "" {
+			iface.DHCP.IP = &tinkv1alpha1.IP{
+				Address: nic.IPAddress,
+				Netmask: nic.Netmask,
+				Gateway: nic.Gateway,
+				Family:  4,
+			}
+		}
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces
+}
+
 // newBMCRefFromMachine returns a BMCRef pointer for Hardware.
 func newBMCRefFromMachine(m Machine) *corev1.TypedLocalObjectReference {
 	if m.HasBMC() {
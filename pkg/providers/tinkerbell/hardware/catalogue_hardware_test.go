@@ -280,3 +280,40 @@ func TestHardwareCatalogueWriter_Write(t *testing.T) {
 	g.Expect(hardware).To(gomega.HaveLen(1))
 	g.Expect(hardware[0].Name).To(gomega.Equal(machine.Hostname))
 }
+
+func TestHardwareCatalogueWriter_WriteAdditionalInterfacesAndDisks(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	catalogue := hardware.NewCatalogue()
+	writer := hardware.NewHardwareCatalogueWriter(catalogue)
+	machine := NewValidMachine()
+	machine.AdditionalDisks = []string{"/dev/sdb"}
+	machine.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:00:01"},
+		{MACAddress: "00:00:00:00:00:02", IPAddress: "10.10.20.10", Netmask: "255.255.255.0", VLANID: "20"},
+	}
+
+	g.Expect(writer.Write(machine)).To(gomega.Succeed())
+
+	hw := catalogue.AllHardware()
+	g.Expect(hw).To(gomega.HaveLen(1))
+	g.Expect(hw[0].Spec.Disks).To(gomega.Equal([]v1alpha1.Disk{{Device: "/dev/sda"}, {Device: "/dev/sdb"}}))
+
+	interfaces := hw[0].Spec.Interfaces
+	g.Expect(interfaces).To(gomega.HaveLen(3))
+	g.Expect(interfaces[0].DHCP.MAC).To(gomega.Equal(machine.MACAddress))
+	g.Expect(*interfaces[0].Netboot.AllowPXE).To(gomega.BeTrue())
+
+	g.Expect(interfaces[1].DHCP.MAC).To(gomega.Equal("00:00:00:00:00:01"))
+	g.Expect(interfaces[1].DHCP.IP).To(gomega.BeNil())
+	g.Expect(*interfaces[1].Netboot.AllowPXE).To(gomega.BeFalse())
+	g.Expect(*interfaces[1].Netboot.AllowWorkflow).To(gomega.BeFalse())
+
+	g.Expect(interfaces[2].DHCP.MAC).To(gomega.Equal("00:00:00:00:00:02"))
+	g.Expect(interfaces[2].DHCP.VLANID).To(gomega.Equal("20"))
+	g.Expect(interfaces[2].DHCP.IP).To(gomega.Equal(&v1alpha1.IP{
+		Address: "10.10.20.10",
+		Netmask: "255.255.255.0",
+		Family:  4,
+	}))
+}
@@ -98,11 +98,11 @@ func ensureRequiredColumnsInCSV(unmatched []string) error {
 	return nil
 }
 
-// BuildHardwareYAML builds a hardware yaml from the csv at the provided path.
+// BuildHardwareYAML builds a hardware yaml from the csv or structured inventory at the provided path.
 func BuildHardwareYAML(path string, opts *BMCOptions) ([]byte, error) {
-	reader, err := NewNormalizedCSVReaderFromFile(path, opts)
+	reader, err := NewNormalizedReaderFromFile(path, opts)
 	if err != nil {
-		return nil, fmt.Errorf("reading csv: %v", err)
+		return nil, fmt.Errorf("reading hardware file: %v", err)
 	}
 
 	var b bytes.Buffer
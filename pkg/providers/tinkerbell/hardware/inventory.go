@@ -0,0 +1,126 @@
+package hardware
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Inventory is a structured, JSON or YAML, hardware inventory. Unlike the CSV format it can
+// describe multiple NICs and disks and BMC options per machine.
+//
+//	machines:
+//	- hostname: worker-1
+//	  ipAddress: 10.10.10.11
+//	  netmask: 255.255.255.0
+//	  gateway: 10.10.10.1
+//	  nameservers: [8.8.8.8]
+//	  mac: 00:00:00:00:00:01
+//	  disk: /dev/sda
+//	  additionalDisks: [/dev/sdb]
+//	  additionalInterfaces:
+//	  - mac: 00:00:00:00:00:02
+//	  labels:
+//	    type: worker
+//	  bmcIP: 10.10.20.11
+//	  bmcOptions:
+//	    rpc:
+//	      consumerURL: https://bmc-proxy.example.com
+//	      hmac:
+//	        secrets: [secret]
+type Inventory struct {
+	Machines []Machine `json:"machines"`
+}
+
+// InventoryReader reads Machine instances from a structured inventory. It satisfies the
+// MachineReader interface.
+type InventoryReader struct {
+	machines []Machine
+	// BMCOptions used in a Machine that doesn't define its own.
+	BMCOptions *BMCOptions
+}
+
+// NewInventoryReader returns a new InventoryReader that consumes a JSON or YAML inventory from r.
+// The inventory can be an Inventory or a NetBox DCIM devices export, see NetBoxExport.
+func NewInventoryReader(r io.Reader, opts *BMCOptions) (*InventoryReader, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	machines, err := parseInventory(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InventoryReader{machines: machines, BMCOptions: opts}, nil
+}
+
+// Read returns the next Machine in the inventory. It returns io.EOF when there are no more machines.
+func (r *InventoryReader) Read() (Machine, error) {
+	if len(r.machines) == 0 {
+		return Machine{}, io.EOF
+	}
+
+	m := r.machines[0]
+	r.machines = r.machines[1:]
+	if m.BMCOptions == nil && r.BMCOptions != nil {
+		m.BMCOptions = r.BMCOptions
+	}
+
+	return m, nil
+}
+
+// inventoryDocument contains the top level fields of all the supported inventory formats,
+// used to detect the format of a document.
+type inventoryDocument struct {
+	Machines []Machine      `json:"machines"`
+	Results  []NetBoxDevice `json:"results"`
+	Devices  []NetBoxDevice `json:"devices"`
+}
+
+func parseInventory(content []byte) ([]Machine, error) {
+	doc := &inventoryDocument{}
+	if err := yaml.Unmarshal(content, doc); err != nil {
+		return nil, fmt.Errorf("parsing hardware inventory: %v", err)
+	}
+
+	switch {
+	case len(doc.Machines) > 0:
+		return doc.Machines, nil
+	case len(doc.Results) > 0:
+		return machinesFromNetBoxDevices(doc.Results)
+	case len(doc.Devices) > 0:
+		return machinesFromNetBoxDevices(doc.Devices)
+	default:
+		return nil, errors.New("parsing hardware inventory: no machines found, expected a machines, results or devices list")
+	}
+}
+
+// NewNormalizedReaderFromFile creates a MachineReader instance that reads the hardware file at path
+// and applies default normalizations to machines. Files with a .csv extension are read with a
+// CSVReader and any other file with an InventoryReader.
+func NewNormalizedReaderFromFile(path string, opts *BMCOptions) (MachineReader, error) {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return NewNormalizedCSVReaderFromFile(path, opts)
+	}
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+
+	reader, err := NewInventoryReader(bufio.NewReader(fh), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNormalizer(reader), nil
+}
@@ -0,0 +1,134 @@
+package hardware_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+func TestInventoryReaderFromFile(t *testing.T) {
+	g := gomega.NewWithT(t)
+	globalOpts := &hardware.BMCOptions{RPC: &hardware.RPCOpts{ConsumerURL: "https://global.example.com"}}
+
+	reader, err := hardware.NewNormalizedReaderFromFile("./testdata/hardware.yaml", globalOpts)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine).To(gomega.Equal(
+		hardware.Machine{
+			Labels:          map[string]string{"type": "cp"},
+			Nameservers:     []string{"1.1.1.1"},
+			Gateway:         "10.10.10.1",
+			Netmask:         "255.255.255.0",
+			IPAddress:       "10.10.10.10",
+			MACAddress:      "00:00:00:00:00:01",
+			Hostname:        "worker1",
+			Disk:            "/dev/sda",
+			BMCIPAddress:    "192.168.0.10",
+			BMCUsername:     "Admin",
+			BMCPassword:     "admin",
+			BMCOptions:      globalOpts,
+			AdditionalDisks: []string{"/dev/sdb"},
+			AdditionalInterfaces: []hardware.NetworkInterface{
+				{MACAddress: "00:00:00:00:00:aa", IPAddress: "10.10.20.10", Netmask: "255.255.255.0", VLANID: "20"},
+			},
+		},
+	))
+
+	machine, err = reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine.Hostname).To(gomega.Equal("worker2"))
+	g.Expect(machine.BMCOptions).To(gomega.Equal(&hardware.BMCOptions{
+		RPC: &hardware.RPCOpts{
+			ConsumerURL: "https://bmc-rpc.example.com",
+			HMAC:        hardware.HMACOpts{Secrets: []string{"secret"}},
+		},
+	}))
+
+	_, err = reader.Read()
+	g.Expect(err).To(gomega.MatchError(io.EOF))
+}
+
+func TestInventoryReaderJSON(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	reader, err := hardware.NewInventoryReader(strings.NewReader(
+		`{"machines": [{"hostname": "worker1", "mac": "00:00:00:00:00:01", "additionalDisks": ["/dev/sdb"]}]}`,
+	), nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine).To(gomega.Equal(hardware.Machine{
+		Hostname:        "worker1",
+		MACAddress:      "00:00:00:00:00:01",
+		AdditionalDisks: []string{"/dev/sdb"},
+	}))
+}
+
+func TestNewInventoryReaderErrors(t *testing.T) {
+	cases := map[string]struct {
+		reader io.Reader
+		err    string
+	}{
+		"read error": {
+			reader: iotest.ErrReader(errors.New("read err")),
+			err:    "read err",
+		},
+		"invalid document": {
+			reader: strings.NewReader("machines: {"),
+			err:    "parsing hardware inventory",
+		},
+		"no machines": {
+			reader: strings.NewReader("machines: []"),
+			err:    "no machines found",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := hardware.NewInventoryReader(tc.reader, nil)
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tc.err)))
+		})
+	}
+}
+
+func TestNewNormalizedReaderFromFileCSV(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	reader, err := hardware.NewNormalizedReaderFromFile("./testdata/hardware.csv", nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine.Hostname).To(gomega.Equal("worker1"))
+}
+
+func TestNewNormalizedReaderFromFileNotFound(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	_, err := hardware.NewNormalizedReaderFromFile("./testdata/missing.yaml", nil)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestBuildHardwareYAMLFromInventory(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	hardwareYaml, err := hardware.BuildHardwareYAML("./testdata/hardware.yaml", nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	content := string(hardwareYaml)
+	g.Expect(content).To(gomega.ContainSubstring("name: worker1"))
+	g.Expect(content).To(gomega.ContainSubstring("name: worker2"))
+	g.Expect(content).To(gomega.ContainSubstring("device: /dev/sdb"))
+	g.Expect(content).To(gomega.ContainSubstring("mac: 00:00:00:00:00:aa"))
+	g.Expect(content).To(gomega.ContainSubstring("consumerURL: https://bmc-rpc.example.com"))
+}
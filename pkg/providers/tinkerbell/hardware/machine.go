@@ -9,93 +9,147 @@ import (
 
 // Machine is a machine configuration with optional BMC interface configuration.
 type Machine struct {
-	Hostname    string      `csv:"hostname"`
-	IPAddress   string      `csv:"ip_address"`
-	Netmask     string      `csv:"netmask"`
-	Gateway     string      `csv:"gateway"`
-	Nameservers Nameservers `csv:"nameservers"`
-	MACAddress  string      `csv:"mac"`
+	Hostname    string      `csv:"hostname" json:"hostname"`
+	IPAddress   string      `csv:"ip_address" json:"ipAddress"`
+	Netmask     string      `csv:"netmask" json:"netmask"`
+	Gateway     string      `csv:"gateway" json:"gateway"`
+	Nameservers Nameservers `csv:"nameservers" json:"nameservers"`
+	MACAddress  string      `csv:"mac" json:"mac"`
 
 	// Disk used to populate the default workflow actions.
 	// Currently needs to be the same for all hardware residing in the same group where a group
 	// is either: control plane hardware, external etcd hard, or the definable worker node groups.
-	Disk string `csv:"disk"`
+	Disk string `csv:"disk" json:"disk"`
 
 	// Labels to be applied to the Hardware resource.
-	Labels Labels `csv:"labels"`
-
-	BMCIPAddress string `csv:"bmc_ip, omitempty"`
-	BMCUsername  string `csv:"bmc_username, omitempty"`
-	BMCPassword  string `csv:"bmc_password, omitempty"`
-	VLANID       string `csv:"vlan_id, omitempty"`
+	Labels Labels `csv:"labels" json:"labels,omitempty"`
+
+	BMCIPAddress string `csv:"bmc_ip, omitempty" json:"bmcIP,omitempty"`
+	BMCUsername  string `csv:"bmc_username, omitempty" json:"bmcUsername,omitempty"`
+	BMCPassword  string `csv:"bmc_password, omitempty" json:"bmcPassword,omitempty"`
+	VLANID       string `csv:"vlan_id, omitempty" json:"vlanID,omitempty"`
+
+	// BMCOptions are the options used for Rufio providers. The CSV format can't express them so
+	// CSV readers apply the same options to all machines. Structured inventories can set them
+	// per machine.
+	BMCOptions *BMCOptions `csv:"-" json:"bmcOptions,omitempty"`
+
+	// AdditionalInterfaces are the NICs of the machine other than the one described by the
+	// top level network fields, which is always used to netboot the machine.
+	AdditionalInterfaces []NetworkInterface `csv:"-" json:"additionalInterfaces,omitempty"`
+
+	// AdditionalDisks are the disks of the machine other than Disk. They are added to the
+	// Hardware but not used by the default workflow actions.
+	AdditionalDisks []string `csv:"-" json:"additionalDisks,omitempty"`
+
+	// InterfaceName is the name the OS gives to the NIC identified by MACAddress, for example eno1.
+	// It's required when the machine has bonds or named additional interfaces, see NetworkLayout.
+	InterfaceName string `csv:"-" json:"interfaceName,omitempty"`
+
+	// Bonds are the bonded interfaces of the machine. When a bond contains the NIC identified by
+	// MACAddress, it carries the machine network, including VLANID.
+	Bonds []Bond `csv:"-" json:"bonds,omitempty"`
+}
+
+// NetworkInterface is a NIC of a Machine that isn't used to netboot it.
+type NetworkInterface struct {
+	MACAddress string `json:"mac"`
+	// Name is the name the OS gives to the NIC, for example eno2. It's required for the
+	// interface to be configured by the default workflow actions.
+	Name string `json:"name,omitempty"`
+	// IPAddress, Netmask and Gateway are optional. When IPAddress is empty, the interface
+	// is added to the Hardware without IP configuration.
+	IPAddress string `json:"ipAddress,omitempty"`
+	Netmask   string `json:"netmask,omitempty"`
+	Gateway   string `json:"gateway,omitempty"`
+	VLANID    string `json:"vlanID,omitempty"`
+}
+
+// BondMode is the bonding mode of a Bond.
+type BondMode string
+
+const (
+	// BondModeLACP aggregates the bond interfaces with IEEE 802.3ad dynamic link aggregation.
+	// It requires LACP to be configured in the switch ports.
+	BondModeLACP BondMode = "802.3ad"
+	// BondModeActiveBackup uses one of the bond interfaces at a time and fails over to another
+	// one when it goes down.
+	BondModeActiveBackup BondMode = "active-backup"
+)
 
-	// BMCOptions are the options used for Rufio providers.
-	BMCOptions *BMCOptions `csv:"-"`
+// Bond is a bonded interface of a Machine.
+type Bond struct {
+	// Name is the name of the bond interface in the OS, for example bond0.
+	Name string   `json:"name"`
+	Mode BondMode `json:"mode"`
+	// Interfaces are the MAC addresses of the bond members. They must be the machine MACAddress
+	// or the MAC addresses of additional interfaces without IP configuration.
+	Interfaces []string `json:"interfaces"`
 }
 
 // BMCOptions are the options used to configure the Rufio providers.
 // Right now we only support the RPC provider.
 type BMCOptions struct {
 	// RPC are the options for the Rufio RPC provider.
-	RPC *RPCOpts `csv:"-"`
+	RPC *RPCOpts `csv:"-" json:"rpc,omitempty"`
 }
 
 // RPCOpts are the options used for the Rufio RPC provider.
 type RPCOpts struct {
 	// ConsumerURL is the URL where an rpc consumer/listener is running
 	// and to which we will send and receive all notifications.
-	ConsumerURL string `csv:"-"`
+	ConsumerURL string `csv:"-" json:"consumerURL,omitempty"`
 	// Request is the options used to create the rpc HTTP request.
-	Request RequestOpts `csv:"-"`
+	Request RequestOpts `csv:"-" json:"request,omitempty"`
 	// Signature is the options used for adding an HMAC signature to an HTTP request.
-	Signature SignatureOpts `csv:"-"`
+	Signature SignatureOpts `csv:"-" json:"signature,omitempty"`
 	// HMAC is the options used to create a HMAC signature.
-	HMAC HMACOpts `csv:"-"`
+	HMAC HMACOpts `csv:"-" json:"hmac,omitempty"`
 	// Experimental options.
-	Experimental ExperimentalOpts `csv:"-"`
+	Experimental ExperimentalOpts `csv:"-" json:"experimental,omitempty"`
 }
 
 // ExperimentalOpts are the experimental options used in the Rufio RPC provider.
 type ExperimentalOpts struct {
 	// CustomRequestPayload must be in json.
-	CustomRequestPayload string `csv:"-"`
+	CustomRequestPayload string `csv:"-" json:"customRequestPayload,omitempty"`
 	// DotPath is the path to the json object where the bmclib RequestPayload{} struct will be embedded. For example: object.data.body
-	DotPath string `csv:"-"`
+	DotPath string `csv:"-" json:"dotPath,omitempty"`
 }
 
 // SignatureOpts are the options used for adding an HMAC signature to an HTTP request.
 type SignatureOpts struct {
 	// HeaderName is the header name that should contain the signature(s). Example: X-BMCLIB-Signature
-	HeaderName string `csv:"-"`
+	HeaderName string `csv:"-" json:"headerName,omitempty"`
 	// AppendAlgoToHeaderDisabled decides whether to append the algorithm to the signature header or not.
 	// Example: X-BMCLIB-Signature becomes X-BMCLIB-Signature-256
 	// When set to true, a header will be added for each algorithm. Example: X-BMCLIB-Signature-256 and X-BMCLIB-Signature-512
-	AppendAlgoToHeaderDisabled bool `csv:"-"`
+	AppendAlgoToHeaderDisabled bool `csv:"-" json:"appendAlgoToHeaderDisabled,omitempty"`
 	// IncludedPayloadHeaders are headers whose values will be included in the signature payload. Example: X-BMCLIB-My-Custom-Header
 	// All headers will be deduplicated.
-	IncludedPayloadHeaders []string `csv:"-"`
+	IncludedPayloadHeaders []string `csv:"-" json:"includedPayloadHeaders,omitempty"`
 }
 
 // RequestOpts are the options used to create the rpc HTTP request.
 type RequestOpts struct {
 	// HTTPContentType is the content type to use for the rpc request notification.
-	HTTPContentType string `csv:"-"`
+	HTTPContentType string `csv:"-" json:"httpContentType,omitempty"`
 	// HTTPMethod is the HTTP method to use for the rpc request notification.
-	HTTPMethod string `csv:"-"`
+	HTTPMethod string `csv:"-" json:"httpMethod,omitempty"`
 	// StaticHeaders are predefined headers that will be added to every request.
-	StaticHeaders http.Header `csv:"-"`
+	StaticHeaders http.Header `csv:"-" json:"staticHeaders,omitempty"`
 	// TimestampFormat is the time format for the timestamp header.
-	TimestampFormat string `csv:"-"`
+	TimestampFormat string `csv:"-" json:"timestampFormat,omitempty"`
 	// TimestampHeader is the header name that should contain the timestamp. Example: X-BMCLIB-Timestamp
-	TimestampHeader string `csv:"-"`
+	TimestampHeader string `csv:"-" json:"timestampHeader,omitempty"`
 }
 
 // HMACOpts are the options used to create a HMAC signature.
 type HMACOpts struct {
 	// PrefixSigDisabled determines whether the algorithm will be prefixed to the signature. Example: sha256=abc123
-	PrefixSigDisabled bool `csv:"-"`
+	PrefixSigDisabled bool `csv:"-" json:"prefixSigDisabled,omitempty"`
 	// Secrets used for signing.
-	Secrets []string `csv:"-"`
+	Secrets []string `csv:"-" json:"secrets,omitempty"`
 }
 
 // HasBMC determines if m has a BMC configuration. A BMC configuration is present if any of the BMC fields
@@ -0,0 +1,171 @@
+package hardware
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// NetBoxDevice is a device from a NetBox DCIM export, the results list of the /api/dcim/devices
+// endpoint with the interfaces of each device embedded. Only the fields needed to build a Machine
+// are read.
+//
+// The primary interface, used to netboot the machine, is the interface holding the primary IPv4
+// address. The first management only interface with an IP address is used as the BMC. Other
+// interfaces with a MAC address become additional interfaces.
+type NetBoxDevice struct {
+	Name         string             `json:"name"`
+	PrimaryIP4   *NetBoxIPAddress   `json:"primary_ip4"`
+	Interfaces   []NetBoxInterface  `json:"interfaces"`
+	CustomFields NetBoxCustomFields `json:"custom_fields"`
+}
+
+// NetBoxIPAddress is an IP address in CIDR notation, for example 10.10.10.10/24.
+type NetBoxIPAddress struct {
+	Address string `json:"address"`
+}
+
+// NetBoxVLAN is a VLAN assigned to a NetBox interface.
+type NetBoxVLAN struct {
+	VID int `json:"vid"`
+}
+
+// NetBoxInterface is an interface of a NetBoxDevice.
+type NetBoxInterface struct {
+	Name         string            `json:"name"`
+	MACAddress   string            `json:"mac_address"`
+	MgmtOnly     bool              `json:"mgmt_only"`
+	IPAddresses  []NetBoxIPAddress `json:"ip_addresses"`
+	UntaggedVLAN *NetBoxVLAN       `json:"untagged_vlan"`
+}
+
+// NetBoxCustomFields are the custom fields of a NetBoxDevice that hold the machine configuration
+// NetBox doesn't model natively.
+type NetBoxCustomFields struct {
+	Gateway     string   `json:"gateway"`
+	Nameservers []string `json:"nameservers"`
+	// Disks are the disk devices of the machine. The first one is used by the default workflow actions.
+	Disks       []string          `json:"disks"`
+	Labels      map[string]string `json:"labels"`
+	BMCUsername string            `json:"bmc_username"`
+	BMCPassword string            `json:"bmc_password"`
+	BMCOptions  *BMCOptions       `json:"bmc_options"`
+}
+
+func machinesFromNetBoxDevices(devices []NetBoxDevice) ([]Machine, error) {
+	machines := make([]Machine, 0, len(devices))
+	for _, d := range devices {
+		m, err := machineFromNetBoxDevice(d)
+		if err != nil {
+			return nil, fmt.Errorf("netbox device %s: %v", d.Name, err)
+		}
+		machines = append(machines, m)
+	}
+
+	return machines, nil
+}
+
+func machineFromNetBoxDevice(d NetBoxDevice) (Machine, error) {
+	m := Machine{
+		Hostname:    d.Name,
+		Gateway:     d.CustomFields.Gateway,
+		Nameservers: d.CustomFields.Nameservers,
+		Labels:      Labels(d.CustomFields.Labels),
+		BMCUsername: d.CustomFields.BMCUsername,
+		BMCPassword: d.CustomFields.BMCPassword,
+		BMCOptions:  d.CustomFields.BMCOptions,
+	}
+	if m.Labels == nil {
+		m.Labels = Labels{}
+	}
+
+	if len(d.CustomFields.Disks) > 0 {
+		m.Disk = d.CustomFields.Disks[0]
+		m.AdditionalDisks = d.CustomFields.Disks[1:]
+	}
+
+	primary, err := netBoxPrimaryInterface(d)
+	if err != nil {
+		return Machine{}, err
+	}
+
+	for i, iface := range d.Interfaces {
+		switch {
+		case i == primary:
+			m.MACAddress = iface.MACAddress
+			m.VLANID = netBoxVLANID(iface)
+			if m.IPAddress, m.Netmask, err = parseNetBoxAddress(d.PrimaryIP4.Address); err != nil {
+				return Machine{}, fmt.Errorf("interface %s: %v", iface.Name, err)
+			}
+		case iface.MgmtOnly:
+			if m.BMCIPAddress != "" || len(iface.IPAddresses) == 0 {
+				continue
+			}
+			if m.BMCIPAddress, _, err = netBoxInterfaceIP(iface); err != nil {
+				return Machine{}, fmt.Errorf("interface %s: %v", iface.Name, err)
+			}
+		case iface.MACAddress != "":
+			additional := NetworkInterface{
+				MACAddress: iface.MACAddress,
+				VLANID:     netBoxVLANID(iface),
+			}
+			if additional.IPAddress, additional.Netmask, err = netBoxInterfaceIP(iface); err != nil {
+				return Machine{}, fmt.Errorf("interface %s: %v", iface.Name, err)
+			}
+			m.AdditionalInterfaces = append(m.AdditionalInterfaces, additional)
+		}
+	}
+
+	return m, nil
+}
+
+// netBoxPrimaryInterface returns the index of the interface holding the primary IPv4 address of d.
+func netBoxPrimaryInterface(d NetBoxDevice) (int, error) {
+	if d.PrimaryIP4 == nil || d.PrimaryIP4.Address == "" {
+		return 0, errors.New("primary_ip4 is empty")
+	}
+
+	for i, iface := range d.Interfaces {
+		for _, ip := range iface.IPAddresses {
+			if ip.Address == d.PrimaryIP4.Address {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no interface has the primary IP address %s", d.PrimaryIP4.Address)
+}
+
+// netBoxInterfaceIP returns the IP and netmask of the first IP address of iface. Both are empty if
+// iface has no addresses.
+func netBoxInterfaceIP(iface NetBoxInterface) (ip, netmask string, err error) {
+	if len(iface.IPAddresses) == 0 {
+		return "", "", nil
+	}
+
+	return parseNetBoxAddress(iface.IPAddresses[0].Address)
+}
+
+// parseNetBoxAddress returns the IP and netmask of address. NetBox addresses are usually in CIDR
+// notation, the netmask is empty otherwise.
+func parseNetBoxAddress(address string) (ip, netmask string, err error) {
+	if !strings.Contains(address, "/") {
+		return address, "", nil
+	}
+
+	parsed, ipNet, err := net.ParseCIDR(address)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parsed.String(), net.IP(ipNet.Mask).String(), nil
+}
+
+func netBoxVLANID(iface NetBoxInterface) string {
+	if iface.UntaggedVLAN == nil || iface.UntaggedVLAN.VID == 0 {
+		return ""
+	}
+	return strconv.Itoa(iface.UntaggedVLAN.VID)
+}
@@ -0,0 +1,98 @@
+package hardware_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+func TestNetBoxExportFromFile(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	reader, err := hardware.NewNormalizedReaderFromFile("./testdata/netbox.json", nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine).To(gomega.Equal(
+		hardware.Machine{
+			Hostname:        "worker1",
+			IPAddress:       "10.10.10.10",
+			Netmask:         "255.255.255.0",
+			Gateway:         "10.10.10.1",
+			Nameservers:     []string{"1.1.1.1", "8.8.8.8"},
+			MACAddress:      "00:00:00:00:00:01",
+			VLANID:          "10",
+			Disk:            "/dev/nvme0n1",
+			AdditionalDisks: []string{"/dev/nvme1n1"},
+			Labels:          map[string]string{"type": "cp"},
+			BMCIPAddress:    "192.168.0.10",
+			BMCUsername:     "Admin",
+			BMCPassword:     "admin",
+			AdditionalInterfaces: []hardware.NetworkInterface{
+				{MACAddress: "00:00:00:00:00:02"},
+			},
+		},
+	))
+
+	_, err = reader.Read()
+	g.Expect(err).To(gomega.MatchError(io.EOF))
+
+	g.Expect(hardware.NewDefaultMachineValidator().Validate(machine)).To(gomega.Succeed())
+}
+
+func TestNetBoxExportDevicesList(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	reader, err := hardware.NewInventoryReader(strings.NewReader(`
+devices:
+- name: worker1
+  primary_ip4:
+    address: 10.10.10.10/22
+  interfaces:
+  - name: eno1
+    mac_address: 00:00:00:00:00:01
+    ip_addresses:
+    - address: 10.10.10.10/22
+`), nil)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+
+	machine, err := reader.Read()
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(machine.IPAddress).To(gomega.Equal("10.10.10.10"))
+	g.Expect(machine.Netmask).To(gomega.Equal("255.255.252.0"))
+	g.Expect(machine.Labels).To(gomega.BeEmpty())
+	g.Expect(machine.HasBMC()).To(gomega.BeFalse())
+}
+
+func TestNetBoxExportErrors(t *testing.T) {
+	cases := map[string]struct {
+		export string
+		err    string
+	}{
+		"no primary ip": {
+			export: `results: [{name: worker1}]`,
+			err:    "netbox device worker1: primary_ip4 is empty",
+		},
+		"primary ip not in any interface": {
+			export: `results: [{name: worker1, primary_ip4: {address: 10.10.10.10/24}, interfaces: [{name: eno1, mac_address: "00:00:00:00:00:01"}]}]`,
+			err:    "netbox device worker1: no interface has the primary IP address 10.10.10.10/24",
+		},
+		"invalid address": {
+			export: `results: [{name: worker1, primary_ip4: {address: 10.10.10.300/24}, interfaces: [{name: eno1, ip_addresses: [{address: 10.10.10.300/24}]}]}]`,
+			err:    "netbox device worker1: interface eno1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			_, err := hardware.NewInventoryReader(strings.NewReader(tc.export), nil)
+			g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(tc.err)))
+		})
+	}
+}
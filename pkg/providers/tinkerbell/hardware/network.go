@@ -0,0 +1,296 @@
+package hardware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+)
+
+// NetworkLayoutAnnotation is the Hardware annotation holding the JSON NetworkLayout of the machine.
+const NetworkLayoutAnnotation = "anywhere.eks.amazonaws.com/network-layout"
+
+// NetworkLayout is the OS network configuration of a machine, independent of its addresses, so
+// machines selected by the same machine config can share it in a workflow template. The static
+// addresses, gateways and nameservers are read from the Hardware of each machine when the workflow
+// template is rendered for it. A bond holding the primary NIC takes the primary NIC MAC address.
+type NetworkLayout struct {
+	Interfaces []LayoutInterface `json:"interfaces"`
+	Bonds      []LayoutBond      `json:"bonds,omitempty"`
+}
+
+// LayoutInterface is a NIC in a NetworkLayout.
+type LayoutInterface struct {
+	Name string `json:"name"`
+	// Primary is true for the NIC used to netboot the machine.
+	Primary bool `json:"primary,omitempty"`
+	// Addressed is true if the NIC gets the address of its Hardware interface.
+	Addressed bool `json:"addressed,omitempty"`
+	// HardwareInterface is the index of the NIC in the Hardware interfaces.
+	HardwareInterface int `json:"hardwareInterface,omitempty"`
+	// VLANID is the VLAN, configured on top of the NIC, the address is configured in.
+	VLANID string `json:"vlanID,omitempty"`
+	// Bond is the name of the bond the NIC is a member of.
+	Bond string `json:"bond,omitempty"`
+}
+
+// LayoutBond is a bond in a NetworkLayout.
+type LayoutBond struct {
+	Name string   `json:"name"`
+	Mode BondMode `json:"mode"`
+	// Primary is true if the bond contains the NIC used to netboot the machine. Only the primary
+	// bond gets an address.
+	Primary bool `json:"primary,omitempty"`
+	// VLANID is the VLAN, configured on top of the bond, the address is configured in.
+	VLANID string `json:"vlanID,omitempty"`
+}
+
+// HasNetworkLayout returns true if m describes more network configuration than its primary NIC,
+// meaning it has bonds or named additional interfaces.
+func (m Machine) HasNetworkLayout() bool {
+	if len(m.Bonds) > 0 {
+		return true
+	}
+
+	for _, nic := range m.AdditionalInterfaces {
+		if nic.Name != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NetworkLayoutFromMachine returns the NetworkLayout of m. It returns nil if m has no network
+// layout, in which case the default workflow actions configure a static address in its primary NIC.
+func NetworkLayoutFromMachine(m Machine) *NetworkLayout {
+	if !m.HasNetworkLayout() {
+		return nil
+	}
+
+	bondByMAC := map[string]string{}
+	for _, b := range m.Bonds {
+		for _, mac := range b.Interfaces {
+			bondByMAC[strings.ToLower(mac)] = b.Name
+		}
+	}
+
+	primaryBond := bondByMAC[strings.ToLower(m.MACAddress)]
+	layout := &NetworkLayout{}
+	layout.Interfaces = append(layout.Interfaces, LayoutInterface{
+		Name:      m.InterfaceName,
+		Primary:   true,
+		Addressed: primaryBond == "",
+		VLANID:    vlanIfNotBonded(m.VLANID, primaryBond),
+		Bond:      primaryBond,
+	})
+
+	for i, nic := range m.AdditionalInterfaces {
+		if nic.Name == "" {
+			continue
+		}
+		bond := bondByMAC[strings.ToLower(nic.MACAddress)]
+		addressed := bond == "" && nic.IPAddress != ""
+		iface := LayoutInterface{
+			Name:      nic.Name,
+			Addressed: addressed,
+			VLANID:    vlanIfNotBonded(nic.VLANID, bond),
+			Bond:      bond,
+		}
+		if addressed {
+			// The primary NIC is the first Hardware interface, followed by the additional ones.
+			iface.HardwareInterface = i + 1
+		}
+		layout.Interfaces = append(layout.Interfaces, iface)
+	}
+
+	for _, b := range m.Bonds {
+		bond := LayoutBond{
+			Name:    b.Name,
+			Mode:    b.Mode,
+			Primary: b.Name == primaryBond,
+		}
+		if bond.Primary {
+			bond.VLANID = m.VLANID
+		}
+		layout.Bonds = append(layout.Bonds, bond)
+	}
+
+	return layout
+}
+
+func vlanIfNotBonded(vlanID, bond string) string {
+	if bond != "" {
+		return ""
+	}
+	return vlanID
+}
+
+// NetworkLayoutFromHardware returns the NetworkLayout stored in hw annotations. It returns nil
+// if hw has no network layout.
+func NetworkLayoutFromHardware(hw *tinkv1alpha1.Hardware) (*NetworkLayout, error) {
+	raw, ok := hw.Annotations[NetworkLayoutAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	layout := &NetworkLayout{}
+	if err := json.Unmarshal([]byte(raw), layout); err != nil {
+		return nil, fmt.Errorf("parsing network layout of hardware %s: %v", hw.Name, err)
+	}
+
+	return layout, nil
+}
+
+func networkLayoutAnnotations(m Machine) map[string]string {
+	layout := NetworkLayoutFromMachine(m)
+	if layout == nil {
+		return nil
+	}
+
+	// NetworkLayout only contains strings and bools so it can always be marshalled.
+	raw, _ := json.Marshal(layout)
+	return map[string]string{NetworkLayoutAnnotation: string(raw)}
+}
+
+// Netplan returns a netplan configuration for l. It's meant to be rendered in a Tinkerbell workflow
+// template: the addresses, gateways and nameservers are template expressions that resolve to the
+// interfaces of the Hardware being provisioned, so every machine sharing the template gets its own
+// addresses at provisioning time.
+func (l NetworkLayout) Netplan() string {
+	var b strings.Builder
+	b.WriteString(netmaskPrefixTemplate)
+	b.WriteString("network:\n  version: 2\n  renderer: networkd\n")
+
+	var vlans []netplanVLAN
+
+	b.WriteString("  ethernets:\n")
+	for _, nic := range l.Interfaces {
+		fmt.Fprintf(&b, "    %s:\n", nic.Name)
+		b.WriteString("      dhcp4: false\n")
+		if !nic.Addressed {
+			continue
+		}
+
+		addresses := netplanAddresses{hardwareInterface: nic.HardwareInterface, primary: nic.Primary}
+		if nic.VLANID != "" {
+			vlans = append(vlans, netplanVLAN{link: nic.Name, id: nic.VLANID, addresses: addresses})
+			continue
+		}
+		addresses.write(&b)
+	}
+
+	if len(l.Bonds) > 0 {
+		b.WriteString("  bonds:\n")
+		for _, bond := range l.Bonds {
+			fmt.Fprintf(&b, "    %s:\n", bond.Name)
+			fmt.Fprintf(&b, "      interfaces: [%s]\n", strings.Join(l.bondMembers(bond.Name), ", "))
+			if bond.Primary {
+				fmt.Fprintf(&b, "      macaddress: %s\n", hardwareInterfaceField(0, "MAC"))
+			}
+			b.WriteString("      dhcp4: false\n")
+			addresses := netplanAddresses{primary: true}
+			if bond.Primary && bond.VLANID == "" {
+				addresses.write(&b)
+			}
+			b.WriteString("      parameters:\n")
+			fmt.Fprintf(&b, "        mode: %s\n", bond.Mode)
+			b.WriteString("        mii-monitor-interval: 100\n")
+			if bond.Mode == BondModeLACP {
+				b.WriteString("        lacp-rate: fast\n")
+				b.WriteString("        transmit-hash-policy: layer3+4\n")
+			}
+			if bond.Primary && bond.VLANID != "" {
+				vlans = append(vlans, netplanVLAN{link: bond.Name, id: bond.VLANID, addresses: addresses})
+			}
+		}
+	}
+
+	if len(vlans) > 0 {
+		b.WriteString("  vlans:\n")
+		for _, v := range vlans {
+			fmt.Fprintf(&b, "    %s.%s:\n", v.link, v.id)
+			fmt.Fprintf(&b, "      id: %s\n", v.id)
+			fmt.Fprintf(&b, "      link: %s\n", v.link)
+			b.WriteString("      dhcp4: false\n")
+			v.addresses.write(&b)
+		}
+	}
+
+	return b.String()
+}
+
+// netmaskPrefixTemplate defines the netmaskPrefix template, which renders the prefix length of
+// a dotted netmask, since netplan only takes addresses in CIDR notation and workflow templates
+// have no function to convert it.
+var netmaskPrefixTemplate = func() string {
+	var b strings.Builder
+	b.WriteString(`{{- define "netmaskPrefix" }}`)
+	for prefix := 32; prefix >= 0; prefix-- {
+		if prefix < 32 {
+			b.WriteString("{{ else ")
+		} else {
+			b.WriteString("{{ ")
+		}
+		fmt.Fprintf(&b, "if eq . %q }}%d", net.IP(net.CIDRMask(prefix, 32)).String(), prefix)
+	}
+	b.WriteString("{{ end }}{{ end -}}\n")
+	return b.String()
+}()
+
+// hardwareInterfaceField returns a workflow template expression for field of the DHCP configuration
+// of the Hardware interface at index.
+func hardwareInterfaceField(index int, field string) string {
+	return fmt.Sprintf("{{ (index .Hardware.Interfaces %d).DHCP.%s }}", index, field)
+}
+
+// netplanAddresses is the static IP configuration of a netplan link, read from a Hardware interface.
+type netplanAddresses struct {
+	// hardwareInterface is the index of the Hardware interface holding the configuration.
+	hardwareInterface int
+	// primary is true for the link carrying the machine network. Its gateway is the preferred
+	// default route and it gets the nameservers of the primary interface.
+	primary bool
+}
+
+// secondaryRouteMetric is the metric of the default routes through the gateways of additional
+// interfaces, so the one of the primary interface is preferred.
+const secondaryRouteMetric = 200
+
+func (a netplanAddresses) write(b *strings.Builder) {
+	dhcp := fmt.Sprintf("(index .Hardware.Interfaces %d).DHCP", a.hardwareInterface)
+
+	fmt.Fprintf(b, "      addresses: [%s/{{ template \"netmaskPrefix\" %s.IP.Netmask }}]\n", hardwareInterfaceField(a.hardwareInterface, "IP.Address"), dhcp)
+	fmt.Fprintf(b, "{{ with %s.IP.Gateway }}", dhcp)
+	b.WriteString("      routes:\n")
+	b.WriteString("      - to: default\n")
+	b.WriteString("        via: {{ . }}\n")
+	if !a.primary {
+		fmt.Fprintf(b, "        metric: %d\n", secondaryRouteMetric)
+	}
+	b.WriteString("{{ end }}")
+	if a.primary {
+		fmt.Fprintf(b, "{{ with %s.NameServers }}", dhcp)
+		b.WriteString("      nameservers:\n")
+		b.WriteString("        addresses: [{{ range $i, $ns := . }}{{ if $i }}, {{ end }}{{ $ns }}{{ end }}]\n")
+		b.WriteString("{{ end }}")
+	}
+}
+
+type netplanVLAN struct {
+	link      string
+	id        string
+	addresses netplanAddresses
+}
+
+func (l NetworkLayout) bondMembers(bond string) []string {
+	var members []string
+	for _, nic := range l.Interfaces {
+		if nic.Bond == bond {
+			members = append(members, nic.Name)
+		}
+	}
+	return members
+}
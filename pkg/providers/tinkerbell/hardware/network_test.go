@@ -0,0 +1,257 @@
+package hardware_test
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/onsi/gomega"
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+func newBondedMachine() hardware.Machine {
+	m := NewValidMachine()
+	m.InterfaceName = "ens1f0"
+	m.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:00:01", Name: "ens1f1"},
+		{MACAddress: "00:00:00:00:00:02", Name: "ens2f0", IPAddress: "10.10.20.10", Netmask: "255.255.255.0", VLANID: "30"},
+	}
+	m.Bonds = []hardware.Bond{
+		{Name: "bond0", Mode: hardware.BondModeLACP, Interfaces: []string{m.MACAddress, "00:00:00:00:00:01"}},
+	}
+	return m
+}
+
+func TestNetworkLayoutFromMachineNoLayout(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m := NewValidMachine()
+	m.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "00:00:00:00:00:01"}}
+
+	g.Expect(m.HasNetworkLayout()).To(gomega.BeFalse())
+	g.Expect(hardware.NetworkLayoutFromMachine(m)).To(gomega.BeNil())
+}
+
+func TestNetworkLayoutFromMachineBonded(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(hardware.NetworkLayoutFromMachine(newBondedMachine())).To(gomega.Equal(&hardware.NetworkLayout{
+		Interfaces: []hardware.LayoutInterface{
+			{Name: "ens1f0", Primary: true, Bond: "bond0"},
+			{Name: "ens1f1", Bond: "bond0"},
+			{Name: "ens2f0", Addressed: true, HardwareInterface: 2, VLANID: "30"},
+		},
+		Bonds: []hardware.LayoutBond{
+			{Name: "bond0", Mode: hardware.BondModeLACP, Primary: true, VLANID: "200"},
+		},
+	}))
+}
+
+func TestNetworkLayoutFromMachineNamedInterfacesWithoutBonds(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m := NewValidMachine()
+	m.VLANID = ""
+	m.InterfaceName = "eno1"
+	m.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:00:01", Name: "eno2", IPAddress: "10.10.20.10"},
+		{MACAddress: "00:00:00:00:00:02", Name: "eno3"},
+	}
+
+	g.Expect(hardware.NetworkLayoutFromMachine(m)).To(gomega.Equal(&hardware.NetworkLayout{
+		Interfaces: []hardware.LayoutInterface{
+			{Name: "eno1", Primary: true, Addressed: true},
+			{Name: "eno2", Addressed: true, HardwareInterface: 1},
+			{Name: "eno3"},
+		},
+	}))
+}
+
+// renderNetplan renders the netplan of the layout of machines[0] for each of machines, as the
+// Tinkerbell workflow controller renders a workflow template for their Hardware.
+func renderNetplan(t *testing.T, machines ...hardware.Machine) []string {
+	t.Helper()
+	catalogue := hardware.NewCatalogue()
+	writer := hardware.NewHardwareCatalogueWriter(catalogue)
+	for _, m := range machines {
+		if err := writer.Write(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tpl, err := template.New("netplan").Option("missingkey=error").Parse(hardware.NetworkLayoutFromMachine(machines[0]).Netplan())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rendered []string
+	for _, hw := range catalogue.AllHardware() {
+		data := map[string]interface{}{
+			"device_1": hw.Spec.Interfaces[0].DHCP.MAC,
+			"Hardware": struct{ Interfaces []tinkv1alpha1.Interface }{hw.Spec.Interfaces},
+		}
+		var b strings.Builder
+		if err := tpl.Execute(&b, data); err != nil {
+			t.Fatal(err)
+		}
+		rendered = append(rendered, b.String())
+	}
+	return rendered
+}
+
+func TestNetworkLayoutNetplan(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m := newBondedMachine()
+	m.Bonds = append(m.Bonds, hardware.Bond{Name: "bond1", Mode: hardware.BondModeActiveBackup, Interfaces: []string{"00:00:00:00:00:03"}})
+	m.AdditionalInterfaces = append(m.AdditionalInterfaces, hardware.NetworkInterface{MACAddress: "00:00:00:00:00:03", Name: "ens3f0"})
+
+	g.Expect(renderNetplan(t, m)).To(gomega.Equal([]string{`network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    ens1f0:
+      dhcp4: false
+    ens1f1:
+      dhcp4: false
+    ens2f0:
+      dhcp4: false
+    ens3f0:
+      dhcp4: false
+  bonds:
+    bond0:
+      interfaces: [ens1f0, ens1f1]
+      macaddress: 00:00:00:00:00:00
+      dhcp4: false
+      parameters:
+        mode: 802.3ad
+        mii-monitor-interval: 100
+        lacp-rate: fast
+        transmit-hash-policy: layer3+4
+    bond1:
+      interfaces: [ens3f0]
+      dhcp4: false
+      parameters:
+        mode: active-backup
+        mii-monitor-interval: 100
+  vlans:
+    ens2f0.30:
+      id: 30
+      link: ens2f0
+      dhcp4: false
+      addresses: [10.10.20.10/24]
+    bond0.200:
+      id: 200
+      link: bond0
+      dhcp4: false
+      addresses: [10.10.10.10/32]
+      routes:
+      - to: default
+        via: 10.10.10.1
+      nameservers:
+        addresses: [ns1]
+`}))
+}
+
+func TestNetworkLayoutNetplanMultipleHardware(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m1 := NewValidMachine()
+	m1.VLANID = ""
+	m1.Netmask = "255.255.255.0"
+	m1.Nameservers = []string{"ns1", "ns2"}
+	m1.InterfaceName = "eno1"
+	m1.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:01:00", Name: "eno2", IPAddress: "10.10.20.10", Netmask: "255.255.255.0", Gateway: "10.10.20.1"},
+	}
+	m2 := m1
+	m2.Hostname = "localhost2"
+	m2.MACAddress = "00:00:00:00:00:01"
+	m2.IPAddress = "10.10.10.11"
+	m2.BMCIPAddress = "10.10.10.12"
+	m2.Gateway = ""
+	m2.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:01:01", Name: "eno2", IPAddress: "10.10.20.11", Netmask: "255.255.0.0", Gateway: "10.10.20.1"},
+	}
+
+	g.Expect(renderNetplan(t, m1, m2)).To(gomega.ConsistOf(`network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    eno1:
+      dhcp4: false
+      addresses: [10.10.10.10/24]
+      routes:
+      - to: default
+        via: 10.10.10.1
+      nameservers:
+        addresses: [ns1, ns2]
+    eno2:
+      dhcp4: false
+      addresses: [10.10.20.10/24]
+      routes:
+      - to: default
+        via: 10.10.20.1
+        metric: 200
+`, `network:
+  version: 2
+  renderer: networkd
+  ethernets:
+    eno1:
+      dhcp4: false
+      addresses: [10.10.10.11/24]
+      nameservers:
+        addresses: [ns1, ns2]
+    eno2:
+      dhcp4: false
+      addresses: [10.10.20.11/16]
+      routes:
+      - to: default
+        via: 10.10.20.1
+        metric: 200
+`))
+}
+
+func TestNetworkLayoutNetplanHasNoAddresses(t *testing.T) {
+	g := gomega.NewWithT(t)
+	m := newBondedMachine()
+
+	netplan := hardware.NetworkLayoutFromMachine(m).Netplan()
+	g.Expect(netplan).ToNot(gomega.ContainSubstring(m.IPAddress))
+	g.Expect(netplan).ToNot(gomega.ContainSubstring(m.MACAddress))
+}
+
+func TestNetworkLayoutFromHardware(t *testing.T) {
+	g := gomega.NewWithT(t)
+	catalogue := hardware.NewCatalogue()
+	m := newBondedMachine()
+
+	g.Expect(hardware.NewHardwareCatalogueWriter(catalogue).Write(m)).To(gomega.Succeed())
+
+	hw := catalogue.AllHardware()[0]
+	g.Expect(hw.Annotations).To(gomega.HaveKey(hardware.NetworkLayoutAnnotation))
+
+	layout, err := hardware.NetworkLayoutFromHardware(hw)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(layout).To(gomega.Equal(hardware.NetworkLayoutFromMachine(m)))
+}
+
+func TestNetworkLayoutFromHardwareWithoutAnnotation(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	layout, err := hardware.NetworkLayoutFromHardware(&tinkv1alpha1.Hardware{})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(layout).To(gomega.BeNil())
+}
+
+func TestNetworkLayoutFromHardwareInvalidAnnotation(t *testing.T) {
+	g := gomega.NewWithT(t)
+	hw := &tinkv1alpha1.Hardware{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "hw1",
+			Annotations: map[string]string{hardware.NetworkLayoutAnnotation: "{"},
+		},
+	}
+
+	_, err := hardware.NetworkLayoutFromHardware(hw)
+	g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("parsing network layout of hardware hw1")))
+}
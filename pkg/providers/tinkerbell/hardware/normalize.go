@@ -46,9 +46,30 @@ func (n *Normalizer) Register(fn NormalizerFunc) {
 	n.normalizers = append(n.normalizers, fn)
 }
 
-// LowercaseMACAddress ensures m's MACAddress field has lower chase characters.
+// LowercaseMACAddress ensures m's MACAddress field, and the MAC addresses of its additional
+// interfaces and bonds, have lower chase characters.
 func LowercaseMACAddress(m Machine) Machine {
 	m.MACAddress = strings.ToLower(m.MACAddress)
+	if len(m.AdditionalInterfaces) > 0 {
+		interfaces := make([]NetworkInterface, 0, len(m.AdditionalInterfaces))
+		for _, nic := range m.AdditionalInterfaces {
+			nic.MACAddress = strings.ToLower(nic.MACAddress)
+			interfaces = append(interfaces, nic)
+		}
+		m.AdditionalInterfaces = interfaces
+	}
+	if len(m.Bonds) > 0 {
+		bonds := make([]Bond, 0, len(m.Bonds))
+		for _, bond := range m.Bonds {
+			macs := make([]string, 0, len(bond.Interfaces))
+			for _, mac := range bond.Interfaces {
+				macs = append(macs, strings.ToLower(mac))
+			}
+			bond.Interfaces = macs
+			bonds = append(bonds, bond)
+		}
+		m.Bonds = bonds
+	}
 	return m
 }
 
@@ -32,6 +32,26 @@ func TestNormalizer(t *testing.T) {
 	g.Expect(machine).To(gomega.Equal(expect))
 }
 
+func TestNormalizerAdditionalInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctrl := gomock.NewController(t)
+	reader := mocks.NewMockMachineReader(ctrl)
+
+	normalizer := hardware.NewNormalizer(reader)
+
+	machine := NewValidMachine()
+	machine.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "AA:BB:CC:DD:EE:01"}}
+	machine.Bonds = []hardware.Bond{{Name: "bond0", Interfaces: []string{"AA:BB:CC:DD:EE:01"}}}
+	reader.EXPECT().Read().Return(machine, (error)(nil))
+
+	got, err := normalizer.Read()
+
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(got.AdditionalInterfaces).To(gomega.Equal([]hardware.NetworkInterface{{MACAddress: "aa:bb:cc:dd:ee:01"}}))
+	g.Expect(got.Bonds).To(gomega.Equal([]hardware.Bond{{Name: "bond0", Interfaces: []string{"aa:bb:cc:dd:ee:01"}}}))
+	g.Expect(machine.AdditionalInterfaces[0].MACAddress).To(gomega.Equal("AA:BB:CC:DD:EE:01"))
+}
+
 func TestRawNormalizer(t *testing.T) {
 	g := gomega.NewWithT(t)
 	ctrl := gomock.NewController(t)
@@ -108,6 +108,26 @@ func StaticMachineAssertions() MachineAssertion {
 			)
 		}
 
+		for _, disk := range m.AdditionalDisks {
+			if !linuxPathValidation.MatchString(disk) {
+				return fmt.Errorf(
+					"additional disk %v must be a valid linux path (\"%v\")",
+					disk,
+					linuxPathRegex,
+				)
+			}
+		}
+
+		for _, nic := range m.AdditionalInterfaces {
+			if err := validateNetworkInterface(nic); err != nil {
+				return err
+			}
+		}
+
+		if err := validateNetworkLayout(m); err != nil {
+			return err
+		}
+
 		for key, value := range m.Labels {
 			if err := validateLabelKey(key); err != nil {
 				return err
@@ -139,23 +159,170 @@ func StaticMachineAssertions() MachineAssertion {
 		}
 
 		if m.VLANID != "" {
-			i, err := strconv.Atoi(m.VLANID)
-			if err != nil {
-				return errors.New("VLANID: must be a string integer")
+			if err := validateVLANID(m.VLANID); err != nil {
+				return fmt.Errorf("VLANID: %v", err)
 			}
+		}
 
-			// valid VLAN IDs are between 1 and 4094 - https://en.m.wikipedia.org/wiki/VLAN#IEEE_802.1Q
-			const (
-				maxVLANID = 4094
-				minVLANID = 1
-			)
-			if i < minVLANID || i > maxVLANID {
-				return errors.New("VLANID: must be between 1 and 4094")
+		return nil
+	}
+}
+
+func validateVLANID(vlanID string) error {
+	i, err := strconv.Atoi(vlanID)
+	if err != nil {
+		return errors.New("must be a string integer")
+	}
+
+	// valid VLAN IDs are between 1 and 4094 - https://en.m.wikipedia.org/wiki/VLAN#IEEE_802.1Q
+	const (
+		maxVLANID = 4094
+		minVLANID = 1
+	)
+	if i < minVLANID || i > maxVLANID {
+		return errors.New("must be between 1 and 4094")
+	}
+
+	return nil
+}
+
+var (
+	interfaceNameRegex      = `^[a-zA-Z0-9_.-]{1,15}$`
+	interfaceNameValidation = regexp.MustCompile(interfaceNameRegex)
+)
+
+// validateNetworkLayout validates the interface names and bonds of m.
+func validateNetworkLayout(m Machine) error {
+	if !m.HasNetworkLayout() && m.InterfaceName == "" {
+		return nil
+	}
+
+	if m.InterfaceName == "" {
+		return newMachineError("InterfaceName is required when the machine has bonds or named additional interfaces")
+	}
+
+	names := map[string]struct{}{}
+	addName := func(name string) error {
+		if !interfaceNameValidation.MatchString(name) {
+			return fmt.Errorf("interface name %v must match \"%v\"", name, interfaceNameRegex)
+		}
+		if _, seen := names[name]; seen {
+			return fmt.Errorf("duplicate interface name: %v", name)
+		}
+		names[name] = struct{}{}
+		return nil
+	}
+
+	if err := addName(m.InterfaceName); err != nil {
+		return err
+	}
+
+	// The netplan written for the layout only renders IPv4 netmasks as prefix lengths.
+	if err := validateNetmask(m.Netmask); err != nil {
+		return fmt.Errorf("Netmask: %v", err)
+	}
+	for _, nic := range m.AdditionalInterfaces {
+		if nic.Name == "" || nic.IPAddress == "" {
+			continue
+		}
+		if err := validateNetmask(nic.Netmask); err != nil {
+			return fmt.Errorf("AdditionalInterfaces Netmask (mac=\"%v\"): %v", nic.MACAddress, err)
+		}
+	}
+
+	nicsByMAC := map[string]*NetworkInterface{strings.ToLower(m.MACAddress): nil}
+	for i, nic := range m.AdditionalInterfaces {
+		nicsByMAC[strings.ToLower(nic.MACAddress)] = &m.AdditionalInterfaces[i]
+		if nic.Name == "" {
+			continue
+		}
+		if err := addName(nic.Name); err != nil {
+			return err
+		}
+	}
+
+	bonded := map[string]struct{}{}
+	for _, bond := range m.Bonds {
+		if err := addName(bond.Name); err != nil {
+			return fmt.Errorf("bond: %v", err)
+		}
+
+		if bond.Mode != BondModeLACP && bond.Mode != BondModeActiveBackup {
+			return fmt.Errorf("bond %v: mode must be one of [%v, %v]", bond.Name, BondModeLACP, BondModeActiveBackup)
+		}
+
+		if len(bond.Interfaces) == 0 {
+			return fmt.Errorf("bond %v: interfaces is empty", bond.Name)
+		}
+
+		for _, mac := range bond.Interfaces {
+			mac = strings.ToLower(mac)
+			nic, ok := nicsByMAC[mac]
+			if !ok {
+				return fmt.Errorf("bond %v: interface %v is not a machine interface", bond.Name, mac)
+			}
+
+			if _, seen := bonded[mac]; seen {
+				return fmt.Errorf("bond %v: interface %v is already in a bond", bond.Name, mac)
+			}
+			bonded[mac] = struct{}{}
+
+			if nic == nil {
+				continue
+			}
+
+			if nic.Name == "" {
+				return fmt.Errorf("bond %v: interface %v must have a name", bond.Name, mac)
+			}
+
+			if nic.IPAddress != "" || nic.VLANID != "" {
+				return fmt.Errorf("bond %v: interface %v can't have IP or VLAN configuration", bond.Name, mac)
 			}
 		}
+	}
 
-		return nil
+	return nil
+}
+
+func validateNetmask(netmask string) error {
+	mask := net.ParseIP(netmask).To4()
+	if mask == nil {
+		return fmt.Errorf("%q is not an IPv4 netmask", netmask)
 	}
+	if ones, bits := net.IPMask(mask).Size(); ones == 0 && bits == 0 {
+		return fmt.Errorf("%q is not a contiguous netmask", netmask)
+	}
+	return nil
+}
+
+func validateNetworkInterface(nic NetworkInterface) error {
+	if nic.MACAddress == "" {
+		return newEmptyFieldError("AdditionalInterfaces MACAddress")
+	}
+
+	if _, err := net.ParseMAC(nic.MACAddress); err != nil {
+		return fmt.Errorf("AdditionalInterfaces MACAddress: %v", err)
+	}
+
+	if nic.IPAddress != "" {
+		if err := networkutils.ValidateIP(nic.IPAddress); err != nil {
+			return fmt.Errorf("AdditionalInterfaces IPAddress (mac=\"%v\"): %v", nic.MACAddress, err)
+		}
+	}
+
+	if nic.Gateway != "" {
+		if err := networkutils.ValidateIP(nic.Gateway); err != nil {
+			return fmt.Errorf("AdditionalInterfaces Gateway (mac=\"%v\"): %v", nic.MACAddress, err)
+		}
+	}
+
+	if nic.VLANID != "" {
+		if err := validateVLANID(nic.VLANID); err != nil {
+			return fmt.Errorf("AdditionalInterfaces VLANID (mac=\"%v\"): %v", nic.MACAddress, err)
+		}
+	}
+
+	return nil
 }
 
 // UniqueIPAddress asserts a given Machine instance has a unique IPAddress field relative to previously seen Machine
@@ -173,16 +340,23 @@ func UniqueIPAddress() MachineAssertion {
 	}
 }
 
-// UniqueMACAddress asserts a given Machine instance has a unique MACAddress field relative to previously seen Machine
-// instances. It is not thread safe. It has a 1 time use.
+// UniqueMACAddress asserts a given Machine instance has a unique MACAddress field, and additional interface MAC
+// addresses, relative to previously seen Machine instances. It is not thread safe. It has a 1 time use.
 func UniqueMACAddress() MachineAssertion {
 	macs := make(map[string]struct{})
 	return func(m Machine) error {
-		if _, seen := macs[m.MACAddress]; seen {
-			return fmt.Errorf("duplicate MACAddress: %v", m.MACAddress)
+		machineMACs := []string{m.MACAddress}
+		for _, nic := range m.AdditionalInterfaces {
+			machineMACs = append(machineMACs, nic.MACAddress)
 		}
 
-		macs[m.MACAddress] = struct{}{}
+		for _, mac := range machineMACs {
+			if _, seen := macs[mac]; seen {
+				return fmt.Errorf("duplicate MACAddress: %v", mac)
+			}
+
+			macs[mac] = struct{}{}
+		}
 
 		return nil
 	}
@@ -146,6 +146,41 @@ func TestStaticMachineAssertions_ValidMachine(t *testing.T) {
 	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
 }
 
+func TestStaticMachineAssertions_ValidMachineWithAdditionalInterfacesAndDisks(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	machine := NewValidMachine()
+	machine.AdditionalDisks = []string{"/dev/sdb", "/dev/nvme0n1"}
+	machine.AdditionalInterfaces = []hardware.NetworkInterface{
+		{MACAddress: "00:00:00:00:00:01"},
+		{MACAddress: "00:00:00:00:00:02", IPAddress: "10.10.20.10", Netmask: "255.255.255.0", Gateway: "10.10.20.1", VLANID: "20"},
+	}
+
+	validate := hardware.StaticMachineAssertions()
+	g.Expect(validate(machine)).ToNot(gomega.HaveOccurred())
+}
+
+func TestStaticMachineAssertions_ValidBondedMachine(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	validate := hardware.StaticMachineAssertions()
+	g.Expect(validate(newBondedMachine())).ToNot(gomega.HaveOccurred())
+}
+
+func TestUniqueMACAddressAdditionalInterfaces(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	first := NewValidMachine()
+	first.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "00:00:00:00:00:01"}}
+
+	second := NewValidMachine()
+	second.MACAddress = "00:00:00:00:00:01"
+
+	assertion := hardware.UniqueMACAddress()
+	g.Expect(assertion(first)).ToNot(gomega.HaveOccurred())
+	g.Expect(assertion(second)).To(gomega.MatchError("duplicate MACAddress: 00:00:00:00:00:01"))
+}
+
 func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -216,6 +251,72 @@ func TestStaticMachineAssertions_InvalidMachines(t *testing.T) {
 		"NonIntVLAN": func(h *hardware.Machine) {
 			h.VLANID = "im not an int"
 		},
+		"InvalidAdditionalDisk": func(h *hardware.Machine) {
+			h.AdditionalDisks = []string{"/dev/"}
+		},
+		"EmptyAdditionalInterfaceMACAddress": func(h *hardware.Machine) {
+			h.AdditionalInterfaces = []hardware.NetworkInterface{{IPAddress: "10.10.20.10"}}
+		},
+		"InvalidAdditionalInterfaceMACAddress": func(h *hardware.Machine) {
+			h.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "invalid mac"}}
+		},
+		"InvalidAdditionalInterfaceIPAddress": func(h *hardware.Machine) {
+			h.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "00:00:00:00:00:01", IPAddress: "invalid"}}
+		},
+		"InvalidAdditionalInterfaceGateway": func(h *hardware.Machine) {
+			h.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "00:00:00:00:00:01", Gateway: "invalid"}}
+		},
+		"InvalidAdditionalInterfaceVLAN": func(h *hardware.Machine) {
+			h.AdditionalInterfaces = []hardware.NetworkInterface{{MACAddress: "00:00:00:00:00:01", VLANID: "4095"}}
+		},
+		"BondsWithoutInterfaceName": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.InterfaceName = ""
+		},
+		"InvalidInterfaceName": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.InterfaceName = "a-very-long-interface-name"
+		},
+		"DuplicateInterfaceName": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.AdditionalInterfaces[1].Name = "ens1f0"
+		},
+		"BondNameMatchesInterface": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Bonds[0].Name = "ens2f0"
+		},
+		"InvalidBondMode": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Bonds[0].Mode = "balance-rr"
+		},
+		"EmptyBondInterfaces": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Bonds[0].Interfaces = nil
+		},
+		"UnknownBondInterface": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Bonds[0].Interfaces = append(h.Bonds[0].Interfaces, "00:00:00:00:00:99")
+		},
+		"InterfaceInTwoBonds": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Bonds = append(h.Bonds, hardware.Bond{Name: "bond1", Mode: hardware.BondModeActiveBackup, Interfaces: []string{"00:00:00:00:00:01"}})
+		},
+		"UnnamedBondInterface": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.AdditionalInterfaces[0].Name = ""
+		},
+		"BondedMachineInvalidNetmask": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.Netmask = "255.0.255.0"
+		},
+		"AddressedInterfaceWithoutNetmask": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.AdditionalInterfaces[1].Netmask = ""
+		},
+		"BondInterfaceWithIP": func(h *hardware.Machine) {
+			*h = newBondedMachine()
+			h.AdditionalInterfaces[0].IPAddress = "10.10.30.10"
+		},
 	}
 
 	validate := hardware.StaticMachineAssertions()
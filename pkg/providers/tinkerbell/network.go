@@ -0,0 +1,163 @@
+package tinkerbell
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/clients/kubernetes"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+// netplanActionName is the name of the default action that writes the OS network configuration.
+const netplanActionName = "write netplan config"
+
+// networkLayouts returns the network layout of the hardware selected by each TinkerbellMachineConfig
+// in spec, by machine config name. Machine configs selecting hardware without a network layout are
+// omitted. All the hardware selected by a machine config must have the same network layout because
+// they share the workflow template.
+func networkLayouts(spec *cluster.Spec, allHardware []*tinkv1alpha1.Hardware) (map[string]*hardware.NetworkLayout, error) {
+	layouts := map[string]*hardware.NetworkLayout{}
+	for name, machineConfig := range spec.TinkerbellMachineConfigs {
+		selector := machineConfig.Spec.HardwareSelector
+		if len(selector) == 0 {
+			continue
+		}
+
+		var selected []*tinkv1alpha1.Hardware
+		for _, hw := range allHardware {
+			if hardware.LabelsMatchSelector(selector, hw.Labels) {
+				selected = append(selected, hw)
+			}
+		}
+
+		layout, err := machineConfigNetworkLayout(spec, machineConfig, selected)
+		if err != nil {
+			return nil, err
+		}
+		if layout != nil {
+			layouts[name] = layout
+		}
+	}
+
+	return layouts, nil
+}
+
+// machineConfigNetworkLayout returns the network layout of the hardware selected by machineConfig,
+// or nil if they have none.
+func machineConfigNetworkLayout(spec *cluster.Spec, machineConfig *v1alpha1.TinkerbellMachineConfig, selected []*tinkv1alpha1.Hardware) (*hardware.NetworkLayout, error) {
+	var layout *hardware.NetworkLayout
+	for i, hw := range selected {
+		hwLayout, err := hardware.NetworkLayoutFromHardware(hw)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			layout = hwLayout
+		} else if !reflect.DeepEqual(layout, hwLayout) {
+			return nil, fmt.Errorf(
+				"hardware %s and %s selected by TinkerbellMachineConfig %s have different network layouts, all hardware selected by a machine config must have the same interface names, bonds and VLANs",
+				selected[0].Name, hw.Name, machineConfig.Name,
+			)
+		}
+	}
+
+	if layout == nil {
+		return nil, nil
+	}
+
+	// The layout is only written by the default template config actions, which are used when
+	// the referenced template config isn't in spec.
+	if _, ok := spec.TinkerbellTemplateConfigs[machineConfig.Spec.TemplateRef.Name]; ok {
+		return nil, fmt.Errorf(
+			"TinkerbellMachineConfig %s references TinkerbellTemplateConfig %s and selects hardware with bonds or named additional interfaces, which are only configured by the default template: configure the network in the template and remove them from the hardware",
+			machineConfig.Name, machineConfig.Spec.TemplateRef.Name,
+		)
+	}
+
+	return layout, nil
+}
+
+// networkLayoutsFromCluster returns the network layouts, by machine config name, of the hardware
+// in the cluster client talks to. It only reads the hardware selected by each machine config.
+func networkLayoutsFromCluster(ctx context.Context, client kubernetes.Client, spec *cluster.Spec) (map[string]*hardware.NetworkLayout, error) {
+	layouts := map[string]*hardware.NetworkLayout{}
+	for name, machineConfig := range spec.TinkerbellMachineConfigs {
+		selector := machineConfig.Spec.HardwareSelector
+		if len(selector) == 0 {
+			continue
+		}
+
+		hardwareList := &tinkv1alpha1.HardwareList{}
+		if err := client.List(ctx, hardwareList, kubernetes.ListOptions{
+			Namespace: constants.EksaSystemNamespace,
+			HasLabels: selector,
+		}); err != nil {
+			return nil, fmt.Errorf("listing hardware: %v", err)
+		}
+
+		var selected []*tinkv1alpha1.Hardware
+		for i := range hardwareList.Items {
+			// Not every client filters by labels, so the selector is checked again.
+			if hw := &hardwareList.Items[i]; hardware.LabelsMatchSelector(selector, hw.Labels) {
+				selected = append(selected, hw)
+			}
+		}
+
+		layout, err := machineConfigNetworkLayout(spec, machineConfig, selected)
+		if err != nil {
+			return nil, err
+		}
+		if layout != nil {
+			layouts[name] = layout
+		}
+	}
+
+	return layouts, nil
+}
+
+// applyNetworkLayout replaces the static network configuration written by the default template
+// config actions with the configuration for layout. The addresses of each machine are resolved
+// from its Hardware when the workflow template is rendered for it.
+func applyNetworkLayout(templateConfig *v1alpha1.TinkerbellTemplateConfig, layout *hardware.NetworkLayout, osFamily v1alpha1.OSFamily) error {
+	if osFamily == v1alpha1.Bottlerocket {
+		return fmt.Errorf("hardware with bonds or named additional interfaces is not supported for %s", osFamily)
+	}
+
+	for _, task := range templateConfig.Spec.Template.Tasks {
+		for _, action := range task.Actions {
+			if action.Name != netplanActionName {
+				continue
+			}
+
+			delete(action.Environment, "STATIC_NETPLAN")
+			action.Environment["CONTENTS"] = layout.Netplan()
+		}
+	}
+
+	return nil
+}
+
+// setNetworkLayouts sets the network layouts of the hardware in p's catalogue in p's template builder.
+func (p *Provider) setNetworkLayouts(spec *cluster.Spec) error {
+	layouts, err := networkLayouts(spec, p.catalogue.AllHardware())
+	if err != nil {
+		return err
+	}
+
+	p.templateBuilder.networkLayouts = layouts
+	return nil
+}
+
+func machineGroupRefName(ref *v1alpha1.Ref) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Name
+}
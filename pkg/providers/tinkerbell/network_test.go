@@ -0,0 +1,131 @@
+package tinkerbell
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/constants"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
+)
+
+const testNetworkLayout = `{"interfaces":[{"name":"ens1f0","primary":true,"bond":"bond0"},{"name":"ens1f1","bond":"bond0"}],"bonds":[{"name":"bond0","mode":"802.3ad","primary":true,"vlanID":"200"}]}`
+
+func hardwareWithLayout(name, machineType, layout string) *tinkv1alpha1.Hardware {
+	hw := &tinkv1alpha1.Hardware{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: constants.EksaSystemNamespace,
+			Labels:    map[string]string{"type": machineType},
+		},
+		Spec: tinkv1alpha1.HardwareSpec{
+			Interfaces: []tinkv1alpha1.Interface{{
+				DHCP: &tinkv1alpha1.DHCP{
+					MAC:         "00:00:00:00:00:00",
+					NameServers: []string{"1.1.1.1"},
+					IP: &tinkv1alpha1.IP{
+						Address: "10.10.10.10",
+						Netmask: "255.255.255.0",
+						Gateway: "10.10.10.1",
+					},
+				},
+			}},
+		},
+	}
+	if layout != "" {
+		hw.Annotations = map[string]string{hardware.NetworkLayoutAnnotation: layout}
+	}
+	return hw
+}
+
+func TestNetworkLayouts(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, testClusterConfigFilename)
+	spec.TinkerbellTemplateConfigs = nil
+
+	layouts, err := networkLayouts(spec, []*tinkv1alpha1.Hardware{
+		hardwareWithLayout("cp-1", "cp", testNetworkLayout),
+		hardwareWithLayout("cp-2", "cp", testNetworkLayout),
+		hardwareWithLayout("worker-1", "worker", ""),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(layouts).To(HaveLen(1))
+	g.Expect(layouts).To(HaveKey("test-cp"))
+	g.Expect(layouts["test-cp"].Bonds[0].Name).To(Equal("bond0"))
+}
+
+func TestNetworkLayoutsTemplateConfig(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, testClusterConfigFilename)
+
+	_, err := networkLayouts(spec, []*tinkv1alpha1.Hardware{
+		hardwareWithLayout("cp-1", "cp", testNetworkLayout),
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("TinkerbellMachineConfig test-cp references TinkerbellTemplateConfig tink-test")))
+}
+
+func TestNetworkLayoutsDifferentLayouts(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, testClusterConfigFilename)
+	spec.TinkerbellTemplateConfigs = nil
+
+	_, err := networkLayouts(spec, []*tinkv1alpha1.Hardware{
+		hardwareWithLayout("worker-1", "worker", testNetworkLayout),
+		hardwareWithLayout("worker-2", "worker", ""),
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("hardware worker-1 and worker-2 selected by TinkerbellMachineConfig test-md have different network layouts")))
+}
+
+func TestApplyNetworkLayout(t *testing.T) {
+	g := NewWithT(t)
+	layout := &hardware.NetworkLayout{
+		Interfaces: []hardware.LayoutInterface{{Name: "eno1", Primary: true, Addressed: true}},
+	}
+	templateConfig := v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(
+		&v1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}}, "https://os-image", "192.168.0.10", "192.168.0.11", v1alpha1.Ubuntu,
+	)
+
+	g.Expect(applyNetworkLayout(templateConfig, layout, v1alpha1.Ubuntu)).To(Succeed())
+
+	var found bool
+	for _, action := range templateConfig.Spec.Template.Tasks[0].Actions {
+		if action.Name != netplanActionName {
+			continue
+		}
+		found = true
+		g.Expect(action.Environment).ToNot(HaveKey("STATIC_NETPLAN"))
+		g.Expect(action.Environment["CONTENTS"]).To(Equal(layout.Netplan()))
+	}
+	g.Expect(found).To(BeTrue())
+}
+
+func TestApplyNetworkLayoutBottlerocket(t *testing.T) {
+	g := NewWithT(t)
+
+	err := applyNetworkLayout(&v1alpha1.TinkerbellTemplateConfig{}, &hardware.NetworkLayout{}, v1alpha1.Bottlerocket)
+	g.Expect(err).To(MatchError(ContainSubstring("not supported for bottlerocket")))
+}
+
+func TestControlPlaneSpecNetworkLayout(t *testing.T) {
+	g := NewWithT(t)
+	logger := test.NewNullLogger()
+	ctx := context.Background()
+	client := test.NewFakeKubeClient(
+		hardwareWithLayout("cp-1", "cp", testNetworkLayout),
+		hardwareWithLayout("worker-1", "worker", ""),
+	)
+	spec := test.NewFullClusterSpec(t, testClusterConfigFilename)
+	spec.TinkerbellTemplateConfigs = nil
+
+	cp, err := ControlPlaneSpec(ctx, logger, client, spec)
+	g.Expect(err).NotTo(HaveOccurred())
+	templateOverride := cp.ControlPlaneMachineTemplate.Spec.Template.Spec.TemplateOverride
+	g.Expect(templateOverride).To(ContainSubstring("(index .Hardware.Interfaces 0).DHCP.MAC"))
+	g.Expect(templateOverride).ToNot(ContainSubstring("10.10.10.10"))
+	g.Expect(templateOverride).ToNot(ContainSubstring("STATIC_NETPLAN"))
+}
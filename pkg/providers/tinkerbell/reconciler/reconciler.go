@@ -40,6 +40,7 @@ type Operation string
 // CNIReconciler is an interface for reconciling CNI in the Tinkerbell cluster reconciler.
 type CNIReconciler interface {
 	Reconcile(ctx context.Context, logger logr.Logger, client client.Client, spec *c.Spec) (controller.Result, error)
+	ReportReady(ctx context.Context, logger logr.Logger, client client.Client, spec *c.Spec) error
 }
 
 // RemoteClientRegistry is an interface that defines methods for remote clients.
@@ -107,6 +108,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, log logr.Logger, cluster *an
 		r.CheckControlPlaneReady,
 		r.ReconcileCNI,
 		r.ReconcileWorkers,
+		r.ReportCNIReady,
 	).Run(ctx, log, NewScope(clusterSpec))
 }
 
@@ -196,7 +198,7 @@ func (r *Reconciler) ReconcileControlPlane(ctx context.Context, log logr.Logger,
 	log = log.WithValues("phase", "reconcileControlPlane")
 	log.Info("Applying control plane CAPI objects")
 
-	return clusters.ReconcileControlPlane(ctx, log, r.client, toClientControlPlane(tinkerbellScope.ControlPlane))
+	return clusters.ReconcileControlPlaneForEKSA(ctx, log, r.client, tinkerbellScope.ClusterSpec.Cluster, toClientControlPlane(tinkerbellScope.ControlPlane))
 }
 
 // CheckControlPlaneReady checks whether the control plane for an eks-a cluster is ready or not.
@@ -213,7 +215,7 @@ func (r *Reconciler) ReconcileWorkers(ctx context.Context, log logr.Logger, tink
 	log = log.WithValues("phase", "reconcileWorkers")
 	log.Info("Applying worker CAPI objects")
 
-	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, spec.Cluster, clusters.ToWorkers(tinkerbellScope.Workers))
+	return clusters.ReconcileWorkersForEKSA(ctx, log, r.client, r.remoteClientRegistry, spec.Cluster, clusters.ToWorkers(tinkerbellScope.Workers))
 }
 
 // ValidateDatacenterConfig updates the cluster status if the TinkerbellDatacenter status indicates that the spec is invalid.
@@ -243,6 +245,20 @@ func (r *Reconciler) ReconcileCNI(ctx context.Context, log logr.Logger, tinkerbe
 	return r.cniReconciler.Reconcile(ctx, log, client, clusterSpec)
 }
 
+// ReportCNIReady records in the cluster status whether the CNI is ready.
+// It runs after the workers have been reconciled so the check covers all the nodes.
+func (r *Reconciler) ReportCNIReady(ctx context.Context, log logr.Logger, tinkerbellScope *Scope) (controller.Result, error) {
+	clusterSpec := tinkerbellScope.ClusterSpec
+	log = log.WithValues("phase", "reportCNIReady")
+
+	client, err := r.remoteClientRegistry.GetClient(ctx, controller.CapiClusterObjectKey(clusterSpec.Cluster))
+	if err != nil {
+		return controller.Result{}, err
+	}
+
+	return controller.Result{}, r.cniReconciler.ReportReady(ctx, log, client, clusterSpec)
+}
+
 func (r *Reconciler) validateTinkerbellIPMatch(ctx context.Context, clusterSpec *c.Spec) error {
 	if clusterSpec.Cluster.IsManaged() {
 
@@ -74,12 +74,13 @@ func TestReconcilerReconcileSuccess(t *testing.T) {
 
 	tt.remoteClientRegistry.EXPECT().GetClient(
 		tt.ctx, client.ObjectKey{Name: workloadClusterName, Namespace: constants.EksaSystemNamespace},
-	).Return(remoteClient, nil)
+	).Return(remoteClient, nil).Times(2)
 	spec := tt.buildSpec()
 	for _, mc := range spec.TinkerbellMachineConfigs {
 		mc.Spec.OSImageURL = "http://tinkerbell-example:8080/bottlerocket-2004-kube-v1.22.5.gz"
 	}
 	tt.cniReconciler.EXPECT().Reconcile(tt.ctx, logger, remoteClient, spec)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, gomock.Any())
 
 	result, err := tt.reconciler().Reconcile(tt.ctx, logger, tt.cluster)
 
@@ -169,6 +170,29 @@ func TestReconcileCNISuccess(t *testing.T) {
 	tt.cleanup()
 }
 
+func TestReportCNIReadySuccess(t *testing.T) {
+	tt := newReconcilerTest(t)
+	tt.withFakeClient()
+
+	logger := test.NewNullLogger()
+	remoteClient := fake.NewClientBuilder().Build()
+	scope := tt.buildScope()
+
+	tt.remoteClientRegistry.EXPECT().GetClient(
+		tt.ctx, client.ObjectKey{Name: workloadClusterName, Namespace: constants.EksaSystemNamespace},
+	).Return(remoteClient, nil)
+	tt.cniReconciler.EXPECT().ReportReady(tt.ctx, logger, remoteClient, scope.ClusterSpec)
+
+	result, err := tt.reconciler().ReportCNIReady(tt.ctx, logger, scope)
+
+	tt.Expect(err).NotTo(HaveOccurred())
+	tt.Expect(tt.cluster.Status.FailureMessage).To(BeZero())
+	tt.Expect(tt.cluster.Status.FailureReason).To(BeZero())
+
+	tt.Expect(result).To(Equal(controller.Result{}))
+	tt.cleanup()
+}
+
 func TestReconcileCNIErrorClientRegistry(t *testing.T) {
 	tt := newReconcilerTest(t)
 	tt.withFakeClient()
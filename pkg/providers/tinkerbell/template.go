@@ -26,6 +26,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/executables"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/providers/common"
+	"github.com/aws/eks-anywhere/pkg/providers/tinkerbell/hardware"
 	"github.com/aws/eks-anywhere/pkg/registrymirror"
 	"github.com/aws/eks-anywhere/pkg/registrymirror/containerd"
 	"github.com/aws/eks-anywhere/pkg/templater"
@@ -55,11 +56,25 @@ type TemplateBuilder struct {
 	etcdMachineSpec             *v1alpha1.TinkerbellMachineConfigSpec
 	tinkerbellIP                string
 	now                         types.NowFunc
+	// networkLayouts are the hardware network layouts by TinkerbellMachineConfig name.
+	networkLayouts map[string]*hardware.NetworkLayout
+}
+
+// TemplateBuilderOpt customizes a TemplateBuilder.
+type TemplateBuilderOpt func(*TemplateBuilder)
+
+// WithNetworkLayouts sets the network layouts, by TinkerbellMachineConfig name, of the hardware
+// selected by each machine config. They are used to configure the OS network in the default
+// template configs.
+func WithNetworkLayouts(layouts map[string]*hardware.NetworkLayout) TemplateBuilderOpt {
+	return func(tb *TemplateBuilder) {
+		tb.networkLayouts = layouts
+	}
 }
 
 // NewTemplateBuilder creates a new TemplateBuilder instance.
-func NewTemplateBuilder(datacenterSpec *v1alpha1.TinkerbellDatacenterConfigSpec, controlPlaneMachineSpec, etcdMachineSpec *v1alpha1.TinkerbellMachineConfigSpec, workerNodeGroupMachineSpecs map[string]v1alpha1.TinkerbellMachineConfigSpec, tinkerbellIP string, now types.NowFunc) providers.TemplateBuilder {
-	return &TemplateBuilder{
+func NewTemplateBuilder(datacenterSpec *v1alpha1.TinkerbellDatacenterConfigSpec, controlPlaneMachineSpec, etcdMachineSpec *v1alpha1.TinkerbellMachineConfigSpec, workerNodeGroupMachineSpecs map[string]v1alpha1.TinkerbellMachineConfigSpec, tinkerbellIP string, now types.NowFunc, opts ...TemplateBuilderOpt) providers.TemplateBuilder {
+	tb := &TemplateBuilder{
 		controlPlaneMachineSpec:     controlPlaneMachineSpec,
 		datacenterSpec:              datacenterSpec,
 		WorkerNodeGroupMachineSpecs: workerNodeGroupMachineSpecs,
@@ -67,6 +82,27 @@ func NewTemplateBuilder(datacenterSpec *v1alpha1.TinkerbellDatacenterConfigSpec,
 		tinkerbellIP:                tinkerbellIP,
 		now:                         now,
 	}
+	for _, opt := range opts {
+		opt(tb)
+	}
+	return tb
+}
+
+// newDefaultTemplateConfig returns the default TinkerbellTemplateConfig for the machine config
+// machineConfigName, configuring the OS network for its hardware network layout if it has one.
+func (tb *TemplateBuilder) newDefaultTemplateConfig(clusterSpec *cluster.Spec, machineConfigName, osImageURL string, osFamily v1alpha1.OSFamily) (*v1alpha1.TinkerbellTemplateConfig, error) {
+	templateConfig := v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec.Cluster, osImageURL, tb.tinkerbellIP, tb.datacenterSpec.TinkerbellIP, osFamily)
+
+	layout, ok := tb.networkLayouts[machineConfigName]
+	if !ok {
+		return templateConfig, nil
+	}
+
+	if err := applyNetworkLayout(templateConfig, layout, osFamily); err != nil {
+		return nil, fmt.Errorf("TinkerbellMachineConfig %s: %v", machineConfigName, err)
+	}
+
+	return templateConfig, nil
 }
 
 func (tb *TemplateBuilder) GenerateCAPISpecControlPlane(clusterSpec *cluster.Spec, buildOptions ...providers.BuildMapOption) (content []byte, err error) {
@@ -82,7 +118,10 @@ func (tb *TemplateBuilder) GenerateCAPISpecControlPlane(clusterSpec *cluster.Spe
 		if tb.controlPlaneMachineSpec.OSImageURL != "" {
 			OSImageURL = tb.controlPlaneMachineSpec.OSImageURL
 		}
-		cpTemplateConfig = v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec.Cluster, OSImageURL, tb.tinkerbellIP, tb.datacenterSpec.TinkerbellIP, tb.controlPlaneMachineSpec.OSFamily)
+		cpTemplateConfig, err = tb.newDefaultTemplateConfig(clusterSpec, machineGroupRefName(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef), OSImageURL, tb.controlPlaneMachineSpec.OSFamily)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	cpTemplateString, err := cpTemplateConfig.ToTemplateString()
@@ -100,7 +139,10 @@ func (tb *TemplateBuilder) GenerateCAPISpecControlPlane(clusterSpec *cluster.Spe
 		}
 		etcdTemplateConfig := clusterSpec.TinkerbellTemplateConfigs[tb.etcdMachineSpec.TemplateRef.Name]
 		if etcdTemplateConfig == nil {
-			etcdTemplateConfig = v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec.Cluster, OSImageURL, tb.tinkerbellIP, tb.datacenterSpec.TinkerbellIP, tb.etcdMachineSpec.OSFamily)
+			etcdTemplateConfig, err = tb.newDefaultTemplateConfig(clusterSpec, machineGroupRefName(clusterSpec.Cluster.Spec.ExternalEtcdConfiguration.MachineGroupRef), OSImageURL, tb.etcdMachineSpec.OSFamily)
+			if err != nil {
+				return nil, err
+			}
 		}
 		etcdTemplateString, err = etcdTemplateConfig.ToTemplateString()
 		if err != nil {
@@ -137,7 +179,10 @@ func (tb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, wo
 			if workerNodeMachineSpec.OSImageURL != "" {
 				OSImageURL = workerNodeMachineSpec.OSImageURL
 			}
-			wTemplateConfig = v1alpha1.NewDefaultTinkerbellTemplateConfigCreate(clusterSpec.Cluster, OSImageURL, tb.tinkerbellIP, tb.datacenterSpec.TinkerbellIP, workerNodeMachineSpec.OSFamily)
+			wTemplateConfig, err = tb.newDefaultTemplateConfig(clusterSpec, machineGroupRefName(workerNodeGroupConfiguration.MachineGroupRef), OSImageURL, workerNodeMachineSpec.OSFamily)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		wTemplateString, err := wTemplateConfig.ToTemplateString()
@@ -164,6 +209,9 @@ func (tb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, wo
 		values["workerNodeGroupName"] = workerNodeGroupConfiguration.Name
 		values["workloadkubeadmconfigTemplateName"] = kubeadmconfigTemplateNames[workerNodeGroupConfiguration.Name]
 		values["autoscalingConfig"] = workerNodeGroupConfiguration.AutoScalingConfiguration
+		// The node resources depend on the hardware selected for each machine, which can differ between
+		// machines of the same group, so the node capacity isn't published and node groups can't scale from zero.
+		values["autoscalingAnnotations"] = clusterapi.AutoscalingAnnotations(workerNodeGroupConfiguration, nil)
 
 		if workerNodeGroupConfiguration.UpgradeRolloutStrategy != nil {
 			values["upgradeRolloutStrategy"] = true
@@ -185,6 +233,10 @@ func (tb *TemplateBuilder) GenerateCAPISpecWorkers(clusterSpec *cluster.Spec, wo
 }
 
 func (p *Provider) generateCAPISpecForUpgrade(ctx context.Context, bootstrapCluster, workloadCluster *types.Cluster, currentSpec, newClusterSpec *cluster.Spec) (controlPlaneSpec, workersSpec []byte, err error) {
+	if err := p.setNetworkLayouts(newClusterSpec); err != nil {
+		return nil, nil, err
+	}
+
 	clusterName := newClusterSpec.Cluster.Name
 	var controlPlaneTemplateName, workloadTemplateName, kubeadmconfigTemplateName, etcdTemplateName string
 	var needsNewEtcdTemplate bool
@@ -330,6 +382,10 @@ func (p *Provider) GenerateCAPISpecForCreate(ctx context.Context, _ *types.Clust
 }
 
 func (p *Provider) generateCAPISpecForCreate(ctx context.Context, clusterSpec *cluster.Spec) (controlPlaneSpec, workersSpec []byte, err error) {
+	if err := p.setNetworkLayouts(clusterSpec); err != nil {
+		return nil, nil, err
+	}
+
 	clusterName := clusterSpec.Cluster.Name
 	cpOpt := func(values map[string]interface{}) {
 		values["controlPlaneTemplateName"] = common.CPMachineTemplateName(clusterName, p.templateBuilder.now)
@@ -719,6 +775,10 @@ func populateRegistryMirrorValues(clusterSpec *cluster.Spec, values map[string]i
 		values["registryCACert"] = registryMirror.CACertContent
 	}
 
+	if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+		return values, err
+	}
+
 	if registryMirror.Auth {
 		values["registryAuth"] = registryMirror.Auth
 		username, password, err := config.ReadCredentials()
@@ -764,7 +824,7 @@ func getEtcdMachineSpec(clusterSpec *cluster.Spec) (*v1alpha1.TinkerbellMachineC
 	return etcdMachineSpec, nil
 }
 
-func generateTemplateBuilder(clusterSpec *cluster.Spec) (providers.TemplateBuilder, error) {
+func generateTemplateBuilder(clusterSpec *cluster.Spec, opts ...TemplateBuilderOpt) (providers.TemplateBuilder, error) {
 	controlPlaneMachineSpec, err := getControlPlaneMachineSpec(clusterSpec)
 	if err != nil {
 		return nil, errors.Wrap(err, "generating control plane machine spec")
@@ -786,6 +846,7 @@ func generateTemplateBuilder(clusterSpec *cluster.Spec) (providers.TemplateBuild
 		workerNodeGroupMachineSpecs,
 		clusterSpec.TinkerbellDatacenter.Spec.TinkerbellIP,
 		time.Now,
+		opts...,
 	)
 	return templateBuilder, nil
 }
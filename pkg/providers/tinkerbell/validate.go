@@ -86,6 +86,10 @@ func validateUpgradeRolloutStrategy(spec *ClusterSpec) error {
 				return errors.New("InPlace upgrades are only supported on the Ubuntu OS family")
 			}
 		}
+		// Canary worker node groups are rolled like RollingUpdate ones once the canary nodes pass.
+		if wnUpgradeRolloutStrategyType == v1alpha1.CanaryStrategyType {
+			wnUpgradeRolloutStrategyType = v1alpha1.RollingUpdateStrategyType
+		}
 		if wnUpgradeRolloutStrategyType != cpUpgradeRolloutStrategyType {
 			return errors.New("cannot specify different upgrade rollout strategy types for control plane and worker node group configurations")
 		}
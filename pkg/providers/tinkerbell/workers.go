@@ -24,7 +24,12 @@ type (
 // It talks to the cluster with a client to detect changes in immutable objects and generates new
 // names for them.
 func WorkersSpec(ctx context.Context, logger logr.Logger, client kubernetes.Client, spec *cluster.Spec) (*Workers, error) {
-	templateBuilder, err := generateTemplateBuilder(spec)
+	layouts, err := networkLayoutsFromCluster(ctx, client, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading hardware network layouts")
+	}
+
+	templateBuilder, err := generateTemplateBuilder(spec, WithNetworkLayouts(layouts))
 	if err != nil {
 		return nil, errors.Wrap(err, "generating tinkerbell template builder")
 	}
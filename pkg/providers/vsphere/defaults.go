@@ -37,6 +37,7 @@ func (d *Defaulter) setDefaultsForMachineConfig(ctx context.Context, spec *Spec)
 	for _, m := range spec.machineConfigs() {
 		m.SetDefaults()
 		m.SetUserDefaults()
+		m.SetAdditionalNetworksFullPath(spec.VSphereDatacenter.Spec.Datacenter)
 
 		if err := d.setDefaultTemplateIfMissing(ctx, spec, m); err != nil {
 			return err
@@ -2,7 +2,9 @@ package vsphere
 
 import (
 	"fmt"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	vspherev1 "sigs.k8s.io/cluster-api-provider-vsphere/apis/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 	"sigs.k8s.io/yaml"
@@ -238,14 +240,24 @@ func buildTemplateMapCP(
 		"eksaCloudProviderPassword":            vuc.EksaVsphereCPPassword,
 		"controlPlaneCloneMode":                controlPlaneMachineSpec.CloneMode,
 		"etcdCloneMode":                        etcdMachineSpec.CloneMode,
+		"kubeVipCidr":                          kubeVipCIDR(clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host),
 	}
 
+	clusterNetwork := clusterSpec.Cluster.Spec.ClusterNetwork
+	if clusterNetwork.HasIPFamily(anywherev1.IPv6Family) {
+		values["cpiIPFamilies"] = cpiIPFamilies(clusterNetwork.IPFamilies())
+	}
+	setDHCPValues(values, &clusterNetwork)
+
 	auditPolicy, err := common.GetAuditPolicy(clusterSpec.Cluster.Spec.KubernetesVersion)
 	if err != nil {
 		return nil, err
 	}
 	values["auditPolicy"] = auditPolicy
 
+	setIPPoolValues(values, "controlPlane", clusterSpec, controlPlaneMachineSpec)
+	setAdditionalDevicesValues(values, "controlPlane", clusterSpec, controlPlaneMachineSpec)
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
@@ -256,6 +268,10 @@ func buildTemplateMapCP(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return values, err
+		}
+
 		if controlPlaneMachineSpec.OSFamily == anywherev1.Bottlerocket &&
 			len(registryMirror.NamespacedRegistryMap) == 1 &&
 			registryMirror.CoreEKSAMirror() != "" {
@@ -313,6 +329,8 @@ func buildTemplateMapCP(
 		values["etcdVsphereStoragePolicyName"] = etcdMachineSpec.StoragePolicyName
 		values["etcdSshUsername"] = firstEtcdMachinesUser.Name
 		values["vsphereEtcdSshAuthorizedKey"] = etcdSSHKey
+		setIPPoolValues(values, "etcd", clusterSpec, etcdMachineSpec)
+		setAdditionalDevicesValues(values, "etcd", clusterSpec, etcdMachineSpec)
 
 		if etcdMachineSpec.HostOSConfiguration != nil {
 			if etcdMachineSpec.HostOSConfiguration.NTPConfiguration != nil {
@@ -385,6 +403,12 @@ func buildTemplateMapCP(
 			return nil, err
 		}
 		values["encryptionProviderConfig"] = conf
+
+		kmsPluginFiles, err := common.GenerateKMSPluginFiles(clusterSpec.Cluster.Spec.EtcdEncryption, "/var/lib/kubeadm/kms-plugin")
+		if err != nil {
+			return nil, err
+		}
+		values["kmsPluginFiles"] = kmsPluginFiles
 	}
 
 	if bottlerocketKubernetesSettings != nil || controlPlaneMachineSpec.HostOSConfiguration != nil {
@@ -420,6 +444,12 @@ func buildTemplateMapCP(
 		values["kubeletExtraArgs"] = kubeletExtraArgs.ToPartialYaml()
 	}
 
+	// The node IP is only a kubelet flag, so it's set even when the kubelet configuration is provided.
+	nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork)
+	if len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs.ToPartialYaml()
+	}
+
 	nodeLabelArgs := clusterapi.ControlPlaneNodeLabelsExtraArgs(clusterSpec.Cluster.Spec.ControlPlaneConfiguration)
 	if len(nodeLabelArgs) != 0 {
 		values["nodeLabelArgs"] = nodeLabelArgs.ToPartialYaml()
@@ -479,9 +509,14 @@ func buildTemplateMapMD(
 		"workerNodeGroupName":            fmt.Sprintf("%s-%s", clusterSpec.Cluster.Name, workerNodeGroupConfiguration.Name),
 		"workerNodeGroupTaints":          workerNodeGroupConfiguration.Taints,
 		"autoscalingConfig":              workerNodeGroupConfiguration.AutoScalingConfiguration,
+		"autoscalingAnnotations":         clusterapi.AutoscalingAnnotations(workerNodeGroupConfiguration, nodeCapacity(workerNodeGroupMachineSpec)),
 		"workerCloneMode":                workerNodeGroupMachineSpec.CloneMode,
 	}
 
+	setIPPoolValues(values, "worker", clusterSpec, workerNodeGroupMachineSpec)
+	setDHCPValues(values, &clusterSpec.Cluster.Spec.ClusterNetwork)
+	setAdditionalDevicesValues(values, "worker", clusterSpec, workerNodeGroupMachineSpec)
+
 	if clusterSpec.Cluster.Spec.RegistryMirrorConfiguration != nil {
 		registryMirror := registrymirror.FromCluster(clusterSpec.Cluster)
 		values["registryMirrorMap"] = containerd.ToAPIEndpoints(registryMirror.NamespacedRegistryMap)
@@ -492,6 +527,10 @@ func buildTemplateMapMD(
 			values["registryCACert"] = registryMirror.CACertContent
 		}
 
+		if err := common.SetRegistryMirrorFallbackValues(values, registryMirror); err != nil {
+			return values, err
+		}
+
 		if workerNodeGroupMachineSpec.OSFamily == anywherev1.Bottlerocket &&
 			len(registryMirror.NamespacedRegistryMap) == 1 &&
 			registryMirror.CoreEKSAMirror() != "" {
@@ -594,6 +633,11 @@ func buildTemplateMapMD(
 		values["kubeletExtraArgs"] = kubeletExtraArgs.ToPartialYaml()
 	}
 
+	nodeIPArgs := clusterapi.NodeIPExtraArgs(&clusterSpec.Cluster.Spec.ClusterNetwork)
+	if len(nodeIPArgs) != 0 {
+		values["nodeIPArgs"] = nodeIPArgs.ToPartialYaml()
+	}
+
 	nodeLabelArgs := clusterapi.WorkerNodeLabelsExtraArgs(workerNodeGroupConfiguration)
 	if len(nodeLabelArgs) != 0 {
 		values["nodeLabelArgs"] = nodeLabelArgs.ToPartialYaml()
@@ -602,6 +646,74 @@ func buildTemplateMapMD(
 	return values, nil
 }
 
+// kubeVipCIDR returns the prefix length kube-vip uses to add the control plane endpoint to the node interface.
+func kubeVipCIDR(endpoint string) string {
+	if family, err := anywherev1.IPAddressFamily(endpoint); err == nil && family == anywherev1.IPv6Family {
+		return "128"
+	}
+	return "32"
+}
+
+// setDHCPValues sets the DHCP IP families of the network devices that don't take their addresses
+// from an ip pool, so the machines get an address in every IP family of the cluster.
+func setDHCPValues(values map[string]interface{}, clusterNetwork *anywherev1.ClusterNetwork) {
+	values["dhcp4"] = clusterNetwork.HasIPFamily(anywherev1.IPv4Family)
+	values["dhcp6"] = clusterNetwork.HasIPFamily(anywherev1.IPv6Family)
+}
+
+// cpiIPFamilies returns the IP families, in order of priority, the vSphere cloud provider
+// uses to pick the node addresses.
+func cpiIPFamilies(families []anywherev1.IPFamily) []string {
+	cpiFamilies := make([]string, 0, len(families))
+	for _, family := range families {
+		cpiFamilies = append(cpiFamilies, strings.ToLower(string(family)))
+	}
+	return cpiFamilies
+}
+
+// setIPPoolValues sets the values to assign the machines static ips from the VSphereIPPool referenced
+// by the machine config, if any. prefix is the machine group the values are for.
+func setIPPoolValues(values map[string]interface{}, prefix string, clusterSpec *cluster.Spec, machineSpec anywherev1.VSphereMachineConfigSpec) {
+	if machineSpec.IPPoolRef == nil {
+		return
+	}
+
+	values[prefix+"IPPool"] = machineSpec.IPPoolRef.Name
+	if pool := clusterSpec.VSphereIPPool(machineSpec.IPPoolRef.Name); pool != nil {
+		values[prefix+"Nameservers"] = pool.Spec.Nameservers
+	}
+}
+
+// setAdditionalDevicesValues sets the values to attach the additional network interfaces and data disks
+// of the machine config to the machines.
+func setAdditionalDevicesValues(values map[string]interface{}, prefix string, clusterSpec *cluster.Spec, machineSpec anywherev1.VSphereMachineConfigSpec) {
+	if len(machineSpec.AdditionalNetworks) > 0 {
+		networks := make([]map[string]interface{}, 0, len(machineSpec.AdditionalNetworks))
+		for _, n := range machineSpec.AdditionalNetworks {
+			network := map[string]interface{}{
+				"networkName": n.Network,
+			}
+			if n.IPPoolRef != nil {
+				network["ipPool"] = n.IPPoolRef.Name
+				if pool := clusterSpec.VSphereIPPool(n.IPPoolRef.Name); pool != nil {
+					network["nameservers"] = pool.Spec.Nameservers
+				}
+			}
+			networks = append(networks, network)
+		}
+		values[prefix+"AdditionalNetworks"] = networks
+	}
+
+	if len(machineSpec.DataDisks) > 0 {
+		sizes := make([]int, 0, len(machineSpec.DataDisks))
+		for _, d := range machineSpec.DataDisks {
+			sizes = append(sizes, d.SizeGiB)
+		}
+		values[prefix+"AdditionalDisksGiB"] = sizes
+		values[prefix+"DataDisks"] = machineSpec.DataDisks
+	}
+}
+
 func buildTemplateMapFailureDomain(
 	clusterSpec *cluster.Spec,
 	failureDomain anywherev1.FailureDomain,
@@ -639,3 +751,13 @@ func getFailureDomainRegionTypeAndName(datacenterSpec anywherev1.VSphereDatacent
 func getFailureDomainZoneTypeAndName(failureDomain anywherev1.FailureDomain) (string, string) {
 	return string(vspherev1.ComputeClusterFailureDomain), failureDomain.ComputeCluster
 }
+
+// nodeCapacity returns the resources of the nodes created from a machine config,
+// published to the cluster autoscaler so it can scale a worker node group from zero.
+func nodeCapacity(machineSpec anywherev1.VSphereMachineConfigSpec) *clusterapi.NodeCapacity {
+	return &clusterapi.NodeCapacity{
+		CPU:           *resource.NewQuantity(int64(machineSpec.NumCPUs), resource.DecimalSI),
+		Memory:        *resource.NewQuantity(int64(machineSpec.MemoryMiB)<<20, resource.BinarySI),
+		EphemeralDisk: *resource.NewQuantity(int64(machineSpec.DiskGiB)<<30, resource.BinarySI),
+	}
+}
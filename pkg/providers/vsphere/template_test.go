@@ -5,6 +5,8 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/aws/eks-anywhere/internal/test"
@@ -12,6 +14,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/clusterapi"
 	"github.com/aws/eks-anywhere/pkg/config"
 	"github.com/aws/eks-anywhere/pkg/providers/vsphere"
+	"github.com/aws/eks-anywhere/pkg/utils/ptr"
 )
 
 const (
@@ -217,3 +220,179 @@ func TestVsphereTemplateBuilderGenerateCAPISpecVCenterTags(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_vcenter_tags.yaml")
 }
+
+func TestVsphereTemplateBuilderGenerateCAPISpecIPPool(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.VSphereIPPools = map[string]*v1alpha1.VSphereIPPool{
+		"ip-pool": {
+			Spec: v1alpha1.VSphereIPPoolSpec{
+				Pools: []v1alpha1.IPPool{
+					{
+						IPStart: "10.0.0.10",
+						IPEnd:   "10.0.0.50",
+						Subnet:  "10.0.0.0/24",
+						Gateway: "10.0.0.1",
+					},
+				},
+				Nameservers: []string{"10.0.0.2", "10.0.0.3"},
+			},
+		},
+	}
+	for _, m := range spec.VSphereMachineConfigs {
+		m.Spec.IPPoolRef = &v1alpha1.Ref{Kind: v1alpha1.VSphereIPPoolKind, Name: "ip-pool"}
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(cpData), "testdata/expected_kcp_ip_pool.yaml")
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_ip_pool.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecDualStack(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00:1::10"
+	spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:10:1::/56", "192.168.0.0/16"}
+	spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:10:96::/112", "10.96.0.0/12"}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(cpData), "testdata/expected_kcp_dual_stack.yaml")
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_dual_stack.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecIPv6KubeletConfiguration(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00:1::10"
+	spec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:10:1::/56"}
+	spec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:10:96::/112"}
+	spec.Cluster.Spec.ControlPlaneConfiguration.KubeletConfiguration = &unstructured.Unstructured{
+		Object: map[string]interface{}{"maxPods": 20},
+	}
+	spec.Cluster.Spec.WorkerNodeGroupConfigurations[0].KubeletConfiguration = &unstructured.Unstructured{
+		Object: map[string]interface{}{"maxPods": 20},
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(cpData)).To(ContainSubstring("node-ip: '::'"))
+	g.Expect(string(cpData)).To(ContainSubstring("dhcp4: false\n          dhcp6: true"))
+
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(wData)).To(ContainSubstring("node-ip: '::'"))
+	g.Expect(string(wData)).To(ContainSubstring("dhcp4: false\n          dhcp6: true"))
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecAdditionalDevices(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.VSphereIPPools = map[string]*v1alpha1.VSphereIPPool{
+		"storage-pool": {
+			Spec: v1alpha1.VSphereIPPoolSpec{
+				Pools: []v1alpha1.IPPool{
+					{
+						IPStart: "10.1.0.10",
+						IPEnd:   "10.1.0.50",
+						Subnet:  "10.1.0.0/24",
+						Gateway: "10.1.0.1",
+					},
+				},
+				Nameservers: []string{"10.1.0.2"},
+			},
+		},
+	}
+	for _, m := range spec.VSphereMachineConfigs {
+		m.Spec.AdditionalNetworks = []v1alpha1.VSphereMachineNetwork{
+			{Network: "/SDDC-Datacenter/network/storage", IPPoolRef: &v1alpha1.Ref{Kind: v1alpha1.VSphereIPPoolKind, Name: "storage-pool"}},
+			{Network: "/SDDC-Datacenter/network/backup"},
+		}
+		m.Spec.DataDisks = []v1alpha1.VSphereDataDisk{
+			{SizeGiB: 50, Device: "/dev/sdb", MountPath: "/var/lib/containerd", Filesystem: "ext4", Label: "containerd"},
+		}
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(cpData), "testdata/expected_kcp_additional_devices.yaml")
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_additional_devices.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecWorkersScaleFromZero(t *testing.T) {
+	g := NewWithT(t)
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	wng := &spec.Cluster.Spec.WorkerNodeGroupConfigurations[0]
+	wng.Count = ptr.Int(0)
+	wng.Labels = map[string]string{"group": "burst"}
+	wng.Taints = []corev1.Taint{{Key: "burst", Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+	wng.AutoScalingConfiguration = &v1alpha1.AutoScalingConfiguration{
+		MinCount:                      0,
+		MaxCount:                      5,
+		ScaleDownUtilizationThreshold: "0.3",
+		ScaleDownUnneededTime:         &metav1.Duration{Duration: 10 * time.Minute},
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_scale_from_zero.yaml")
+}
+
+func TestVsphereTemplateBuilderGenerateCAPISpecRegistryMirrorFallbacks(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("REGISTRY_USERNAME", "username")
+	t.Setenv("REGISTRY_PASSWORD", "password")
+	t.Setenv("REGISTRY_USERNAME_1", "username-1")
+	t.Setenv("REGISTRY_PASSWORD_1", "password-1")
+	spec := test.NewFullClusterSpec(t, "testdata/cluster_main.yaml")
+	spec.Cluster.Spec.RegistryMirrorConfiguration = &v1alpha1.RegistryMirrorConfiguration{
+		Endpoint:      "harbor.eksa.demo",
+		Port:          "443",
+		CACertContent: "primary-ca",
+		Authenticate:  true,
+		OCINamespaces: []v1alpha1.OCINamespace{
+			{
+				Registry:  "public.ecr.aws",
+				Namespace: "eks-anywhere",
+			},
+		},
+		FallbackEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+			{
+				Endpoint:      "harbor-2.eksa.demo",
+				Port:          "443",
+				CACertContent: "fallback-ca",
+				Authenticate:  true,
+			},
+			{
+				Endpoint:           "harbor-3.eksa.demo",
+				Port:               "5000",
+				InsecureSkipVerify: true,
+			},
+		},
+	}
+	builder := vsphere.NewVsphereTemplateBuilder(time.Now)
+	cpData, err := builder.GenerateCAPISpecControlPlane(spec, func(values map[string]interface{}) {
+		values["controlPlaneTemplateName"] = clusterapi.ControlPlaneMachineTemplateName(spec.Cluster)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(cpData), "testdata/expected_kcp_registry_mirror_fallbacks.yaml")
+	wData, err := builder.GenerateCAPISpecWorkers(spec, nil, nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	test.AssertContentToFile(t, string(wData), "testdata/expected_kct_registry_mirror_fallbacks.yaml")
+}
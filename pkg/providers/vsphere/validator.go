@@ -211,19 +211,37 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, vsphereCl
 		if etcdMachineConfig.Spec.HostOSConfiguration != nil && etcdMachineConfig.Spec.HostOSConfiguration.BottlerocketConfiguration != nil && etcdMachineConfig.Spec.HostOSConfiguration.BottlerocketConfiguration.Kubernetes != nil {
 			logger.Info("Bottlerocket Kubernetes settings are not supported for etcd machines. Ignoring Kubernetes settings for etcd machines.", "etcdMachineConfig", etcdMachineConfig.Name)
 		}
+		if len(etcdMachineConfig.Spec.DataDisks) > 0 {
+			return fmt.Errorf("dataDisks are not supported for etcd machines, VSphereMachineConfig %s", etcdMachineConfig.Name)
+		}
 	}
 
 	// TODO: move this to api Cluster validations
-	if err := v.validateControlPlaneIp(vsphereClusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host); err != nil {
+	if err := v.validateControlPlaneIp(vsphereClusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host, &vsphereClusterSpec.Cluster.Spec.ClusterNetwork); err != nil {
 		return err
 	}
 
+	// Bottlerocket has no setting to make the kubelet pick an IPv6 node address.
+	if vsphereClusterSpec.Cluster.Spec.ClusterNetwork.IsIPv6Primary() {
+		for _, mc := range vsphereClusterSpec.VSphereMachineConfigs {
+			if mc.OSFamily() == anywherev1.Bottlerocket {
+				return fmt.Errorf("VSphereMachineConfig %s: osFamily %s is not supported when IPv6 is the primary IP family of the cluster", mc.Name, anywherev1.Bottlerocket)
+			}
+		}
+	}
+
 	for _, config := range vsphereClusterSpec.VSphereMachineConfigs {
 		var b bool                                                                                             // Temporary until we remove the need to pass a bool pointer
 		err := v.govc.ValidateVCenterSetupMachineConfig(ctx, vsphereClusterSpec.VSphereDatacenter, config, &b) // TODO: remove side effects from this implementation or directly move it to set defaults (pointer to bool is not needed)
 		if err != nil {
 			return fmt.Errorf("validating vCenter setup for VSphereMachineConfig %v: %v", config.Name, err)
 		}
+
+		for _, n := range config.Spec.AdditionalNetworks {
+			if err := v.validateNetwork(ctx, n.Network); err != nil {
+				return fmt.Errorf("validating additional networks for VSphereMachineConfig %v: %v", config.Name, err)
+			}
+		}
 	}
 
 	if err := v.validateTemplates(ctx, vsphereClusterSpec); err != nil {
@@ -247,12 +265,17 @@ func (v *Validator) ValidateClusterMachineConfigs(ctx context.Context, vsphereCl
 	return nil
 }
 
-func (v *Validator) validateControlPlaneIp(ip string) error {
+func (v *Validator) validateControlPlaneIp(ip string, clusterNetwork *anywherev1.ClusterNetwork) error {
 	// check if controlPlaneEndpointIp is valid
 	parsedIp := net.ParseIP(ip)
 	if parsedIp == nil {
 		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host is invalid: %s", ip)
 	}
+	// kube-vip can only announce the endpoint in an IP family the nodes have an address for
+	family, _ := anywherev1.IPAddressFamily(ip)
+	if !clusterNetwork.HasIPFamily(family) {
+		return fmt.Errorf("cluster controlPlaneConfiguration.Endpoint.Host %s is %s but the cluster network only uses %v", ip, family, clusterNetwork.IPFamilies())
+	}
 	return nil
 }
 
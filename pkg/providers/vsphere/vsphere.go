@@ -393,7 +393,7 @@ func (p *vsphereProvider) SetupAndValidateCreateCluster(ctx context.Context, clu
 	return nil
 }
 
-func (p *vsphereProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cluster *types.Cluster, clusterSpec, _ *cluster.Spec) error {
+func (p *vsphereProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cluster *types.Cluster, clusterSpec, currentClusterSpec *cluster.Spec) error {
 	if err := SetupEnvVars(clusterSpec.VSphereDatacenter); err != nil {
 		return fmt.Errorf("failed setup and validations: %v", err)
 	}
@@ -442,6 +442,40 @@ func (p *vsphereProvider) SetupAndValidateUpgradeCluster(ctx context.Context, cl
 	if err != nil {
 		return fmt.Errorf("failed validate machineconfig uniqueness: %v", err)
 	}
+
+	if err := validateInPlaceUpgradeMachineDevices(currentClusterSpec, clusterSpec); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateInPlaceUpgradeMachineDevices checks the additional networks and data disks of the machines
+// upgraded in place don't change, since that requires replacing the VMs.
+func validateInPlaceUpgradeMachineDevices(currentSpec, newSpec *cluster.Spec) error {
+	if currentSpec == nil {
+		return nil
+	}
+
+	devicesChanged := func(machineConfigName string) bool {
+		oldVmc, newVmc := currentSpec.VsphereMachineConfig(machineConfigName), newSpec.VsphereMachineConfig(machineConfigName)
+		if oldVmc == nil || newVmc == nil {
+			return false
+		}
+		return !v1alpha1.AdditionalNetworksEqual(oldVmc.Spec.AdditionalNetworks, newVmc.Spec.AdditionalNetworks) ||
+			!v1alpha1.DataDisksEqual(oldVmc.Spec.DataDisks, newVmc.Spec.DataDisks)
+	}
+
+	cp := newSpec.Cluster.Spec.ControlPlaneConfiguration
+	if cp.UpgradeRolloutStrategy != nil && cp.UpgradeRolloutStrategy.Type == v1alpha1.InPlaceStrategyType && devicesChanged(cp.MachineGroupRef.Name) {
+		return fmt.Errorf("additionalNetworks and dataDisks of VSphereMachineConfig %s can't be changed with the %s upgrade rollout strategy", cp.MachineGroupRef.Name, v1alpha1.InPlaceStrategyType)
+	}
+
+	for _, w := range newSpec.Cluster.Spec.WorkerNodeGroupConfigurations {
+		if w.UpgradeRolloutStrategy != nil && w.UpgradeRolloutStrategy.Type == v1alpha1.InPlaceStrategyType && devicesChanged(w.MachineGroupRef.Name) {
+			return fmt.Errorf("additionalNetworks and dataDisks of VSphereMachineConfig %s can't be changed with the %s upgrade rollout strategy", w.MachineGroupRef.Name, v1alpha1.InPlaceStrategyType)
+		}
+	}
+
 	return nil
 }
 
@@ -499,7 +533,7 @@ func (p *vsphereProvider) getPrevMachineConfigDatastoreUsage(ctx context.Context
 			return 0, err
 		}
 		if em != nil {
-			return float64(em.Spec.DiskGiB * count), nil
+			return float64(em.TotalDiskGiB() * count), nil
 		}
 	}
 	return 0, nil
@@ -510,7 +544,7 @@ func (p *vsphereProvider) getMachineConfigDatastoreRequirements(ctx context.Cont
 	if err != nil {
 		return 0, 0, fmt.Errorf("getting datastore details: %v", err)
 	}
-	needGiB := machineConfig.Spec.DiskGiB * count
+	needGiB := machineConfig.TotalDiskGiB() * count
 	return availableSpace, needGiB, nil
 }
 
@@ -746,6 +780,9 @@ func NeedsNewControlPlaneTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc
 	if oldSpec.Bundles.Spec.Number != newSpec.Bundles.Spec.Number {
 		return true
 	}
+	if ipPoolNameserversChanged(oldSpec, newSpec, oldVmc, newVmc) {
+		return true
+	}
 	return AnyImmutableFieldChanged(oldVdc, newVdc, oldVmc, newVmc)
 }
 
@@ -759,12 +796,16 @@ func NeedsNewWorkloadTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc *v1
 		!v1alpha1.WorkerNodeGroupConfigurationKubeVersionUnchanged(&oldWorker, &newWorker, oldSpec.Cluster, newSpec.Cluster) {
 		return true
 	}
+	if ipPoolNameserversChanged(oldSpec, newSpec, oldVmc, newVmc) {
+		return true
+	}
 	return AnyImmutableFieldChanged(oldVdc, newVdc, oldVmc, newVmc)
 }
 
 func NeedsNewKubeadmConfigTemplate(newWorkerNodeGroup, oldWorkerNodeGroup *v1alpha1.WorkerNodeGroupConfiguration, oldWorkerNodeVmc, newWorkerNodeVmc *v1alpha1.VSphereMachineConfig) bool {
 	return !v1alpha1.TaintsSliceEqual(newWorkerNodeGroup.Taints, oldWorkerNodeGroup.Taints) || !v1alpha1.MapEqual(newWorkerNodeGroup.Labels, oldWorkerNodeGroup.Labels) ||
-		!v1alpha1.UsersSliceEqual(oldWorkerNodeVmc.Spec.Users, newWorkerNodeVmc.Spec.Users)
+		!v1alpha1.UsersSliceEqual(oldWorkerNodeVmc.Spec.Users, newWorkerNodeVmc.Spec.Users) ||
+		!v1alpha1.DataDisksEqual(oldWorkerNodeVmc.Spec.DataDisks, newWorkerNodeVmc.Spec.DataDisks)
 }
 
 func NeedsNewEtcdTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc *v1alpha1.VSphereDatacenterConfig, oldVmc, newVmc *v1alpha1.VSphereMachineConfig) bool {
@@ -774,6 +815,9 @@ func NeedsNewEtcdTemplate(oldSpec, newSpec *cluster.Spec, oldVdc, newVdc *v1alph
 	if oldSpec.Bundles.Spec.Number != newSpec.Bundles.Spec.Number {
 		return true
 	}
+	if ipPoolNameserversChanged(oldSpec, newSpec, oldVmc, newVmc) {
+		return true
+	}
 	return AnyImmutableFieldChanged(oldVdc, newVdc, oldVmc, newVmc)
 }
 
@@ -805,9 +849,32 @@ func AnyImmutableFieldChanged(oldVdc, newVdc *v1alpha1.VSphereDatacenterConfig,
 	if oldVmc.Spec.Template != newVmc.Spec.Template {
 		return true
 	}
+	if !oldVmc.Spec.IPPoolRef.Equal(newVmc.Spec.IPPoolRef) {
+		return true
+	}
+	if !v1alpha1.AdditionalNetworksEqual(oldVmc.Spec.AdditionalNetworks, newVmc.Spec.AdditionalNetworks) {
+		return true
+	}
+	if !v1alpha1.DataDisksEqual(oldVmc.Spec.DataDisks, newVmc.Spec.DataDisks) {
+		return true
+	}
 	return false
 }
 
+// ipPoolNameserversChanged checks if the nameservers of the VSphereIPPool referenced by a machine config changed.
+// They are rendered in the machine template, so a change requires a new one.
+func ipPoolNameserversChanged(oldSpec, newSpec *cluster.Spec, oldVmc, newVmc *v1alpha1.VSphereMachineConfig) bool {
+	if oldVmc.Spec.IPPoolRef == nil || newVmc.Spec.IPPoolRef == nil {
+		return false
+	}
+	oldPool := oldSpec.VSphereIPPool(oldVmc.Spec.IPPoolRef.Name)
+	newPool := newSpec.VSphereIPPool(newVmc.Spec.IPPoolRef.Name)
+	if oldPool == nil || newPool == nil {
+		return oldPool != newPool
+	}
+	return !v1alpha1.SliceEqual(oldPool.Spec.Nameservers, newPool.Spec.Nameservers)
+}
+
 func (p *vsphereProvider) generateCAPISpecForUpgrade(ctx context.Context, bootstrapCluster, workloadCluster *types.Cluster, currentSpec, newClusterSpec *cluster.Spec) (controlPlaneSpec, workersSpec []byte, err error) {
 	clusterName := newClusterSpec.Cluster.Name
 	var controlPlaneTemplateName, workloadTemplateName, kubeadmconfigTemplateName, etcdTemplateName string
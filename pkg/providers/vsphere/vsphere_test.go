@@ -2301,6 +2301,37 @@ func TestSetupAndValidateCreateClusterBogusIp(t *testing.T) {
 	thenErrorExpected(t, "cluster controlPlaneConfiguration.Endpoint.Host is invalid: bogus", err)
 }
 
+func TestSetupAndValidateCreateClusterIPv6EndpointForIPv4Cluster(t *testing.T) {
+	ctx := context.Background()
+	clusterSpec := givenClusterSpec(t, testClusterConfigMainFilename)
+	provider := givenProvider(t)
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00::10"
+	setupContext(t)
+
+	err := provider.SetupAndValidateCreateCluster(ctx, clusterSpec)
+
+	thenErrorExpected(t, "cluster controlPlaneConfiguration.Endpoint.Host fd00::10 is IPv6 but the cluster network only uses [IPv4]", err)
+}
+
+func TestSetupAndValidateCreateClusterIPv6PrimaryBottlerocket(t *testing.T) {
+	ctx := context.Background()
+	clusterSpec := givenClusterSpec(t, testClusterConfigMainFilename)
+	provider := givenProvider(t)
+	clusterSpec.Cluster.Spec.ControlPlaneConfiguration.Endpoint.Host = "fd00::10"
+	clusterSpec.Cluster.Spec.ClusterNetwork.Pods.CidrBlocks = []string{"fd00:10:1::/56"}
+	clusterSpec.Cluster.Spec.ClusterNetwork.Services.CidrBlocks = []string{"fd00:10:96::/112"}
+	for _, mc := range clusterSpec.VSphereMachineConfigs {
+		mc.Spec.OSFamily = v1alpha1.Bottlerocket
+	}
+	setupContext(t)
+
+	err := provider.SetupAndValidateCreateCluster(ctx, clusterSpec)
+
+	if err == nil || !strings.Contains(err.Error(), "osFamily bottlerocket is not supported when IPv6 is the primary IP family of the cluster") {
+		t.Fatalf("expected Bottlerocket IPv6 error, got %v", err)
+	}
+}
+
 func TestSetupAndValidateCreateClusterUsedIp(t *testing.T) {
 	ctx := context.Background()
 	clusterSpec := givenClusterSpec(t, testClusterConfigMainFilename)
@@ -4201,3 +4232,93 @@ func TestProviderGenerateCAPISpecForUpgradeEtcdEncryption(t *testing.T) {
 		})
 	}
 }
+
+func TestNeedsNewControlPlaneTemplateIPPool(t *testing.T) {
+	g := NewWithT(t)
+	oldSpec := givenClusterSpec(t, testClusterConfigMainFilename)
+	newSpec := oldSpec.DeepCopy()
+	oldVmc := oldSpec.VSphereMachineConfigs[oldSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name]
+	newVmc := oldVmc.DeepCopy()
+	vdc := oldSpec.VSphereDatacenter
+
+	newVmc.Spec.IPPoolRef = &v1alpha1.Ref{Kind: v1alpha1.VSphereIPPoolKind, Name: "pool"}
+	g.Expect(NeedsNewControlPlaneTemplate(oldSpec, newSpec, vdc, vdc, oldVmc, newVmc)).To(BeTrue())
+
+	oldVmc = newVmc.DeepCopy()
+	oldSpec.VSphereIPPools = map[string]*v1alpha1.VSphereIPPool{
+		"pool": {Spec: v1alpha1.VSphereIPPoolSpec{Nameservers: []string{"1.1.1.1"}}},
+	}
+	newSpec.VSphereIPPools = map[string]*v1alpha1.VSphereIPPool{
+		"pool": {Spec: v1alpha1.VSphereIPPoolSpec{Nameservers: []string{"1.1.1.1"}}},
+	}
+	g.Expect(NeedsNewControlPlaneTemplate(oldSpec, newSpec, vdc, vdc, oldVmc, newVmc)).To(BeFalse())
+
+	newSpec.VSphereIPPools["pool"].Spec.Nameservers = []string{"8.8.8.8"}
+	g.Expect(NeedsNewControlPlaneTemplate(oldSpec, newSpec, vdc, vdc, oldVmc, newVmc)).To(BeTrue())
+}
+
+func TestNeedsNewControlPlaneTemplateDataDisks(t *testing.T) {
+	g := NewWithT(t)
+	oldSpec := givenClusterSpec(t, testClusterConfigMainFilename)
+	newSpec := oldSpec.DeepCopy()
+	oldVmc := oldSpec.VSphereMachineConfigs[oldSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name]
+	newVmc := oldVmc.DeepCopy()
+	vdc := oldSpec.VSphereDatacenter
+
+	newVmc.Spec.DataDisks = []v1alpha1.VSphereDataDisk{
+		{SizeGiB: 50, Device: "/dev/sdb", MountPath: "/data", Filesystem: "ext4", Label: "data"},
+	}
+	g.Expect(NeedsNewControlPlaneTemplate(oldSpec, newSpec, vdc, vdc, oldVmc, newVmc)).To(BeTrue())
+	wng := &newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0]
+	g.Expect(NeedsNewKubeadmConfigTemplate(wng, wng, oldVmc, newVmc)).To(BeTrue())
+}
+
+func TestValidateInPlaceUpgradeMachineDevices(t *testing.T) {
+	tests := []struct {
+		name    string
+		inPlace bool
+		worker  bool
+		wantErr string
+	}{
+		{
+			name: "rolling update",
+		},
+		{
+			name:    "in place control plane",
+			inPlace: true,
+			wantErr: "additionalNetworks and dataDisks of VSphereMachineConfig test-cp can't be changed with the InPlace upgrade rollout strategy",
+		},
+		{
+			name:    "in place workers",
+			inPlace: true,
+			worker:  true,
+			wantErr: "additionalNetworks and dataDisks of VSphereMachineConfig test-wn can't be changed with the InPlace upgrade rollout strategy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			currentSpec := givenClusterSpec(t, testClusterConfigMainFilename)
+			newSpec := currentSpec.DeepCopy()
+			machineConfigName := newSpec.Cluster.Spec.ControlPlaneConfiguration.MachineGroupRef.Name
+			if tt.worker {
+				machineConfigName = newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].MachineGroupRef.Name
+			}
+			newSpec.VSphereMachineConfigs[machineConfigName].Spec.AdditionalNetworks = []v1alpha1.VSphereMachineNetwork{
+				{Network: "/SDDC-Datacenter/network/storage"},
+			}
+			if tt.inPlace && tt.worker {
+				newSpec.Cluster.Spec.WorkerNodeGroupConfigurations[0].UpgradeRolloutStrategy = &v1alpha1.WorkerNodesUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+			} else if tt.inPlace {
+				newSpec.Cluster.Spec.ControlPlaneConfiguration.UpgradeRolloutStrategy = &v1alpha1.ControlPlaneUpgradeRolloutStrategy{Type: v1alpha1.InPlaceStrategyType}
+			}
+
+			err := validateInPlaceUpgradeMachineDevices(currentSpec, newSpec)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/eks-anywhere/pkg/errors"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// FailoverRegistries returns registry followed by the same registry on each of the fallback endpoints,
+// in order. registry may include a namespace, which is kept for all the fallback endpoints.
+func FailoverRegistries(registry string, fallbackEndpoints []string) []string {
+	registries := []string{registry}
+	_, namespace, _ := strings.Cut(registry, "/")
+	for _, endpoint := range fallbackEndpoints {
+		if namespace == "" {
+			registries = append(registries, endpoint)
+		} else {
+			registries = append(registries, endpoint+"/"+namespace)
+		}
+	}
+	return registries
+}
+
+// WithFailover calls fn with each of the registries in order, stopping at the first one that succeeds.
+// If all of them fail, it returns an aggregate of their errors.
+func WithFailover(registries []string, fn func(registry string) error) error {
+	if len(registries) == 0 {
+		return fmt.Errorf("no registries provided")
+	}
+
+	var errs []error
+	for i, registry := range registries {
+		err := fn(registry)
+		if err == nil {
+			return nil
+		}
+		if i < len(registries)-1 {
+			logger.V(4).Info("Registry failed, trying next registry", "registry", registry, "attempt", i+1, "error", err)
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return fmt.Errorf("all registries failed: %v", errors.NewAggregate(errs))
+}
@@ -0,0 +1,52 @@
+package registry_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws/eks-anywhere/pkg/registry"
+)
+
+func TestFailoverRegistries(t *testing.T) {
+	got := registry.FailoverRegistries("mirror:443/curated-packages", []string{"fallback-1:443", "fallback-2"})
+	assert.Equal(t, []string{"mirror:443/curated-packages", "fallback-1:443/curated-packages", "fallback-2/curated-packages"}, got)
+}
+
+func TestFailoverRegistriesNoNamespace(t *testing.T) {
+	got := registry.FailoverRegistries("mirror:443", []string{"fallback:443"})
+	assert.Equal(t, []string{"mirror:443", "fallback:443"}, got)
+}
+
+func TestWithFailover(t *testing.T) {
+	var tried []string
+	err := registry.WithFailover([]string{"mirror", "fallback-1", "fallback-2"}, func(r string) error {
+		tried = append(tried, r)
+		if r == "mirror" {
+			return fmt.Errorf("oops")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mirror", "fallback-1"}, tried)
+}
+
+func TestWithFailoverAllFail(t *testing.T) {
+	err := registry.WithFailover([]string{"mirror", "fallback"}, func(r string) error {
+		return fmt.Errorf("%s unreachable", r)
+	})
+	assert.EqualError(t, err, "all registries failed: [mirror unreachable, fallback unreachable]")
+}
+
+func TestWithFailoverSingleRegistry(t *testing.T) {
+	err := registry.WithFailover([]string{"mirror"}, func(r string) error {
+		return fmt.Errorf("%s unreachable", r)
+	})
+	assert.EqualError(t, err, "mirror unreachable")
+}
+
+func TestWithFailoverNoRegistries(t *testing.T) {
+	err := registry.WithFailover(nil, func(string) error { return nil })
+	assert.EqualError(t, err, "no registries provided")
+}
@@ -37,3 +37,18 @@ func ToAPIEndpoints(URLs map[string]string) map[string]string {
 	}
 	return endpoints
 }
+
+// ToAPIEndpointLists utilizes ToAPIEndpoint to turn all the lists of URLs
+// from a map to valid API endpoints for a local registry.
+func ToAPIEndpointLists(URLs map[string][]string) map[string][]string {
+	if URLs == nil {
+		return nil
+	}
+	endpoints := make(map[string][]string, len(URLs))
+	for key, urls := range URLs {
+		for _, url := range urls {
+			endpoints[key] = append(endpoints[key], ToAPIEndpoint(url))
+		}
+	}
+	return endpoints
+}
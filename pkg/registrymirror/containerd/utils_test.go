@@ -73,3 +73,15 @@ func TestToAPIEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestToAPIEndpointLists(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(containerd.ToAPIEndpointLists(nil)).To(BeNil())
+	g.Expect(containerd.ToAPIEndpointLists(map[string][]string{
+		constants.DefaultCoreEKSARegistry:        {"1.2.3.4:443", "1.2.3.5:443"},
+		constants.DefaultCuratedPackagesRegistry: {"1.2.3.4:443/curated-packages", "1.2.3.5:443/curated-packages"},
+	})).To(Equal(map[string][]string{
+		constants.DefaultCoreEKSARegistry:        {"1.2.3.4:443", "1.2.3.5:443"},
+		constants.DefaultCuratedPackagesRegistry: {"1.2.3.4:443/v2/curated-packages", "1.2.3.5:443/v2/curated-packages"},
+	}))
+}
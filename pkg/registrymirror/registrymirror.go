@@ -24,6 +24,21 @@ type RegistryMirror struct {
 	// InsecureSkipVerify skips the registry certificate verification.
 	// Only use this solution for isolated testing or in a tightly controlled, air-gapped environment.
 	InsecureSkipVerify bool
+	// Fallbacks is the ordered list of registry mirror endpoints to pull from when BaseRegistry is not reachable.
+	// They host the same namespaces as BaseRegistry.
+	Fallbacks []Endpoint
+}
+
+// Endpoint is a fallback registry mirror endpoint.
+type Endpoint struct {
+	// BaseRegistry is the address of the registry mirror endpoint without namespace. Just the host and the port.
+	BaseRegistry string
+	// Auth should be marked as true if authentication is required for the registry mirror endpoint
+	Auth bool
+	// CACertContent defines the contents of the registry mirror endpoint CA certificate
+	CACertContent string
+	// InsecureSkipVerify skips the registry mirror endpoint certificate verification.
+	InsecureSkipVerify bool
 }
 
 var re = regexp.MustCompile(constants.DefaultCuratedPackagesRegistryRegex)
@@ -57,13 +72,37 @@ func FromClusterRegistryMirrorConfiguration(config *v1alpha1.RegistryMirrorConfi
 		// when no namespace mapping is specified
 		registryMap[constants.DefaultCoreEKSARegistry] = base
 	}
+	var fallbacks []Endpoint
+	for _, fallback := range config.FallbackEndpoints {
+		fallbacks = append(fallbacks, Endpoint{
+			BaseRegistry:       net.JoinHostPort(fallback.Endpoint, fallback.Port),
+			Auth:               fallback.Authenticate,
+			CACertContent:      fallback.CACertContent,
+			InsecureSkipVerify: fallback.InsecureSkipVerify,
+		})
+	}
 	return &RegistryMirror{
 		BaseRegistry:          base,
 		NamespacedRegistryMap: registryMap,
 		Auth:                  config.Authenticate,
 		CACertContent:         config.CACertContent,
 		InsecureSkipVerify:    config.InsecureSkipVerify,
+		Fallbacks:             fallbacks,
+	}
+}
+
+// FallbackRegistryMap returns, for each artifact registry, the ordered list of its mirrors in the fallback endpoints.
+func (r *RegistryMirror) FallbackRegistryMap() map[string][]string {
+	if len(r.Fallbacks) == 0 {
+		return nil
+	}
+	registryMap := make(map[string][]string, len(r.NamespacedRegistryMap))
+	for registry, mirror := range r.NamespacedRegistryMap {
+		for _, fallback := range r.Fallbacks {
+			registryMap[registry] = append(registryMap[registry], strings.Replace(mirror, r.BaseRegistry, fallback.BaseRegistry, 1))
+		}
 	}
+	return registryMap
 }
 
 // CoreEKSAMirror returns the configured mirror for public.ecr.aws.
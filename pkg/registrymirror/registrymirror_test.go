@@ -200,6 +200,34 @@ func TestFromClusterRegistryMirrorConfiguration(t *testing.T) {
 				Auth: false,
 			},
 		},
+		{
+			testName: "fallback endpoints",
+			config: &v1alpha1.RegistryMirrorConfiguration{
+				Endpoint: "harbor.eksa.demo",
+				Port:     "30003",
+				FallbackEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+					{
+						Endpoint:      "harbor-2.eksa.demo",
+						Port:          "443",
+						CACertContent: "ca",
+						Authenticate:  true,
+					},
+				},
+			},
+			want: &registrymirror.RegistryMirror{
+				BaseRegistry: "harbor.eksa.demo:30003",
+				NamespacedRegistryMap: map[string]string{
+					constants.DefaultCoreEKSARegistry: "harbor.eksa.demo:30003",
+				},
+				Fallbacks: []registrymirror.Endpoint{
+					{
+						BaseRegistry:  "harbor-2.eksa.demo:443",
+						CACertContent: "ca",
+						Auth:          true,
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -214,11 +242,41 @@ func TestFromClusterRegistryMirrorConfiguration(t *testing.T) {
 					g.Expect(result.NamespacedRegistryMap).Should(HaveKeyWithValue(k, v))
 				}
 				g.Expect(result.Auth).To(Equal(tt.want.Auth))
+				g.Expect(result.Fallbacks).To(Equal(tt.want.Fallbacks))
 			}
 		})
 	}
 }
 
+func TestFallbackRegistryMap(t *testing.T) {
+	g := NewWithT(t)
+	r := registrymirror.FromClusterRegistryMirrorConfiguration(&v1alpha1.RegistryMirrorConfiguration{
+		Endpoint: "harbor.eksa.demo",
+		Port:     "30003",
+		OCINamespaces: []v1alpha1.OCINamespace{
+			{
+				Registry:  "public.ecr.aws",
+				Namespace: "eks-anywhere",
+			},
+			{
+				Registry:  "783794618700.dkr.ecr.us-west-2.amazonaws.com",
+				Namespace: "curated-packages",
+			},
+		},
+		FallbackEndpoints: []v1alpha1.RegistryMirrorEndpoint{
+			{Endpoint: "harbor-2.eksa.demo", Port: "443"},
+			{Endpoint: "harbor-3.eksa.demo", Port: "443"},
+		},
+	})
+	g.Expect(r.FallbackRegistryMap()).To(Equal(map[string][]string{
+		constants.DefaultCoreEKSARegistry:        {"harbor-2.eksa.demo:443/eks-anywhere", "harbor-3.eksa.demo:443/eks-anywhere"},
+		constants.DefaultCuratedPackagesRegistry: {"harbor-2.eksa.demo:443/curated-packages", "harbor-3.eksa.demo:443/curated-packages"},
+	}))
+
+	r.Fallbacks = nil
+	g.Expect(r.FallbackRegistryMap()).To(BeNil())
+}
+
 func TestCoreEKSAMirror(t *testing.T) {
 	testCases := []struct {
 		testName       string
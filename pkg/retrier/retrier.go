@@ -4,6 +4,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/logger"
 )
 
@@ -107,6 +108,12 @@ func (r *Retrier) Retry(fn func() error) error {
 		}
 
 		logger.V(5).Info("Sleeping before next retry", "time", wait)
+		events.Record(events.Event{
+			Type:        events.Retry,
+			Attempt:     retries,
+			WaitSeconds: wait.Seconds(),
+			Error:       err.Error(),
+		})
 		time.Sleep(wait)
 	}
 
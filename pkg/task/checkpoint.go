@@ -0,0 +1,223 @@
+package task
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/logger"
+)
+
+// CheckpointVersion is the version of the checkpoint file format written by the task runner.
+const CheckpointVersion = "v1"
+
+// Operation is the cluster operation a checkpoint was saved for.
+type Operation string
+
+const (
+	// UpgradeOperation is the upgrade cluster operation.
+	UpgradeOperation Operation = "upgrade"
+	// DeleteOperation is the delete cluster operation.
+	DeleteOperation Operation = "delete"
+)
+
+// ClusterGenerationReader reads the generation of the EKS-A Cluster object the tasks operate on.
+type ClusterGenerationReader func(ctx context.Context, commandContext *CommandContext) (int64, error)
+
+type TaskCheckpoint interface{}
+
+// CheckpointInfo is the content of the checkpoint file. The task runner writes it, as
+// <cluster-name>-checkpoint.yaml in the writer's temp folder, every time a run fails,
+// so a later run can skip the tasks that already completed.
+//
+//	version: v1
+//	operation: upgrade
+//	clusterName: my-cluster
+//	clusterState:
+//	  specHash: 3f5c...
+//	  generation: 4
+//	completedTasks:
+//	  setup-and-validate:
+//	    checkpoint: null
+//	  upgrade-core-components:
+//	    checkpoint:
+//	      components: [...]
+//
+// Version, operation, cluster name and cluster state are optional so checkpoints written
+// before they were introduced can still be read. The checkpoint of each completed task is
+// opaque to the runner and only interpreted by the task's Restore method.
+type CheckpointInfo struct {
+	Version        string                    `json:"version,omitempty"`
+	Operation      Operation                 `json:"operation,omitempty"`
+	ClusterName    string                    `json:"clusterName,omitempty"`
+	ClusterState   *ClusterState             `json:"clusterState,omitempty"`
+	CompletedTasks map[string]*CompletedTask `json:"completedTasks"`
+}
+
+// ClusterState identifies the state of the cluster when a checkpoint was saved.
+type ClusterState struct {
+	// SpecHash is the sha256 of the desired EKS-A Cluster spec.
+	SpecHash string `json:"specHash"`
+	// Generation is the generation of the EKS-A Cluster object. It's omitted when
+	// it couldn't be read, for example because the cluster was already deleted.
+	Generation int64 `json:"generation,omitempty"`
+}
+
+type CompletedTask struct {
+	Checkpoint TaskCheckpoint `json:"checkpoint"`
+}
+
+func newCheckpointInfo() CheckpointInfo {
+	return CheckpointInfo{
+		CompletedTasks: make(map[string]*CompletedTask),
+	}
+}
+
+func (c CheckpointInfo) taskCompleted(name string, completedTask *CompletedTask) {
+	c.CompletedTasks[name] = completedTask
+}
+
+func (tr *taskRunner) saveCheckpoint(ctx context.Context, commandContext *CommandContext, checkpointInfo CheckpointInfo, filename string) error {
+	logger.V(4).Info("Saving checkpoint", "file", filename)
+	checkpointInfo.Version = CheckpointVersion
+	checkpointInfo.Operation = tr.operation
+	checkpointInfo.ClusterName = commandContext.ClusterSpec.Cluster.Name
+	checkpointInfo.ClusterState = tr.clusterState(ctx, commandContext)
+	content, err := yaml.Marshal(checkpointInfo)
+	if err != nil {
+		return fmt.Errorf("saving task runner checkpoint: %v\n", err)
+	}
+
+	if _, err = tr.writer.Write(filename, content); err != nil {
+		return fmt.Errorf("saving task runner checkpoint: %v\n", err)
+	}
+	return nil
+}
+
+func (tr *taskRunner) setupCheckpointInfo(ctx context.Context, commandContext *CommandContext, checkpointFileName string) (CheckpointInfo, error) {
+	checkpointInfo := newCheckpointInfo()
+	if !tr.withCheckpoint {
+		return checkpointInfo, nil
+	}
+
+	checkpointFilePath := filepath.Join(commandContext.Writer.TempDir(), checkpointFileName)
+	if _, err := os.Stat(checkpointFilePath); err != nil {
+		if tr.resume {
+			return checkpointInfo, fmt.Errorf("no checkpoint to resume from found in %s", checkpointFilePath)
+		}
+		return checkpointInfo, nil
+	}
+
+	checkpointFile, err := readCheckpointFile(checkpointFilePath)
+	if err != nil {
+		return checkpointInfo, err
+	}
+	if err = tr.validateCheckpoint(ctx, commandContext, checkpointFile); err != nil {
+		return checkpointInfo, fmt.Errorf("validating checkpoint %s: %v", checkpointFilePath, err)
+	}
+	checkpointInfo.CompletedTasks = checkpointFile.CompletedTasks
+
+	return checkpointInfo, nil
+}
+
+// validateCheckpoint checks the checkpoint was saved for the same operation and cluster
+// and that the cluster hasn't changed since.
+func (tr *taskRunner) validateCheckpoint(ctx context.Context, commandContext *CommandContext, checkpointInfo *CheckpointInfo) error {
+	if checkpointInfo.Version != "" && checkpointInfo.Version != CheckpointVersion {
+		return fmt.Errorf("unsupported checkpoint version %s, supported version is %s", checkpointInfo.Version, CheckpointVersion)
+	}
+
+	if checkpointInfo.Operation != "" && tr.operation != "" && checkpointInfo.Operation != tr.operation {
+		return fmt.Errorf("checkpoint was saved by a %s operation and can't be used to resume a %s operation", checkpointInfo.Operation, tr.operation)
+	}
+
+	clusterName := commandContext.ClusterSpec.Cluster.Name
+	if checkpointInfo.ClusterName != "" && checkpointInfo.ClusterName != clusterName {
+		return fmt.Errorf("checkpoint was saved for cluster %s, not %s", checkpointInfo.ClusterName, clusterName)
+	}
+
+	if checkpointInfo.ClusterState == nil {
+		logger.V(4).Info("Checkpoint doesn't contain the cluster state, skipping cluster state validation")
+		return nil
+	}
+
+	current := tr.clusterState(ctx, commandContext)
+	if current.SpecHash != checkpointInfo.ClusterState.SpecHash {
+		return fmt.Errorf("cluster spec has changed since the checkpoint was saved")
+	}
+
+	if current.Generation != 0 && checkpointInfo.ClusterState.Generation != 0 && current.Generation != checkpointInfo.ClusterState.Generation {
+		return fmt.Errorf("cluster %s has been modified since the checkpoint was saved: generation was %d, now is %d",
+			clusterName, checkpointInfo.ClusterState.Generation, current.Generation)
+	}
+
+	return nil
+}
+
+// clusterState returns the current state of the cluster. Reading the generation is best effort,
+// it's left empty if it fails.
+func (tr *taskRunner) clusterState(ctx context.Context, commandContext *CommandContext) *ClusterState {
+	state := &ClusterState{
+		SpecHash: specHash(commandContext.ClusterSpec),
+	}
+
+	if tr.generationReader != nil {
+		generation, err := tr.generationReader(ctx, commandContext)
+		if err != nil {
+			logger.V(4).Info("Failed reading cluster generation for checkpoint", "error", err)
+		} else {
+			state.Generation = generation
+		}
+	}
+
+	return state
+}
+
+func specHash(spec *cluster.Spec) string {
+	content, err := json.Marshal(spec.Cluster.Spec)
+	if err != nil {
+		logger.V(4).Info("Failed hashing cluster spec for checkpoint", "error", err)
+		return ""
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func readCheckpointFile(file string) (*CheckpointInfo, error) {
+	logger.V(4).Info("Reading checkpoint", "file", file)
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading checkpoint file: %v\n", err)
+	}
+	checkpointInfo := &CheckpointInfo{}
+	err = yaml.Unmarshal(content, checkpointInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed unmarshalling checkpoint: %v\n", err)
+	}
+
+	return checkpointInfo, nil
+}
+
+/*
+	UnmarshalTaskCheckpoint marshals the received task checkpoint (type interface{}) then unmarshalls it into the desired type
+
+specified in the Restore() method.
+When reading from a yaml file, there isn't a direct way in Go to do a type conversion from interface{} to the desired type.
+We use interface{} because the TaskCheckpoint type will vary depending on what's needed for a specific task. The known workaround
+for this is to marshal & unmarshal it into the checkpoint type.
+*/
+func UnmarshalTaskCheckpoint(taskCheckpoint TaskCheckpoint, config TaskCheckpoint) error {
+	checkpointYaml, err := yaml.Marshal(taskCheckpoint)
+	if err != nil {
+		return nil
+	}
+	return yaml.Unmarshal(checkpointYaml, config)
+}
@@ -0,0 +1,100 @@
+package task_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/pkg/events"
+	"github.com/aws/eks-anywhere/pkg/task"
+)
+
+type eventsRecorder struct {
+	lock   sync.Mutex
+	events []events.Event
+}
+
+func (r *eventsRecorder) Record(e events.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *eventsRecorder) types() []string {
+	var types []string
+	for _, e := range r.events {
+		types = append(types, string(e.Type)+":"+e.Task)
+	}
+	return types
+}
+
+func TestTaskRunnerRunTaskRecordsEvents(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	recorder := &eventsRecorder{}
+	events.SetRecorder(recorder)
+	t.Cleanup(func() { events.SetRecorder(nil) })
+	wantErr := errors.New("task failed")
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(tt.taskB)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.SetError(wantErr)
+		return tt.taskC
+	})
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskC.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	tt.taskC.EXPECT().Name().Return("taskC").AnyTimes()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any())
+
+	g.Expect(task.NewTaskRunner(tt.taskA, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(MatchError(wantErr))
+	g.Expect(recorder.types()).To(Equal([]string{
+		"WorkflowStarted:",
+		"TaskStarted:taskA",
+		"TaskFinished:taskA",
+		"TaskStarted:taskB",
+		"TaskFailed:taskB",
+		"TaskStarted:taskC",
+		"TaskFinished:taskC",
+		"WorkflowFailed:",
+	}))
+	g.Expect(recorder.events[4].Error).To(Equal("task failed"))
+}
+
+func TestParallelTaskRecordsEventsPerTask(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	recorder := &eventsRecorder{}
+	events.SetRecorder(recorder)
+	t.Cleanup(func() { events.SetRecorder(nil) })
+	wantErr := errors.New("task failed")
+	failed := make(chan struct{})
+
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.SetError(wantErr)
+		close(failed)
+		return nil
+	})
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	// taskB finishes after its sibling fails, but it shouldn't be reported as failed.
+	tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, _ *task.CommandContext) task.Task {
+		<-failed
+		return nil
+	})
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any())
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(MatchError(wantErr))
+	g.Expect(recorder.types()).To(ContainElements("TaskFailed:taskA", "TaskFinished:taskB", "TaskFailed:parallel"))
+	g.Expect(recorder.types()).NotTo(ContainElement("TaskFailed:taskB"))
+}
@@ -0,0 +1,128 @@
+package task
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/eks-anywhere/pkg/features"
+)
+
+// ParallelTask runs a group of tasks that don't depend on each other at the same time and
+// continues with Next once all of them have finished.
+// Each task in the group runs together with the tasks it returns until the chain ends, so error
+// handling tasks like diagnostics collection still run for the task that failed. If any of them
+// sets an error in the CommandContext, Next is not run.
+// Each chain runs with its own copy of the CommandContext, so an error is only seen by the chain
+// that set it. Once all of them have finished, the state they set (cluster specs, clusters, backup
+// dir and cleanup flag) is merged back into the shared CommandContext. If several chains set the
+// same field, the one that comes last in Tasks wins.
+// The tasks in the group are profiled and checkpointed individually, so resuming after a failure
+// only runs the ones that didn't complete. Setting the SEQUENTIAL_TASKS feature flag runs the
+// group one task at a time, in order, for debugging.
+type ParallelTask struct {
+	TaskName string
+	Tasks    []Task
+	Next     Task
+}
+
+// Run runs all the tasks in the group and waits for them to finish.
+func (p *ParallelTask) Run(ctx context.Context, commandContext *CommandContext) Task {
+	// Each chain gets its own copy of the context, with its own copy of the checkpoint to record
+	// completed tasks, since they can't write to the same map at the same time.
+	base := *commandContext
+	contexts := make([]*CommandContext, len(p.Tasks))
+	for i := range p.Tasks {
+		chainContext := *commandContext
+		chainContext.checkpointInfo = newCheckpointInfo()
+		for name, completedTask := range commandContext.checkpointInfo.CompletedTasks {
+			chainContext.checkpointInfo.taskCompleted(name, completedTask)
+		}
+		contexts[i] = &chainContext
+	}
+
+	if features.IsActive(features.SequentialTasks()) {
+		for i, t := range p.Tasks {
+			runTaskChain(ctx, contexts[i], t)
+		}
+	} else {
+		var wg sync.WaitGroup
+		for i, t := range p.Tasks {
+			wg.Add(1)
+			go func(t Task, chainContext *CommandContext) {
+				defer wg.Done()
+				runTaskChain(ctx, chainContext, t)
+			}(t, contexts[i])
+		}
+		wg.Wait()
+	}
+
+	for _, chainContext := range contexts {
+		commandContext.mergeState(&base, chainContext)
+		if chainContext.OriginalError != nil {
+			commandContext.SetError(chainContext.OriginalError)
+		}
+		if commandContext.checkpointInfo.CompletedTasks != nil {
+			for name, completedTask := range chainContext.checkpointInfo.CompletedTasks {
+				commandContext.checkpointInfo.taskCompleted(name, completedTask)
+			}
+		}
+	}
+
+	if commandContext.OriginalError != nil {
+		return nil
+	}
+
+	return p.Next
+}
+
+// Name returns the name of the group.
+func (p *ParallelTask) Name() string {
+	return p.TaskName
+}
+
+// Checkpoint implements the Task interface.
+func (p *ParallelTask) Checkpoint() *CompletedTask {
+	return &CompletedTask{
+		Checkpoint: nil,
+	}
+}
+
+// Restore skips the whole group, since all of its tasks completed in a previous run.
+// Tasks in the group completed in a previous run that failed are restored individually by Run.
+func (p *ParallelTask) Restore(ctx context.Context, commandContext *CommandContext, completedTask *CompletedTask) (Task, error) {
+	return p.Next, nil
+}
+
+func runTaskChain(ctx context.Context, commandContext *CommandContext, task Task) {
+	if err := runTasks(ctx, commandContext, task, commandContext.checkpointInfo); err != nil {
+		commandContext.SetError(err)
+	}
+}
+
+// mergeState sets in c the state fields that chain changed from base.
+func (c *CommandContext) mergeState(base, chain *CommandContext) {
+	if chain.ClusterSpec != base.ClusterSpec {
+		c.ClusterSpec = chain.ClusterSpec
+	}
+	if chain.CurrentClusterSpec != base.CurrentClusterSpec {
+		c.CurrentClusterSpec = chain.CurrentClusterSpec
+	}
+	if chain.UpgradeChangeDiff != base.UpgradeChangeDiff {
+		c.UpgradeChangeDiff = chain.UpgradeChangeDiff
+	}
+	if chain.BootstrapCluster != base.BootstrapCluster {
+		c.BootstrapCluster = chain.BootstrapCluster
+	}
+	if chain.ManagementCluster != base.ManagementCluster {
+		c.ManagementCluster = chain.ManagementCluster
+	}
+	if chain.WorkloadCluster != base.WorkloadCluster {
+		c.WorkloadCluster = chain.WorkloadCluster
+	}
+	if chain.BackupClusterStateDir != base.BackupClusterStateDir {
+		c.BackupClusterStateDir = chain.BackupClusterStateDir
+	}
+	if chain.ForceCleanup != base.ForceCleanup {
+		c.ForceCleanup = chain.ForceCleanup
+	}
+}
@@ -0,0 +1,202 @@
+package task_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws/eks-anywhere/pkg/features"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
+	"github.com/aws/eks-anywhere/pkg/task"
+	mocktasks "github.com/aws/eks-anywhere/pkg/task/mocks"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+func TestParallelTaskRun(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	next := mocktasks.NewMockTask(gomock.NewController(t))
+
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).Return(tt.taskC)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil)
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+	tt.taskC.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil)
+	tt.taskC.EXPECT().Name().Return("taskC").AnyTimes()
+	tt.taskC.EXPECT().Checkpoint()
+	next.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	next.EXPECT().Name().Return("next").AnyTimes()
+	next.EXPECT().Checkpoint()
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+		Next:     next,
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+	for _, name := range []string{"parallel", "taskA", "taskB", "taskC", "next"} {
+		g.Expect(tt.cmdContext.Profiler.Metrics()).To(HaveKey(name))
+	}
+}
+
+func TestParallelTaskRunSequential(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	t.Setenv(features.SequentialTasksEnvVar, "true")
+	features.ClearCache()
+	t.Cleanup(features.ClearCache)
+
+	gomock.InOrder(
+		tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil),
+		tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil),
+	)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+}
+
+func TestParallelTaskRunError(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	// Next shouldn't run when a task in the group fails.
+	next := mocktasks.NewMockTask(gomock.NewController(t))
+	wantErr := errors.New("task failed")
+
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.SetError(wantErr)
+		return nil
+	})
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil)
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any())
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+		Next:     next,
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(MatchError(wantErr))
+}
+
+func TestParallelTaskRunErrorCheckpointsCompletedTasks(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	t.Setenv(features.SequentialTasksEnvVar, "true")
+	features.ClearCache()
+	t.Cleanup(features.ClearCache)
+	var content []byte
+
+	tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil)
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint().Return(&task.CompletedTask{})
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.SetError(errors.New("task failed"))
+		return nil
+	})
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).DoAndReturn(
+		func(_ string, c []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+			content = c
+			return "", nil
+		},
+	)
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskB, tt.taskA},
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(MatchError("task failed"))
+
+	checkpoint := &task.CheckpointInfo{}
+	g.Expect(yaml.Unmarshal(content, checkpoint)).To(Succeed())
+	g.Expect(checkpoint.CompletedTasks).To(HaveKey("taskB"))
+	g.Expect(checkpoint.CompletedTasks).NotTo(HaveKey("taskA"))
+	g.Expect(checkpoint.CompletedTasks).NotTo(HaveKey("parallel"))
+}
+
+func TestParallelTaskRunRestoresCompletedTasks(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	next := mocktasks.NewMockTask(gomock.NewController(t))
+	dir := t.TempDir()
+	content := []byte("version: v1\ncompletedTasks:\n  taskB:\n    checkpoint: null\n")
+	g.Expect(os.WriteFile(filepath.Join(dir, "test-cluster-checkpoint.yaml"), content, 0o644)).To(Succeed())
+	t.Setenv(features.CheckpointEnabledEnvVar, "true")
+	tt.writer.EXPECT().TempDir().Return(dir)
+
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).Return(nil)
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Restore(tt.ctx, gomock.Any(), gomock.Any()).Return(nil, nil)
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	next.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	next.EXPECT().Name().Return("next").AnyTimes()
+	next.EXPECT().Checkpoint()
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+		Next:     next,
+	}
+
+	runner := task.NewTaskRunner(parallel, tt.writer, task.WithCheckpointFile())
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+}
+
+func TestParallelTaskRunMergesChainState(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	next := mocktasks.NewMockTask(gomock.NewController(t))
+	workloadCluster := &types.Cluster{Name: "workload"}
+	managementCluster := &types.Cluster{Name: "management"}
+
+	tt.taskA.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.WorkloadCluster = workloadCluster
+		return nil
+	})
+	tt.taskA.EXPECT().Name().Return("taskA").AnyTimes()
+	tt.taskA.EXPECT().Checkpoint()
+	tt.taskB.EXPECT().Run(tt.ctx, gomock.Any()).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		c.ManagementCluster = managementCluster
+		c.BackupClusterStateDir = "backup"
+		return nil
+	})
+	tt.taskB.EXPECT().Name().Return("taskB").AnyTimes()
+	tt.taskB.EXPECT().Checkpoint()
+	next.EXPECT().Run(tt.ctx, tt.cmdContext).DoAndReturn(func(_ interface{}, c *task.CommandContext) task.Task {
+		g.Expect(c.WorkloadCluster).To(Equal(workloadCluster))
+		g.Expect(c.ManagementCluster).To(Equal(managementCluster))
+		g.Expect(c.BackupClusterStateDir).To(Equal("backup"))
+		return nil
+	})
+	next.EXPECT().Name().Return("next").AnyTimes()
+	next.EXPECT().Checkpoint()
+
+	parallel := &task.ParallelTask{
+		TaskName: "parallel",
+		Tasks:    []task.Task{tt.taskA, tt.taskB},
+		Next:     next,
+	}
+
+	g.Expect(task.NewTaskRunner(parallel, tt.writer).RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+}
@@ -3,13 +3,11 @@ package task
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sync"
 	"time"
 
-	"sigs.k8s.io/yaml"
-
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/filewriter"
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/providers"
@@ -54,6 +52,10 @@ type CommandContext struct {
 	ForceCleanup          bool
 	ClusterMover          interfaces.ClusterMover
 	IamAuth               interfaces.AwsIamAuth
+
+	// checkpointInfo holds the tasks completed in a previous run and records the ones
+	// that complete in this one.
+	checkpointInfo CheckpointInfo
 }
 
 func (c *CommandContext) SetError(err error) {
@@ -62,9 +64,17 @@ func (c *CommandContext) SetError(err error) {
 	}
 }
 
+// TaskCompleted returns whether the task with the given name completed, in this run or in a
+// previous one recorded in the checkpoint.
+func (c *CommandContext) TaskCompleted(name string) bool {
+	_, ok := c.checkpointInfo.CompletedTasks[name]
+	return ok
+}
+
 type Profiler struct {
 	metrics map[string]map[string]time.Duration
 	starts  map[string]map[string]time.Time
+	lock    sync.Mutex
 }
 
 // profiler for a Task.
@@ -74,6 +84,8 @@ func (pp *Profiler) SetStartTask(taskName string) {
 
 // this can be used to profile sub tasks.
 func (pp *Profiler) SetStart(taskName string, msg string) {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
 	if _, ok := pp.starts[taskName]; !ok {
 		pp.starts[taskName] = map[string]time.Time{}
 	}
@@ -87,6 +99,8 @@ func (pp *Profiler) MarkDoneTask(taskName string) {
 
 // this can be used to profile sub tasks.
 func (pp *Profiler) MarkDone(taskName string, msg string) {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
 	if _, ok := pp.metrics[taskName]; !ok {
 		pp.metrics[taskName] = map[string]time.Duration{}
 	}
@@ -102,6 +116,8 @@ func (pp *Profiler) Metrics() map[string]map[string]time.Duration {
 
 // debug logs for task metric.
 func (pp *Profiler) logProfileSummary(taskName string) {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
 	if durationMap, ok := pp.metrics[taskName]; ok {
 		for k, v := range durationMap {
 			if k != taskName {
@@ -117,13 +133,18 @@ func (pp *Profiler) logProfileSummary(taskName string) {
 
 // Manages Task execution.
 type taskRunner struct {
-	task           Task
-	writer         filewriter.FileWriter
-	withCheckpoint bool
+	task             Task
+	writer           filewriter.FileWriter
+	withCheckpoint   bool
+	resume           bool
+	operation        Operation
+	generationReader ClusterGenerationReader
 }
 
 type TaskRunnerOpt func(*taskRunner)
 
+// WithCheckpointFile makes the runner restore the tasks completed in a previous run
+// from the checkpoint file, if it exists.
 func WithCheckpointFile() TaskRunnerOpt {
 	return func(t *taskRunner) {
 		logger.V(4).Info("Checkpoint feature enabled")
@@ -131,6 +152,31 @@ func WithCheckpointFile() TaskRunnerOpt {
 	}
 }
 
+// WithResume makes the runner resume a previous run from its checkpoint file.
+// Unlike WithCheckpointFile, it fails if the checkpoint file doesn't exist.
+func WithResume() TaskRunnerOpt {
+	return func(t *taskRunner) {
+		t.withCheckpoint = true
+		t.resume = true
+	}
+}
+
+// WithOperation sets the operation recorded in the checkpoint file. When resuming,
+// the runner refuses checkpoints saved by a different operation.
+func WithOperation(operation Operation) TaskRunnerOpt {
+	return func(t *taskRunner) {
+		t.operation = operation
+	}
+}
+
+// WithClusterGenerationReader configures how the runner reads the generation of the
+// EKS-A Cluster object, used to record and validate the cluster state in the checkpoint.
+func WithClusterGenerationReader(reader ClusterGenerationReader) TaskRunnerOpt {
+	return func(t *taskRunner) {
+		t.generationReader = reader
+	}
+}
+
 func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContext) error {
 	checkpointFileName := fmt.Sprintf("%s-checkpoint.yaml", commandContext.ClusterSpec.Cluster.Name)
 	var checkpointInfo CheckpointInfo
@@ -143,16 +189,38 @@ func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContex
 	}
 	task := tr.task
 	start := time.Now()
-	defer taskRunnerFinalBlock(start)
+	events.Record(events.Started(events.WorkflowStarted, ""))
+	defer func() {
+		taskRunnerFinalBlock(start, err)
+	}()
 
-	checkpointInfo, err = tr.setupCheckpointInfo(commandContext, checkpointFileName)
+	checkpointInfo, err = tr.setupCheckpointInfo(ctx, commandContext, checkpointFileName)
 	if err != nil {
 		return err
 	}
 
+	commandContext.checkpointInfo = checkpointInfo
+	if err = runTasks(ctx, commandContext, task, checkpointInfo); err != nil {
+		return err
+	}
+	if commandContext.OriginalError != nil {
+		if err = tr.saveCheckpoint(ctx, commandContext, checkpointInfo, checkpointFileName); err != nil {
+			return err
+		}
+	}
+	err = commandContext.OriginalError
+	return err
+}
+
+// runTasks runs task and the tasks it returns until the chain ends. Tasks completed in a previous
+// run are restored from checkpointInfo instead of run, and the ones that complete without an error
+// are recorded in it.
+func runTasks(ctx context.Context, commandContext *CommandContext, task Task, checkpointInfo CheckpointInfo) error {
 	for task != nil {
-		if completedTask, ok := checkpointInfo.CompletedTasks[task.Name()]; ok {
-			logger.V(4).Info("Restoring task", "task_name", task.Name())
+		name := task.Name()
+		if completedTask, ok := checkpointInfo.CompletedTasks[name]; ok {
+			logger.V(4).Info("Restoring task", "task_name", name)
+			events.Record(events.Started(events.TaskRestored, name))
 			nextTask, err := task.Restore(ctx, commandContext, completedTask)
 			if err != nil {
 				return fmt.Errorf("restoring checkpoint info: %v", err)
@@ -160,26 +228,42 @@ func (tr *taskRunner) RunTask(ctx context.Context, commandContext *CommandContex
 			task = nextTask
 			continue
 		}
-		logger.V(4).Info("Task start", "task_name", task.Name())
-		commandContext.Profiler.SetStartTask(task.Name())
-		nextTask := task.Run(ctx, commandContext)
-		commandContext.Profiler.MarkDoneTask(task.Name())
-		commandContext.Profiler.logProfileSummary(task.Name())
+		nextTask := runTask(ctx, commandContext, task)
 		if commandContext.OriginalError == nil {
-			checkpointInfo.taskCompleted(task.Name(), task.Checkpoint())
+			checkpointInfo.taskCompleted(name, task.Checkpoint())
 		}
 		task = nextTask
 	}
-	if commandContext.OriginalError != nil {
-		if err := tr.saveCheckpoint(checkpointInfo, checkpointFileName); err != nil {
-			return err
-		}
+
+	return nil
+}
+
+// runTask runs a single task, profiling it and recording its progress events.
+func runTask(ctx context.Context, commandContext *CommandContext, task Task) Task {
+	name := task.Name()
+	logger.V(4).Info("Task start", "task_name", name)
+	events.Record(events.Started(events.TaskStarted, name))
+	start := time.Now()
+	commandContext.Profiler.SetStartTask(name)
+	previousErr := commandContext.OriginalError
+
+	nextTask := task.Run(ctx, commandContext)
+
+	commandContext.Profiler.MarkDoneTask(name)
+	commandContext.Profiler.logProfileSummary(name)
+	// Only the task that sets the error fails, tasks run after it to handle the error don't.
+	var taskErr error
+	if err := commandContext.OriginalError; previousErr == nil && err != nil {
+		taskErr = err
 	}
-	return commandContext.OriginalError
+	events.Record(events.Finished(events.TaskFinished, events.TaskFailed, name, start, taskErr))
+
+	return nextTask
 }
 
-func taskRunnerFinalBlock(startTime time.Time) {
+func taskRunnerFinalBlock(startTime time.Time, err error) {
 	logger.V(4).Info("Tasks completed", "duration", time.Since(startTime))
+	events.Record(events.Finished(events.WorkflowFinished, events.WorkflowFailed, "", startTime, err))
 }
 
 func NewTaskRunner(task Task, writer filewriter.FileWriter, opts ...TaskRunnerOpt) *taskRunner {
@@ -193,82 +277,3 @@ func NewTaskRunner(task Task, writer filewriter.FileWriter, opts ...TaskRunnerOp
 	}
 	return t
 }
-
-func (tr *taskRunner) saveCheckpoint(checkpointInfo CheckpointInfo, filename string) error {
-	logger.V(4).Info("Saving checkpoint", "file", filename)
-	content, err := yaml.Marshal(checkpointInfo)
-	if err != nil {
-		return fmt.Errorf("saving task runner checkpoint: %v\n", err)
-	}
-
-	if _, err = tr.writer.Write(filename, content); err != nil {
-		return fmt.Errorf("saving task runner checkpoint: %v\n", err)
-	}
-	return nil
-}
-
-func (tr *taskRunner) setupCheckpointInfo(commandContext *CommandContext, checkpointFileName string) (CheckpointInfo, error) {
-	checkpointInfo := newCheckpointInfo()
-	if tr.withCheckpoint {
-		checkpointFilePath := filepath.Join(commandContext.Writer.TempDir(), checkpointFileName)
-		if _, err := os.Stat(checkpointFilePath); err == nil {
-			checkpointFile, err := readCheckpointFile(checkpointFilePath)
-			if err != nil {
-				return checkpointInfo, err
-			}
-			checkpointInfo.CompletedTasks = checkpointFile.CompletedTasks
-		}
-	}
-	return checkpointInfo, nil
-}
-
-type TaskCheckpoint interface{}
-
-type CheckpointInfo struct {
-	CompletedTasks map[string]*CompletedTask `json:"completedTasks"`
-}
-
-type CompletedTask struct {
-	Checkpoint TaskCheckpoint `json:"checkpoint"`
-}
-
-func newCheckpointInfo() CheckpointInfo {
-	return CheckpointInfo{
-		CompletedTasks: make(map[string]*CompletedTask),
-	}
-}
-
-func (c CheckpointInfo) taskCompleted(name string, completedTask *CompletedTask) {
-	c.CompletedTasks[name] = completedTask
-}
-
-func readCheckpointFile(file string) (*CheckpointInfo, error) {
-	logger.V(4).Info("Reading checkpoint", "file", file)
-	content, err := os.ReadFile(file)
-	if err != nil {
-		return nil, fmt.Errorf("failed reading checkpoint file: %v\n", err)
-	}
-	checkpointInfo := &CheckpointInfo{}
-	err = yaml.Unmarshal(content, checkpointInfo)
-	if err != nil {
-		return nil, fmt.Errorf("failed unmarshalling checkpoint: %v\n", err)
-	}
-
-	return checkpointInfo, nil
-}
-
-/*
-	UnmarshalTaskCheckpoint marshals the received task checkpoint (type interface{}) then unmarshalls it into the desired type
-
-specified in the Restore() method.
-When reading from a yaml file, there isn't a direct way in Go to do a type conversion from interface{} to the desired type.
-We use interface{} because the TaskCheckpoint type will vary depending on what's needed for a specific task. The known workaround
-for this is to marshal & unmarshal it into the checkpoint type.
-*/
-func UnmarshalTaskCheckpoint(taskCheckpoint TaskCheckpoint, config TaskCheckpoint) error {
-	checkpointYaml, err := yaml.Marshal(taskCheckpoint)
-	if err != nil {
-		return nil
-	}
-	return yaml.Unmarshal(checkpointYaml, config)
-}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
 	"github.com/aws/eks-anywhere/pkg/features"
+	"github.com/aws/eks-anywhere/pkg/filewriter"
 	writermocks "github.com/aws/eks-anywhere/pkg/filewriter/mocks"
 	"github.com/aws/eks-anywhere/pkg/task"
 	mocktasks "github.com/aws/eks-anywhere/pkg/task/mocks"
@@ -21,13 +25,13 @@ func TestTaskRunnerRunTask(t *testing.T) {
 	tr := newTaskRunnerTest(t)
 
 	tr.taskA.EXPECT().Run(tr.ctx, tr.cmdContext).Return(tr.taskB).Times(1)
-	tr.taskA.EXPECT().Name().Return("taskA").Times(7)
+	tr.taskA.EXPECT().Name().Return("taskA").Times(3)
 	tr.taskA.EXPECT().Checkpoint()
 	tr.taskB.EXPECT().Run(tr.ctx, tr.cmdContext).Return(tr.taskC).Times(1)
-	tr.taskB.EXPECT().Name().Return("taskB").Times(7)
+	tr.taskB.EXPECT().Name().Return("taskB").Times(3)
 	tr.taskB.EXPECT().Checkpoint()
 	tr.taskC.EXPECT().Run(tr.ctx, tr.cmdContext).Return(nil).Times(1)
-	tr.taskC.EXPECT().Name().Return("taskC").Times(7)
+	tr.taskC.EXPECT().Name().Return("taskC").Times(3)
 	tr.taskC.EXPECT().Checkpoint()
 
 	type fields struct {
@@ -63,12 +67,12 @@ func TestTaskRunnerRunTaskWithCheckpointSecondRunSuccess(t *testing.T) {
 	tt := newTaskRunnerTest(t)
 
 	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).Return(tt.taskB, nil)
-	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(1)
 	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).Return(tt.taskC).Times(1)
-	tt.taskB.EXPECT().Name().Return("taskB").Times(6)
+	tt.taskB.EXPECT().Name().Return("taskB").Times(2)
 	tt.taskB.EXPECT().Checkpoint()
 	tt.taskC.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil).Times(1)
-	tt.taskC.EXPECT().Name().Return("taskC").Times(6)
+	tt.taskC.EXPECT().Name().Return("taskC").Times(2)
 	tt.taskC.EXPECT().Checkpoint()
 	tt.writer.EXPECT().TempDir().Return("testdata")
 
@@ -90,7 +94,7 @@ func TestTaskRunnerRunTaskWithCheckpointFirstRunFailed(t *testing.T) {
 	tt.cmdContext.OriginalError = fmt.Errorf("error")
 
 	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
-	tt.taskA.EXPECT().Name().Return("taskA").Times(5)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
 	tt.writer.EXPECT().TempDir()
 	tt.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", tt.cmdContext.ClusterSpec.Cluster.Name), gomock.Any())
 
@@ -110,7 +114,7 @@ func TestTaskRunnerRunTaskWithCheckpointSecondRunRestoreFailure(t *testing.T) {
 	tt := newTaskRunnerTest(t)
 
 	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).Return(nil, fmt.Errorf("error"))
-	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(1)
 	tt.writer.EXPECT().TempDir().Return("testdata")
 
 	tasks := []task.Task{tt.taskA, tt.taskB, tt.taskC}
@@ -131,7 +135,7 @@ func TestTaskRunnerRunTaskWithCheckpointSaveFailed(t *testing.T) {
 	tt.cmdContext.OriginalError = fmt.Errorf("error")
 
 	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
-	tt.taskA.EXPECT().Name().Return("taskA").Times(5)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
 	tt.writer.EXPECT().TempDir()
 	tt.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", tt.cmdContext.ClusterSpec.Cluster.Name), gomock.Any()).Return("", fmt.Errorf("error"))
 
@@ -179,6 +183,188 @@ func TestUnmarshalTaskCheckpointSuccess(t *testing.T) {
 	}
 }
 
+func TestTaskRunnerRunTaskSavesCheckpointWithClusterState(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	tt.cmdContext.OriginalError = fmt.Errorf("error")
+	var content []byte
+
+	tt.taskA.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	tt.taskA.EXPECT().Name().Return("taskA").Times(2)
+	tt.writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).DoAndReturn(
+		func(_ string, c []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+			content = c
+			return "", nil
+		},
+	)
+
+	runner := task.NewTaskRunner(tt.taskA, tt.writer,
+		task.WithOperation(task.UpgradeOperation),
+		task.WithClusterGenerationReader(generationReader(4, nil)),
+	)
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(MatchError("error"))
+
+	checkpoint := &task.CheckpointInfo{}
+	g.Expect(yaml.Unmarshal(content, checkpoint)).To(Succeed())
+	g.Expect(checkpoint.Version).To(Equal(task.CheckpointVersion))
+	g.Expect(checkpoint.Operation).To(Equal(task.UpgradeOperation))
+	g.Expect(checkpoint.ClusterName).To(Equal("test-cluster"))
+	g.Expect(checkpoint.ClusterState.SpecHash).NotTo(BeEmpty())
+	g.Expect(checkpoint.ClusterState.Generation).To(BeEquivalentTo(4))
+}
+
+func TestTaskRunnerRunTaskWithResumeNoCheckpoint(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	tt.writer.EXPECT().TempDir().Return(t.TempDir())
+
+	runner := task.NewTaskRunner(tt.taskA, tt.writer, task.WithResume())
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(MatchError(ContainSubstring("no checkpoint to resume from found")))
+}
+
+func TestTaskRunnerRunTaskWithResumeSuccess(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	dir := writeCheckpoint(t, tt.cmdContext, task.UpgradeOperation, 4)
+
+	tt.writer.EXPECT().TempDir().Return(dir)
+	tt.taskA.EXPECT().Name().Return("taskA")
+	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).Return(tt.taskB, nil)
+	tt.taskB.EXPECT().Name().Return("taskB").Times(2)
+	tt.taskB.EXPECT().Run(tt.ctx, tt.cmdContext).Return(nil)
+	tt.taskB.EXPECT().Checkpoint()
+
+	runner := task.NewTaskRunner(tt.taskA, tt.writer,
+		task.WithResume(),
+		task.WithOperation(task.UpgradeOperation),
+		task.WithClusterGenerationReader(generationReader(4, nil)),
+	)
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+}
+
+func TestTaskRunnerRunTaskWithResumeGenerationNotAvailable(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	dir := writeCheckpoint(t, tt.cmdContext, task.DeleteOperation, 4)
+
+	tt.writer.EXPECT().TempDir().Return(dir)
+	tt.taskA.EXPECT().Name().Return("taskA")
+	tt.taskA.EXPECT().Restore(tt.ctx, tt.cmdContext, gomock.Any()).Return(nil, nil)
+
+	runner := task.NewTaskRunner(tt.taskA, tt.writer,
+		task.WithResume(),
+		task.WithOperation(task.DeleteOperation),
+		task.WithClusterGenerationReader(generationReader(0, fmt.Errorf("cluster not found"))),
+	)
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(Succeed())
+}
+
+func TestTaskRunnerRunTaskWithResumeInvalidCheckpoint(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   task.Operation
+		generation  int64
+		updateSpec  func(*task.CommandContext)
+		wantErrorIs string
+	}{
+		{
+			name:        "different operation",
+			operation:   task.DeleteOperation,
+			generation:  4,
+			wantErrorIs: "checkpoint was saved by a upgrade operation and can't be used to resume a delete operation",
+		},
+		{
+			name:       "different cluster spec",
+			operation:  task.UpgradeOperation,
+			generation: 4,
+			updateSpec: func(c *task.CommandContext) {
+				c.ClusterSpec.Cluster.Spec.KubernetesVersion = "1.29"
+			},
+			wantErrorIs: "cluster spec has changed since the checkpoint was saved",
+		},
+		{
+			name:        "different generation",
+			operation:   task.UpgradeOperation,
+			generation:  5,
+			wantErrorIs: "cluster test-cluster has been modified since the checkpoint was saved: generation was 4, now is 5",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tt := newTaskRunnerTest(t)
+			dir := writeCheckpoint(t, tt.cmdContext, task.UpgradeOperation, 4)
+			if tc.updateSpec != nil {
+				tc.updateSpec(tt.cmdContext)
+			}
+			tt.writer.EXPECT().TempDir().Return(dir)
+
+			runner := task.NewTaskRunner(tt.taskA, tt.writer,
+				task.WithResume(),
+				task.WithOperation(tc.operation),
+				task.WithClusterGenerationReader(generationReader(tc.generation, nil)),
+			)
+			g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(MatchError(ContainSubstring(tc.wantErrorIs)))
+		})
+	}
+}
+
+func TestTaskRunnerRunTaskWithResumeUnsupportedVersion(t *testing.T) {
+	g := NewWithT(t)
+	tt := newTaskRunnerTest(t)
+	dir := t.TempDir()
+	content := []byte("version: v2\ncompletedTasks: {}\n")
+	g.Expect(os.WriteFile(filepath.Join(dir, "test-cluster-checkpoint.yaml"), content, 0o644)).To(Succeed())
+	tt.writer.EXPECT().TempDir().Return(dir)
+
+	runner := task.NewTaskRunner(tt.taskA, tt.writer, task.WithResume())
+	g.Expect(runner.RunTask(tt.ctx, tt.cmdContext)).To(MatchError(ContainSubstring("unsupported checkpoint version v2")))
+}
+
+func generationReader(generation int64, err error) task.ClusterGenerationReader {
+	return func(_ context.Context, _ *task.CommandContext) (int64, error) {
+		return generation, err
+	}
+}
+
+// writeCheckpoint runs a failing task with a runner configured for the given operation and
+// generation and writes the saved checkpoint, with taskA as completed, to a temp folder.
+func writeCheckpoint(t *testing.T, cmdContext *task.CommandContext, operation task.Operation, generation int64) string {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	writer := writermocks.NewMockFileWriter(ctrl)
+	failedTask := mocktasks.NewMockTask(ctrl)
+	dir := t.TempDir()
+
+	failingContext := &task.CommandContext{ClusterSpec: cmdContext.ClusterSpec.DeepCopy()}
+	failedTask.EXPECT().Name().Return("taskA").Times(2)
+	failedTask.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, c *task.CommandContext) task.Task {
+		c.SetError(fmt.Errorf("error"))
+		return nil
+	})
+	writer.EXPECT().Write("test-cluster-checkpoint.yaml", gomock.Any()).DoAndReturn(
+		func(name string, content []byte, _ ...filewriter.FileOptionsFunc) (string, error) {
+			checkpoint := &task.CheckpointInfo{}
+			if err := yaml.Unmarshal(content, checkpoint); err != nil {
+				t.Fatal(err)
+			}
+			checkpoint.CompletedTasks["taskA"] = &task.CompletedTask{}
+			content, err := yaml.Marshal(checkpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return name, os.WriteFile(filepath.Join(dir, name), content, 0o644)
+		},
+	)
+
+	runner := task.NewTaskRunner(failedTask, writer, task.WithOperation(operation), task.WithClusterGenerationReader(generationReader(generation, nil)))
+	if err := runner.RunTask(context.Background(), failingContext); err == nil {
+		t.Fatal("RunTask() err = nil, want err not nil")
+	}
+
+	return dir
+}
+
 type taskRunnerTest struct {
 	ctx        context.Context
 	cmdContext *task.CommandContext
@@ -2,6 +2,8 @@ package types
 
 type ChangeDiff struct {
 	ComponentReports []ComponentChangeDiff `json:"components"`
+	// NodeGroups describes how the upgrade affects the machines of each node group.
+	NodeGroups []NodeGroupRollout `json:"nodeGroups,omitempty"`
 }
 
 type ComponentChangeDiff struct {
@@ -10,6 +12,49 @@ type ComponentChangeDiff struct {
 	NewVersion    string `json:"newVersion"`
 }
 
+// NodeRolloutAction is the action an upgrade takes on the machines of a node group.
+type NodeRolloutAction string
+
+const (
+	// NodeRolloutNone means the machines of the node group are not modified.
+	NodeRolloutNone NodeRolloutAction = "None"
+	// NodeRolloutReplace means the machines of the node group are replaced by new ones.
+	NodeRolloutReplace NodeRolloutAction = "Replace"
+	// NodeRolloutInPlace means the machines of the node group are upgraded in place.
+	NodeRolloutInPlace NodeRolloutAction = "InPlace"
+	// NodeRolloutCreate means the node group is new and its machines are created.
+	NodeRolloutCreate NodeRolloutAction = "Create"
+	// NodeRolloutDelete means the node group is removed and its machines are deleted.
+	NodeRolloutDelete NodeRolloutAction = "Delete"
+)
+
+// Node group roles.
+const (
+	NodeGroupRoleControlPlane = "control-plane"
+	NodeGroupRoleEtcd         = "etcd"
+	NodeGroupRoleWorker       = "worker"
+)
+
+// NodeGroupRollout describes how an upgrade affects the machines of a node group.
+type NodeGroupRollout struct {
+	Name   string            `json:"name"`
+	Role   string            `json:"role"`
+	Action NodeRolloutAction `json:"action"`
+	// Reasons are the changes that trigger the action.
+	Reasons []string `json:"reasons,omitempty"`
+	// Nodes is the number of machines affected by the action.
+	Nodes          int `json:"nodes"`
+	MaxSurge       int `json:"maxSurge"`
+	MaxUnavailable int `json:"maxUnavailable"`
+	// Batches is the estimated number of rollout steps, given the max surge and max unavailable.
+	Batches int `json:"batches"`
+}
+
+// Rolls returns true if the machines of the node group are replaced or upgraded in place.
+func (n NodeGroupRollout) Rolls() bool {
+	return n.Action == NodeRolloutReplace || n.Action == NodeRolloutInPlace
+}
+
 func NewChangeDiff(componentReports ...*ComponentChangeDiff) *ChangeDiff {
 	reports := make([]ComponentChangeDiff, 0, len(componentReports))
 	for _, r := range componentReports {
@@ -27,6 +72,7 @@ func (c *ChangeDiff) Append(changeDiffs ...*ChangeDiff) {
 	for _, diff := range changeDiffs {
 		if diff != nil {
 			c.ComponentReports = append(c.ComponentReports, diff.ComponentReports...)
+			c.NodeGroups = append(c.NodeGroups, diff.NodeGroups...)
 		}
 	}
 }
@@ -33,7 +33,7 @@ func TestAppend(t *testing.T) {
 				},
 			},
 			changeDiffs: &types.ChangeDiff{
-				[]types.ComponentChangeDiff{
+				ComponentReports: []types.ComponentChangeDiff{
 					{
 						ComponentName: "test",
 						OldVersion:    "0.0.1",
@@ -46,7 +46,7 @@ func TestAppend(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			changeDiffs := &types.ChangeDiff{tt.componentReports}
+			changeDiffs := &types.ChangeDiff{ComponentReports: tt.componentReports}
 			prevLen := len(tt.changeDiffs.ComponentReports)
 			tt.changeDiffs.Append(changeDiffs)
 
@@ -56,3 +56,19 @@ func TestAppend(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendNodeGroups(t *testing.T) {
+	changeDiff := &types.ChangeDiff{
+		ComponentReports: []types.ComponentChangeDiff{{ComponentName: "test"}},
+	}
+	changeDiff.Append(&types.ChangeDiff{
+		NodeGroups: []types.NodeGroupRollout{{Name: "md-0", Action: types.NodeRolloutReplace}},
+	})
+
+	if len(changeDiff.ComponentReports) != 1 || len(changeDiff.NodeGroups) != 1 {
+		t.Errorf("Node groups were not appended")
+	}
+	if !changeDiff.NodeGroups[0].Rolls() {
+		t.Errorf("NodeGroupRollout.Rolls() = false, want true")
+	}
+}
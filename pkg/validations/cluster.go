@@ -43,6 +43,9 @@ func ValidateOSForRegistryMirror(clusterSpec *cluster.Spec, provider providers.P
 		if mc.OSFamily() == v1alpha1.Bottlerocket && cluster.Spec.RegistryMirrorConfiguration.InsecureSkipVerify {
 			return errors.New("InsecureSkipVerify is not supported for bottlerocket")
 		}
+		if mc.OSFamily() == v1alpha1.Bottlerocket && len(cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints) > 0 {
+			return errors.New("FallbackEndpoints are not supported for bottlerocket")
+		}
 	}
 
 	ociNamespaces := cluster.Spec.RegistryMirrorConfiguration.OCINamespaces
@@ -59,23 +62,36 @@ func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsVa
 		return nil
 	}
 
-	if cluster.Spec.RegistryMirrorConfiguration.InsecureSkipVerify {
-		logger.V(1).Info("Warning: skip registry certificate verification is enabled", "registryMirrorConfiguration.insecureSkipVerify", true)
+	mirrorConfig := cluster.Spec.RegistryMirrorConfiguration
+	if err := validateCertForRegistryMirrorEndpoint(mirrorConfig.Endpoint, mirrorConfig.Port, mirrorConfig.CACertContent, mirrorConfig.InsecureSkipVerify, tlsValidator); err != nil {
+		return err
+	}
+
+	for _, fallback := range mirrorConfig.FallbackEndpoints {
+		if err := validateCertForRegistryMirrorEndpoint(fallback.Endpoint, fallback.Port, fallback.CACertContent, fallback.InsecureSkipVerify, tlsValidator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateCertForRegistryMirrorEndpoint(host, port, certContent string, insecureSkipVerify bool, tlsValidator TlsValidator) error {
+	if insecureSkipVerify {
+		logger.V(1).Info("Warning: skip registry certificate verification is enabled", "endpoint", host, "insecureSkipVerify", true)
 		return nil
 	}
 
-	host, port := cluster.Spec.RegistryMirrorConfiguration.Endpoint, cluster.Spec.RegistryMirrorConfiguration.Port
 	authorityUnknown, err := tlsValidator.IsSignedByUnknownAuthority(host, port)
 	if err != nil {
 		return fmt.Errorf("validating registry mirror endpoint: %v", err)
 	}
 	if authorityUnknown {
-		logger.V(1).Info(fmt.Sprintf("Warning: registry mirror endpoint %s is using self-signed certs", cluster.Spec.RegistryMirrorConfiguration.Endpoint))
+		logger.V(1).Info(fmt.Sprintf("Warning: registry mirror endpoint %s is using self-signed certs", host))
 	}
 
-	certContent := cluster.Spec.RegistryMirrorConfiguration.CACertContent
 	if certContent == "" && authorityUnknown {
-		return fmt.Errorf("registry %s is using self-signed certs, please provide the certificate using caCertContent field. Or use insecureSkipVerify field to skip registry certificate verification", cluster.Spec.RegistryMirrorConfiguration.Endpoint)
+		return fmt.Errorf("registry %s is using self-signed certs, please provide the certificate using caCertContent field. Or use insecureSkipVerify field to skip registry certificate verification", host)
 	}
 
 	if certContent != "" {
@@ -88,14 +104,26 @@ func ValidateCertForRegistryMirror(clusterSpec *cluster.Spec, tlsValidator TlsVa
 }
 
 // ValidateAuthenticationForRegistryMirror checks if REGISTRY_USERNAME and REGISTRY_PASSWORD is set if authenticated registry mirrors are used.
+// For authenticated fallback endpoints, it checks REGISTRY_USERNAME_<n> and REGISTRY_PASSWORD_<n>, n being the position of the endpoint.
 func ValidateAuthenticationForRegistryMirror(clusterSpec *cluster.Spec) error {
 	cluster := clusterSpec.Cluster
-	if cluster.Spec.RegistryMirrorConfiguration != nil && cluster.Spec.RegistryMirrorConfiguration.Authenticate {
+	if cluster.Spec.RegistryMirrorConfiguration == nil {
+		return nil
+	}
+	if cluster.Spec.RegistryMirrorConfiguration.Authenticate {
 		_, _, err := config.ReadCredentials()
 		if err != nil {
 			return err
 		}
 	}
+	for i, fallback := range cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints {
+		if !fallback.Authenticate {
+			continue
+		}
+		if _, _, err := config.ReadFallbackCredentials(i); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -329,3 +357,23 @@ func ValidateK8s133Support(clusterSpec *cluster.Spec) error {
 	}
 	return nil
 }
+
+// ValidateCiliumHubbleImages checks the bundle for the cluster Kubernetes version includes the images
+// for the Hubble components enabled in the Cilium config.
+func ValidateCiliumHubbleImages(clusterSpec *cluster.Spec) error {
+	cni := clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig
+	if cni == nil || cni.Cilium == nil || cni.Cilium.Hubble == nil {
+		return nil
+	}
+
+	hubble := cni.Cilium.Hubble
+	ciliumBundle := clusterSpec.RootVersionsBundle().Cilium
+	if hubble.Relay && ciliumBundle.HubbleRelay.URI == "" {
+		return fmt.Errorf("hubble relay is enabled but the bundle for kubernetes version %s doesn't include the hubble relay image", clusterSpec.Cluster.Spec.KubernetesVersion)
+	}
+	if hubble.UI && (ciliumBundle.HubbleUI.URI == "" || ciliumBundle.HubbleUIBackend.URI == "") {
+		return fmt.Errorf("hubble ui is enabled but the bundle for kubernetes version %s doesn't include the hubble ui images", clusterSpec.Cluster.Spec.KubernetesVersion)
+	}
+
+	return nil
+}
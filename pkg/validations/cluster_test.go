@@ -26,6 +26,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
 	"github.com/aws/eks-anywhere/pkg/validations/mocks"
+	releasev1alpha1 "github.com/aws/eks-anywhere/release/api/v1alpha1"
 )
 
 type fakeFileReader struct {
@@ -143,6 +144,27 @@ func TestValidateCertForRegistryMirrorInsecureSkip(t *testing.T) {
 	tt.Expect(validations.ValidateCertForRegistryMirror(tt.clusterSpec, tt.tlsValidator)).To(Succeed())
 }
 
+func TestValidateCertForRegistryMirrorFallbackEndpoints(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints = []anywherev1.RegistryMirrorEndpoint{
+		{
+			Endpoint:           "fallback-1.h",
+			Port:               "443",
+			InsecureSkipVerify: true,
+		},
+		{
+			Endpoint: "fallback-2.h",
+			Port:     "443",
+		},
+	}
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority(tt.host, tt.port).Return(false, nil)
+	tt.tlsValidator.EXPECT().IsSignedByUnknownAuthority("fallback-2.h", "443").Return(true, nil)
+
+	tt.Expect(validations.ValidateCertForRegistryMirror(tt.clusterSpec, tt.tlsValidator)).To(
+		MatchError(ContainSubstring("registry fallback-2.h is using self-signed certs, please provide the certificate using caCertContent field")),
+	)
+}
+
 func TestValidateAuthenticationForRegistryMirrorNoRegistryMirror(t *testing.T) {
 	tt := newTest(t, withTLS())
 	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration = nil
@@ -180,6 +202,48 @@ func TestValidateAuthenticationForRegistryMirrorAuthValid(t *testing.T) {
 	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
 }
 
+func TestValidateAuthenticationForRegistryMirrorFallbackAuth(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints = []anywherev1.RegistryMirrorEndpoint{
+		{
+			Endpoint: "fallback-1.h",
+			Port:     "443",
+		},
+		{
+			Endpoint:     "fallback-2.h",
+			Port:         "443",
+			Authenticate: true,
+		},
+	}
+	t.Setenv("REGISTRY_USERNAME_2", "username")
+
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(
+		MatchError(ContainSubstring("please set REGISTRY_PASSWORD_2 env var")))
+
+	t.Setenv("REGISTRY_PASSWORD_2", "password")
+	tt.Expect(validations.ValidateAuthenticationForRegistryMirror(tt.clusterSpec)).To(Succeed())
+}
+
+func TestValidateOSForRegistryMirrorFallbackEndpointsBottlerocket(t *testing.T) {
+	tt := newTest(t, withTLS())
+	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration.FallbackEndpoints = []anywherev1.RegistryMirrorEndpoint{
+		{
+			Endpoint: "fallback-1.h",
+			Port:     "443",
+		},
+	}
+	tt.provider.EXPECT().MachineConfigs(tt.clusterSpec).Return([]providers.MachineConfig{
+		&anywherev1.VSphereMachineConfig{
+			Spec: anywherev1.VSphereMachineConfigSpec{
+				OSFamily: anywherev1.Bottlerocket,
+			},
+		},
+	})
+
+	tt.Expect(validations.ValidateOSForRegistryMirror(tt.clusterSpec, tt.provider)).To(
+		MatchError("FallbackEndpoints are not supported for bottlerocket"))
+}
+
 func TestValidateOSForRegistryMirrorNoRegistryMirror(t *testing.T) {
 	tt := newTest(t, withTLS())
 	tt.clusterSpec.Cluster.Spec.RegistryMirrorConfiguration = nil
@@ -1173,3 +1237,56 @@ func TestValidateK8s133SupportActive(t *testing.T) {
 	os.Setenv(features.K8s133SupportEnvVar, "true")
 	tt.Expect(validations.ValidateK8s133Support(tt.clusterSpec)).To(Succeed())
 }
+
+func TestValidateCiliumHubbleImages(t *testing.T) {
+	image := releasev1alpha1.Image{URI: "public.ecr.aws/isovalent/hubble:v1.15.14-eksa.1"}
+	tests := []struct {
+		name    string
+		hubble  *anywherev1.CiliumHubbleConfig
+		bundle  releasev1alpha1.CiliumBundle
+		wantErr string
+	}{
+		{
+			name:   "hubble disabled",
+			hubble: nil,
+		},
+		{
+			name:   "hubble without relay or ui",
+			hubble: &anywherev1.CiliumHubbleConfig{},
+		},
+		{
+			name:   "relay and ui with images",
+			hubble: &anywherev1.CiliumHubbleConfig{Relay: true, UI: true},
+			bundle: releasev1alpha1.CiliumBundle{HubbleRelay: image, HubbleUI: image, HubbleUIBackend: image},
+		},
+		{
+			name:    "relay without image",
+			hubble:  &anywherev1.CiliumHubbleConfig{Relay: true},
+			wantErr: "hubble relay is enabled but the bundle for kubernetes version 1.19 doesn't include the hubble relay image",
+		},
+		{
+			name:    "ui without backend image",
+			hubble:  &anywherev1.CiliumHubbleConfig{Relay: true, UI: true},
+			bundle:  releasev1alpha1.CiliumBundle{HubbleRelay: image, HubbleUI: image},
+			wantErr: "hubble ui is enabled but the bundle for kubernetes version 1.19 doesn't include the hubble ui images",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tt := newTest(t)
+			tt.clusterSpec.Cluster.Spec.KubernetesVersion = anywherev1.Kube119
+			tt.clusterSpec.Cluster.Spec.ClusterNetwork.CNIConfig = &anywherev1.CNIConfig{
+				Cilium: &anywherev1.CiliumConfig{Hubble: tc.hubble},
+			}
+			tt.clusterSpec.RootVersionsBundle().Cilium = tc.bundle
+
+			err := validations.ValidateCiliumHubbleImages(tt.clusterSpec)
+			if tc.wantErr != "" {
+				tt.Expect(err).To(MatchError(tc.wantErr))
+			} else {
+				tt.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"runtime"
 
 	"github.com/aws/eks-anywhere/pkg/cluster"
-	"github.com/aws/eks-anywhere/pkg/gitops/flux"
 	"github.com/aws/eks-anywhere/pkg/kubeconfig"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/validations"
@@ -15,7 +14,7 @@ import (
 type ValidationManager struct {
 	clusterSpec       *cluster.Spec
 	provider          providers.Provider
-	gitOpsFlux        *flux.Flux
+	gitOps            GitOpsValidator
 	createValidations Validator
 	dockerExec        validations.DockerExecutable
 }
@@ -24,11 +23,16 @@ type Validator interface {
 	PreflightValidations(ctx context.Context) []validations.Validation
 }
 
-func NewValidations(clusterSpec *cluster.Spec, provider providers.Provider, gitOpsFlux *flux.Flux, createValidations Validator, dockerExec validations.DockerExecutable) *ValidationManager {
+// GitOpsValidator provides the validations of the GitOps engine configured for the cluster.
+type GitOpsValidator interface {
+	Validations(ctx context.Context, clusterSpec *cluster.Spec) []validations.Validation
+}
+
+func NewValidations(clusterSpec *cluster.Spec, provider providers.Provider, gitOps GitOpsValidator, createValidations Validator, dockerExec validations.DockerExecutable) *ValidationManager {
 	return &ValidationManager{
 		clusterSpec:       clusterSpec,
 		provider:          provider,
-		gitOpsFlux:        gitOpsFlux,
+		gitOps:            gitOps,
 		createValidations: createValidations,
 		dockerExec:        dockerExec,
 	}
@@ -37,7 +41,7 @@ func NewValidations(clusterSpec *cluster.Spec, provider providers.Provider, gitO
 func (v *ValidationManager) Validate(ctx context.Context) error {
 	runner := validations.NewRunner()
 	runner.Register(v.generateCreateValidations(ctx)...)
-	runner.Register(v.gitOpsFlux.Validations(ctx, v.clusterSpec)...)
+	runner.Register(v.gitOps.Validations(ctx, v.clusterSpec)...)
 	err := runner.Run()
 
 	return err
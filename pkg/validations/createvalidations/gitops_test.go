@@ -134,3 +134,43 @@ func TestValidateFluxConfigSuccess(t *testing.T) {
 	tt.k.EXPECT().GetObject(tt.ctx, "fluxconfigs.anywhere.eks.amazonaws.com", "gitops", "", "kubeconfig", &v1alpha1.FluxConfig{}).Return(nil)
 	tt.Expect(createvalidations.ValidateGitOps(tt.ctx, tt.k, tt.c.Opts.ManagementCluster, tt.c.Opts.Spec)).To(Succeed())
 }
+
+func TestValidateArgoCDConfigNameExists(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.Spec.ArgoCDConfig = &v1alpha1.ArgoCDConfig{}
+	tt.k.EXPECT().GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).Return(nil)
+	tt.Expect(createvalidations.ValidateGitOps(tt.ctx, tt.k, tt.c.Opts.ManagementCluster, tt.c.Opts.Spec)).To(MatchError(ContainSubstring("argoCDConfig gitops already exists")))
+}
+
+func TestValidateArgoCDConfigMgmtNotArgoCD(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.Spec.ArgoCDConfig = &v1alpha1.ArgoCDConfig{}
+	tt.k.EXPECT().GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).Return(apierrors.NewNotFound(schema.GroupResource{Group: "", Resource: ""}, ""))
+	tt.k.EXPECT().GetEksaCluster(tt.ctx, tt.c.Opts.Spec.ManagementCluster, tt.c.Opts.Spec.Cluster.ManagedBy()).Return(&v1alpha1.Cluster{Spec: v1alpha1.ClusterSpec{GitOpsRef: &v1alpha1.Ref{Kind: v1alpha1.FluxConfigKind, Name: "gitops"}}}, nil)
+	tt.Expect(createvalidations.ValidateGitOps(tt.ctx, tt.k, tt.c.Opts.ManagementCluster, tt.c.Opts.Spec)).To(MatchError(ContainSubstring("expected the management cluster to use an argoCDConfig")))
+}
+
+func TestValidateArgoCDConfigNotEqual(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.Spec.ArgoCDConfig = &v1alpha1.ArgoCDConfig{}
+	tt.k.EXPECT().GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).Return(apierrors.NewNotFound(schema.GroupResource{Group: "", Resource: ""}, ""))
+	tt.k.EXPECT().GetEksaCluster(tt.ctx, tt.c.Opts.Spec.ManagementCluster, tt.c.Opts.Spec.Cluster.ManagedBy()).Return(&v1alpha1.Cluster{Spec: v1alpha1.ClusterSpec{GitOpsRef: &v1alpha1.Ref{Kind: v1alpha1.ArgoCDConfigKind, Name: "gitops"}}}, nil)
+	tt.k.EXPECT().
+		GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "gitops", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).
+		DoAndReturn(func(_ context.Context, _, _, _, _ string, obj *v1alpha1.ArgoCDConfig) error {
+			obj.Spec = v1alpha1.ArgoCDConfigSpec{
+				SystemNamespace: "custom",
+			}
+			return nil
+		})
+	tt.Expect(createvalidations.ValidateGitOps(tt.ctx, tt.k, tt.c.Opts.ManagementCluster, tt.c.Opts.Spec)).To(MatchError(ContainSubstring("expected argoCDConfig.spec to be the same")))
+}
+
+func TestValidateArgoCDConfigSuccess(t *testing.T) {
+	tt := newPreflightValidationsTest(t)
+	tt.c.Opts.Spec.ArgoCDConfig = &v1alpha1.ArgoCDConfig{}
+	tt.k.EXPECT().GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).Return(apierrors.NewNotFound(schema.GroupResource{Group: "", Resource: ""}, ""))
+	tt.k.EXPECT().GetEksaCluster(tt.ctx, tt.c.Opts.Spec.ManagementCluster, tt.c.Opts.Spec.Cluster.ManagedBy()).Return(&v1alpha1.Cluster{Spec: v1alpha1.ClusterSpec{GitOpsRef: &v1alpha1.Ref{Kind: v1alpha1.ArgoCDConfigKind, Name: "gitops"}}}, nil)
+	tt.k.EXPECT().GetObject(tt.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", "gitops", "", "kubeconfig", &v1alpha1.ArgoCDConfig{}).Return(nil)
+	tt.Expect(createvalidations.ValidateGitOps(tt.ctx, tt.k, tt.c.Opts.ManagementCluster, tt.c.Opts.Spec)).To(Succeed())
+}
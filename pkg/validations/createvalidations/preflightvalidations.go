@@ -65,6 +65,13 @@ func (v *CreateValidations) PreflightValidations(ctx context.Context) []validati
 				Silent:      true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate cilium hubble images are available",
+				Remediation: "disable hubble relay and ui or use an EKS Anywhere version whose bundle includes the hubble images",
+				Err:         validations.ValidateCiliumHubbleImages(v.Opts.Spec),
+			}
+		},
 	}
 
 	if len(v.Opts.Spec.VSphereMachineConfigs) != 0 {
@@ -9,7 +9,8 @@ import (
 )
 
 func ValidateAuthenticationForGitProvider(clusterSpec *cluster.Spec, cliConfig *config.CliConfig) error {
-	if clusterSpec.FluxConfig == nil || clusterSpec.FluxConfig.Spec.Git == nil {
+	gitOpsConfig := clusterSpec.GitOpsRepositoryConfig()
+	if gitOpsConfig == nil || gitOpsConfig.Spec.Git == nil {
 		return nil
 	}
 
@@ -25,6 +26,11 @@ func ValidateAuthenticationForGitProvider(clusterSpec *cluster.Spec, cliConfig *
 		return fmt.Errorf("private key file does not exist at %s or is empty", cliConfig.GitPrivateKeyFile)
 	}
 
+	// Host keys pinned in the GitOps config take precedence over the known hosts file.
+	if gitOpsConfig.Spec.Git.KnownHosts != "" {
+		return nil
+	}
+
 	if cliConfig.GitKnownHostsFile == "" {
 		return errors.New("provide a path to an SSH Known Hosts file which contains a valid entry associate with the given private key via the EKSA_GIT_SSH_KNOWN_HOSTS environment variable")
 	}
@@ -110,6 +110,17 @@ func TestValidateGitOpsGitProviderNoAuthForWorkloadCluster(t *testing.T) {
 				GitKnownHostsFile:   "testdata/git_nonempty_ssh_known_hosts",
 			},
 		},
+		{
+			name:    "Pinned known hosts",
+			wantErr: nil,
+			git: &v1alpha1.GitProviderConfig{
+				RepositoryUrl: "testRepo",
+				KnownHosts:    "git.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+			},
+			cliConfig: &config.CliConfig{
+				GitPrivateKeyFile: "testdata/git_nonempty_private_key",
+			},
+		},
 		{
 			name:    "Empty known hosts",
 			wantErr: fmt.Errorf("SSH known hosts file does not exist at testdata/git_empty_file or is empty"),
@@ -163,3 +174,26 @@ func TestValidateGitOpsGitProviderNoAuthForWorkloadCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAuthenticationForGitProviderArgoCD(t *testing.T) {
+	clusterSpec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Spec.GitOpsRef = &v1alpha1.Ref{
+			Kind: v1alpha1.ArgoCDConfigKind,
+			Name: "gitopstest",
+		}
+		s.ArgoCDConfig = &v1alpha1.ArgoCDConfig{
+			Spec: v1alpha1.ArgoCDConfigSpec{
+				Git: &v1alpha1.GitProviderConfig{RepositoryUrl: "testRepo"},
+			},
+		}
+	})
+	cliConfig := &config.CliConfig{
+		GitPrivateKeyFile: emptyVar,
+		GitKnownHostsFile: "testdata/git_nonempty_ssh_known_hosts",
+	}
+
+	wantErr := fmt.Errorf("provide a path to a private key file via the EKSA_GIT_PRIVATE_KEY in order to use the generic git Flux provider")
+	if err := validations.ValidateAuthenticationForGitProvider(clusterSpec, cliConfig); !reflect.DeepEqual(err, wantErr) {
+		t.Errorf("got = %v, \nwant %v", err, wantErr)
+	}
+}
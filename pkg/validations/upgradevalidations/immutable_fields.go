@@ -7,11 +7,14 @@ import (
 
 	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
 	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/git/providers/gitlab"
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/types"
 	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
+var argoCDConfigResourceType = fmt.Sprintf("argocdconfigs.%s", v1alpha1.GroupVersion.Group)
+
 func ValidateImmutableFields(ctx context.Context, k validations.KubectlClient, cluster *types.Cluster, spec *cluster.Spec, provider providers.Provider) error {
 	prevSpec, err := k.GetEksaCluster(ctx, cluster, spec.Cluster.Name)
 	if err != nil {
@@ -66,6 +69,11 @@ func ValidateImmutableFields(ctx context.Context, k validations.KubectlClient, c
 		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.skipUpgrade cannot be toggled off")
 	}
 
+	// kube-proxy is removed when Cilium replaces it and EKS Anywhere doesn't reinstall it.
+	if oCNI != nil && oCNI.Cilium != nil && oCNI.Cilium.KubeProxyReplacement && (nCNI == nil || nCNI.Cilium == nil || !nCNI.Cilium.KubeProxyReplacement) {
+		return fmt.Errorf("spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement cannot be toggled off")
+	}
+
 	if !nSpec.ProxyConfiguration.Equal(oSpec.ProxyConfiguration) {
 		return fmt.Errorf("spec.proxyConfiguration is immutable")
 	}
@@ -173,6 +181,28 @@ func ValidateGitOpsImmutableFields(ctx context.Context, k validations.KubectlCli
 			}
 		}
 
+		if prevGitOps.Spec.Gitlab != nil {
+			if clusterSpec.FluxConfig.Spec.Gitlab == nil {
+				return errors.New("fluxConfig spec.gitlab is immutable")
+			}
+
+			if gitlab.Hostname(prevGitOps.Spec.Gitlab) != gitlab.Hostname(clusterSpec.FluxConfig.Spec.Gitlab) {
+				return errors.New("fluxConfig spec.gitlab.hostname is immutable")
+			}
+
+			if prevGitOps.Spec.Gitlab.Repository != clusterSpec.FluxConfig.Spec.Gitlab.Repository {
+				return errors.New("fluxConfig spec.gitlab.repository is immutable")
+			}
+
+			if prevGitOps.Spec.Gitlab.Owner != clusterSpec.FluxConfig.Spec.Gitlab.Owner {
+				return errors.New("fluxConfig spec.gitlab.owner is immutable")
+			}
+
+			if prevGitOps.Spec.Gitlab.Personal != clusterSpec.FluxConfig.Spec.Gitlab.Personal {
+				return errors.New("fluxConfig spec.gitlab.personal is immutable")
+			}
+		}
+
 		if prevGitOps.Spec.Branch != clusterSpec.FluxConfig.Spec.Branch {
 			return errors.New("fluxConfig spec.branch is immutable")
 		}
@@ -184,6 +214,16 @@ func ValidateGitOpsImmutableFields(ctx context.Context, k validations.KubectlCli
 		if prevGitOps.Spec.SystemNamespace != clusterSpec.FluxConfig.Spec.SystemNamespace {
 			return errors.New("fluxConfig spec.systemNamespace is immutable")
 		}
+
+	case v1alpha1.ArgoCDConfigKind:
+		prevGitOps := &v1alpha1.ArgoCDConfig{}
+		if err := k.GetObject(ctx, argoCDConfigResourceType, clusterSpec.Cluster.Spec.GitOpsRef.Name, clusterSpec.Cluster.Namespace, cluster.KubeconfigFile, prevGitOps); err != nil {
+			return err
+		}
+
+		if !prevGitOps.Spec.Equal(&clusterSpec.ArgoCDConfig.Spec) {
+			return errors.New("argoCDConfig spec is immutable")
+		}
 	}
 
 	return nil
@@ -246,6 +246,55 @@ func TestValidateGitOpsImmutableFieldsFluxConfig(t *testing.T) {
 	}
 }
 
+func TestValidateGitOpsImmutableFieldsArgoCDConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		new, old v1alpha1.ArgoCDConfigSpec
+		wantErr  string
+	}{
+		{
+			name: "no changes",
+			new:  v1alpha1.ArgoCDConfigSpec{Branch: "main", Github: &v1alpha1.GithubProviderConfig{Owner: "a", Repository: "b"}},
+			old:  v1alpha1.ArgoCDConfigSpec{Branch: "main", Github: &v1alpha1.GithubProviderConfig{Owner: "a", Repository: "b"}},
+		},
+		{
+			name:    "repository diff",
+			new:     v1alpha1.ArgoCDConfigSpec{Branch: "main", Github: &v1alpha1.GithubProviderConfig{Owner: "a", Repository: "b"}},
+			old:     v1alpha1.ArgoCDConfigSpec{Branch: "main", Github: &v1alpha1.GithubProviderConfig{Owner: "a", Repository: "c"}},
+			wantErr: "argoCDConfig spec is immutable",
+		},
+		{
+			name:    "branch diff",
+			new:     v1alpha1.ArgoCDConfigSpec{Branch: "main"},
+			old:     v1alpha1.ArgoCDConfigSpec{Branch: "dev"},
+			wantErr: "argoCDConfig spec is immutable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			g := newGitClientTest(t)
+			g.o.Spec.GitOpsRef.Kind = v1alpha1.ArgoCDConfigKind
+			g.s.Cluster.Spec.GitOpsRef.Kind = v1alpha1.ArgoCDConfigKind
+			g.s.ArgoCDConfig = &v1alpha1.ArgoCDConfig{Spec: tc.new}
+
+			g.k.EXPECT().
+				GetObject(g.ctx, "argocdconfigs.anywhere.eks.amazonaws.com", g.s.Cluster.Spec.GitOpsRef.Name, g.s.Cluster.Namespace, "kubeconfig", &v1alpha1.ArgoCDConfig{}).
+				DoAndReturn(func(_ context.Context, _, _, _, _ string, obj *v1alpha1.ArgoCDConfig) error {
+					obj.Spec = tc.old
+					return nil
+				})
+
+			err := upgradevalidations.ValidateGitOpsImmutableFields(g.ctx, g.k, g.c, g.s, g.o)
+			if tc.wantErr == "" {
+				g.Expect(err).To(Succeed())
+			} else {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.wantErr)))
+			}
+		})
+	}
+}
+
 func TestValidateImmutableFields(t *testing.T) {
 	tests := []struct {
 		Name             string
@@ -301,6 +350,22 @@ func TestValidateImmutableFields(t *testing.T) {
 			},
 			ExpectedError: "spec.clusterNetwork.cniConfig.cilium.skipUpgrade cannot be toggled off",
 		},
+		{
+			Name: "Toggle Spec.ClusterNetwork.CNIConfig.Cilium.KubeProxyReplacement off",
+			ConfigureCurrent: func(current *v1alpha1.Cluster) {
+				current.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{
+						KubeProxyReplacement: true,
+					},
+				}
+			},
+			ConfigureDesired: func(desired *v1alpha1.Cluster) {
+				desired.Spec.ClusterNetwork.CNIConfig = &v1alpha1.CNIConfig{
+					Cilium: &v1alpha1.CiliumConfig{},
+				}
+			},
+			ExpectedError: "spec.clusterNetwork.cniConfig.cilium.kubeProxyReplacement cannot be toggled off",
+		},
 	}
 
 	clstr := &types.Cluster{}
@@ -138,6 +138,13 @@ func (u *UpgradeValidations) PreflightValidations(ctx context.Context) []validat
 				Silent:      true,
 			}
 		},
+		func() *validations.ValidationResult {
+			return &validations.ValidationResult{
+				Name:        "validate cilium hubble images are available",
+				Remediation: "disable hubble relay and ui or use an EKS Anywhere version whose bundle includes the hubble images",
+				Err:         validations.ValidateCiliumHubbleImages(u.Opts.Spec),
+			}
+		},
 	}
 
 	if len(u.Opts.Spec.VSphereMachineConfigs) != 0 {
@@ -2,6 +2,9 @@ package workflow
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/eks-anywhere/pkg/events"
 )
 
 // Config is the configuration for constructing a Workflow instance.
@@ -57,23 +60,19 @@ func (w *Workflow) AppendTask(name TaskName, t Task) error {
 }
 
 // Execute executes the workflow running any pre and post hooks registered for each task.
-func (w *Workflow) Execute(ctx context.Context) error {
-	var err error
+func (w *Workflow) Execute(ctx context.Context) (err error) {
+	start := time.Now()
+	events.Record(events.Started(events.WorkflowStarted, ""))
+	defer func() {
+		events.Record(events.Finished(events.WorkflowFinished, events.WorkflowFailed, "", start, err))
+	}()
 
 	if ctx, err = runHooks(ctx, w.preWorkflowHooks); err != nil {
 		return w.handleError(ctx, err)
 	}
 
 	for _, task := range w.tasks {
-		if ctx, err = w.runPreTaskHooks(ctx, task.Name); err != nil {
-			return w.handleError(ctx, err)
-		}
-
-		if ctx, err = task.RunTask(ctx); err != nil {
-			return w.handleError(ctx, err)
-		}
-
-		if ctx, err = w.runPostTaskHooks(ctx, task.Name); err != nil {
+		if ctx, err = w.runTask(ctx, task); err != nil {
 			return w.handleError(ctx, err)
 		}
 	}
@@ -85,6 +84,25 @@ func (w *Workflow) Execute(ctx context.Context) error {
 	return nil
 }
 
+// runTask runs task together with its pre and post hooks.
+func (w *Workflow) runTask(ctx context.Context, task namedTask) (_ context.Context, err error) {
+	start := time.Now()
+	events.Record(events.Started(events.TaskStarted, string(task.Name)))
+	defer func() {
+		events.Record(events.Finished(events.TaskFinished, events.TaskFailed, string(task.Name), start, err))
+	}()
+
+	if ctx, err = w.runPreTaskHooks(ctx, task.Name); err != nil {
+		return ctx, err
+	}
+
+	if ctx, err = task.RunTask(ctx); err != nil {
+		return ctx, err
+	}
+
+	return w.runPostTaskHooks(ctx, task.Name)
+}
+
 // BindPreWorkflowHook implements the HookBinder interface.
 func (w *Workflow) BindPreWorkflowHook(t Task) {
 	w.preWorkflowHooks = append(w.preWorkflowHooks, t)
@@ -3,11 +3,13 @@ package workflow_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	gomock "github.com/golang/mock/gomock"
 	"github.com/onsi/gomega"
 
+	"github.com/aws/eks-anywhere/pkg/events"
 	"github.com/aws/eks-anywhere/pkg/workflow"
 )
 
@@ -348,3 +350,54 @@ func TestDuplicateTaskNames(t *testing.T) {
 	err = wflw.AppendTask(taskName, task2)
 	g.Expect(err).To(gomega.HaveOccurred())
 }
+
+type eventsRecorder struct {
+	lock   sync.Mutex
+	events []events.Event
+}
+
+func (r *eventsRecorder) Record(e events.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, e)
+}
+
+func TestWorkflowExecuteRecordsEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	g := gomega.NewWithT(t)
+	recorder := &eventsRecorder{}
+	events.SetRecorder(recorder)
+	t.Cleanup(func() { events.SetRecorder(nil) })
+
+	expect := errors.New("expected error")
+
+	task1 := NewMockTask(ctrl)
+	task1.EXPECT().
+		RunTask(gomock.Any()).
+		Return(context.Background(), nil)
+
+	task2 := NewMockTask(ctrl)
+	task2.EXPECT().
+		RunTask(gomock.Any()).
+		Return(context.Background(), expect)
+
+	wflw := workflow.New(workflow.Config{})
+	g.Expect(wflw.AppendTask("task1", task1)).To(gomega.Succeed())
+	g.Expect(wflw.AppendTask("task2", task2)).To(gomega.Succeed())
+
+	g.Expect(wflw.Execute(context.Background())).To(gomega.MatchError(expect))
+
+	var got []string
+	for _, e := range recorder.events {
+		got = append(got, string(e.Type)+":"+e.Task)
+	}
+	g.Expect(got).To(gomega.Equal([]string{
+		"WorkflowStarted:",
+		"TaskStarted:task1",
+		"TaskFinished:task1",
+		"TaskStarted:task2",
+		"TaskFailed:task2",
+		"WorkflowFailed:",
+	}))
+	g.Expect(recorder.events[4].Error).To(gomega.Equal("expected error"))
+}
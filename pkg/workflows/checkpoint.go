@@ -0,0 +1,33 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/task"
+	"github.com/aws/eks-anywhere/pkg/types"
+)
+
+// ClusterGenerationReader returns a task.ClusterGenerationReader that reads the EKS-A Cluster
+// object from the cluster returned by getCluster, usually the one that manages it.
+func ClusterGenerationReader(getCluster func(*task.CommandContext) *types.Cluster) task.ClusterGenerationReader {
+	return func(ctx context.Context, commandContext *task.CommandContext) (int64, error) {
+		c := getCluster(commandContext)
+		if c == nil {
+			return 0, errors.New("cluster holding the EKS-A Cluster object is not known")
+		}
+
+		client, err := commandContext.ClientFactory.BuildClientFromKubeconfig(c.KubeconfigFile)
+		if err != nil {
+			return 0, err
+		}
+
+		eksaCluster := &v1alpha1.Cluster{}
+		if err := client.Get(ctx, commandContext.ClusterSpec.Cluster.Name, commandContext.ClusterSpec.Cluster.Namespace, eksaCluster); err != nil {
+			return 0, err
+		}
+
+		return eksaCluster.Generation, nil
+	}
+}
@@ -0,0 +1,83 @@
+package workflows_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	"github.com/aws/eks-anywhere/internal/test"
+	"github.com/aws/eks-anywhere/pkg/api/v1alpha1"
+	"github.com/aws/eks-anywhere/pkg/cluster"
+	"github.com/aws/eks-anywhere/pkg/task"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/workflows"
+	"github.com/aws/eks-anywhere/pkg/workflows/interfaces/mocks"
+)
+
+func managementCluster(c *task.CommandContext) *types.Cluster {
+	return c.ManagementCluster
+}
+
+func TestClusterGenerationReader(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	clientFactory := mocks.NewMockClientFactory(gomock.NewController(t))
+	spec := test.NewClusterSpec(func(s *cluster.Spec) {
+		s.Cluster.Name = "my-cluster"
+		s.Cluster.Namespace = "default"
+	})
+	eksaCluster := spec.Cluster.DeepCopy()
+	eksaCluster.Generation = 3
+	commandContext := &task.CommandContext{
+		ClientFactory:     clientFactory,
+		ClusterSpec:       spec,
+		ManagementCluster: &types.Cluster{KubeconfigFile: "mgmt.kubeconfig"},
+	}
+	clientFactory.EXPECT().BuildClientFromKubeconfig("mgmt.kubeconfig").Return(test.NewFakeKubeClient(eksaCluster), nil)
+
+	generation, err := workflows.ClusterGenerationReader(managementCluster)(ctx, commandContext)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(generation).To(BeEquivalentTo(3))
+}
+
+func TestClusterGenerationReaderNoCluster(t *testing.T) {
+	g := NewWithT(t)
+	commandContext := &task.CommandContext{
+		ClusterSpec: test.NewClusterSpec(),
+	}
+
+	_, err := workflows.ClusterGenerationReader(managementCluster)(context.Background(), commandContext)
+	g.Expect(err).To(MatchError(ContainSubstring("not known")))
+}
+
+func TestClusterGenerationReaderClientError(t *testing.T) {
+	g := NewWithT(t)
+	clientFactory := mocks.NewMockClientFactory(gomock.NewController(t))
+	commandContext := &task.CommandContext{
+		ClientFactory:     clientFactory,
+		ClusterSpec:       test.NewClusterSpec(),
+		ManagementCluster: &types.Cluster{KubeconfigFile: "mgmt.kubeconfig"},
+	}
+	clientFactory.EXPECT().BuildClientFromKubeconfig("mgmt.kubeconfig").Return(nil, errors.New("client error"))
+
+	_, err := workflows.ClusterGenerationReader(managementCluster)(context.Background(), commandContext)
+	g.Expect(err).To(MatchError("client error"))
+}
+
+func TestClusterGenerationReaderClusterNotFound(t *testing.T) {
+	g := NewWithT(t)
+	clientFactory := mocks.NewMockClientFactory(gomock.NewController(t))
+	commandContext := &task.CommandContext{
+		ClientFactory:     clientFactory,
+		ClusterSpec:       test.NewClusterSpec(func(s *cluster.Spec) { s.Cluster = &v1alpha1.Cluster{} }),
+		ManagementCluster: &types.Cluster{KubeconfigFile: "mgmt.kubeconfig"},
+	}
+	commandContext.ClusterSpec.Cluster.Name = "missing"
+	clientFactory.EXPECT().BuildClientFromKubeconfig("mgmt.kubeconfig").Return(test.NewFakeKubeClient(), nil)
+
+	_, err := workflows.ClusterGenerationReader(managementCluster)(context.Background(), commandContext)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -451,6 +451,20 @@ func (mr *MockGitOpsManagerMockRecorder) ResumeClusterResourcesReconcile(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeClusterResourcesReconcile", reflect.TypeOf((*MockGitOpsManager)(nil).ResumeClusterResourcesReconcile), arg0, arg1, arg2, arg3)
 }
 
+// UninstallGitOps mocks base method.
+func (m *MockGitOpsManager) UninstallGitOps(arg0 context.Context, arg1 *types.Cluster, arg2 *cluster.Spec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallGitOps", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallGitOps indicates an expected call of UninstallGitOps.
+func (mr *MockGitOpsManagerMockRecorder) UninstallGitOps(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallGitOps", reflect.TypeOf((*MockGitOpsManager)(nil).UninstallGitOps), arg0, arg1, arg2)
+}
+
 // UpdateGitEksaSpec mocks base method.
 func (m *MockGitOpsManager) UpdateGitEksaSpec(arg0 context.Context, arg1 *cluster.Spec, arg2 providers.DatacenterConfig, arg3 []providers.MachineConfig) error {
 	m.ctrl.T.Helper()
@@ -76,3 +76,18 @@ func (c *Create) Run(ctx context.Context, clusterSpec *cluster.Spec, validator i
 
 	return task.NewTaskRunner(&setupAndValidateCreate{}, c.writer).RunTask(ctx, commandContext)
 }
+
+// newPostCreateTasks returns the tasks run once the management components have been moved to the
+// workload cluster. Installing GitOps, writing the cluster config and installing the curated packages
+// don't depend on each other, so they run in parallel before deleting the bootstrap cluster.
+func newPostCreateTasks() task.Task {
+	return &task.ParallelTask{
+		TaskName: "post-create-tasks",
+		Tasks: []task.Task{
+			&installGitOpsManagerTask{},
+			&writeCreateClusterConfig{},
+			&installCuratedPackagesTask{},
+		},
+		Next: &deleteBootstrapClusterTask{},
+	}
+}
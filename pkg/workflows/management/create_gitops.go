@@ -17,7 +17,7 @@ func (s *installGitOpsManagerTask) Run(ctx context.Context, commandContext *task
 	if err := commandContext.GitOpsManager.InstallGitOps(ctx, commandContext.WorkloadCluster, managementComponents, commandContext.ClusterSpec, commandContext.Provider.DatacenterConfig(commandContext.ClusterSpec), commandContext.Provider.MachineConfigs(commandContext.ClusterSpec)); err != nil {
 		logger.MarkFail("Error when installing GitOps toolkits on workload cluster; EKS-A will continue with cluster creation, but GitOps will not be enabled", "error", err)
 	}
-	return &writeCreateClusterConfig{}
+	return nil
 }
 
 func (s *installGitOpsManagerTask) Name() string {
@@ -89,7 +89,7 @@ func (s *installEksaComponentsOnWorkloadTask) Run(ctx context.Context, commandCo
 	}
 	logger.V(6).Info(string(resourcesSpec))
 
-	return &installGitOpsManagerTask{}
+	return newPostCreateTasks()
 }
 
 func (s *installEksaComponentsOnWorkloadTask) Name() string {
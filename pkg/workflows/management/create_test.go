@@ -870,6 +870,7 @@ func TestCreateWriteConfigFailure(t *testing.T) {
 	test.expectMoveManagement(nil)
 	test.expectInstallEksaComponentsWorkload(nil, nil, nil, nil, nil)
 	test.expectInstallGitOpsManager()
+	test.expectCuratedPackagesInstallation()
 	test.expectPreflightValidationsToPass()
 	test.expectCreateNamespace()
 	test.expectDatacenterConfig()
@@ -905,6 +906,7 @@ func TestCreateWriteConfigAWSIAMFailure(t *testing.T) {
 	test.expectMoveManagement(nil)
 	test.expectInstallEksaComponentsWorkload(nil, nil, nil, nil, nil)
 	test.expectInstallGitOpsManager()
+	test.expectCuratedPackagesInstallation()
 	test.expectPreflightValidationsToPass()
 	test.clusterSpec.AWSIamConfig = &v1alpha1.AWSIamConfig{}
 	test.expectWriteClusterConfig()
@@ -23,6 +23,17 @@ type Delete struct {
 	eksaInstaller  interfaces.EksaInstaller
 	clientFactory  interfaces.ClientFactory
 	clusterMover   interfaces.ClusterMover
+	resume         bool
+}
+
+// DeleteOpt allows to customize a Delete workflow on construction.
+type DeleteOpt func(*Delete)
+
+// WithDeleteResume makes the delete resume from the checkpoint saved by a previous failed run.
+func WithDeleteResume() DeleteOpt {
+	return func(d *Delete) {
+		d.resume = true
+	}
 }
 
 // NewDelete builds a new delete construct.
@@ -36,8 +47,9 @@ func NewDelete(bootstrapper interfaces.Bootstrapper,
 	eksaInstaller interfaces.EksaInstaller,
 	clientFactory interfaces.ClientFactory,
 	mover interfaces.ClusterMover,
+	opts ...DeleteOpt,
 ) *Delete {
-	return &Delete{
+	d := &Delete{
 		bootstrapper:   bootstrapper,
 		provider:       provider,
 		writer:         writer,
@@ -49,6 +61,12 @@ func NewDelete(bootstrapper interfaces.Bootstrapper,
 		clientFactory:  clientFactory,
 		clusterMover:   mover,
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 // Run executes the tasks to delete a management cluster.
@@ -68,5 +86,12 @@ func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *
 		ClusterMover:    c.clusterMover,
 	}
 
-	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer).RunTask(ctx, commandContext)
+	// The Cluster object is moved to the bootstrap cluster and deleted along the way,
+	// so only the cluster spec is used to validate the checkpoint.
+	runnerOpts := []task.TaskRunnerOpt{task.WithOperation(task.DeleteOperation)}
+	if c.resume {
+		runnerOpts = append(runnerOpts, task.WithResume())
+	}
+
+	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer, runnerOpts...).RunTask(ctx, commandContext)
 }
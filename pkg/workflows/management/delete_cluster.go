@@ -33,11 +33,13 @@ func (s *deleteManagementCluster) Name() string {
 }
 
 func (s *deleteManagementCluster) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &cleanupGitRepo{}, nil
 }
 
 func (s *deleteManagementCluster) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
 }
 
 type cleanupGitRepo struct{}
@@ -58,9 +60,11 @@ func (s *cleanupGitRepo) Name() string {
 }
 
 func (s *cleanupGitRepo) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &deleteBootstrapClusterForDeleteTask{}, nil
 }
 
 func (s *cleanupGitRepo) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
 }
@@ -2,12 +2,17 @@ package management
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/eks-anywhere/pkg/logger"
 	"github.com/aws/eks-anywhere/pkg/task"
+	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/validations"
 )
 
-type createBootStrapClusterForDeleteTask struct{}
+type createBootStrapClusterForDeleteTask struct {
+	BootstrapCluster *types.Cluster
+}
 
 func (s *createBootStrapClusterForDeleteTask) Run(ctx context.Context, commandContext *task.CommandContext) task.Task {
 	logger.Info("Creating new bootstrap cluster")
@@ -24,6 +29,7 @@ func (s *createBootStrapClusterForDeleteTask) Run(ctx context.Context, commandCo
 		return nil
 	}
 	commandContext.BootstrapCluster = bootstrapCluster
+	s.BootstrapCluster = bootstrapCluster
 
 	return &installCAPIComponentsForDeleteTask{}
 }
@@ -33,9 +39,46 @@ func (s *createBootStrapClusterForDeleteTask) Name() string {
 }
 
 func (s *createBootStrapClusterForDeleteTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	bootstrapCluster := &types.Cluster{}
+	if err := task.UnmarshalTaskCheckpoint(completedTask.Checkpoint, bootstrapCluster); err != nil {
+		return nil, fmt.Errorf("restoring bootstrap cluster from checkpoint: %v", err)
+	}
+
+	if bootstrapCluster.KubeconfigFile == "" || !validations.FileExists(bootstrapCluster.KubeconfigFile) {
+		// Once the cluster management has been moved, the cluster objects only exist in the
+		// bootstrap cluster, so a new one can't be used to delete the cluster.
+		if commandContext.TaskCompleted((&moveClusterManagementForDeleteTask{}).Name()) {
+			return nil, fmt.Errorf("bootstrap cluster %s from checkpoint doesn't exist anymore and the cluster management was already moved to it, the cluster objects can't be recovered to delete the cluster", bootstrapCluster.Name)
+		}
+		logger.Info("Bootstrap cluster from checkpoint doesn't exist anymore, creating a new one", "cluster", bootstrapCluster.Name, "kubeconfig", bootstrapCluster.KubeconfigFile)
+		return s.recreate(ctx, commandContext, completedTask), nil
+	}
+
+	logger.Info("Reusing existing bootstrap cluster", "cluster", bootstrapCluster.Name)
+	s.BootstrapCluster = bootstrapCluster
+	commandContext.BootstrapCluster = bootstrapCluster
+	return &installCAPIComponentsForDeleteTask{}, nil
+}
+
+// recreate creates a new bootstrap cluster and installs CAPI on it. The CAPI install is run
+// directly since it's also marked as completed in the checkpoint and would be skipped otherwise.
+// The checkpoint is updated so a later resume reuses the new bootstrap cluster.
+func (s *createBootStrapClusterForDeleteTask) recreate(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) task.Task {
+	next := s.Run(ctx, commandContext)
+	if commandContext.OriginalError != nil {
+		return next
+	}
+	completedTask.Checkpoint = s.BootstrapCluster
+
+	if installCAPI, ok := next.(*installCAPIComponentsForDeleteTask); ok {
+		return installCAPI.Run(ctx, commandContext)
+	}
+
+	return next
 }
 
 func (s *createBootStrapClusterForDeleteTask) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: s.BootstrapCluster,
+	}
 }
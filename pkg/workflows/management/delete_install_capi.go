@@ -33,9 +33,11 @@ func (s *installCAPIComponentsForDeleteTask) Name() string {
 }
 
 func (s *installCAPIComponentsForDeleteTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &moveClusterManagementForDeleteTask{}, nil
 }
 
 func (s *installCAPIComponentsForDeleteTask) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
 }
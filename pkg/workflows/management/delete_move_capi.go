@@ -18,6 +18,12 @@ func (s *moveClusterManagementForDeleteTask) Run(ctx context.Context, commandCon
 		return &workflows.CollectDiagnosticsTask{}
 	}
 
+	err = commandContext.GitOpsManager.UninstallGitOps(ctx, commandContext.WorkloadCluster, commandContext.ClusterSpec)
+	if err != nil {
+		commandContext.SetError(err)
+		return &workflows.CollectDiagnosticsTask{}
+	}
+
 	logger.Info("Moving cluster management from workload cluster to bootstrap")
 	err = commandContext.ClusterManager.MoveCAPI(ctx, commandContext.WorkloadCluster, commandContext.BootstrapCluster, commandContext.WorkloadCluster.Name, commandContext.ClusterSpec, types.WithNodeRef())
 	if err != nil {
@@ -33,9 +39,11 @@ func (s *moveClusterManagementForDeleteTask) Name() string {
 }
 
 func (s *moveClusterManagementForDeleteTask) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	return &installEksaComponentsOnBootstrapForDeleteTask{}, nil
 }
 
 func (s *moveClusterManagementForDeleteTask) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -164,6 +166,7 @@ func (c *deleteTestSetup) expectMoveCAPI(err1, err2 error) {
 	if err1 != nil {
 		return
 	}
+	c.gitopsManager.EXPECT().UninstallGitOps(c.ctx, c.workloadCluster, c.clusterSpec).Return(nil)
 	c.clusterManager.EXPECT().MoveCAPI(c.ctx, c.workloadCluster, c.bootstrapCluster, c.workloadCluster.Name, c.clusterSpec, gomock.Any()).Return(err2)
 }
 
@@ -321,6 +324,26 @@ func TestDeleteRunFailPauseEksa(t *testing.T) {
 	}
 }
 
+func TestDeleteRunFailUninstallGitOps(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.expectSetup(nil)
+	test.expectBootstrapOpts(nil)
+	test.expectCreateBootstrap(nil)
+	test.expectPreCAPI(nil)
+	test.expectInstallCAPI(nil)
+	test.clusterManager.EXPECT().PauseEKSAControllerReconcile(test.ctx, test.workloadCluster, test.clusterSpec, test.provider).Return(nil)
+	test.gitopsManager.EXPECT().UninstallGitOps(test.ctx, test.workloadCluster, test.clusterSpec).Return(fmt.Errorf(""))
+	test.expectSaveLogsWorkload()
+	test.expectSaveLogsManagement()
+
+	err := test.run()
+	if err == nil {
+		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
+	}
+}
+
 func TestDeleteRunFailMoveCAPI(t *testing.T) {
 	features.ClearCache()
 	os.Setenv(features.UseControllerForCli, "true")
@@ -561,3 +584,124 @@ func TestDeleteRunFailDeleteBootstrap(t *testing.T) {
 		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
 	}
 }
+
+func (c *deleteTestSetup) withResume() {
+	c.workload = management.NewDelete(
+		c.bootstrapper,
+		c.provider,
+		c.writer,
+		c.clusterManager,
+		c.gitopsManager,
+		c.clusterDeleter,
+		c.eksdInstaller,
+		c.eksaInstaller,
+		c.clientFactory,
+		c.mover,
+		management.WithDeleteResume(),
+	)
+}
+
+func (c *deleteTestSetup) writeCheckpoint(dir, bootstrapKubeconfig string, completedTasks ...string) {
+	checkpoint := fmt.Sprintf(`version: v1
+operation: delete
+clusterName: workload
+completedTasks:
+  setup-and-validate-delete:
+    checkpoint: null
+  bootstrap-cluster-for-delete-init:
+    checkpoint:
+      name: bootstrap
+      kubeconfigFile: %s
+  install-capi-components-bootstrap-for-delete:
+    checkpoint: null
+`, bootstrapKubeconfig)
+	for _, name := range completedTasks {
+		checkpoint += fmt.Sprintf("  %s:\n    checkpoint: null\n", name)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "workload-checkpoint.yaml"), []byte(checkpoint), 0o644); err != nil {
+		c.t.Fatal(err)
+	}
+	c.writer.EXPECT().TempDir().Return(dir)
+}
+
+func TestDeleteRunResumeSuccess(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.withResume()
+	dir := t.TempDir()
+	kubeconfig := filepath.Join(dir, "bootstrap.kubeconfig")
+	if err := os.WriteFile(kubeconfig, []byte{}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	test.bootstrapCluster = &types.Cluster{Name: "bootstrap", KubeconfigFile: kubeconfig}
+	test.writeCheckpoint(dir, kubeconfig)
+
+	test.expectSetup(nil)
+	test.expectMoveCAPI(nil, nil)
+	test.expectInstallEksaComponentsBootstrap(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	test.expectApplyOnBootstrap(nil)
+	test.expectDeleteCluster(nil, nil)
+	test.expectCleanupGitRepo(nil)
+	test.expectDeleteBootstrap(nil)
+
+	err := test.run()
+	if err != nil {
+		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
+	}
+}
+
+func TestDeleteRunResumeBootstrapClusterNotFound(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.withResume()
+	dir := t.TempDir()
+	test.writeCheckpoint(dir, filepath.Join(dir, "missing.kubeconfig"))
+
+	test.expectSetup(nil)
+	test.expectBootstrapOpts(nil)
+	test.expectCreateBootstrap(nil)
+	test.expectPreCAPI(nil)
+	test.expectInstallCAPI(nil)
+	test.expectMoveCAPI(nil, nil)
+	test.expectInstallEksaComponentsBootstrap(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	test.expectApplyOnBootstrap(nil)
+	test.expectDeleteCluster(nil, nil)
+	test.expectCleanupGitRepo(nil)
+	test.expectDeleteBootstrap(nil)
+
+	err := test.run()
+	if err != nil {
+		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
+	}
+}
+
+func TestDeleteRunResumeBootstrapClusterNotFoundAfterMove(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.withResume()
+	dir := t.TempDir()
+	test.writeCheckpoint(dir, filepath.Join(dir, "missing.kubeconfig"), "capi-management-move-for-delete")
+
+	test.expectSetup(nil)
+
+	err := test.run()
+	if err == nil || !strings.Contains(err.Error(), "cluster management was already moved to it") {
+		t.Fatalf("Delete.Run() err = %v, want bootstrap cluster lost after move error", err)
+	}
+}
+
+func TestDeleteRunResumeNoCheckpoint(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.withResume()
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
+	err := test.run()
+	if err == nil || !strings.Contains(err.Error(), "no checkpoint to resume from") {
+		t.Fatalf("Delete.Run() err = %v, want no checkpoint error", err)
+	}
+}
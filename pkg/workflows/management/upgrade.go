@@ -9,6 +9,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/task"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/workflows"
 	"github.com/aws/eks-anywhere/pkg/workflows/interfaces"
 )
 
@@ -26,6 +27,17 @@ type Upgrade struct {
 	clusterUpgrader   interfaces.ClusterUpgrader
 	packageManager    interfaces.PackageManager
 	iamAuth           interfaces.AwsIamAuth
+	resume            bool
+}
+
+// UpgradeOpt allows to customize an Upgrade workflow on construction.
+type UpgradeOpt func(*Upgrade)
+
+// WithUpgradeResume makes the upgrade resume from the checkpoint saved by a previous failed run.
+func WithUpgradeResume() UpgradeOpt {
+	return func(u *Upgrade) {
+		u.resume = true
+	}
 }
 
 // NewUpgrade builds a new upgrade construct.
@@ -39,6 +51,7 @@ func NewUpgrade(clientFactory interfaces.ClientFactory, provider providers.Provi
 	clusterUpgrade interfaces.ClusterUpgrader,
 	packageManager interfaces.PackageManager,
 	iamAuth interfaces.AwsIamAuth,
+	opts ...UpgradeOpt,
 ) *Upgrade {
 	upgradeChangeDiff := types.NewChangeDiff()
 	upgradeWorkflow := &Upgrade{
@@ -56,6 +69,10 @@ func NewUpgrade(clientFactory interfaces.ClientFactory, provider providers.Provi
 		iamAuth:           iamAuth,
 	}
 
+	for _, opt := range opts {
+		opt(upgradeWorkflow)
+	}
+
 	return upgradeWorkflow
 }
 
@@ -78,9 +95,20 @@ func (c *Upgrade) Run(ctx context.Context, clusterSpec *cluster.Spec, management
 		PackageManager:    c.packageManager,
 		IamAuth:           c.iamAuth,
 	}
-	if features.IsActive(features.CheckpointEnabled()) {
-		return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer, task.WithCheckpointFile()).RunTask(ctx, commandContext)
+
+	runnerOpts := []task.TaskRunnerOpt{
+		task.WithOperation(task.UpgradeOperation),
+		task.WithClusterGenerationReader(workflows.ClusterGenerationReader(managementClusterFromContext)),
+	}
+	if c.resume {
+		runnerOpts = append(runnerOpts, task.WithResume())
+	} else if features.IsActive(features.CheckpointEnabled()) {
+		runnerOpts = append(runnerOpts, task.WithCheckpointFile())
 	}
 
-	return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer).RunTask(ctx, commandContext)
+	return task.NewTaskRunner(&setupAndValidateUpgrade{}, c.writer, runnerOpts...).RunTask(ctx, commandContext)
+}
+
+func managementClusterFromContext(commandContext *task.CommandContext) *types.Cluster {
+	return commandContext.ManagementCluster
 }
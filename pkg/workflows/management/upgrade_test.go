@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -306,6 +307,7 @@ func (c *upgradeManagementTestSetup) expectSaveLogs() {
 
 func (c *upgradeManagementTestSetup) expectWriteCheckpointFile() {
 	gomock.InOrder(
+		c.clientFactory.EXPECT().BuildClientFromKubeconfig(c.managementCluster.KubeconfigFile).Return(c.client, nil),
 		c.writer.EXPECT().Write(fmt.Sprintf("%s-checkpoint.yaml", c.newClusterSpec.Cluster.Name), gomock.Any()),
 	)
 }
@@ -849,3 +851,41 @@ func TestTinkerbellUpgradeManagementRunSuccess(t *testing.T) {
 		t.Fatalf("UpgradeManagement.Run() err = %v, want err = nil", err)
 	}
 }
+
+func (c *upgradeManagementTestSetup) withResume() {
+	c.management = management.NewUpgrade(
+		c.clientFactory,
+		c.provider,
+		c.capiManager,
+		c.clusterManager,
+		c.gitOpsManager,
+		c.writer,
+		c.eksdUpgrader,
+		c.eksdInstaller,
+		c.clusterUpgrader,
+		c.packages,
+		c.iamAuth,
+		management.WithUpgradeResume(),
+	)
+}
+
+func TestUpgradeManagementRunResumeNoCheckpoint(t *testing.T) {
+	g := NewWithT(t)
+	test := newUpgradeManagementClusterTest(t)
+	test.withResume()
+	test.writer.EXPECT().TempDir().Return(t.TempDir())
+
+	g.Expect(test.run()).To(MatchError(ContainSubstring("no checkpoint to resume from")))
+}
+
+func TestUpgradeManagementRunResumeCheckpointFromDelete(t *testing.T) {
+	g := NewWithT(t)
+	test := newUpgradeManagementClusterTest(t)
+	test.withResume()
+	dir := t.TempDir()
+	checkpoint := []byte("version: v1\noperation: delete\nclusterName: management\ncompletedTasks: {}\n")
+	g.Expect(os.WriteFile(filepath.Join(dir, "management-checkpoint.yaml"), checkpoint, 0o644)).To(Succeed())
+	test.writer.EXPECT().TempDir().Return(dir)
+
+	g.Expect(test.run()).To(MatchError(ContainSubstring("can't be used to resume a upgrade operation")))
+}
@@ -130,9 +130,16 @@ func (s *setupAndValidateDelete) Name() string {
 }
 
 func (s *setupAndValidateDelete) Restore(ctx context.Context, commandContext *task.CommandContext, completedTask *task.CompletedTask) (task.Task, error) {
-	return nil, nil
+	if err := commandContext.Provider.SetupAndValidateDeleteCluster(ctx, commandContext.WorkloadCluster, commandContext.ClusterSpec); err != nil {
+		commandContext.SetError(err)
+		return nil, err
+	}
+
+	return &createBootStrapClusterForDeleteTask{}, nil
 }
 
 func (s *setupAndValidateDelete) Checkpoint() *task.CompletedTask {
-	return nil
+	return &task.CompletedTask{
+		Checkpoint: nil,
+	}
 }
@@ -59,7 +59,7 @@ func (s *writeCreateClusterConfig) Run(ctx context.Context, commandContext *task
 		}
 	}
 
-	return &deleteBootstrapClusterTask{}
+	return nil
 }
 
 func (s *writeCreateClusterConfig) Name() string {
@@ -18,6 +18,17 @@ type Delete struct {
 	clusterManager interfaces.ClusterManager
 	clusterDeleter interfaces.ClusterDeleter
 	gitopsManager  interfaces.GitOpsManager
+	resume         bool
+}
+
+// DeleteOpt allows to customize a Delete workflow on construction.
+type DeleteOpt func(*Delete)
+
+// WithDeleteResume makes the delete resume from the checkpoint saved by a previous failed run.
+func WithDeleteResume() DeleteOpt {
+	return func(d *Delete) {
+		d.resume = true
+	}
 }
 
 // NewDelete builds a new delete construct.
@@ -26,14 +37,21 @@ func NewDelete(provider providers.Provider,
 	clusterManager interfaces.ClusterManager,
 	clusterDeleter interfaces.ClusterDeleter,
 	gitopsManager interfaces.GitOpsManager,
+	opts ...DeleteOpt,
 ) *Delete {
-	return &Delete{
+	d := &Delete{
 		provider:       provider,
 		writer:         writer,
 		clusterManager: clusterManager,
 		clusterDeleter: clusterDeleter,
 		gitopsManager:  gitopsManager,
 	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 // Run executes the tasks to delete a workload cluster.
@@ -49,5 +67,10 @@ func (c *Delete) Run(ctx context.Context, workload *types.Cluster, clusterSpec *
 		GitOpsManager:     c.gitopsManager,
 	}
 
-	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer).RunTask(ctx, commandContext)
+	runnerOpts := []task.TaskRunnerOpt{task.WithOperation(task.DeleteOperation)}
+	if c.resume {
+		runnerOpts = append(runnerOpts, task.WithResume())
+	}
+
+	return task.NewTaskRunner(&setupAndValidateDelete{}, c.writer, runnerOpts...).RunTask(ctx, commandContext)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -160,3 +161,31 @@ func TestDeleteRunFailCleanup(t *testing.T) {
 		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
 	}
 }
+
+func TestDeleteRunResume(t *testing.T) {
+	features.ClearCache()
+	os.Setenv(features.UseControllerForCli, "true")
+	test := newDeleteTest(t)
+	test.workload = workload.NewDelete(test.provider, test.writer, test.clusterManager, test.clusterDeleter, test.gitopsManager, workload.WithDeleteResume())
+	dir := t.TempDir()
+	checkpoint := []byte(`version: v1
+operation: delete
+clusterName: workload
+completedTasks:
+  setup-and-validate-delete:
+    checkpoint: null
+  delete-workload-cluster:
+    checkpoint: null
+`)
+	if err := os.WriteFile(filepath.Join(dir, "workload-checkpoint.yaml"), checkpoint, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	test.writer.EXPECT().TempDir().Return(dir)
+	test.expectSetup(nil)
+	test.expectCleanup(nil)
+
+	err := test.run()
+	if err != nil {
+		t.Fatalf("Delete.Run() err = %v, want err = nil", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/eks-anywhere/pkg/providers"
 	"github.com/aws/eks-anywhere/pkg/task"
 	"github.com/aws/eks-anywhere/pkg/types"
+	"github.com/aws/eks-anywhere/pkg/workflows"
 	"github.com/aws/eks-anywhere/pkg/workflows/interfaces"
 )
 
@@ -22,6 +23,17 @@ type Upgrade struct {
 	clusterUpgrader  interfaces.ClusterUpgrader
 	packageInstaller interfaces.PackageManager
 	iamAuth          interfaces.AwsIamAuth
+	resume           bool
+}
+
+// UpgradeOpt allows to customize an Upgrade workflow on construction.
+type UpgradeOpt func(*Upgrade)
+
+// WithUpgradeResume makes the upgrade resume from the checkpoint saved by a previous failed run.
+func WithUpgradeResume() UpgradeOpt {
+	return func(u *Upgrade) {
+		u.resume = true
+	}
 }
 
 // NewUpgrade builds a new upgrade construct.
@@ -33,6 +45,7 @@ func NewUpgrade(clientFactory interfaces.ClientFactory,
 	eksdInstaller interfaces.EksdInstaller,
 	packageInstaller interfaces.PackageManager,
 	iamAuth interfaces.AwsIamAuth,
+	opts ...UpgradeOpt,
 ) *Upgrade {
 	upgradeWorkflow := &Upgrade{
 		clientFactory:    clientFactory,
@@ -46,6 +59,10 @@ func NewUpgrade(clientFactory interfaces.ClientFactory,
 		iamAuth:          iamAuth,
 	}
 
+	for _, opt := range opts {
+		opt(upgradeWorkflow)
+	}
+
 	return upgradeWorkflow
 }
 
@@ -65,5 +82,17 @@ func (c *Upgrade) Run(ctx context.Context, cluster *types.Cluster, clusterSpec *
 		IamAuth:           c.iamAuth,
 	}
 
-	return task.NewTaskRunner(&setAndValidateUpgradeWorkloadTask{}, c.writer).RunTask(ctx, commandContext)
+	runnerOpts := []task.TaskRunnerOpt{
+		task.WithOperation(task.UpgradeOperation),
+		task.WithClusterGenerationReader(workflows.ClusterGenerationReader(managementClusterFromContext)),
+	}
+	if c.resume {
+		runnerOpts = append(runnerOpts, task.WithResume())
+	}
+
+	return task.NewTaskRunner(&setAndValidateUpgradeWorkloadTask{}, c.writer, runnerOpts...).RunTask(ctx, commandContext)
+}
+
+func managementClusterFromContext(commandContext *task.CommandContext) *types.Cluster {
+	return commandContext.ManagementCluster
 }
@@ -176,6 +176,7 @@ func (c *upgradeTestSetup) expectSaveLogsManagement() {
 }
 
 func (c *upgradeTestSetup) expectWrite() {
+	c.clientFactory.EXPECT().BuildClientFromKubeconfig(c.clusterSpec.ManagementCluster.KubeconfigFile).Return(c.client, nil)
 	c.writer.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 }
 
@@ -66,6 +66,9 @@ func (vb *VersionsBundle) Manifests() map[string][]*string {
 		"kindnetd": {
 			&vb.Kindnetd.Manifest.URI,
 		},
+		"argo-cd": {
+			&vb.ArgoCD.Manifest.URI,
+		},
 		"eks-anywhere-cluster-controller": {
 			&vb.Eksa.Components.URI,
 		},
@@ -175,6 +178,33 @@ func (vb *VersionsBundle) NutanixImages() []Image {
 	return i
 }
 
+// ArgoCDImages returns images needed for the Argo CD GitOps engine in a VersionsBundle.
+// Bundles built before Argo CD was added don't include them.
+func (vb *VersionsBundle) ArgoCDImages() []Image {
+	if vb.ArgoCD.ArgoCD.URI == "" {
+		return nil
+	}
+
+	return []Image{
+		vb.ArgoCD.ArgoCD,
+		vb.ArgoCD.Dex,
+		vb.ArgoCD.Redis,
+	}
+}
+
+// CiliumHubbleImages returns the Hubble Relay and UI images in a VersionsBundle.
+// Bundles built before they were added don't include them.
+func (vb *VersionsBundle) CiliumHubbleImages() []Image {
+	var images []Image
+	for _, i := range []Image{vb.Cilium.HubbleRelay, vb.Cilium.HubbleUI, vb.Cilium.HubbleUIBackend} {
+		if i.URI != "" {
+			images = append(images, i)
+		}
+	}
+
+	return images
+}
+
 // SharedImages returns images that are shared across different providers in a VersionsBundle.
 func (vb *VersionsBundle) SharedImages() []Image {
 	return []Image{
@@ -223,6 +253,8 @@ func (vb *VersionsBundle) Images() []Image {
 		vb.SnowImages(),
 		vb.TinkerbellImages(),
 		vb.NutanixImages(),
+		vb.ArgoCDImages(),
+		vb.CiliumHubbleImages(),
 	}
 
 	size := 0
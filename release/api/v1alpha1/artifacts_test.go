@@ -127,6 +127,42 @@ func TestVersionsBundleSnowImages(t *testing.T) {
 	}
 }
 
+func TestVersionsBundleArgoCDImages(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect((&v1alpha1.VersionsBundle{}).ArgoCDImages()).To(BeEmpty())
+
+	vb := &v1alpha1.VersionsBundle{
+		ArgoCD: v1alpha1.ArgoCDBundle{
+			ArgoCD: v1alpha1.Image{Name: "argocd", URI: "argocd-uri"},
+			Dex:    v1alpha1.Image{Name: "dex", URI: "dex-uri"},
+			Redis:  v1alpha1.Image{Name: "redis", URI: "redis-uri"},
+		},
+	}
+	g.Expect(vb.ArgoCDImages()).To(Equal([]v1alpha1.Image{
+		{Name: "argocd", URI: "argocd-uri"},
+		{Name: "dex", URI: "dex-uri"},
+		{Name: "redis", URI: "redis-uri"},
+	}))
+}
+
+func TestVersionsBundleCiliumHubbleImages(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect((&v1alpha1.VersionsBundle{}).CiliumHubbleImages()).To(BeEmpty())
+
+	vb := &v1alpha1.VersionsBundle{
+		Cilium: v1alpha1.CiliumBundle{
+			HubbleRelay:     v1alpha1.Image{Name: "hubble-relay", URI: "hubble-relay-uri"},
+			HubbleUI:        v1alpha1.Image{Name: "hubble-ui", URI: "hubble-ui-uri"},
+			HubbleUIBackend: v1alpha1.Image{Name: "hubble-ui-backend", URI: "hubble-ui-backend-uri"},
+		},
+	}
+	g.Expect(vb.CiliumHubbleImages()).To(Equal([]v1alpha1.Image{
+		{Name: "hubble-relay", URI: "hubble-relay-uri"},
+		{Name: "hubble-ui", URI: "hubble-ui-uri"},
+		{Name: "hubble-ui-backend", URI: "hubble-ui-backend-uri"},
+	}))
+}
+
 func TestVersionsBundleSharedImages(t *testing.T) {
 	expectedSharedImages := make([]v1alpha1.Image, 27)
 	expectedSharedImages = append(
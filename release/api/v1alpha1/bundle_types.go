@@ -76,6 +76,7 @@ type VersionsBundle struct {
 	Cilium                     CiliumBundle                     `json:"cilium"`
 	Kindnetd                   KindnetdBundle                   `json:"kindnetd"`
 	Flux                       FluxBundle                       `json:"flux"`
+	ArgoCD                     ArgoCDBundle                     `json:"argoCD,omitempty"`
 	PackageController          PackageBundle                    `json:"packageController"`
 	BottleRocketHostContainers BottlerocketHostContainersBundle `json:"bottlerocketHostContainers"`
 	ExternalEtcdBootstrap      EtcdadmBootstrapBundle           `json:"etcdadmBootstrap"`
@@ -249,6 +250,12 @@ type CiliumBundle struct {
 	Operator  Image    `json:"operator"`
 	Manifest  Manifest `json:"manifest"`
 	HelmChart Image    `json:"helmChart,omitempty"`
+	// HubbleRelay is the Hubble Relay image, used when Hubble Relay is enabled.
+	HubbleRelay Image `json:"hubbleRelay,omitempty"`
+	// HubbleUI is the Hubble UI frontend image, used when the Hubble UI is enabled.
+	HubbleUI Image `json:"hubbleUI,omitempty"`
+	// HubbleUIBackend is the Hubble UI backend image, used when the Hubble UI is enabled.
+	HubbleUIBackend Image `json:"hubbleUIBackend,omitempty"`
 }
 
 // KindnetdBundle defines the Kindnetd version and manifest for this bundle.
@@ -266,6 +273,15 @@ type FluxBundle struct {
 	NotificationController Image  `json:"notificationController"`
 }
 
+// ArgoCDBundle defines the Argo CD components and versions used in this bundle.
+type ArgoCDBundle struct {
+	Version  string   `json:"version,omitempty"`
+	ArgoCD   Image    `json:"argoCD"`
+	Dex      Image    `json:"dex"`
+	Redis    Image    `json:"redis"`
+	Manifest Manifest `json:"manifest"`
+}
+
 // PackageBundle defines the EKS Anywhere package controller and related images for this bundle.
 type PackageBundle struct {
 	Version                   string `json:"version,omitempty"`
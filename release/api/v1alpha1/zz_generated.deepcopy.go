@@ -80,6 +80,25 @@ func (in *Archive) DeepCopy() *Archive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDBundle) DeepCopyInto(out *ArgoCDBundle) {
+	*out = *in
+	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
+	in.Dex.DeepCopyInto(&out.Dex)
+	in.Redis.DeepCopyInto(&out.Redis)
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDBundle.
+func (in *ArgoCDBundle) DeepCopy() *ArgoCDBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsBundle) DeepCopyInto(out *AwsBundle) {
 	*out = *in
@@ -279,6 +298,9 @@ func (in *CiliumBundle) DeepCopyInto(out *CiliumBundle) {
 	in.Operator.DeepCopyInto(&out.Operator)
 	out.Manifest = in.Manifest
 	in.HelmChart.DeepCopyInto(&out.HelmChart)
+	in.HubbleRelay.DeepCopyInto(&out.HubbleRelay)
+	in.HubbleUI.DeepCopyInto(&out.HubbleUI)
+	in.HubbleUIBackend.DeepCopyInto(&out.HubbleUIBackend)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumBundle.
@@ -1017,6 +1039,7 @@ func (in *VersionsBundle) DeepCopyInto(out *VersionsBundle) {
 	in.Cilium.DeepCopyInto(&out.Cilium)
 	out.Kindnetd = in.Kindnetd
 	in.Flux.DeepCopyInto(&out.Flux)
+	in.ArgoCD.DeepCopyInto(&out.ArgoCD)
 	in.PackageController.DeepCopyInto(&out.PackageController)
 	in.BottleRocketHostContainers.DeepCopyInto(&out.BottleRocketHostContainers)
 	in.ExternalEtcdBootstrap.DeepCopyInto(&out.ExternalEtcdBootstrap)
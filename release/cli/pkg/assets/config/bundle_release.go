@@ -21,6 +21,32 @@ import (
 )
 
 var bundleReleaseAssetsConfigMap = []assettypes.AssetConfig{
+	// Argo CD artifacts
+	{
+		ProjectName: "argo-cd",
+		ProjectPath: "projects/argoproj/argo-cd",
+		Images: []*assettypes.Image{
+			{
+				RepoName: "argocd",
+			},
+			{
+				RepoName: "dex",
+			},
+			{
+				RepoName: "redis",
+			},
+		},
+		ImageRepoPrefix: "argoproj",
+		ImageTagOptions: []string{
+			"gitTag",
+			"projectPath",
+		},
+		Manifests: []*assettypes.ManifestComponent{
+			{
+				ManifestFiles: []string{"install.yaml"},
+			},
+		},
+	},
 	// Boots artifacts
 	{
 		ProjectName: "boots",
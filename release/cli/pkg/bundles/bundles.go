@@ -106,6 +106,11 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 		return nil, errors.Wrapf(err, "Error getting bundle for Flux controllers")
 	}
 
+	argoCDBundle, err := GetArgoCDBundle(r, imageDigests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error getting bundle for Argo CD")
+	}
+
 	etcdadmBootstrapBundle, err := GetEtcdadmBootstrapBundle(r, imageDigests)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Error getting bundle for external Etcdadm bootstrap")
@@ -197,6 +202,7 @@ func GetVersionsBundles(r *releasetypes.ReleaseConfig, imageDigests releasetypes
 			Cilium:                     ciliumBundle,
 			Kindnetd:                   kindnetdBundle,
 			Flux:                       fluxBundle,
+			ArgoCD:                     argoCDBundle,
 			PackageController:          packageBundle,
 			ExternalEtcdBootstrap:      etcdadmBootstrapBundle,
 			ExternalEtcdController:     etcdadmControllerBundle,
@@ -28,12 +28,18 @@ import (
 )
 
 const (
-	ciliumImageName         = "cilium"
-	ciliumOperatorImageName = "operator-generic"
-	ciliumHelmChartName     = "cilium-chart"
-	ciliumHelmChart         = "cilium"
-	ciliumImage             = "cilium"
-	ciliumOperatorImage     = "operator-generic"
+	ciliumImageName          = "cilium"
+	ciliumOperatorImageName  = "operator-generic"
+	ciliumHelmChartName      = "cilium-chart"
+	ciliumHelmChart          = "cilium"
+	ciliumImage              = "cilium"
+	ciliumOperatorImage      = "operator-generic"
+	hubbleRelayImageName     = "hubble-relay"
+	hubbleRelayImage         = "hubble-relay"
+	hubbleUIImageName        = "hubble-ui"
+	hubbleUIImage            = "hubble-ui"
+	hubbleUIBackendImageName = "hubble-ui-backend"
+	hubbleUIBackendImage     = "hubble-ui-backend"
 )
 
 func GetCiliumBundle(r *releasetypes.ReleaseConfig) (anywherev1alpha1.CiliumBundle, error) {
@@ -50,6 +56,9 @@ func GetCiliumBundle(r *releasetypes.ReleaseConfig) (anywherev1alpha1.CiliumBund
 	ciliumImages := []imageDefinition{
 		containerImage(ciliumImageName, ciliumImage, ciliumContainerRegistry, ciliumGitTag),
 		containerImage(ciliumOperatorImageName, ciliumOperatorImage, ciliumContainerRegistry, ciliumGitTag),
+		containerImage(hubbleRelayImageName, hubbleRelayImage, ciliumContainerRegistry, ciliumGitTag),
+		containerImage(hubbleUIImageName, hubbleUIImage, ciliumContainerRegistry, ciliumGitTag),
+		containerImage(hubbleUIBackendImageName, hubbleUIBackendImage, ciliumContainerRegistry, ciliumGitTag),
 		// Helm charts are in the same repository and have the same
 		// sem version as the corresponding container image but omiting the initial "v"
 		chart(ciliumHelmChartName, ciliumHelmChart, ciliumContainerRegistry, strings.TrimPrefix(ciliumGitTag, "v")),
@@ -79,11 +88,14 @@ func GetCiliumBundle(r *releasetypes.ReleaseConfig) (anywherev1alpha1.CiliumBund
 	}
 
 	bundle := anywherev1alpha1.CiliumBundle{
-		Version:   ciliumGitTag,
-		Cilium:    bundleImageArtifacts[ciliumImageName],
-		Operator:  bundleImageArtifacts[ciliumOperatorImageName],
-		Manifest:  bundleManifestArtifacts["cilium.yaml"],
-		HelmChart: bundleImageArtifacts[ciliumHelmChartName],
+		Version:         ciliumGitTag,
+		Cilium:          bundleImageArtifacts[ciliumImageName],
+		Operator:        bundleImageArtifacts[ciliumOperatorImageName],
+		Manifest:        bundleManifestArtifacts["cilium.yaml"],
+		HelmChart:       bundleImageArtifacts[ciliumHelmChartName],
+		HubbleRelay:     bundleImageArtifacts[hubbleRelayImageName],
+		HubbleUI:        bundleImageArtifacts[hubbleUIImageName],
+		HubbleUIBackend: bundleImageArtifacts[hubbleUIBackendImageName],
 	}
 
 	return bundle, nil
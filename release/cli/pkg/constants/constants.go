@@ -27,6 +27,7 @@ const (
 	YamlSeparator            = "\n---\n"
 
 	// Project paths.
+	ArgoCDProjectPath                   = "projects/argoproj/argo-cd"
 	CapasProjectPath                    = "projects/aws/cluster-api-provider-aws-snow"
 	CapcProjectPath                     = "projects/kubernetes-sigs/cluster-api-provider-cloudstack"
 	CapiProjectPath                     = "projects/kubernetes-sigs/cluster-api"